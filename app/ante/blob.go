@@ -0,0 +1,126 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package ante
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// CheckBlobTxs validates the EIP-4844 blob transactions and charges their blob
+// gas at the blob base fee of the block. The blob fee isn't refunded.
+//
+// The sidecars are only verified in CheckTx: the proposer strips them from the
+// transactions of the block, which only carry the versioned hashes of the blobs.
+//
+// This AnteHandler decorator will fail if:
+// - blob transactions aren't enabled yet (Cancun)
+// - the blob fee cap is lower than the blob base fee
+// - the sidecar is missing or its KZG proofs don't match the blobs, in CheckTx
+// - the sidecar isn't stripped, outside of CheckTx
+// - the block blob gas limit is exceeded
+// - user doesn't have enough balance to deduct the blob fees
+func CheckBlobTxs(
+	ctx sdk.Context, tx sdk.Tx,
+	ethCfg *params.ChainConfig,
+	evmKeeper EVMKeeper,
+	feeMarketKeeper FeeMarketKeeper,
+	evmDenom string,
+) error {
+	for _, msg := range tx.GetMsgs() {
+		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			return errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid message type %T, expected %T", msg, (*evmtypes.MsgEthereumTx)(nil))
+		}
+
+		txData, err := evmtypes.UnpackTxData(msgEthTx.Data)
+		if err != nil {
+			return errorsmod.Wrap(err, "failed to unpack tx data")
+		}
+
+		blobTx, ok := txData.(*evmtypes.BlobTx)
+		if !ok {
+			continue
+		}
+
+		if !ethCfg.IsCancun(big.NewInt(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())) {
+			return errorsmod.Wrap(evmtypes.ErrTxTypeNotSupported, "blob transactions are enabled by Cancun")
+		}
+
+		blobBaseFee := feeMarketKeeper.GetBlobBaseFee(ctx)
+		if blobBaseFee == nil {
+			return errorsmod.Wrap(evmtypes.ErrTxTypeNotSupported, "blob transactions require the base fee")
+		}
+
+		// the sidecar and the balance are already checked during CheckTx so
+		// there's no need to verify them again during ReCheckTx
+		if ctx.IsReCheckTx() {
+			continue
+		}
+
+		if blobTx.GetBlobFeeCap().Cmp(blobBaseFee) < 0 {
+			return errorsmod.Wrapf(
+				errortypes.ErrInsufficientFee,
+				"max fee per blob gas less than block blob base fee (%s < %s)",
+				blobTx.GetBlobFeeCap(), blobBaseFee,
+			)
+		}
+
+		if ctx.IsCheckTx() {
+			if err := blobTx.VerifySidecar(); err != nil {
+				return err
+			}
+		} else if blobTx.Sidecar != nil {
+			return errorsmod.Wrap(evmtypes.ErrInvalidBlob, "blob sidecar must be stripped from the block transactions")
+		}
+
+		if !ctx.IsCheckTx() {
+			blobGasUsed := feeMarketKeeper.AddTransientBlobGasUsed(ctx, blobTx.BlobGas())
+			if blobGasUsed > params.MaxBlobGasPerBlock {
+				return errorsmod.Wrapf(
+					evmtypes.ErrInvalidBlob,
+					"block blob gas (%d) exceeds limit (%d)",
+					blobGasUsed, params.MaxBlobGasPerBlock,
+				)
+			}
+		}
+
+		blobFee := new(big.Int).Mul(blobBaseFee, new(big.Int).SetUint64(blobTx.BlobGas()))
+		fees := sdk.Coins{sdk.NewCoin(evmDenom, sdkmath.NewIntFromBigInt(blobFee))}
+		if err := evmKeeper.DeductTxCostsFromUserBalance(ctx, fees, common.BytesToAddress(msgEthTx.From)); err != nil {
+			return errorsmod.Wrapf(err, "failed to deduct blob fees from user balance")
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				sdk.EventTypeTx,
+				sdk.NewAttribute(evmtypes.AttributeKeyBlobFee, fees.String()),
+			),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package ante_test
+
+import (
+	"math/big"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
+
+	"github.com/evmos/ethermint/app/ante"
+	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+	"github.com/evmos/ethermint/tests"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+func (suite *AnteTestSuite) TestCheckBlobTxs() {
+	addr, priv := tests.NewAddrKey()
+	key, err := priv.(*ethsecp256k1.PrivKey).ToECDSA()
+	suite.Require().NoError(err)
+
+	evmParams := suite.app.EvmKeeper.GetParams(suite.ctx)
+	chainID := suite.app.EvmKeeper.ChainID()
+	ethCfg := evmParams.GetChainConfig().EthereumConfig(chainID)
+	blobBaseFee := suite.app.FeeMarketKeeper.GetBlobBaseFee(suite.ctx)
+	suite.Require().NotNil(blobBaseFee)
+
+	var blob kzg4844.Blob
+	commitment, err := kzg4844.BlobToCommitment(blob)
+	suite.Require().NoError(err)
+	proof, err := kzg4844.ComputeBlobProof(blob, commitment)
+	suite.Require().NoError(err)
+	otherBlob := blob
+	otherBlob[31] = 1
+
+	newBlobTx := func(blobFeeCap *big.Int, blobs ...kzg4844.Blob) *evmtypes.MsgEthereumTx {
+		sidecar := &ethtypes.BlobTxSidecar{Blobs: blobs}
+		for range blobs {
+			sidecar.Commitments = append(sidecar.Commitments, commitment)
+			sidecar.Proofs = append(sidecar.Proofs, proof)
+		}
+		ethTx, err := ethtypes.SignNewTx(key, ethtypes.LatestSignerForChainID(chainID), &ethtypes.BlobTx{
+			ChainID:    uint256.MustFromBig(chainID),
+			GasTipCap:  uint256.NewInt(1),
+			GasFeeCap:  uint256.NewInt(1),
+			Gas:        21000,
+			To:         tests.GenerateAddress(),
+			Value:      uint256.NewInt(0),
+			BlobFeeCap: uint256.MustFromBig(blobFeeCap),
+			BlobHashes: sidecar.BlobHashes(),
+			Sidecar:    sidecar,
+		})
+		suite.Require().NoError(err)
+		msg := &evmtypes.MsgEthereumTx{}
+		suite.Require().NoError(msg.FromSignedEthereumTx(ethTx, chainID))
+		return msg
+	}
+	// the sidecars are stripped from the block transactions
+	stripped := func(msg *evmtypes.MsgEthereumTx) *evmtypes.MsgEthereumTx {
+		_, err := msg.StripBlobSidecar()
+		suite.Require().NoError(err)
+		return msg
+	}
+
+	blobFee := new(big.Int).Mul(blobBaseFee, big.NewInt(params.BlobTxBlobGasPerBlob))
+
+	testCases := []struct {
+		name     string
+		tx       *evmtypes.MsgEthereumTx
+		balance  *big.Int
+		expPass  bool
+		checkTx  bool
+		blobUsed uint64
+	}{
+		{"success", stripped(newBlobTx(blobBaseFee, blob)), blobFee, true, false, params.BlobTxBlobGasPerBlob},
+		{"success, check tx doesn't count the blob gas", newBlobTx(blobBaseFee, blob), blobFee, true, true, 0},
+		{"blob fee cap lower than blob base fee", stripped(newBlobTx(new(big.Int).Sub(blobBaseFee, big.NewInt(1)), blob)), blobFee, false, false, 0},
+		{"invalid proof", newBlobTx(blobBaseFee, otherBlob), blobFee, false, true, 0},
+		{"missing sidecar in check tx", stripped(newBlobTx(blobBaseFee, blob)), blobFee, false, true, 0},
+		{"sidecar in a block transaction", newBlobTx(blobBaseFee, blob), blobFee, false, false, 0},
+		{"insufficient balance", stripped(newBlobTx(blobBaseFee, blob)), new(big.Int).Sub(blobFee, big.NewInt(1)), false, false, params.BlobTxBlobGasPerBlob},
+		{
+			"block blob gas limit exceeded",
+			stripped(newBlobTx(blobBaseFee, blob, blob, blob, blob, blob, blob, blob)),
+			new(big.Int).Mul(blobFee, big.NewInt(7)),
+			false, false,
+			7 * params.BlobTxBlobGasPerBlob,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			vmdb := suite.StateDB()
+			vmdb.AddBalance(addr, tc.balance)
+			suite.Require().NoError(vmdb.Commit())
+
+			ctx := suite.ctx.WithIsCheckTx(tc.checkTx)
+			err := ante.CheckBlobTxs(ctx, tc.tx, ethCfg, suite.app.EvmKeeper, suite.app.FeeMarketKeeper, evmParams.EvmDenom)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				balance := suite.app.EvmKeeper.GetBalance(suite.ctx, addr.Bytes(), evmParams.EvmDenom)
+				suite.Require().Zero(balance.Sign())
+			} else {
+				suite.Require().Error(err)
+			}
+			suite.Require().Equal(tc.blobUsed, suite.app.FeeMarketKeeper.GetTransientBlobGasUsed(suite.ctx))
+		})
+	}
+}
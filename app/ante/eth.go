@@ -27,7 +27,9 @@ import (
 
 	ethermint "github.com/evmos/ethermint/types"
 	"github.com/evmos/ethermint/x/evm/keeper"
+	"github.com/evmos/ethermint/x/evm/statedb"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	feemarkettypes "github.com/evmos/ethermint/x/feemarket/types"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/params"
@@ -39,9 +41,13 @@ import (
 // - any of the msgs is not a MsgEthereumTx
 // - from address is empty
 // - account balance is lower than the transaction cost
+//
+// When the gas is paid with a fee token, the evm denom balance only has to cover the
+// transferred value and the fee token balance the converted fee.
 func VerifyEthAccount(
 	ctx sdk.Context, tx sdk.Tx,
 	evmKeeper EVMKeeper, ak evmtypes.AccountKeeper, evmDenom string,
+	feePayment *feemarkettypes.FeePayment,
 ) error {
 	if !ctx.IsCheckTx() {
 		return nil
@@ -71,19 +77,38 @@ func VerifyEthAccount(
 		if acct == nil {
 			acc := ak.NewAccountWithAddress(ctx, from)
 			ak.SetAccount(ctx, acc)
-		} else if acct.IsContract() {
+		} else if acct.IsContract() && !isDelegated(ctx, evmKeeper, acct) {
 			return errorsmod.Wrapf(errortypes.ErrInvalidType,
 				"the sender is not EOA: address %s, codeHash <%s>", fromAddr, acct.CodeHash)
 		}
 
 		balance := evmKeeper.GetBalance(ctx, sdk.AccAddress(fromAddr.Bytes()), evmDenom)
-		if err := keeper.CheckSenderBalance(sdkmath.NewIntFromBigInt(balance), txData); err != nil {
-			return errorsmod.Wrap(err, "failed to check sender balance")
+		if feePayment == nil {
+			if err := keeper.CheckSenderBalance(sdkmath.NewIntFromBigInt(balance), txData); err != nil {
+				return errorsmod.Wrap(err, "failed to check sender balance")
+			}
+			continue
+		}
+
+		if value := txData.GetValue(); value != nil && balance.Cmp(value) < 0 {
+			return errorsmod.Wrapf(errortypes.ErrInsufficientFunds, "sender balance < tx value (%s < %s)", balance, value)
+		}
+		fee := feePayment.ConvertFee(sdkmath.NewIntFromBigInt(txData.Fee()))
+		feeBalance := evmKeeper.GetBalance(ctx, sdk.AccAddress(fromAddr.Bytes()), feePayment.Denom)
+		if feeBalance.Cmp(fee.Amount.BigInt()) < 0 {
+			return errorsmod.Wrapf(errortypes.ErrInsufficientFunds, "sender balance < tx fee (%s%s < %s)", feeBalance, feePayment.Denom, fee)
 		}
 	}
 	return nil
 }
 
+// isDelegated returns true if the code of the account is an EIP-7702 delegation
+// designator, such accounts are still EOAs.
+func isDelegated(ctx sdk.Context, evmKeeper EVMKeeper, acct *statedb.Account) bool {
+	_, ok := evmtypes.ParseDelegation(evmKeeper.GetCode(ctx, common.BytesToHash(acct.CodeHash)))
+	return ok
+}
+
 // CheckEthGasConsume validates that the Ethereum tx message has enough to cover intrinsic gas
 // (during CheckTx only) and that the sender has enough balance to pay for the gas cost.
 //
@@ -95,7 +120,8 @@ func VerifyEthAccount(
 // - the message is not a MsgEthereumTx
 // - sender account cannot be found
 // - transaction's gas limit is lower than the intrinsic gas
-// - user doesn't have enough balance to deduct the transaction fees (gas_limit * gas_price)
+// - user doesn't have enough balance to deduct the transaction fees (gas_limit * gas_price), in the
+// fee token if any
 // - transaction or block gas meter runs out of gas
 // - sets the gas meter limit
 // - gas limit is greater than the block gas meter limit
@@ -106,6 +132,7 @@ func CheckEthGasConsume(
 	baseFee *big.Int,
 	maxGasWanted uint64,
 	evmDenom string,
+	feePayment *feemarkettypes.FeePayment,
 ) (sdk.Context, error) {
 	gasWanted := uint64(0)
 
@@ -156,6 +183,9 @@ func CheckEthGasConsume(
 		if err != nil {
 			return ctx, errorsmod.Wrapf(err, "failed to verify the fees")
 		}
+		if feePayment != nil {
+			fees = sdk.NewCoins(feePayment.ConvertFee(fees.AmountOf(evmDenom)))
+		}
 
 		err = evmKeeper.DeductTxCostsFromUserBalance(ctx, fees, common.BytesToAddress(msgEthTx.From))
 		if err != nil {
@@ -89,7 +89,7 @@ func (suite *AnteTestSuite) TestNewEthAccountVerificationDecorator() {
 			tc.malleate()
 			suite.Require().NoError(vmdb.Commit())
 
-			err := ante.VerifyEthAccount(suite.ctx.WithIsCheckTx(tc.checkTx), tc.tx, suite.app.EvmKeeper, suite.app.AccountKeeper, evmtypes.DefaultEVMDenom)
+			err := ante.VerifyEthAccount(suite.ctx.WithIsCheckTx(tc.checkTx), tc.tx, suite.app.EvmKeeper, suite.app.AccountKeeper, evmtypes.DefaultEVMDenom, nil)
 
 			if tc.expPass {
 				suite.Require().NoError(err)
@@ -155,6 +155,57 @@ func (suite *AnteTestSuite) TestEthNonceVerificationDecorator() {
 	}
 }
 
+func (suite *AnteTestSuite) TestEthPendingNonce() {
+	suite.SetupTest()
+
+	addr := tests.GenerateAddress()
+	acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr.Bytes())
+	suite.Require().NoError(acc.SetSequence(5))
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+	newTx := func(nonce uint64) *evmtypes.MsgEthereumTx {
+		tx := evmtypes.NewTxContract(suite.app.EvmKeeper.ChainID(), nonce, big.NewInt(10), 1000, big.NewInt(1), nil, nil, nil, nil)
+		tx.From = addr.Bytes()
+		return tx
+	}
+
+	testCases := []struct {
+		name      string
+		tx        sdk.Tx
+		reCheckTx bool
+		expPass   bool
+		expSeq    uint64
+	}{
+		{"invalid transaction type", &invalidTx{}, false, false, 5},
+		{"next nonce", newTx(5), false, true, 6},
+		{"next nonce on recheck", newTx(5), true, true, 6},
+		{"replacement", newTx(4), false, true, 5},
+		{"replacement on recheck", newTx(4), true, false, 5},
+		{"queued", newTx(7), false, true, 5},
+		{"queued on recheck", newTx(7), true, true, 5},
+		{"nonce gap too large", newTx(8), false, false, 5},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.WithIsReCheckTx(tc.reCheckTx).CacheContext()
+			newCtx, err := ante.CheckEthPendingNonce(ctx, tc.tx, suite.app.AccountKeeper, 2)
+
+			seq, seqErr := suite.app.AccountKeeper.GetSequence(ctx, addr.Bytes())
+			suite.Require().NoError(seqErr)
+			suite.Require().Equal(tc.expSeq, seq)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				nonce, ok := ante.SenderNonceFromContext(newCtx)
+				suite.Require().True(ok)
+				suite.Require().Equal(uint64(5), nonce)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *AnteTestSuite) TestEthGasConsumeDecorator() {
 	evmParams := suite.app.EvmKeeper.GetParams(suite.ctx)
 	chainID := suite.app.EvmKeeper.ChainID()
@@ -298,7 +349,7 @@ func (suite *AnteTestSuite) TestEthGasConsumeDecorator() {
 				suite.Require().Panics(func() {
 					_, _ = ante.CheckEthGasConsume(
 						suite.ctx.WithIsCheckTx(true).WithGasMeter(storetypes.NewGasMeter(1)), tc.tx,
-						ethCfg, suite.app.EvmKeeper, baseFee, config.DefaultMaxTxGasWanted, evmtypes.DefaultEVMDenom,
+						ethCfg, suite.app.EvmKeeper, baseFee, config.DefaultMaxTxGasWanted, evmtypes.DefaultEVMDenom, nil,
 					)
 				})
 				return
@@ -306,7 +357,7 @@ func (suite *AnteTestSuite) TestEthGasConsumeDecorator() {
 
 			ctx, err := ante.CheckEthGasConsume(
 				suite.ctx.WithIsCheckTx(true).WithGasMeter(storetypes.NewInfiniteGasMeter()), tc.tx,
-				ethCfg, suite.app.EvmKeeper, baseFee, config.DefaultMaxTxGasWanted, evmtypes.DefaultEVMDenom,
+				ethCfg, suite.app.EvmKeeper, baseFee, config.DefaultMaxTxGasWanted, evmtypes.DefaultEVMDenom, nil,
 			)
 			if tc.expPass {
 				suite.Require().NoError(err)
@@ -38,7 +38,7 @@ import (
 // b) tipFeeCap = tx.MaxPriorityPrice (default) or MaxInt64
 // - when `ExtensionOptionDynamicFeeTx` is omitted, `tipFeeCap` defaults to `MaxInt64`.
 // - when london hardfork is not enabled, it fallbacks to SDK default behavior (validator min-gas-prices).
-// - Tx priority is set to `effectiveGasPrice / DefaultPriorityReduction`.
+// - Tx priority is set to `(effectiveGasPrice - baseFee) / DefaultPriorityReduction`, like Ethereum txs.
 func NewDynamicFeeChecker(ethCfg *params.ChainConfig, evmParams *types.Params, feemarketParams *feemarkettypes.Params) authante.TxFeeChecker {
 	return func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
 		feeTx, ok := tx.(sdk.FeeTx)
@@ -98,12 +98,7 @@ func NewDynamicFeeChecker(ethCfg *params.ChainConfig, evmParams *types.Params, f
 			},
 		}
 
-		bigPriority := effectivePrice.Sub(baseFeeInt).Quo(types.DefaultPriorityReduction)
-		priority := int64(math.MaxInt64)
-
-		if bigPriority.IsInt64() {
-			priority = bigPriority.Int64()
-		}
+		priority := types.GetTipPriority(effectivePrice.Sub(baseFeeInt).BigInt())
 
 		return effectiveFee, priority, nil
 	}
@@ -146,18 +141,16 @@ func checkTxFeeWithValidatorMinGasPrices(ctx sdk.Context, tx sdk.Tx) (sdk.Coins,
 }
 
 // getTxPriority returns a naive tx priority based on the amount of the smallest denomination of the gas price
-// provided in a transaction.
+// provided in a transaction. Without base fee, the whole gas price is the tip, like for Ethereum txs.
 func getTxPriority(fees sdk.Coins, gas int64) int64 {
 	var priority int64
+	if gas <= 0 {
+		return priority
+	}
 
 	for _, fee := range fees {
 		gasPrice := fee.Amount.QuoRaw(gas)
-		amt := gasPrice.Quo(types.DefaultPriorityReduction)
-		p := int64(math.MaxInt64)
-
-		if amt.IsInt64() {
-			p = amt.Int64()
-		}
+		p := types.GetTipPriority(gasPrice.BigInt())
 
 		if priority == 0 || p < priority {
 			priority = p
@@ -228,3 +228,38 @@ func TestSDKTxFeeChecker(t *testing.T) {
 		})
 	}
 }
+
+func TestTxPriorityComparable(t *testing.T) {
+	encodingConfig := encoding.MakeConfig()
+	ctx := sdk.NewContext(nil, tmproto.Header{Height: 1}, false, log.NewNopLogger())
+	keeper := MockEVMKeeper{EnableLondonHF: true, BaseFee: big.NewInt(10)}
+	evmParams := keeper.GetParams(ctx)
+	feemarketParams := feemarkettypes.Params{BaseFee: sdkmath.NewIntFromBigInt(keeper.BaseFee)}
+	chainCfg := evmParams.GetChainConfig()
+	ethCfg := chainCfg.EthereumConfig(keeper.ChainID())
+
+	feeCap := sdkmath.NewInt(20).Mul(types.DefaultPriorityReduction)
+	tipCap := sdkmath.NewInt(3).Mul(types.DefaultPriorityReduction)
+
+	ethTx := evmtypes.NewTx(keeper.ChainID(), 0, nil, nil, 21000, nil, feeCap.BigInt(), tipCap.BigInt(), nil, nil)
+	txData, err := evmtypes.UnpackTxData(ethTx.Data)
+	require.NoError(t, err)
+	ethPriority := evmtypes.GetTxPriority(txData, keeper.BaseFee)
+	require.Equal(t, int64(3), ethPriority)
+
+	// a cosmos tx paying the same fee cap and tip cap in evm denom gets the same priority
+	txBuilder := encodingConfig.TxConfig.NewTxBuilder().(authtx.ExtensionOptionsTxBuilder)
+	txBuilder.SetGasLimit(21000)
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin("aphoton", feeCap.MulRaw(21000))))
+	option, err := codectypes.NewAnyWithValue(&ethermint.ExtensionOptionDynamicFeeTx{MaxPriorityPrice: tipCap})
+	require.NoError(t, err)
+	txBuilder.SetExtensionOptions(option)
+
+	_, priority, err := NewDynamicFeeChecker(ethCfg, &evmParams, &feemarketParams)(ctx, txBuilder.GetTx())
+	require.NoError(t, err)
+	require.Equal(t, ethPriority, priority)
+
+	// without base fee, the whole gas price is the tip
+	require.Equal(t, int64(20), getTxPriority(sdk.NewCoins(sdk.NewCoin("aphoton", feeCap.MulRaw(21000))), 21000))
+	require.Equal(t, int64(0), getTxPriority(sdk.NewCoins(sdk.NewCoin("aphoton", feeCap)), 0))
+}
@@ -0,0 +1,96 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	feemarkettypes "github.com/evmos/ethermint/x/feemarket/types"
+)
+
+// GetEthFeeDenom returns the denom selected by the ExtensionOptionsEthereumTx of the
+// transaction to pay for the gas, the evm denom if none.
+//
+// The extension option isn't covered by the signature of the ethereum transaction,
+// anyone relaying its raw bytes could select the token it's paid with. A fee token
+// other than the evm denom must then be signed by the sender, and only pays for a
+// single ethereum transaction.
+func GetEthFeeDenom(tx sdk.Tx, evmDenom string) (string, error) {
+	hasExtOptsTx, ok := tx.(authante.HasExtensionOptionsTx)
+	if !ok {
+		return evmDenom, nil
+	}
+	for _, opt := range hasExtOptsTx.GetExtensionOptions() {
+		extOpt, ok := opt.GetCachedValue().(*evmtypes.ExtensionOptionsEthereumTx)
+		if !ok || extOpt.FeeDenom == "" || extOpt.FeeDenom == evmDenom {
+			continue
+		}
+
+		msgs := tx.GetMsgs()
+		if len(msgs) != 1 {
+			return "", errorsmod.Wrapf(errortypes.ErrInvalidRequest, "fee token %s pays for a single ethereum transaction, got %d messages", extOpt.FeeDenom, len(msgs))
+		}
+		msgEthTx, ok := msgs[0].(*evmtypes.MsgEthereumTx)
+		if !ok {
+			return "", errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid message type %T, expected %T", msgs[0], (*evmtypes.MsgEthereumTx)(nil))
+		}
+		if err := msgEthTx.VerifyFeeDenomSignature(extOpt.FeeDenom, extOpt.FeeDenomSignature); err != nil {
+			return "", err
+		}
+		return extOpt.FeeDenom, nil
+	}
+	return evmDenom, nil
+}
+
+// SetupEthFeePayment returns the fee token paying for the gas of the ethereum
+// transactions, nil if they are paid with the evm denom, and records it for each
+// transaction so that their leftover gas is refunded in the same token.
+func SetupEthFeePayment(
+	ctx sdk.Context, tx sdk.Tx,
+	feeMarketKeeper FeeMarketKeeper,
+	evmDenom string,
+) (*feemarkettypes.FeePayment, error) {
+	denom, err := GetEthFeeDenom(tx, evmDenom)
+	if err != nil {
+		return nil, err
+	}
+	if denom == evmDenom {
+		return nil, nil
+	}
+
+	payment, err := feeMarketKeeper.GetFeeTokenPayment(ctx, denom)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to get the fee token payment")
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			return nil, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid message type %T, expected %T", msg, (*evmtypes.MsgEthereumTx)(nil))
+		}
+		// the blob fee is always paid with the evm denom
+		if msgEthTx.TxType() == ethtypes.BlobTxType {
+			return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "blob transactions can't pay fees with %s", denom)
+		}
+		feeMarketKeeper.SetTransientFeePayment(ctx, msgEthTx.ComputeHash(), payment)
+	}
+	return &payment, nil
+}
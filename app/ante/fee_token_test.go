@@ -0,0 +1,107 @@
+package ante_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+	"github.com/evmos/ethermint/tests"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	feemarkettypes "github.com/evmos/ethermint/x/feemarket/types"
+)
+
+func (suite *AnteTestSuite) TestEthFeeToken() {
+	const feeDenom = "ibc/usdc"
+	addr, privKey := tests.NewAddrKey()
+	gasPrice := big.NewInt(150)
+	value := big.NewInt(10)
+	// the rate is 2 with a markup of 50%
+	fee := new(big.Int).Mul(gasPrice, big.NewInt(int64(TestGasLimit)))
+	tokenFee := new(big.Int).Mul(fee, big.NewInt(3))
+
+	_, otherKey := tests.NewAddrKey()
+	newTx := func(denom string, signer cryptotypes.PrivKey) sdk.Tx {
+		msg := evmtypes.NewTxContract(suite.app.EvmKeeper.ChainID(), 1, value, TestGasLimit, gasPrice, nil, nil, nil, nil)
+		msg.From = addr.Bytes()
+		builder := suite.CreateTestTxBuilder(msg, privKey, 1, false)
+		var sig []byte
+		if signer != nil {
+			key, err := signer.(*ethsecp256k1.PrivKey).ToECDSA()
+			suite.Require().NoError(err)
+			sig, err = crypto.Sign(evmtypes.FeeDenomSigHash(msg.ComputeHash(), denom).Bytes(), key)
+			suite.Require().NoError(err)
+		}
+		option, err := codectypes.NewAnyWithValue(&evmtypes.ExtensionOptionsEthereumTx{FeeDenom: denom, FeeDenomSignature: sig})
+		suite.Require().NoError(err)
+		builder.(authtx.ExtensionOptionsTxBuilder).SetExtensionOptions(option)
+		return builder.GetTx()
+	}
+
+	testCases := []struct {
+		name         string
+		denom        string
+		signer       cryptotypes.PrivKey
+		tokenBalance *big.Int
+		checkTx      bool
+		expPass      bool
+	}{
+		{"success - DeliverTx", feeDenom, privKey, tokenFee, false, true},
+		{"success - CheckTx", feeDenom, privKey, tokenFee, true, true},
+		{"success - evm denom", evmtypes.DefaultEVMDenom, nil, big.NewInt(0), false, true},
+		{"fail - unknown fee token", "ibc/atom", privKey, tokenFee, false, false},
+		{"fail - fee token not signed, relayed raw tx", feeDenom, nil, tokenFee, false, false},
+		{"fail - fee token not signed by the sender", feeDenom, otherKey, tokenFee, true, false},
+		{"fail - insufficient balance, CheckTx", feeDenom, privKey, new(big.Int).Sub(tokenFee, big.NewInt(1)), true, false},
+		{"fail - insufficient balance, DeliverTx", feeDenom, privKey, new(big.Int).Sub(tokenFee, big.NewInt(1)), false, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.app.FeeMarketKeeper.SetBaseFee(suite.ctx, big.NewInt(100))
+			params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+			params.FeeTokens = []feemarkettypes.FeeToken{{
+				Denom:  feeDenom,
+				Rate:   sdkmath.LegacyNewDec(2),
+				Markup: sdkmath.LegacyNewDecWithPrec(5, 1),
+			}}
+			suite.Require().NoError(suite.app.FeeMarketKeeper.SetParams(suite.ctx, params))
+
+			acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr.Bytes())
+			suite.Require().NoError(acc.SetSequence(1))
+			suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+			evmBalance := value
+			if tc.denom == evmtypes.DefaultEVMDenom {
+				evmBalance = new(big.Int).Add(value, fee)
+			}
+			suite.Require().NoError(suite.app.EvmKeeper.SetBalance(suite.ctx, addr, evmBalance, evmtypes.DefaultEVMDenom))
+			suite.Require().NoError(suite.app.EvmKeeper.SetBalance(suite.ctx, addr, tc.tokenBalance, feeDenom))
+
+			tx := newTx(tc.denom, tc.signer)
+			_, err := suite.anteHandler(suite.ctx.WithIsCheckTx(tc.checkTx), tx, false)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			// the value stays in the evm denom and the fee is paid with the token
+			suite.Require().Equal(value, suite.app.EvmKeeper.GetBalance(suite.ctx, addr.Bytes(), evmtypes.DefaultEVMDenom))
+			suite.Require().Zero(suite.app.EvmKeeper.GetBalance(suite.ctx, addr.Bytes(), feeDenom).Sign())
+
+			msg := tx.GetMsgs()[0].(*evmtypes.MsgEthereumTx)
+			payment, found := suite.app.FeeMarketKeeper.GetTransientFeePayment(suite.ctx, msg.ComputeHash())
+			suite.Require().Equal(tc.denom == feeDenom, found)
+			if found {
+				suite.Require().Equal(feeDenom, payment.Denom)
+				suite.Require().Equal(sdkmath.LegacyNewDec(3), payment.Price)
+			}
+		})
+	}
+}
@@ -48,6 +48,11 @@ type HandlerOptions struct {
 	DynamicFeeChecker bool
 	DisabledAuthzMsgs []string
 	ExtraDecorators   []sdk.AnteDecorator
+	// enable the mempool nonce rules of CheckEthPendingNonce on (Re)CheckTx, the app-side
+	// mempool must then reject the lower nonces that don't replace a pending transaction
+	ReplaceByFee bool
+	// maximum distance between the nonce of a queued transaction and the next nonce of its sender
+	MaxNonceGap uint64
 }
 
 func (options HandlerOptions) validate() error {
@@ -108,7 +113,16 @@ func newEthAnteHandler(options HandlerOptions) sdk.AnteHandler {
 			return ctx, err
 		}
 
-		if err := VerifyEthAccount(ctx, tx, options.EvmKeeper, options.AccountKeeper, evmDenom); err != nil {
+		if err := VerifySetCodeAuthorizations(ctx, tx, ethCfg); err != nil {
+			return ctx, err
+		}
+
+		feePayment, err := SetupEthFeePayment(ctx, tx, options.FeeMarketKeeper, evmDenom)
+		if err != nil {
+			return ctx, err
+		}
+
+		if err := VerifyEthAccount(ctx, tx, options.EvmKeeper, options.AccountKeeper, evmDenom, feePayment); err != nil {
 			return ctx, err
 		}
 
@@ -118,13 +132,22 @@ func newEthAnteHandler(options HandlerOptions) sdk.AnteHandler {
 
 		ctx, err = CheckEthGasConsume(
 			ctx, tx, ethCfg, options.EvmKeeper,
-			baseFee, options.MaxTxGasWanted, evmDenom,
+			baseFee, options.MaxTxGasWanted, evmDenom, feePayment,
 		)
 		if err != nil {
 			return ctx, err
 		}
 
-		if err := CheckEthSenderNonce(ctx, tx, options.AccountKeeper); err != nil {
+		if err := CheckBlobTxs(ctx, tx, ethCfg, options.EvmKeeper, options.FeeMarketKeeper, evmDenom); err != nil {
+			return ctx, err
+		}
+
+		if ctx.IsCheckTx() && options.ReplaceByFee {
+			ctx, err = CheckEthPendingNonce(ctx, tx, options.AccountKeeper, options.MaxNonceGap)
+			if err != nil {
+				return ctx, err
+			}
+		} else if err := CheckEthSenderNonce(ctx, tx, options.AccountKeeper); err != nil {
 			return ctx, err
 		}
 
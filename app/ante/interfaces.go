@@ -44,4 +44,8 @@ type protoTxProvider interface {
 type FeeMarketKeeper interface {
 	GetParams(ctx sdk.Context) (params feemarkettypes.Params)
 	AddTransientGasWanted(ctx sdk.Context, gasWanted uint64) (uint64, error)
+	GetBlobBaseFee(ctx sdk.Context) *big.Int
+	AddTransientBlobGasUsed(ctx sdk.Context, blobGas uint64) uint64
+	GetFeeTokenPayment(ctx sdk.Context, denom string) (feemarkettypes.FeePayment, error)
+	SetTransientFeePayment(ctx sdk.Context, txHash common.Hash, payment feemarkettypes.FeePayment)
 }
@@ -0,0 +1,102 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package ante
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// senderNonceKey is the context key of the sender nonce recorded by CheckEthPendingNonce.
+type senderNonceKey struct{}
+
+// SenderNonceFromContext returns the next nonce of the transaction sender, as seen by
+// CheckEthPendingNonce before admitting the transaction.
+func SenderNonceFromContext(ctx context.Context) (uint64, bool) {
+	nonce, ok := ctx.Value(senderNonceKey{}).(uint64)
+	return nonce, ok
+}
+
+// CheckEthPendingNonce is the CheckTx counterpart of CheckEthSenderNonce, implementing the
+// mempool nonce rules. Besides the next nonce of the sender, it admits:
+// - lower nonces on CheckTx, which the mempool only accepts as the replacement of a pending
+// transaction with the same nonce
+// - higher nonces, at most maxNonceGap ahead of the next one, which the mempool queues until
+// the gap is filled
+//
+// Only the transactions carrying the next nonce increment the sequence of the sender. The
+// next nonce of the sender is recorded in the returned context for the mempool.
+func CheckEthPendingNonce(
+	ctx sdk.Context, tx sdk.Tx, ak evmtypes.AccountKeeper, maxNonceGap uint64,
+) (sdk.Context, error) {
+	for i, msg := range tx.GetMsgs() {
+		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			return ctx, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid message type %T, expected %T", msg, (*evmtypes.MsgEthereumTx)(nil))
+		}
+
+		txData, err := evmtypes.UnpackTxData(msgEthTx.Data)
+		if err != nil {
+			return ctx, errorsmod.Wrap(err, "failed to unpack tx data")
+		}
+
+		acc := ak.GetAccount(ctx, msgEthTx.GetFrom())
+		if acc == nil {
+			return ctx, errorsmod.Wrapf(
+				errortypes.ErrUnknownAddress,
+				"account %s is nil", common.BytesToAddress(msgEthTx.GetFrom().Bytes()),
+			)
+		}
+		nonce := acc.GetSequence()
+		txNonce := txData.GetNonce()
+
+		// the mempool only considers the first signer of a transaction
+		if i == 0 {
+			ctx = ctx.WithValue(senderNonceKey{}, nonce)
+		}
+
+		switch {
+		case txNonce == nonce:
+			if err := acc.SetSequence(nonce + 1); err != nil {
+				return ctx, errorsmod.Wrapf(err, "failed to set sequence to %d", nonce+1)
+			}
+			ak.SetAccount(ctx, acc)
+		case txNonce > nonce:
+			if txNonce-nonce > maxNonceGap {
+				return ctx, errorsmod.Wrapf(
+					errortypes.ErrInvalidSequence,
+					"nonce too high; got %d, expected %d, max nonce gap %d", txNonce, nonce, maxNonceGap,
+				)
+			}
+		case ctx.IsReCheckTx():
+			// replaced or already included in a block
+			return ctx, errorsmod.Wrapf(
+				errortypes.ErrInvalidSequence,
+				"invalid nonce; got %d, expected %d", txNonce, nonce,
+			)
+		}
+	}
+
+	return ctx, nil
+}
@@ -123,7 +123,9 @@ func ValidateEthBasic(ctx sdk.Context, tx sdk.Tx, evmParams *evmtypes.Params, ba
 			return errorsmod.Wrap(evmtypes.ErrCallDisabled, "failed to call contract")
 		}
 
-		if baseFee == nil && txData.TxType() == ethtypes.DynamicFeeTxType {
+		if baseFee == nil && (txData.TxType() == ethtypes.DynamicFeeTxType ||
+			txData.TxType() == ethtypes.BlobTxType ||
+			txData.TxType() == evmtypes.SetCodeTxType) {
 			return errorsmod.Wrap(ethtypes.ErrTxTypeNotSupported, "dynamic fee tx not supported")
 		}
 
@@ -21,6 +21,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/params"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 )
 
@@ -43,3 +44,32 @@ func VerifyEthSig(tx sdk.Tx, chainID *big.Int) error {
 
 	return nil
 }
+
+// VerifySetCodeAuthorizations validates that the set-code transactions are
+// enabled and that the authorities of their authorizations can be recovered.
+// The execution skips the authorizations that don't apply to the state, like
+// the ones with a stale nonce, but a transaction carrying an unsigned
+// authorization is rejected.
+func VerifySetCodeAuthorizations(ctx sdk.Context, tx sdk.Tx, ethCfg *params.ChainConfig) error {
+	for _, msg := range tx.GetMsgs() {
+		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			return errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid message type %T, expected %T", msg, (*evmtypes.MsgEthereumTx)(nil))
+		}
+
+		auths := msgEthTx.GetSetCodeAuthorizations()
+		if len(auths) == 0 {
+			continue
+		}
+		if !ethCfg.IsPrague(big.NewInt(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())) {
+			return errorsmod.Wrap(evmtypes.ErrTxTypeNotSupported, "set-code transactions are enabled by Prague")
+		}
+		for i, auth := range auths {
+			if _, err := auth.Authority(); err != nil {
+				return errorsmod.Wrapf(err, "authorization %d", i)
+			}
+		}
+	}
+
+	return nil
+}
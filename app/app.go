@@ -23,11 +23,13 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"sync/atomic"
 
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
 	reflectionv1 "cosmossdk.io/api/cosmos/reflection/v1"
 	"cosmossdk.io/client/v2/autocli"
 	"cosmossdk.io/core/appmodule"
+	errorsmod "cosmossdk.io/errors"
 	runtimeservices "github.com/cosmos/cosmos-sdk/runtime/services"
 	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/gogoproto/proto"
@@ -64,7 +66,7 @@ import (
 	upgradekeeper "cosmossdk.io/x/upgrade/keeper"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	mempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	"github.com/cosmos/cosmos-sdk/version"
@@ -202,6 +204,7 @@ var (
 		govtypes.ModuleName:            {authtypes.Burner},
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
 		evmtypes.ModuleName:            {authtypes.Minter, authtypes.Burner}, // used for secure addition and subtraction of balance using module account
+		feemarkettypes.ModuleName:      {authtypes.Burner},                   // used to burn the base fees
 	}
 
 	// module accounts that are allowed to receive tokens
@@ -268,6 +271,15 @@ type EthermintApp struct {
 	// simulation manager
 	sm *module.SimulationManager
 
+	// app-side mempool
+	mempool *EthMempool
+
+	// sidecars of the blob txs accepted in CheckTx, which are stripped from the blocks
+	blobSidecars *blobSidecarPool
+
+	// minimum gas prices set at runtime, overriding the node configuration in CheckTx
+	minGasPrices atomic.Pointer[sdk.DecCoins]
+
 	// the configurator
 	configurator module.Configurator
 }
@@ -290,18 +302,6 @@ func NewEthermintApp(
 	eip712.SetEncodingConfig(encodingConfig)
 
 	// NOTE we use custom transaction decoder that supports the sdk.Tx interface instead of sdk.StdTx
-	// Setup Mempool and Proposal Handlers
-	baseAppOptions = append(baseAppOptions, func(app *baseapp.BaseApp) {
-		mempool := mempool.NewPriorityMempool(mempool.PriorityNonceMempoolConfig[int64]{
-			TxPriority:      mempool.NewDefaultTxPriority(),
-			SignerExtractor: NewEthSignerExtractionAdapter(mempool.NewDefaultSignerExtractionAdapter()),
-		})
-		handler := baseapp.NewDefaultProposalHandler(mempool, app)
-
-		app.SetMempool(mempool)
-		app.SetPrepareProposal(handler.PrepareProposalHandler())
-		app.SetProcessProposal(handler.ProcessProposalHandler())
-	})
 	bApp := baseapp.NewBaseApp(
 		appName,
 		logger,
@@ -347,6 +347,7 @@ func NewEthermintApp(
 		keys:              keys,
 		tkeys:             tkeys,
 		memKeys:           memKeys,
+		blobSidecars:      openBlobSidecarPool(db),
 	}
 
 	// init params keeper and subspaces
@@ -492,6 +493,7 @@ func NewEthermintApp(
 		runtime.NewKVStoreService(keys[feemarkettypes.StoreKey]),
 		authtypes.NewModuleAddress(govtypes.ModuleName),
 		keys[feemarkettypes.StoreKey], tkeys[feemarkettypes.TransientKey],
+		app.BankKeeper, app.DistrKeeper,
 	)
 
 	// Set authority to x/gov module account to only expect the module account to update params
@@ -515,6 +517,11 @@ func NewEthermintApp(
 		nil,
 		allKeys,
 	)
+	app.EvmKeeper.SetSgxExecutors(cast.ToStringSlice(appOpts.Get(srvflags.EVMSgxExecutors)))
+	app.EvmKeeper.SetShadowExecution(cast.ToBool(appOpts.Get(srvflags.EVMShadowExecution)))
+	app.EvmKeeper.SetSgxBlockSession(cast.ToBool(appOpts.Get(srvflags.EVMSgxBlockSession)))
+	app.EvmKeeper.SetInternalTxTracing(cast.ToBool(appOpts.Get(srvflags.EVMTraceInternalTxs)))
+	app.EvmKeeper.SetParallelExecution(cast.ToInt(appOpts.Get(srvflags.EVMParallelWorkers)))
 
 	// register the proposal types
 	govRouter := govv1beta1.NewRouter()
@@ -775,7 +782,13 @@ func NewEthermintApp(
 	app.SetPreBlocker(app.PreBlocker)
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetEndBlocker(app.EndBlocker)
-	app.setAnteHandler(txConfig, cast.ToUint64(appOpts.Get(srvflags.EVMMaxTxGasWanted)))
+	// Setup Mempool and Proposal Handlers
+	mempoolCfg := MempoolConfig{
+		PriceBump:   cast.ToUint64(appOpts.Get(srvflags.EVMMempoolPriceBump)),
+		MaxNonceGap: cast.ToUint64(appOpts.Get(srvflags.EVMMempoolMaxNonceGap)),
+	}
+	app.setMempool(mempoolCfg)
+	app.setAnteHandler(txConfig, cast.ToUint64(appOpts.Get(srvflags.EVMMaxTxGasWanted)), mempoolCfg.MaxNonceGap)
 	// In v0.46, the SDK introduces _postHandlers_. PostHandlers are like
 	// antehandlers, but are run _after_ the `runMsgs` execution. They are also
 	// defined as a chain, and have the same signature as antehandlers.
@@ -816,7 +829,7 @@ func NewEthermintApp(
 }
 
 // use Ethermint's custom AnteHandler
-func (app *EthermintApp) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64) {
+func (app *EthermintApp) setAnteHandler(txConfig client.TxConfig, maxGasWanted, maxNonceGap uint64) {
 	anteHandler, err := ante.NewAnteHandler(ante.HandlerOptions{
 		AccountKeeper:          app.AccountKeeper,
 		BankKeeper:             app.BankKeeper,
@@ -833,12 +846,35 @@ func (app *EthermintApp) setAnteHandler(txConfig client.TxConfig, maxGasWanted u
 			sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{}),
 			sdk.MsgTypeURL(&vestingtypes.MsgCreateVestingAccount{}),
 		},
+		ReplaceByFee: true,
+		MaxNonceGap:  maxNonceGap,
 	})
 	if err != nil {
 		panic(err)
 	}
 
-	app.SetAnteHandler(anteHandler)
+	app.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		if minGasPrices := app.minGasPrices.Load(); minGasPrices != nil && ctx.IsCheckTx() {
+			ctx = ctx.WithMinGasPrices(*minGasPrices)
+		}
+		return anteHandler(ctx, tx, simulate)
+	})
+}
+
+// SetMinGasPrices updates the minimum gas prices of the transactions accepted in the mempool,
+// overriding the node configuration until the node restarts.
+func (app *EthermintApp) SetMinGasPrices(minGasPrices sdk.DecCoins) {
+	app.minGasPrices.Store(&minGasPrices)
+}
+
+// use Ethermint's mempool, with replace-by-fee, and proposal handler
+func (app *EthermintApp) setMempool(cfg MempoolConfig) {
+	app.mempool = NewEthMempool(cfg, app.AccountKeeper)
+	handler := NewProposalHandler(app.mempool, app, app.txConfig, app)
+
+	app.SetMempool(app.mempool)
+	app.SetPrepareProposal(handler.PrepareProposalHandler())
+	app.SetProcessProposal(handler.ProcessProposalHandler())
 }
 
 func (app *EthermintApp) setPostHandler() {
@@ -856,10 +892,46 @@ func (app *EthermintApp) setPostHandler() {
 func (app *EthermintApp) Name() string { return app.BaseApp.Name() }
 
 // PreBlocker updates every pre begin block
-func (app *EthermintApp) PreBlocker(ctx sdk.Context, _ *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+func (app *EthermintApp) PreBlocker(ctx sdk.Context, req *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+	app.EvmKeeper.QueueBlockTxs(ctx, app.txConfig.TxDecoder(), req.Txs)
 	return app.ModuleManager.PreBlock(ctx)
 }
 
+// CheckTx implements the ABCI interface. The transactions replaced in the app-side mempool
+// fail their recheck, so that CometBFT drops them from its mempool and CheckTx cache. The
+// sidecars of the accepted blob transactions are kept for the blob store.
+func (app *EthermintApp) CheckTx(req *abci.RequestCheckTx) (*abci.ResponseCheckTx, error) {
+	if req.Type == abci.CheckTxType_Recheck && app.mempool != nil && app.mempool.PopEvicted(req.Tx) {
+		return errortypes.ResponseCheckTxWithEvents(
+			errorsmod.Wrap(errortypes.ErrInvalidSequence, "transaction replaced in the mempool"), 0, 0, nil, false,
+		), nil
+	}
+	res, err := app.BaseApp.CheckTx(req)
+	if err == nil && res.IsOK() && req.Type == abci.CheckTxType_New {
+		if err := app.blobSidecars.addTx(app.txConfig.TxDecoder(), req.Tx); err != nil {
+			app.Logger().Error("failed to store the blob sidecars", "error", err.Error())
+		}
+	}
+	return res, err
+}
+
+// openBlobSidecarPool opens the pool of the blob sidecars, persisted under their own prefix of
+// the application database, outside of the committed stores.
+func openBlobSidecarPool(db dbm.DB) *blobSidecarPool {
+	pool, err := newBlobSidecarPool(dbm.NewPrefixDB(db, blobSidecarsPrefix))
+	if err != nil {
+		panic(fmt.Errorf("failed to load the blob sidecars: %w", err))
+	}
+	return pool
+}
+
+// BlobSidecar returns the sidecar of a blob tx accepted in CheckTx, nil if it's unknown or
+// was evicted. The sidecars are verified by the ante handler before being stored. It implements indexer.BlobSidecarProvider, the sidecars being stripped from
+// the txs included in the blocks.
+func (app *EthermintApp) BlobSidecar(ethTxHash string) *evmtypes.BlobTxSidecar {
+	return app.blobSidecars.get(ethTxHash)
+}
+
 // BeginBlocker updates every begin block
 func (app *EthermintApp) BeginBlocker(ctx sdk.Context) (sdk.BeginBlock, error) {
 	return app.ModuleManager.BeginBlock(ctx)
@@ -0,0 +1,171 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package app
+
+import (
+	"encoding/binary"
+	"sync"
+
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/params"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+const (
+	// maxPooledBlobs is the number of blobs whose sidecars are kept by the blobSidecarPool,
+	// about 512 MiB.
+	maxPooledBlobs = 4096
+
+	// minBlobTxSize is the size of the smallest transaction carrying a sidecar, that is a
+	// single blob.
+	minBlobTxSize = params.BlobTxFieldElementsPerBlob * params.BlobTxBytesPerFieldElement
+)
+
+var (
+	// blobSidecarsPrefix prefixes the blobSidecarPool in the application database, apart from
+	// the "s/" keys of the multistore
+	blobSidecarsPrefix = []byte("ethermint/blobsidecars/")
+	// sidecarPrefix prefixes the sidecars, by Ethereum tx hash
+	sidecarPrefix = []byte{0x01}
+	// orderPrefix prefixes the Ethereum tx hashes of the sidecars, by insertion sequence, each
+	// one preceded by the 2 bytes number of blobs of its sidecar
+	orderPrefix = []byte{0x02}
+)
+
+// blobSidecarPool keeps the sidecars of the blob transactions accepted in CheckTx, once
+// verified by the ante handler, as they are stripped from the transactions included in the
+// blocks. They are persisted in the database of the node, so that they survive a restart, are
+// checked by ProcessProposal and read by the blob store of the indexer once the transactions
+// are committed. The oldest are evicted first.
+type blobSidecarPool struct {
+	mu sync.Mutex
+	// mu protects the following fields
+	db    dbm.DB
+	seq   uint64 // next insertion sequence
+	blobs int
+}
+
+// newBlobSidecarPool returns the pool of the sidecars persisted in the database.
+func newBlobSidecarPool(db dbm.DB) (*blobSidecarPool, error) {
+	p := &blobSidecarPool{db: db}
+
+	it, err := dbm.IteratePrefix(db, orderPrefix)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		p.seq = binary.BigEndian.Uint64(it.Key()[len(orderPrefix):]) + 1
+		p.blobs += int(binary.BigEndian.Uint16(it.Value()))
+	}
+	return p, it.Error()
+}
+
+// addTx records the sidecars of the Ethereum messages of the tx.
+func (p *blobSidecarPool) addTx(decoder sdk.TxDecoder, txBz []byte) error {
+	if len(txBz) < minBlobTxSize {
+		return nil
+	}
+	tx, err := decoder(txBz)
+	if err != nil {
+		return nil
+	}
+	for _, msg := range tx.GetMsgs() {
+		ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			continue
+		}
+		// the tx is decoded from its own bytes, it can be stripped
+		sidecar, err := ethMsg.StripBlobSidecar()
+		if err != nil || sidecar == nil {
+			continue
+		}
+		if err := p.add(ethMsg.Hash, sidecar); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *blobSidecarPool) add(hash string, sidecar *evmtypes.BlobTxSidecar) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	sidecarKey := append(append([]byte{}, sidecarPrefix...), hash...)
+	if ok, err := p.db.Has(sidecarKey); err != nil || ok {
+		return err
+	}
+	bz, err := sidecar.Marshal()
+	if err != nil {
+		return err
+	}
+
+	batch := p.db.NewBatch()
+	defer batch.Close()
+	if err := batch.Set(sidecarKey, bz); err != nil {
+		return err
+	}
+	order := binary.BigEndian.AppendUint16(nil, uint16(len(sidecar.Blobs)))
+	if err := batch.Set(orderKey(p.seq), append(order, hash...)); err != nil {
+		return err
+	}
+	blobs := p.blobs + len(sidecar.Blobs)
+
+	// evict the oldest sidecars, the new one is always kept
+	it, err := dbm.IteratePrefix(p.db, orderPrefix)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+	for ; it.Valid() && blobs > maxPooledBlobs; it.Next() {
+		blobs -= int(binary.BigEndian.Uint16(it.Value()))
+		if err := batch.Delete(it.Key()); err != nil {
+			return err
+		}
+		if err := batch.Delete(append(append([]byte{}, sidecarPrefix...), it.Value()[2:]...)); err != nil {
+			return err
+		}
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+
+	if err := batch.Write(); err != nil {
+		return err
+	}
+	p.seq++
+	p.blobs = blobs
+	return nil
+}
+
+// get returns the sidecar of the Ethereum tx, nil if it's unknown or was evicted.
+func (p *blobSidecarPool) get(hash string) *evmtypes.BlobTxSidecar {
+	bz, err := p.db.Get(append(append([]byte{}, sidecarPrefix...), hash...))
+	if err != nil || bz == nil {
+		return nil
+	}
+	var sidecar evmtypes.BlobTxSidecar
+	if err := sidecar.Unmarshal(bz); err != nil {
+		return nil
+	}
+	return &sidecar
+}
+
+func orderKey(seq uint64) []byte {
+	return binary.BigEndian.AppendUint64(append([]byte{}, orderPrefix...), seq)
+}
@@ -0,0 +1,48 @@
+package app
+
+import (
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+func TestBlobSidecarPool(t *testing.T) {
+	db := dbm.NewMemDB()
+	pool, err := newBlobSidecarPool(db)
+	require.NoError(t, err)
+
+	// sidecars of half the pool each
+	sidecar := func(b byte) *evmtypes.BlobTxSidecar {
+		sc := &evmtypes.BlobTxSidecar{}
+		for i := 0; i < maxPooledBlobs/2; i++ {
+			sc.Blobs = append(sc.Blobs, []byte{b})
+			sc.Commitments = append(sc.Commitments, []byte{b})
+			sc.Proofs = append(sc.Proofs, []byte{b})
+		}
+		return sc
+	}
+	require.NoError(t, pool.add("0x01", sidecar(1)))
+	require.NoError(t, pool.add("0x02", sidecar(2)))
+	require.Equal(t, sidecar(1), pool.get("0x01"))
+	require.Nil(t, pool.get("0x03"))
+
+	// the sidecars are reloaded from the database
+	pool, err = newBlobSidecarPool(db)
+	require.NoError(t, err)
+	require.Equal(t, maxPooledBlobs, pool.blobs)
+	require.Equal(t, sidecar(2), pool.get("0x02"))
+
+	// the oldest sidecar is evicted
+	require.NoError(t, pool.add("0x03", sidecar(3)))
+	require.Nil(t, pool.get("0x01"))
+	require.Equal(t, sidecar(2), pool.get("0x02"))
+	require.Equal(t, sidecar(3), pool.get("0x03"))
+	require.Equal(t, maxPooledBlobs, pool.blobs)
+
+	// a known sidecar isn't added again
+	require.NoError(t, pool.add("0x03", sidecar(3)))
+	require.Equal(t, maxPooledBlobs, pool.blobs)
+}
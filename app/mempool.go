@@ -0,0 +1,301 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package app
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+
+	errorsmod "cosmossdk.io/errors"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+
+	"github.com/evmos/ethermint/app/ante"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+var _ mempool.Mempool = (*EthMempool)(nil)
+
+// SequenceKeeper defines the account sequence getter used to select the executable
+// transactions of the mempool.
+type SequenceKeeper interface {
+	GetSequence(context.Context, sdk.AccAddress) (uint64, error)
+}
+
+// MempoolConfig defines the admission rules of the EthMempool.
+type MempoolConfig struct {
+	// PriceBump is the minimum fee increase, in percent, for a transaction to replace a
+	// pending one with the same sender and nonce.
+	PriceBump uint64
+	// MaxNonceGap is the maximum distance between the nonce of a queued transaction and the
+	// next nonce of its sender, enforced by the ante handler.
+	MaxNonceGap uint64
+}
+
+// senderNonce identifies a pending transaction in the mempool.
+type senderNonce struct {
+	sender string
+	nonce  uint64
+}
+
+// pendingTx is the bookkeeping of a transaction inserted in the mempool.
+type pendingTx struct {
+	tx       sdk.Tx
+	key      cmttypes.TxKey
+	priority int64
+}
+
+// EthMempool extends the priority nonce mempool with geth-like replacement rules: a
+// transaction replaces the pending one with the same sender and nonce only if it bumps its
+// fees by at least PriceBump percent. Transactions below the next nonce of their sender that
+// don't replace a pending one are rejected, the ones above it are queued and skipped by
+// Select until the gap is filled.
+//
+// Replaced transactions are evicted from the CometBFT mempool, and its CheckTx cache, by
+// failing their recheck, see EthermintApp.CheckTx.
+type EthMempool struct {
+	*mempool.PriorityNonceMempool[int64]
+
+	cfg             MempoolConfig
+	signerExtractor mempool.SignerExtractionAdapter
+	sequenceKeeper  SequenceKeeper
+
+	mtx     sync.Mutex
+	txs     map[senderNonce]pendingTx
+	evicted map[cmttypes.TxKey]senderNonce
+}
+
+// NewEthMempool returns a new EthMempool, the sequence keeper is used to skip the queued
+// transactions on Select.
+func NewEthMempool(cfg MempoolConfig, sequenceKeeper SequenceKeeper) *EthMempool {
+	signerExtractor := NewEthSignerExtractionAdapter(mempool.NewDefaultSignerExtractionAdapter())
+	return &EthMempool{
+		PriorityNonceMempool: mempool.NewPriorityMempool(mempool.PriorityNonceMempoolConfig[int64]{
+			TxPriority:      mempool.NewDefaultTxPriority(),
+			SignerExtractor: signerExtractor,
+		}),
+		cfg:             cfg,
+		signerExtractor: signerExtractor,
+		sequenceKeeper:  sequenceKeeper,
+		txs:             make(map[senderNonce]pendingTx),
+		evicted:         make(map[cmttypes.TxKey]senderNonce),
+	}
+}
+
+// Insert implements mempool.Mempool, it enforces the replacement rules before inserting the
+// transaction in the priority nonce mempool.
+func (mp *EthMempool) Insert(goCtx context.Context, tx sdk.Tx) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	id, _, err := mp.senderNonce(tx)
+	if err != nil {
+		return err
+	}
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	old, replacing := mp.txs[id]
+	if replacing {
+		if !mp.canReplace(old, tx, ctx.Priority()) {
+			return errorsmod.Wrapf(
+				errortypes.ErrInsufficientFee,
+				"replacement transaction underpriced, nonce %d needs a fee bump of %d%%", id.nonce, mp.cfg.PriceBump,
+			)
+		}
+	} else if next, ok := ante.SenderNonceFromContext(ctx); ok && id.nonce < next {
+		return errorsmod.Wrapf(
+			errortypes.ErrInvalidSequence,
+			"nonce too low; got %d, expected %d", id.nonce, next,
+		)
+	}
+
+	if err := mp.PriorityNonceMempool.Insert(ctx, tx); err != nil {
+		return err
+	}
+
+	key := cmttypes.Tx(ctx.TxBytes()).Key()
+	if replacing && old.key != key {
+		mp.evicted[old.key] = id
+	}
+	mp.txs[id] = pendingTx{tx: tx, key: key, priority: ctx.Priority()}
+	return nil
+}
+
+// Remove implements mempool.Mempool. The evicted transactions with the same sender and nonce
+// are forgotten, as CometBFT drops them from its mempool once the nonce is used.
+func (mp *EthMempool) Remove(tx sdk.Tx) error {
+	if err := mp.PriorityNonceMempool.Remove(tx); err != nil {
+		return err
+	}
+
+	id, _, err := mp.senderNonce(tx)
+	if err != nil {
+		return err
+	}
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	delete(mp.txs, id)
+	for key, evicted := range mp.evicted {
+		if evicted == id {
+			delete(mp.evicted, key)
+		}
+	}
+	return nil
+}
+
+// Select implements mempool.Mempool, the iterator skips the transactions queued behind a
+// nonce gap.
+func (mp *EthMempool) Select(goCtx context.Context, txs [][]byte) mempool.Iterator {
+	iterator := mp.PriorityNonceMempool.Select(goCtx, txs)
+	ctx, ok := goCtx.(sdk.Context)
+	if iterator == nil || !ok || mp.sequenceKeeper == nil {
+		return iterator
+	}
+
+	it := &executableIterator{
+		Iterator: iterator,
+		ctx:      ctx,
+		mp:       mp,
+		nonces:   make(map[string]uint64),
+	}
+	return it.skipQueued()
+}
+
+// PopEvicted reports if the transaction has been evicted from the mempool by a replacement,
+// and forgets it.
+func (mp *EthMempool) PopEvicted(txBytes []byte) bool {
+	key := cmttypes.Tx(txBytes).Key()
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	if _, ok := mp.evicted[key]; !ok {
+		return false
+	}
+	delete(mp.evicted, key)
+	return true
+}
+
+// senderNonce returns the sender and nonce of the first signer of the transaction, which
+// identify it in the priority nonce mempool.
+func (mp *EthMempool) senderNonce(tx sdk.Tx) (senderNonce, sdk.AccAddress, error) {
+	sigs, err := mp.signerExtractor.GetSigners(tx)
+	if err != nil {
+		return senderNonce{}, nil, err
+	}
+	if len(sigs) == 0 {
+		return senderNonce{}, nil, fmt.Errorf("tx must have at least one signer")
+	}
+	return senderNonce{sender: sigs[0].Signer.String(), nonce: sigs[0].Sequence}, sigs[0].Signer, nil
+}
+
+// canReplace applies the price bump to both the fee cap and the tip cap of Ethereum
+// transactions, like geth, and to the priority otherwise.
+func (mp *EthMempool) canReplace(old pendingTx, tx sdk.Tx, priority int64) bool {
+	oldFeeCap, oldTipCap, okOld := ethFeeCaps(old.tx)
+	feeCap, tipCap, ok := ethFeeCaps(tx)
+	if okOld && ok {
+		return mp.bumped(oldFeeCap, feeCap) && mp.bumped(oldTipCap, tipCap)
+	}
+	return mp.bumped(big.NewInt(old.priority), big.NewInt(priority))
+}
+
+// bumped checks that the new value is at least PriceBump percent above the old one.
+func (mp *EthMempool) bumped(old, value *big.Int) bool {
+	threshold := new(big.Int).Mul(old, new(big.Int).SetUint64(100+mp.cfg.PriceBump))
+	threshold.Quo(threshold, big.NewInt(100))
+	return value.Cmp(threshold) >= 0
+}
+
+// ethFeeCaps returns the fee cap and the tip cap of an Ethereum transaction.
+func ethFeeCaps(tx sdk.Tx) (feeCap, tipCap *big.Int, ok bool) {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return nil, nil, false
+	}
+	ethMsg, ok := msgs[0].(*evmtypes.MsgEthereumTx)
+	if !ok {
+		return nil, nil, false
+	}
+	txData, err := evmtypes.UnpackTxData(ethMsg.Data)
+	if err != nil {
+		return nil, nil, false
+	}
+	return txData.GetGasFeeCap(), txData.GetGasTipCap(), true
+}
+
+// executableIterator wraps the priority nonce iterator to skip the transactions whose nonce
+// is above the next nonce of their sender.
+type executableIterator struct {
+	mempool.Iterator
+
+	ctx    sdk.Context
+	mp     *EthMempool
+	nonces map[string]uint64
+}
+
+// Next implements mempool.Iterator.
+func (it *executableIterator) Next() mempool.Iterator {
+	it.Iterator = it.Iterator.Next()
+	return it.skipQueued()
+}
+
+// skipQueued advances the iterator to the next executable transaction.
+func (it *executableIterator) skipQueued() mempool.Iterator {
+	for it.Iterator != nil {
+		if it.executable(it.Iterator.Tx()) {
+			return it
+		}
+		it.Iterator = it.Iterator.Next()
+	}
+	return nil
+}
+
+// executable reports if the transaction carries the next nonce of its sender, assuming the
+// previously returned transactions are included. Transactions below it are returned, so the
+// proposal handler removes them from the mempool once they fail to verify.
+func (it *executableIterator) executable(tx sdk.Tx) bool {
+	id, signer, err := it.mp.senderNonce(tx)
+	if err != nil {
+		return true
+	}
+
+	next, ok := it.nonces[id.sender]
+	if !ok {
+		next, err = it.mp.sequenceKeeper.GetSequence(it.ctx, signer)
+		if err != nil {
+			return true
+		}
+	}
+
+	switch {
+	case id.nonce > next:
+		it.nonces[id.sender] = next
+		return false
+	case id.nonce == next:
+		next++
+	}
+	it.nonces[id.sender] = next
+	return true
+}
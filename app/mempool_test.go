@@ -0,0 +1,147 @@
+package app
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/ethermint/app/ante"
+	srvflags "github.com/evmos/ethermint/server/flags"
+	"github.com/evmos/ethermint/tests"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+type mempoolTestSuite struct {
+	app  *EthermintApp
+	ctx  sdk.Context
+	from common.Address
+}
+
+func setupMempoolTest(t *testing.T) mempoolTestSuite {
+	app := SetupWithOpts(false, nil, simtestutil.AppOptionsMap{
+		srvflags.EVMMempoolPriceBump:   10,
+		srvflags.EVMMempoolMaxNonceGap: 2,
+	})
+	ctx := app.BaseApp.NewContext(true)
+	from := tests.GenerateAddress()
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, from.Bytes()))
+	return mempoolTestSuite{app: app, ctx: ctx, from: from}
+}
+
+// newEthTx returns a dynamic fee transaction and its encoding.
+func newEthTx(
+	t *testing.T, chainID *big.Int, from common.Address, nonce, gas uint64, feeCap, tipCap int64,
+) (sdk.Tx, []byte) {
+	to := common.BigToAddress(big.NewInt(1))
+	msg := evmtypes.NewTx(chainID, nonce, &to, big.NewInt(0), gas, nil, big.NewInt(feeCap), big.NewInt(tipCap), nil, nil)
+	msg.From = from.Bytes()
+
+	txConfig := MakeConfigForTest().TxConfig
+	tx, err := msg.BuildTx(txConfig.NewTxBuilder(), evmtypes.DefaultEVMDenom)
+	require.NoError(t, err)
+	bz, err := txConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+	return tx, bz
+}
+
+func (s mempoolTestSuite) buildTx(t *testing.T, nonce uint64, feeCap, tipCap int64) (sdk.Tx, []byte) {
+	return newEthTx(t, s.app.EvmKeeper.ChainID(), s.from, nonce, 21000, feeCap, tipCap)
+}
+
+// checkTx runs the CheckTx nonce rules of the ante handler, then inserts the transaction in
+// the mempool, like baseapp does.
+func (s *mempoolTestSuite) checkTx(t *testing.T, tx sdk.Tx, bz []byte) error {
+	ctx, err := ante.CheckEthPendingNonce(s.ctx.WithTxBytes(bz), tx, s.app.AccountKeeper, 2)
+	if err != nil {
+		return err
+	}
+	return s.app.mempool.Insert(ctx, tx)
+}
+
+func TestEthMempoolReplacement(t *testing.T) {
+	s := setupMempoolTest(t)
+
+	tx, bz := s.buildTx(t, 0, 1000, 100)
+	require.NoError(t, s.checkTx(t, tx, bz))
+
+	// a fee bump below 10% is rejected, on the fee cap or on the tip cap
+	underpriced, underpricedBz := s.buildTx(t, 0, 1099, 110)
+	require.ErrorContains(t, s.checkTx(t, underpriced, underpricedBz), "replacement transaction underpriced")
+	underpriced, underpricedBz = s.buildTx(t, 0, 1100, 109)
+	require.ErrorContains(t, s.checkTx(t, underpriced, underpricedBz), "replacement transaction underpriced")
+	require.False(t, s.app.mempool.PopEvicted(bz))
+
+	replacement, replacementBz := s.buildTx(t, 0, 1100, 110)
+	require.NoError(t, s.checkTx(t, replacement, replacementBz))
+	require.Equal(t, 1, s.app.mempool.CountTx())
+
+	it := s.app.mempool.Select(s.ctx, nil)
+	require.NotNil(t, it)
+	require.Equal(t, replacement, it.Tx())
+	require.Nil(t, it.Next())
+
+	// the replaced transaction fails its recheck, once
+	res, err := s.app.CheckTx(&abci.RequestCheckTx{Tx: bz, Type: abci.CheckTxType_Recheck})
+	require.NoError(t, err)
+	require.NotEqual(t, abci.CodeTypeOK, res.Code)
+	require.Contains(t, res.Log, "transaction replaced in the mempool")
+	require.False(t, s.app.mempool.PopEvicted(bz))
+}
+
+func TestEthMempoolEvictedRemoved(t *testing.T) {
+	s := setupMempoolTest(t)
+
+	tx, bz := s.buildTx(t, 0, 1000, 100)
+	require.NoError(t, s.checkTx(t, tx, bz))
+	replacement, replacementBz := s.buildTx(t, 0, 2000, 200)
+	require.NoError(t, s.checkTx(t, replacement, replacementBz))
+
+	// once the nonce is used, the evicted transaction is forgotten
+	require.NoError(t, s.app.mempool.Remove(replacement))
+	require.Equal(t, 0, s.app.mempool.CountTx())
+	require.False(t, s.app.mempool.PopEvicted(bz))
+}
+
+func TestEthMempoolNonceGap(t *testing.T) {
+	s := setupMempoolTest(t)
+
+	tx0, bz0 := s.buildTx(t, 0, 1000, 100)
+	require.NoError(t, s.checkTx(t, tx0, bz0))
+
+	// queued up to the max nonce gap ahead of the next nonce
+	tx3, bz3 := s.buildTx(t, 3, 1000, 100)
+	require.NoError(t, s.checkTx(t, tx3, bz3))
+	tx4, bz4 := s.buildTx(t, 4, 1000, 100)
+	require.ErrorContains(t, s.checkTx(t, tx4, bz4), "nonce too high")
+
+	// a nonce below the next one must replace a pending transaction
+	require.NoError(t, s.app.mempool.Remove(tx0))
+	stale, staleBz := s.buildTx(t, 0, 2000, 200)
+	require.ErrorContains(t, s.checkTx(t, stale, staleBz), "nonce too low")
+
+	// the queued transaction isn't selected until the gap is filled
+	selectCtx := s.app.BaseApp.NewContext(false)
+	acc := s.app.AccountKeeper.NewAccountWithAddress(selectCtx, s.from.Bytes())
+	require.NoError(t, acc.SetSequence(1))
+	s.app.AccountKeeper.SetAccount(selectCtx, acc)
+	require.Nil(t, s.app.mempool.Select(selectCtx, nil))
+
+	tx1, bz1 := s.buildTx(t, 1, 1000, 100)
+	require.NoError(t, s.checkTx(t, tx1, bz1))
+	tx2, bz2 := s.buildTx(t, 2, 1000, 100)
+	require.NoError(t, s.checkTx(t, tx2, bz2))
+
+	var selected []sdk.Tx
+	for it := s.app.mempool.Select(selectCtx, nil); it != nil; it = it.Next() {
+		selected = append(selected, it.Tx())
+	}
+	require.Equal(t, []sdk.Tx{tx1, tx2, tx3}, selected)
+}
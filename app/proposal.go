@@ -0,0 +1,322 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package app
+
+import (
+	"errors"
+	"math"
+	"slices"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	ethermint "github.com/evmos/ethermint/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// ProposalHandler defines the Ethermint PrepareProposal and ProcessProposal handlers. Unlike
+// the default ones of the SDK, they extract the sender and nonce of the Ethereum txs, and
+// account for the gas wanted by their messages, which is what the ante handler charges to the
+// block outside of CheckTx. The sidecars of the blob txs are stripped from the proposal.
+type ProposalHandler struct {
+	mempool         mempool.Mempool
+	txVerifier      baseapp.ProposalTxVerifier
+	txConfig        client.TxConfig
+	blobSidecars    BlobSidecarProvider
+	signerExtractor mempool.SignerExtractionAdapter
+}
+
+// BlobSidecarProvider provides the verified sidecars of the blob txs received by the node, by
+// Ethereum tx hash.
+type BlobSidecarProvider interface {
+	BlobSidecar(ethTxHash string) *evmtypes.BlobTxSidecar
+}
+
+// NewProposalHandler returns a new ProposalHandler selecting the txs of the given mempool, and
+// checking the availability of the blob sidecars with the provider.
+func NewProposalHandler(
+	mp mempool.Mempool, txVerifier baseapp.ProposalTxVerifier, txConfig client.TxConfig, blobSidecars BlobSidecarProvider,
+) *ProposalHandler {
+	return &ProposalHandler{
+		mempool:         mp,
+		txVerifier:      txVerifier,
+		txConfig:        txConfig,
+		blobSidecars:    blobSidecars,
+		signerExtractor: NewEthSignerExtractionAdapter(mempool.NewDefaultSignerExtractionAdapter()),
+	}
+}
+
+// PrepareProposalHandler returns the PrepareProposal handler. The txs are taken from the
+// mempool by decreasing priority, i.e. effective tip, stripped of their blob sidecars, and
+// added to the proposal as long as:
+// - the nonces of each sender follow each other, once a tx of a sender is left out, the
+// following ones are left out as well
+// - the total gas wanted stays within the block gas limit
+// - the total size stays within the max tx bytes
+// - they pass the ante handler, the failing ones are removed from the mempool
+//
+// Unlike the default handler, a tx that doesn't fit doesn't stop the selection, smaller
+// txs of other senders may still fit.
+func (h *ProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		maxBlockGas := blockGasLimit(ctx)
+		maxTxBytes := req.MaxTxBytes
+
+		var (
+			selected   [][]byte
+			totalGas   uint64
+			totalBytes int64
+			nonces     = make(map[string]uint64)
+			skipped    = make(map[string]bool)
+		)
+
+		for iterator := h.mempool.Select(ctx, req.Txs); iterator != nil; iterator = iterator.Next() {
+			memTx := iterator.Tx()
+			signers, err := h.signerExtractor.GetSigners(memTx)
+			if err != nil {
+				return nil, err
+			}
+
+			if !inSequence(signers, nonces, skipped) {
+				skipSigners(signers, skipped)
+				continue
+			}
+
+			gasWanted := txGasWanted(memTx)
+			tx, txBz, err := h.stripBlobSidecars(memTx)
+			if err != nil {
+				return nil, err
+			}
+			if totalGas+gasWanted > maxBlockGas || totalGas+gasWanted < totalGas ||
+				totalBytes+int64(len(txBz)) > maxTxBytes {
+				skipSigners(signers, skipped)
+				continue
+			}
+
+			// NOTE: the txs were verified in CheckTx, but the state may have changed since, so we
+			// check them again, this also updates the nonces of the proposal state.
+			if _, err := h.txVerifier.PrepareProposalVerifyTx(tx); err != nil {
+				skipSigners(signers, skipped)
+				if err := h.mempool.Remove(memTx); err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+					return nil, err
+				}
+				continue
+			}
+
+			for _, signer := range signers {
+				nonces[signer.Signer.String()] = signer.Sequence
+			}
+			selected = append(selected, txBz)
+			totalGas += gasWanted
+			totalBytes += int64(len(txBz))
+		}
+
+		return &abci.ResponsePrepareProposal{Txs: selected}, nil
+	}
+}
+
+// stripBlobSidecars returns the tx and its encoding without the sidecars of its blob txs, only
+// their versioned hashes are included in the blocks. The sidecars were verified in CheckTx,
+// they are kept in the mempool tx, which is left untouched. As the committed tx doesn't
+// match the bytes of the CometBFT mempool, it's dropped from there when its recheck fails on
+// the nonce.
+func (h *ProposalHandler) stripBlobSidecars(tx sdk.Tx) (sdk.Tx, []byte, error) {
+	txBz, err := h.txVerifier.TxEncode(tx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !hasBlobSidecar(tx) {
+		return tx, txBz, nil
+	}
+
+	// work on a copy of the tx, the messages are shared with the mempool
+	stripped, err := h.txVerifier.TxDecode(txBz)
+	if err != nil {
+		return nil, nil, err
+	}
+	msgs := stripped.GetMsgs()
+	for _, msg := range msgs {
+		if ethMsg, ok := msg.(*evmtypes.MsgEthereumTx); ok {
+			if _, err := ethMsg.StripBlobSidecar(); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+	builder, err := h.txConfig.WrapTxBuilder(stripped)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := builder.SetMsgs(msgs...); err != nil {
+		return nil, nil, err
+	}
+	tx = builder.GetTx()
+	txBz, err = h.txVerifier.TxEncode(tx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return tx, txBz, nil
+}
+
+// hasBlobSidecar reports if one of the Ethereum messages of the tx carries a blob sidecar.
+func hasBlobSidecar(tx sdk.Tx) bool {
+	for _, msg := range tx.GetMsgs() {
+		ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			return false
+		}
+		if ethMsg.TxType() != ethtypes.BlobTxType {
+			continue
+		}
+		txData, err := evmtypes.UnpackTxData(ethMsg.Data)
+		if err == nil && txData.(*evmtypes.BlobTx).Sidecar != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// ProcessProposalHandler returns the ProcessProposal handler. A proposal is rejected if any
+// of its txs fails to decode or to pass the ante handler, if the nonces of a sender don't
+// follow each other, if the total gas wanted exceeds the block gas limit, or if the sidecar
+// of one of its blob txs isn't available to the node.
+//
+// Only the versioned hashes of the blobs are included in the blocks, so a validator only
+// votes for the blob txs whose sidecars it received and verified in CheckTx, which are
+// persisted by the node. The blob txs are therefore only included once they were gossiped
+// to more than two thirds of the voting power. The nodes catching up with block sync don't
+// process the proposals, and don't have the sidecars of the blocks they didn't receive.
+func (h *ProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		reject := &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}
+		maxBlockGas := blockGasLimit(ctx)
+
+		var totalGas uint64
+		nonces := make(map[string]uint64)
+		for _, txBz := range req.Txs {
+			tx, err := h.txVerifier.ProcessProposalVerifyTx(txBz)
+			if err != nil {
+				return reject, nil
+			}
+
+			signers, err := h.signerExtractor.GetSigners(tx)
+			if err != nil || !inSequence(signers, nonces, nil) {
+				return reject, nil
+			}
+			for _, signer := range signers {
+				nonces[signer.Signer.String()] = signer.Sequence
+			}
+
+			gasWanted := txGasWanted(tx)
+			if totalGas+gasWanted > maxBlockGas || totalGas+gasWanted < totalGas {
+				return reject, nil
+			}
+			totalGas += gasWanted
+
+			if !h.blobSidecarsAvailable(tx) {
+				return reject, nil
+			}
+		}
+
+		return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
+	}
+}
+
+// blobSidecarsAvailable checks that the verified sidecars of the blob txs of the tx are
+// available, and match their versioned hashes.
+func (h *ProposalHandler) blobSidecarsAvailable(tx sdk.Tx) bool {
+	for _, msg := range tx.GetMsgs() {
+		ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok || ethMsg.TxType() != ethtypes.BlobTxType {
+			continue
+		}
+		txData, err := evmtypes.UnpackTxData(ethMsg.Data)
+		if err != nil {
+			return false
+		}
+		sidecar := h.blobSidecars.BlobSidecar(ethMsg.Hash)
+		if sidecar == nil {
+			return false
+		}
+		ethSidecar, err := sidecar.ToEthSidecar()
+		if err != nil || !slices.Equal(ethSidecar.BlobHashes(), txData.(*evmtypes.BlobTx).GetBlobVersionedHashes()) {
+			return false
+		}
+	}
+	return true
+}
+
+// blockGasLimit returns the block gas limit of types.BlockGasLimit, where 0 means no limit.
+func blockGasLimit(ctx sdk.Context) uint64 {
+	limit := ethermint.BlockGasLimit(ctx)
+	if limit == 0 {
+		return math.MaxUint64
+	}
+	return limit
+}
+
+// inSequence checks that the nonce of each signer follows the previous one in the proposal,
+// and that none of them had a tx left out of it.
+func inSequence(signers []mempool.SignerData, nonces map[string]uint64, skipped map[string]bool) bool {
+	for _, signer := range signers {
+		sender := signer.Signer.String()
+		if skipped[sender] {
+			return false
+		}
+		if nonce, ok := nonces[sender]; ok && signer.Sequence != nonce+1 {
+			return false
+		}
+	}
+	return true
+}
+
+// skipSigners leaves the following txs of the signers out of the proposal.
+func skipSigners(signers []mempool.SignerData, skipped map[string]bool) {
+	for _, signer := range signers {
+		skipped[signer.Signer.String()] = true
+	}
+}
+
+// txGasWanted returns the gas wanted by a tx in a block: the sum of the gas limits of its
+// Ethereum messages, as the ante handler doesn't cap it outside of CheckTx, or the gas limit
+// of a Cosmos tx.
+func txGasWanted(tx sdk.Tx) uint64 {
+	var (
+		gasWanted uint64
+		isEthTx   bool
+	)
+	for _, msg := range tx.GetMsgs() {
+		ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			break
+		}
+		isEthTx = true
+		gasWanted += ethMsg.GetGas()
+	}
+	if isEthTx {
+		return gasWanted
+	}
+
+	if feeTx, ok := tx.(sdk.FeeTx); ok {
+		return feeTx.GetGas()
+	}
+	return 0
+}
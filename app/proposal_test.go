@@ -0,0 +1,266 @@
+package app
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/holiman/uint256"
+
+	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+	"github.com/evmos/ethermint/tests"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// mockTxVerifier stands in for the ante handler of the proposal handler.
+type mockTxVerifier struct {
+	txConfig client.TxConfig
+	invalid  map[string]bool
+}
+
+func (v mockTxVerifier) PrepareProposalVerifyTx(tx sdk.Tx) ([]byte, error) {
+	bz, err := v.TxEncode(tx)
+	if err != nil {
+		return nil, err
+	}
+	if v.invalid[string(bz)] {
+		return nil, errors.New("invalid tx")
+	}
+	return bz, nil
+}
+
+func (v mockTxVerifier) ProcessProposalVerifyTx(txBz []byte) (sdk.Tx, error) {
+	if v.invalid[string(txBz)] {
+		return nil, errors.New("invalid tx")
+	}
+	return v.TxDecode(txBz)
+}
+
+func (v mockTxVerifier) TxDecode(txBz []byte) (sdk.Tx, error) {
+	return v.txConfig.TxDecoder()(txBz)
+}
+
+func (v mockTxVerifier) TxEncode(tx sdk.Tx) ([]byte, error) {
+	return v.txConfig.TxEncoder()(tx)
+}
+
+// blobSidecars stands in for the sidecars stored by the node.
+type blobSidecars map[string]*evmtypes.BlobTxSidecar
+
+func (s blobSidecars) BlobSidecar(ethTxHash string) *evmtypes.BlobTxSidecar {
+	return s[ethTxHash]
+}
+
+type proposalTestSuite struct {
+	ctx      sdk.Context
+	mempool  *EthMempool
+	verifier mockTxVerifier
+	sidecars blobSidecars
+	handler  *ProposalHandler
+	chainID  *big.Int
+	txs      map[string][]byte
+}
+
+func setupProposalTest(t *testing.T, maxGas int64) *proposalTestSuite {
+	ctx := sdk.NewContext(nil, cmtproto.Header{Height: 1}, false, log.NewNopLogger()).
+		WithConsensusParams(cmtproto.ConsensusParams{Block: &cmtproto.BlockParams{MaxGas: maxGas}})
+	mp := NewEthMempool(MempoolConfig{PriceBump: 10}, nil)
+	verifier := mockTxVerifier{txConfig: MakeConfigForTest().TxConfig, invalid: make(map[string]bool)}
+	sidecars := make(blobSidecars)
+
+	s := &proposalTestSuite{
+		ctx:      ctx,
+		mempool:  mp,
+		verifier: verifier,
+		sidecars: sidecars,
+		handler:  NewProposalHandler(mp, verifier, verifier.txConfig, sidecars),
+		chainID:  big.NewInt(9000),
+		txs:      make(map[string][]byte),
+	}
+
+	// priorities decrease from A to C, B0 is the only tx using more than 21000 gas
+	senders := map[string]common.Address{"A": tests.GenerateAddress(), "B": tests.GenerateAddress(), "C": tests.GenerateAddress()}
+	for _, tc := range []struct {
+		name     string
+		nonce    uint64
+		gas      uint64
+		priority int64
+	}{
+		{"A0", 0, 21000, 10},
+		{"A1", 1, 21000, 10},
+		{"A2", 2, 21000, 10},
+		{"B0", 0, 30000, 5},
+		{"B1", 1, 21000, 5},
+		{"C0", 0, 21000, 1},
+	} {
+		tx, bz := newEthTx(t, s.chainID, senders[tc.name[:1]], tc.nonce, tc.gas, 1000, 100)
+		s.txs[tc.name] = bz
+		if tc.name == "A2" {
+			// not in the mempool
+			continue
+		}
+		require.NoError(t, mp.Insert(ctx.WithTxBytes(bz).WithPriority(tc.priority), tx))
+	}
+	return s
+}
+
+func (s *proposalTestSuite) proposal(names ...string) [][]byte {
+	var txs [][]byte
+	for _, name := range names {
+		txs = append(txs, s.txs[name])
+	}
+	return txs
+}
+
+func TestPrepareProposal(t *testing.T) {
+	testCases := []struct {
+		name       string
+		maxGas     int64
+		maxTxBytes int64
+		invalid    []string
+		expTxs     []string
+		expRemoved int
+	}{
+		{"no block gas limit", -1, 1 << 20, nil, []string{"A0", "A1", "B0", "B1", "C0"}, 0},
+		{"skip the txs exceeding the block gas limit and the following ones of their senders", 63000, 1 << 20, nil, []string{"A0", "A1", "C0"}, 0},
+		{"remove the invalid txs", -1, 1 << 20, []string{"A0"}, []string{"B0", "B1", "C0"}, 1},
+		{"max tx bytes", -1, 0, nil, nil, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := setupProposalTest(t, tc.maxGas)
+			for _, name := range tc.invalid {
+				s.verifier.invalid[string(s.txs[name])] = true
+			}
+
+			res, err := s.handler.PrepareProposalHandler()(s.ctx, &abci.RequestPrepareProposal{MaxTxBytes: tc.maxTxBytes})
+			require.NoError(t, err)
+			require.Equal(t, s.proposal(tc.expTxs...), res.Txs)
+			require.Equal(t, 5-tc.expRemoved, s.mempool.CountTx())
+		})
+	}
+}
+
+func TestPrepareProposalStripBlobSidecars(t *testing.T) {
+	s := setupProposalTest(t, -1)
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	key, err := priv.ToECDSA()
+	require.NoError(t, err)
+
+	var blob kzg4844.Blob
+	commitment, err := kzg4844.BlobToCommitment(blob)
+	require.NoError(t, err)
+	proof, err := kzg4844.ComputeBlobProof(blob, commitment)
+	require.NoError(t, err)
+	sidecar := &ethtypes.BlobTxSidecar{
+		Blobs:       []kzg4844.Blob{blob},
+		Commitments: []kzg4844.Commitment{commitment},
+		Proofs:      []kzg4844.Proof{proof},
+	}
+	ethTx, err := ethtypes.SignNewTx(key, ethtypes.LatestSignerForChainID(s.chainID), &ethtypes.BlobTx{
+		ChainID:    uint256.MustFromBig(s.chainID),
+		GasTipCap:  uint256.NewInt(1),
+		GasFeeCap:  uint256.NewInt(1),
+		Gas:        21000,
+		To:         tests.GenerateAddress(),
+		Value:      uint256.NewInt(0),
+		BlobFeeCap: uint256.NewInt(1),
+		BlobHashes: sidecar.BlobHashes(),
+		Sidecar:    sidecar,
+	})
+	require.NoError(t, err)
+	msg := &evmtypes.MsgEthereumTx{}
+	require.NoError(t, msg.FromSignedEthereumTx(ethTx, s.chainID))
+	tx, err := msg.BuildTx(s.verifier.txConfig.NewTxBuilder(), evmtypes.DefaultEVMDenom)
+	require.NoError(t, err)
+	bz, err := s.verifier.TxEncode(tx)
+	require.NoError(t, err)
+	require.NoError(t, s.mempool.Insert(s.ctx.WithTxBytes(bz).WithPriority(100), tx))
+
+	res, err := s.handler.PrepareProposalHandler()(s.ctx, &abci.RequestPrepareProposal{MaxTxBytes: 1 << 20})
+	require.NoError(t, err)
+	require.Len(t, res.Txs, 6)
+	require.Less(t, len(res.Txs[0]), len(bz))
+
+	proposed, err := s.verifier.TxDecode(res.Txs[0])
+	require.NoError(t, err)
+	proposedMsg := proposed.GetMsgs()[0].(*evmtypes.MsgEthereumTx)
+	require.Equal(t, ethTx.Hash().Hex(), proposedMsg.Hash)
+	txData, err := evmtypes.UnpackTxData(proposedMsg.Data)
+	require.NoError(t, err)
+	require.Nil(t, txData.(*evmtypes.BlobTx).Sidecar)
+	require.Equal(t, ethTx.Hash(), proposedMsg.AsTransaction().Hash())
+
+	// the tx of the mempool keeps its sidecar
+	txData, err = evmtypes.UnpackTxData(msg.Data)
+	require.NoError(t, err)
+	require.NotNil(t, txData.(*evmtypes.BlobTx).Sidecar)
+
+	// the proposal is only accepted by the nodes having the sidecar
+	processRes, err := s.handler.ProcessProposalHandler()(s.ctx, &abci.RequestProcessProposal{Txs: res.Txs[:1]})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_REJECT, processRes.Status)
+
+	otherSidecar := &ethtypes.BlobTxSidecar{
+		Blobs:       []kzg4844.Blob{{1}},
+		Commitments: []kzg4844.Commitment{{1}},
+		Proofs:      []kzg4844.Proof{{1}},
+	}
+	s.sidecars[ethTx.Hash().Hex()] = evmtypes.NewBlobTxSidecar(otherSidecar)
+	processRes, err = s.handler.ProcessProposalHandler()(s.ctx, &abci.RequestProcessProposal{Txs: res.Txs[:1]})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_REJECT, processRes.Status)
+
+	s.sidecars[ethTx.Hash().Hex()] = evmtypes.NewBlobTxSidecar(sidecar)
+	processRes, err = s.handler.ProcessProposalHandler()(s.ctx, &abci.RequestProcessProposal{Txs: res.Txs[:1]})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, processRes.Status)
+}
+
+func TestProcessProposal(t *testing.T) {
+	testCases := []struct {
+		name      string
+		maxGas    int64
+		txs       []string
+		invalid   []string
+		expAccept bool
+	}{
+		{"accept", 63000, []string{"A0", "A1", "C0"}, nil, true},
+		{"accept without block gas limit", -1, []string{"A0", "B0", "A1", "C0"}, nil, true},
+		{"exceed the block gas limit", 63000, []string{"A0", "A1", "B0"}, nil, false},
+		{"nonces out of order", -1, []string{"A1", "A0"}, nil, false},
+		{"nonce gap", -1, []string{"A0", "A2"}, nil, false},
+		{"invalid tx", -1, []string{"A0", "B0"}, []string{"B0"}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := setupProposalTest(t, tc.maxGas)
+			for _, name := range tc.invalid {
+				s.verifier.invalid[string(s.txs[name])] = true
+			}
+
+			res, err := s.handler.ProcessProposalHandler()(s.ctx, &abci.RequestProcessProposal{Txs: s.proposal(tc.txs...)})
+			require.NoError(t, err)
+			if tc.expAccept {
+				require.Equal(t, abci.ResponseProcessProposal_ACCEPT, res.Status)
+			} else {
+				require.Equal(t, abci.ResponseProcessProposal_REJECT, res.Status)
+			}
+		})
+	}
+}
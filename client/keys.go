@@ -86,6 +86,8 @@ The pass backend requires GnuPG: https://gnupg.org/
 		flags.LineBreak,
 		UnsafeExportEthKeyCommand(),
 		UnsafeImportKeyCommand(),
+		ImportKeystoreCommand(),
+		ExportKeystoreCommand(),
 	)
 
 	cmd.PersistentFlags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
@@ -0,0 +1,154 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package client
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/crypto"
+
+	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+	"github.com/evmos/ethermint/crypto/hd"
+)
+
+// flagKeystoreDir is the flag of the keystore directory the key is exported to
+const flagKeystoreDir = "keystore-dir"
+
+// ImportKeystoreCommand imports a key from a Web3 Secret Storage (keystore v3) file.
+func ImportKeystoreCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "import-keystore <name> <keyfile>",
+		Short: "Import an Ethereum key from a keystore file into the local keybase",
+		Long: `Import an Ethereum key from a Web3 Secret Storage (keystore v3) file, like the ones of go-ethereum
+and of the personal JSON-RPC namespace, decrypted with the password of the file.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd).WithKeyringOptions(hd.EthSecp256k1Option())
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			keyJSON, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			// the keystore files have no minimum password length
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			password, err := input.GetPassword("Enter the password of the keystore file:", inBuf)
+			if err != nil && password == "" {
+				return err
+			}
+
+			key, err := keystore.DecryptKey(keyJSON, password)
+			if err != nil {
+				return err
+			}
+
+			privKey := &ethsecp256k1.PrivKey{Key: ethcrypto.FromECDSA(key.PrivateKey)}
+			armor := crypto.EncryptArmorPrivKey(privKey, password, ethsecp256k1.KeyType)
+			if err := clientCtx.Keyring.ImportPrivKey(args[0], armor, password); err != nil {
+				return err
+			}
+
+			cmd.PrintErrf("imported %s\n", key.Address.Hex())
+			return nil
+		},
+	}
+}
+
+// ExportKeystoreCommand exports a key as a Web3 Secret Storage (keystore v3) file.
+func ExportKeystoreCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-keystore <name>",
+		Short: "Export an Ethereum key as a keystore file",
+		Long: `Export an Ethereum key as a Web3 Secret Storage (keystore v3) file, encrypted with a new password.
+The file is printed, or written to the keystore directory with the file name used by go-ethereum.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd).WithKeyringOptions(hd.EthSecp256k1Option())
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			password, err := input.GetPassword("Enter the password to encrypt the keystore file:", inBuf)
+			if err != nil {
+				return err
+			}
+
+			// the armor only transports the key out of the keyring
+			armor, err := clientCtx.Keyring.ExportPrivKeyArmor(args[0], password)
+			if err != nil {
+				return err
+			}
+			privKey, algo, err := crypto.UnarmorDecryptPrivKey(armor, password)
+			if err != nil {
+				return err
+			}
+			ethPrivKey, ok := privKey.(*ethsecp256k1.PrivKey)
+			if !ok || algo != ethsecp256k1.KeyType {
+				return fmt.Errorf("invalid key algorithm, got %s, expected %s", algo, ethsecp256k1.KeyType)
+			}
+			key, err := ethPrivKey.ToECDSA()
+			if err != nil {
+				return err
+			}
+
+			dir, err := cmd.Flags().GetString(flagKeystoreDir)
+			if err != nil {
+				return err
+			}
+			if dir != "" {
+				account, err := keystore.NewKeyStore(dir, keystore.StandardScryptN, keystore.StandardScryptP).ImportECDSA(key, password)
+				if err != nil {
+					return err
+				}
+				cmd.PrintErrf("exported %s to %s\n", account.Address.Hex(), account.URL.Path)
+				return nil
+			}
+
+			id, err := uuid.NewRandom()
+			if err != nil {
+				return err
+			}
+			keyJSON, err := keystore.EncryptKey(&keystore.Key{
+				Id:         id,
+				Address:    ethcrypto.PubkeyToAddress(key.PublicKey),
+				PrivateKey: key,
+			}, password, keystore.StandardScryptN, keystore.StandardScryptP)
+			if err != nil {
+				return err
+			}
+			cmd.Println(string(keyJSON))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagKeystoreDir, "", "Write the keystore file to the directory instead of printing it")
+	return cmd
+}
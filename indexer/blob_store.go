@@ -0,0 +1,199 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package indexer
+
+import (
+	"encoding/binary"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+
+	ethermint "github.com/evmos/ethermint/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+const (
+	KeyPrefixBlobSidecar = 1
+
+	// BlobSidecarKeyLength is the length of blob sidecar key
+	BlobSidecarKeyLength = 1 + 8 + 4
+)
+
+var _ ethermint.EVMBlobStore = &BlobStore{}
+
+// BlobSidecarProvider provides the sidecars of the blob transactions, which are
+// stripped from the transactions included in the blocks. It's implemented by the
+// app, which keeps the sidecars of the transactions accepted in its mempool.
+type BlobSidecarProvider interface {
+	BlobSidecar(ethTxHash string) *evmtypes.BlobTxSidecar
+}
+
+// BlobStore implements the node-local store of the EIP-4844 blob sidecars on a
+// KV db. The sidecars of the blocks older than the retention are pruned.
+type BlobStore struct {
+	db        dbm.DB
+	logger    log.Logger
+	clientCtx client.Context
+	// retention is the number of recent blocks whose sidecars are kept, zero
+	// keeps all of them.
+	retention uint64
+	sidecars  BlobSidecarProvider
+}
+
+// NewBlobStore creates the BlobStore
+func NewBlobStore(db dbm.DB, logger log.Logger, clientCtx client.Context, retention uint64) *BlobStore {
+	return &BlobStore{db: db, logger: logger, clientCtx: clientCtx, retention: retention}
+}
+
+// SetSidecarProvider sets the provider of the sidecars stripped from the blocks.
+func (bs *BlobStore) SetSidecarProvider(sidecars BlobSidecarProvider) {
+	bs.sidecars = sidecars
+}
+
+// IndexBlock stores the sidecars of the blob transactions included in the
+// block, and prunes the ones that fall out of the retention window. The
+// sidecars are stripped from the blocks, only the ones known to the sidecar
+// provider, i.e. that went through the mempool of the node, are stored.
+func (bs *BlobStore) IndexBlock(block *tmtypes.Block, txResults []*abci.ExecTxResult) error {
+	height := block.Header.Height
+
+	batch := bs.db.NewBatch()
+	defer batch.Close()
+
+	// record index of valid eth tx during the iteration, like the KVIndexer
+	var ethTxIndex int32
+	for txIndex, tx := range block.Txs {
+		if !rpctypes.TxSuccessOrExceedsBlockGasLimit(txResults[txIndex]) {
+			continue
+		}
+
+		tx, err := bs.clientCtx.TxConfig.TxDecoder()(tx)
+		if err != nil {
+			bs.logger.Error("Fail to decode tx", "err", err, "block", height, "txIndex", txIndex)
+			continue
+		}
+
+		if !isEthTx(tx) {
+			continue
+		}
+
+		for _, msg := range tx.GetMsgs() {
+			ethMsg := msg.(*evmtypes.MsgEthereumTx)
+			index := ethTxIndex
+			ethTxIndex++
+
+			txData, err := evmtypes.UnpackTxData(ethMsg.Data)
+			if err != nil {
+				bs.logger.Error("Fail to unpack tx data", "err", err, "block", height, "txIndex", txIndex)
+				continue
+			}
+			blobTx, ok := txData.(*evmtypes.BlobTx)
+			if !ok {
+				continue
+			}
+			sidecar := blobTx.Sidecar
+			if sidecar == nil && bs.sidecars != nil {
+				sidecar = bs.sidecars.BlobSidecar(ethMsg.Hash)
+			}
+			if sidecar == nil {
+				bs.logger.Debug("missing blob sidecar", "block", height, "hash", ethMsg.Hash)
+				continue
+			}
+
+			result := ethermint.BlobSidecarResult{
+				Height:      height,
+				EthTxIndex:  index,
+				EthTxHash:   ethMsg.Hash,
+				BlobHashes:  blobTx.BlobHashes,
+				Blobs:       sidecar.Blobs,
+				Commitments: sidecar.Commitments,
+				Proofs:      sidecar.Proofs,
+			}
+			if err := batch.Set(BlobSidecarKey(height, index), bs.clientCtx.Codec.MustMarshal(&result)); err != nil {
+				return errorsmod.Wrapf(err, "IndexBlock %d, set blob sidecar", height)
+			}
+		}
+	}
+
+	if err := bs.prune(batch, height); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, prune", height)
+	}
+	if err := batch.Write(); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, write batch", height)
+	}
+	return nil
+}
+
+// GetBlobSidecars returns the sidecars of the blob transactions included in
+// the block, ordered by eth tx index.
+func (bs *BlobStore) GetBlobSidecars(height int64) ([]*ethermint.BlobSidecarResult, error) {
+	it, err := bs.db.Iterator(BlobSidecarKey(height, 0), BlobSidecarKey(height+1, 0))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetBlobSidecars %d", height)
+	}
+	defer it.Close()
+
+	var results []*ethermint.BlobSidecarResult
+	for ; it.Valid(); it.Next() {
+		var result ethermint.BlobSidecarResult
+		if err := bs.clientCtx.Codec.Unmarshal(it.Value(), &result); err != nil {
+			return nil, errorsmod.Wrapf(err, "GetBlobSidecars %d", height)
+		}
+		results = append(results, &result)
+	}
+	return results, nil
+}
+
+// Retention returns the number of recent blocks whose sidecars are kept.
+func (bs *BlobStore) Retention() uint64 {
+	return bs.retention
+}
+
+// prune deletes the sidecars of the blocks out of the retention window ending
+// at the given height.
+func (bs *BlobStore) prune(batch dbm.Batch, height int64) error {
+	if bs.retention == 0 || uint64(height) <= bs.retention {
+		return nil
+	}
+
+	end := BlobSidecarKey(height-int64(bs.retention)+1, 0)
+	it, err := bs.db.Iterator([]byte{KeyPrefixBlobSidecar}, end)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		if err := batch.Delete(it.Key()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// BlobSidecarKey returns the key for db entry: `(block number, eth tx index) -> blob sidecar`
+func BlobSidecarKey(blockNumber int64, ethTxIndex int32) []byte {
+	key := make([]byte, 0, BlobSidecarKeyLength)
+	key = append(key, KeyPrefixBlobSidecar)
+	key = append(key, sdk.Uint64ToBigEndian(uint64(blockNumber))...)
+	return binary.BigEndian.AppendUint32(key, uint32(ethTxIndex))
+}
@@ -0,0 +1,122 @@
+package indexer_test
+
+import (
+	"math/big"
+	"testing"
+
+	tmlog "cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/app"
+	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+	"github.com/evmos/ethermint/indexer"
+	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+func TestBlobStore(t *testing.T) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	key, err := priv.ToECDSA()
+	require.NoError(t, err)
+	chainID := big.NewInt(9000)
+
+	var blob kzg4844.Blob
+	commitment, err := kzg4844.BlobToCommitment(blob)
+	require.NoError(t, err)
+	proof, err := kzg4844.ComputeBlobProof(blob, commitment)
+	require.NoError(t, err)
+	sidecar := &ethtypes.BlobTxSidecar{
+		Blobs:       []kzg4844.Blob{blob},
+		Commitments: []kzg4844.Commitment{commitment},
+		Proofs:      []kzg4844.Proof{proof},
+	}
+	ethTx, err := ethtypes.SignNewTx(key, ethtypes.LatestSignerForChainID(chainID), &ethtypes.BlobTx{
+		ChainID:    uint256.MustFromBig(chainID),
+		GasTipCap:  uint256.NewInt(1),
+		GasFeeCap:  uint256.NewInt(1),
+		Gas:        21000,
+		To:         tests.GenerateAddress(),
+		Value:      uint256.NewInt(0),
+		BlobFeeCap: uint256.NewInt(1),
+		BlobHashes: sidecar.BlobHashes(),
+		Sidecar:    sidecar,
+	})
+	require.NoError(t, err)
+	tx := &types.MsgEthereumTx{}
+	require.NoError(t, tx.FromSignedEthereumTx(ethTx, chainID))
+
+	encodingConfig := app.MakeConfigForTest()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+	tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), "aphoton")
+	require.NoError(t, err)
+	txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+	require.NoError(t, err)
+
+	store := indexer.NewBlobStore(dbm.NewMemDB(), tmlog.NewNopLogger(), clientCtx, 2)
+	for height := int64(1); height <= 3; height++ {
+		block := &tmtypes.Block{Header: tmtypes.Header{Height: height}, Data: tmtypes.Data{Txs: []tmtypes.Tx{txBz}}}
+		require.NoError(t, store.IndexBlock(block, []*abci.ExecTxResult{{Code: 0}}))
+	}
+
+	sidecars, err := store.GetBlobSidecars(3)
+	require.NoError(t, err)
+	require.Len(t, sidecars, 1)
+	require.Equal(t, int64(3), sidecars[0].Height)
+	require.Equal(t, int32(0), sidecars[0].EthTxIndex)
+	require.Equal(t, ethTx.Hash().Hex(), sidecars[0].EthTxHash)
+	require.Equal(t, []string{sidecar.BlobHashes()[0].Hex()}, sidecars[0].BlobHashes)
+	require.Equal(t, [][]byte{blob[:]}, sidecars[0].Blobs)
+	require.Equal(t, [][]byte{commitment[:]}, sidecars[0].Commitments)
+	require.Equal(t, [][]byte{proof[:]}, sidecars[0].Proofs)
+
+	sidecars, err = store.GetBlobSidecars(2)
+	require.NoError(t, err)
+	require.Len(t, sidecars, 1)
+
+	// the first block fell out of the retention window
+	sidecars, err = store.GetBlobSidecars(1)
+	require.NoError(t, err)
+	require.Empty(t, sidecars)
+
+	// failed txs aren't indexed
+	block := &tmtypes.Block{Header: tmtypes.Header{Height: 4}, Data: tmtypes.Data{Txs: []tmtypes.Tx{txBz}}}
+	require.NoError(t, store.IndexBlock(block, []*abci.ExecTxResult{{Code: 11}}))
+	sidecars, err = store.GetBlobSidecars(4)
+	require.NoError(t, err)
+	require.Empty(t, sidecars)
+
+	// the sidecars stripped from the blocks are read from the provider
+	stripped, err := tx.StripBlobSidecar()
+	require.NoError(t, err)
+	tmTx, err = tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), "aphoton")
+	require.NoError(t, err)
+	strippedBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+	require.NoError(t, err)
+	block = &tmtypes.Block{Header: tmtypes.Header{Height: 5}, Data: tmtypes.Data{Txs: []tmtypes.Tx{strippedBz}}}
+	require.NoError(t, store.IndexBlock(block, []*abci.ExecTxResult{{Code: 0}}))
+	sidecars, err = store.GetBlobSidecars(5)
+	require.NoError(t, err)
+	require.Empty(t, sidecars)
+
+	store.SetSidecarProvider(sidecarProvider{ethTx.Hash().Hex(): stripped})
+	block = &tmtypes.Block{Header: tmtypes.Header{Height: 6}, Data: tmtypes.Data{Txs: []tmtypes.Tx{strippedBz}}}
+	require.NoError(t, store.IndexBlock(block, []*abci.ExecTxResult{{Code: 0}}))
+	sidecars, err = store.GetBlobSidecars(6)
+	require.NoError(t, err)
+	require.Len(t, sidecars, 1)
+	require.Equal(t, [][]byte{blob[:]}, sidecars[0].Blobs)
+}
+
+type sidecarProvider map[string]*types.BlobTxSidecar
+
+func (p sidecarProvider) BlobSidecar(ethTxHash string) *types.BlobTxSidecar {
+	return p[ethTxHash]
+}
@@ -16,6 +16,7 @@
 package indexer
 
 import (
+	"encoding/binary"
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
@@ -35,11 +36,14 @@ import (
 )
 
 const (
-	KeyPrefixTxHash  = 1
-	KeyPrefixTxIndex = 2
+	KeyPrefixTxHash     = 1
+	KeyPrefixTxIndex    = 2
+	KeyPrefixInternalTx = 3
 
 	// TxIndexKeyLength is the length of tx-index key
 	TxIndexKeyLength = 1 + 8 + 8
+	// InternalTxKeyLength is the length of internal tx key
+	InternalTxKeyLength = 1 + 8 + 4 + 4
 )
 
 var _ ethermint.EVMTxIndexer = &KVIndexer{}
@@ -49,11 +53,23 @@ type KVIndexer struct {
 	db        dbm.DB
 	logger    log.Logger
 	clientCtx client.Context
+	blobStore ethermint.EVMBlobStore
 }
 
 // NewKVIndexer creates the KVIndexer
 func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *KVIndexer {
-	return &KVIndexer{db, logger, clientCtx}
+	return &KVIndexer{db: db, logger: logger, clientCtx: clientCtx}
+}
+
+// SetBlobStore sets the blob sidecar store that indexes the blocks along the
+// transactions.
+func (kv *KVIndexer) SetBlobStore(blobStore ethermint.EVMBlobStore) {
+	kv.blobStore = blobStore
+}
+
+// BlobStore returns the blob sidecar store, nil if it's not set.
+func (kv *KVIndexer) BlobStore() ethermint.EVMBlobStore {
+	return kv.blobStore
 }
 
 // IndexBlock index all the eth txs in a block through the following steps:
@@ -61,6 +77,7 @@ func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *KVInd
 // - Parses eth Tx infos from cosmos-sdk events for every TxResult
 // - Iterates over all the messages of the Tx
 // - Builds and stores a indexer.TxResult based on parsed events for every message
+// - Stores the internal transactions parsed from the events, if they are traced
 func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.ExecTxResult) error {
 	height := block.Header.Height
 
@@ -92,9 +109,11 @@ func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.ExecTxRe
 		}
 
 		var cumulativeGasUsed uint64
+		ethTxIndexes := make(map[string]int32)
 		for msgIndex, msg := range tx.GetMsgs() {
 			ethMsg := msg.(*evmtypes.MsgEthereumTx)
 			txHash := common.HexToHash(ethMsg.Hash)
+			ethTxIndexes[txHash.Hex()] = ethTxIndex
 
 			txResult := ethermint.TxResult{
 				Height:     height,
@@ -128,10 +147,31 @@ func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.ExecTxRe
 				return errorsmod.Wrapf(err, "IndexBlock %d", height)
 			}
 		}
+
+		internalTxs, err := evmtypes.DecodeInternalTxsFromEvents(result.Events, height)
+		if err != nil {
+			kv.logger.Error("Fail to parse internal txs", "err", err, "block", height, "txIndex", txIndex)
+			continue
+		}
+		for _, internalTx := range internalTxs {
+			index, ok := ethTxIndexes[internalTx.EthTxHash]
+			if !ok {
+				kv.logger.Error("internal tx of unknown eth tx", "hash", internalTx.EthTxHash, "block", height)
+				continue
+			}
+			internalTx.EthTxIndex = index
+			key := InternalTxKey(height, index, internalTx.Index)
+			if err := batch.Set(key, kv.clientCtx.Codec.MustMarshal(internalTx)); err != nil {
+				return errorsmod.Wrapf(err, "IndexBlock %d, set internal tx", height)
+			}
+		}
 	}
 	if err := batch.Write(); err != nil {
 		return errorsmod.Wrapf(err, "IndexBlock %d, write batch", block.Height)
 	}
+	if kv.blobStore != nil {
+		return kv.blobStore.IndexBlock(block, txResults)
+	}
 	return nil
 }
 
@@ -173,6 +213,26 @@ func (kv *KVIndexer) GetByBlockAndIndex(blockNumber int64, txIndex int32) (*ethe
 	return kv.GetByTxHash(common.BytesToHash(bz))
 }
 
+// GetInternalTxsByBlock returns the internal transactions of the eth txs in the
+// block, ordered by eth tx index and execution order.
+func (kv *KVIndexer) GetInternalTxsByBlock(blockNumber int64) ([]*ethermint.InternalTxResult, error) {
+	it, err := kv.db.Iterator(InternalTxKey(blockNumber, 0, 0), InternalTxKey(blockNumber+1, 0, 0))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetInternalTxsByBlock %d", blockNumber)
+	}
+	defer it.Close()
+
+	var results []*ethermint.InternalTxResult
+	for ; it.Valid(); it.Next() {
+		var result ethermint.InternalTxResult
+		if err := kv.clientCtx.Codec.Unmarshal(it.Value(), &result); err != nil {
+			return nil, errorsmod.Wrapf(err, "GetInternalTxsByBlock %d", blockNumber)
+		}
+		results = append(results, &result)
+	}
+	return results, nil
+}
+
 // TxHashKey returns the key for db entry: `tx hash -> tx result struct`
 func TxHashKey(hash common.Hash) []byte {
 	return append([]byte{KeyPrefixTxHash}, hash.Bytes()...)
@@ -185,6 +245,15 @@ func TxIndexKey(blockNumber int64, txIndex int32) []byte {
 	return append(append([]byte{KeyPrefixTxIndex}, bz1...), bz2...)
 }
 
+// InternalTxKey returns the key for db entry: `(block number, eth tx index, index) -> internal tx`
+func InternalTxKey(blockNumber int64, ethTxIndex int32, index uint32) []byte {
+	key := make([]byte, 0, InternalTxKeyLength)
+	key = append(key, KeyPrefixInternalTx)
+	key = append(key, sdk.Uint64ToBigEndian(uint64(blockNumber))...)
+	key = binary.BigEndian.AppendUint32(key, uint32(ethTxIndex))
+	return binary.BigEndian.AppendUint32(key, index)
+}
+
 // LoadLastBlock returns the latest indexed block number, returns -1 if db is empty
 func LoadLastBlock(db dbm.DB) (int64, error) {
 	it, err := db.ReverseIterator([]byte{KeyPrefixTxIndex}, []byte{KeyPrefixTxIndex + 1})
@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/evmos/ethermint/app"
 	"github.com/evmos/ethermint/crypto/ethsecp256k1"
 	"github.com/evmos/ethermint/indexer"
@@ -51,10 +52,11 @@ func TestKVIndexer(t *testing.T) {
 	require.NoError(t, err)
 
 	testCases := []struct {
-		name        string
-		block       *tmtypes.Block
-		blockResult []*abci.ExecTxResult
-		expSuccess  bool
+		name           string
+		block          *tmtypes.Block
+		blockResult    []*abci.ExecTxResult
+		expSuccess     bool
+		expInternalTxs int
 	}{
 		{
 			"success, format 1",
@@ -75,6 +77,7 @@ func TestKVIndexer(t *testing.T) {
 				},
 			},
 			true,
+			0,
 		},
 		{
 			"success, format 2",
@@ -97,6 +100,31 @@ func TestKVIndexer(t *testing.T) {
 				},
 			},
 			true,
+			0,
+		},
+		{
+			"success, internal txs",
+			&tmtypes.Block{Header: tmtypes.Header{Height: 1}, Data: tmtypes.Data{Txs: []tmtypes.Tx{txBz}}},
+			[]*abci.ExecTxResult{
+				{
+					Code: 0,
+					Events: append(
+						[]abci.Event{
+							{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+								{Key: "ethereumTxHash", Value: txHash.Hex()},
+								{Key: "txIndex", Value: "0"},
+								{Key: "txGasUsed", Value: "21000"},
+							}},
+						},
+						types.NewInternalTxEvents(txHash, 0, []types.InternalTx{
+							{Type: vm.CALL, From: to, To: from, Value: big.NewInt(10), Depth: 1},
+							{Type: vm.SELFDESTRUCT, From: to, To: from, Value: big.NewInt(0), Depth: 2},
+						}).ToABCIEvents()...,
+					),
+				},
+			},
+			true,
+			2,
 		},
 		{
 			"success, exceed block gas limit",
@@ -109,6 +137,7 @@ func TestKVIndexer(t *testing.T) {
 				},
 			},
 			true,
+			0,
 		},
 		{
 			"fail, failed eth tx",
@@ -121,6 +150,7 @@ func TestKVIndexer(t *testing.T) {
 				},
 			},
 			false,
+			0,
 		},
 		{
 			"fail, invalid events",
@@ -132,6 +162,7 @@ func TestKVIndexer(t *testing.T) {
 				},
 			},
 			false,
+			0,
 		},
 		{
 			"fail, not eth tx",
@@ -143,6 +174,7 @@ func TestKVIndexer(t *testing.T) {
 				},
 			},
 			false,
+			0,
 		},
 	}
 
@@ -176,6 +208,14 @@ func TestKVIndexer(t *testing.T) {
 				res2, err := idxer.GetByBlockAndIndex(1, 0)
 				require.NoError(t, err)
 				require.Equal(t, res1, res2)
+
+				internalTxs, err := idxer.GetInternalTxsByBlock(1)
+				require.NoError(t, err)
+				require.Len(t, internalTxs, tc.expInternalTxs)
+				for i, internalTx := range internalTxs {
+					require.Equal(t, txHash.Hex(), internalTx.EthTxHash)
+					require.Equal(t, uint32(i), internalTx.Index)
+				}
 			}
 		})
 	}
@@ -24,6 +24,7 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/evmos/ethermint/rpc/backend"
+	"github.com/evmos/ethermint/rpc/namespaces/ethereum/admin"
 	"github.com/evmos/ethermint/rpc/namespaces/ethereum/debug"
 	"github.com/evmos/ethermint/rpc/namespaces/ethereum/eth"
 	"github.com/evmos/ethermint/rpc/namespaces/ethereum/eth/filters"
@@ -51,6 +52,7 @@ const (
 	TxPoolNamespace   = "txpool"
 	DebugNamespace    = "debug"
 	MinerNamespace    = "miner"
+	AdminNamespace    = "admin"
 
 	apiVersion = "1.0"
 )
@@ -127,12 +129,18 @@ func init() {
 				},
 			}
 		},
-		TxPoolNamespace: func(ctx *server.Context, _ client.Context, _ *stream.RPCStream, _ bool, _ ethermint.EVMTxIndexer) []rpc.API {
+		TxPoolNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *stream.RPCStream,
+			allowUnprotectedTxs bool,
+			indexer ethermint.EVMTxIndexer,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
 			return []rpc.API{
 				{
 					Namespace: TxPoolNamespace,
 					Version:   apiVersion,
-					Service:   txpool.NewPublicAPI(ctx.Logger),
+					Service:   txpool.NewPublicAPI(ctx.Logger, evmBackend),
 					Public:    true,
 				},
 			}
@@ -169,6 +177,23 @@ func init() {
 				},
 			}
 		},
+		// the admin calls are authenticated with a JWT token by the rate limiter, the node
+		// services they manage are carried by the command context of the client
+		AdminNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *stream.RPCStream,
+			_ bool,
+			_ ethermint.EVMTxIndexer,
+		) []rpc.API {
+			return []rpc.API{
+				{
+					Namespace: AdminNamespace,
+					Version:   apiVersion,
+					Service:   admin.NewAPI(ctx, clientCtx, admin.NodeFromContext(clientCtx.CmdContext)),
+					Public:    false,
+				},
+			}
+		},
 	}
 }
 
@@ -28,6 +28,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/server"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/common/math"
@@ -68,6 +69,12 @@ type EVMBackend interface {
 	ImportRawKey(privkey, password string) (common.Address, error)
 	ListAccounts() ([]common.Address, error)
 	NewMnemonic(uid string, language keyring.Language, hdPath, bip39Passphrase string, algo keyring.SignatureAlgo) (*keyring.Record, error)
+	NewAccount(password string) (common.Address, error)
+	ImportKeystore(keyJSON []byte, password, newPassword string) (common.Address, error)
+	ExportKeystore(address common.Address, password, newPassword string) ([]byte, error)
+	UnlockAccount(address common.Address, password string, duration time.Duration) error
+	LockAccount(address common.Address) error
+	Wallets() []accounts.Wallet
 	UnprotectedAllowed() bool
 	RPCGasCap() uint64            // global gas cap for eth_call over rpc: DoS protection
 	RPCEVMTimeout() time.Duration // global timeout for eth_call over rpc: DoS protection
@@ -76,7 +83,9 @@ type EVMBackend interface {
 
 	// Sign Tx
 	Sign(address common.Address, data hexutil.Bytes) (hexutil.Bytes, error)
+	SignWithPassphrase(address common.Address, data hexutil.Bytes, passphrase string) (hexutil.Bytes, error)
 	SendTransaction(args evmtypes.TransactionArgs) (common.Hash, error)
+	SendTransactionWithPassphrase(args evmtypes.TransactionArgs, passphrase string) (common.Hash, error)
 	SignTypedData(address common.Address, typedData apitypes.TypedData) (hexutil.Bytes, error)
 
 	// Blocks Info
@@ -97,6 +106,7 @@ type EVMBackend interface {
 	RPCBlockFromTendermintBlock(resBlock *tmrpctypes.ResultBlock, blockRes *tmrpctypes.ResultBlockResults, fullTx bool) (map[string]interface{}, error)
 	EthBlockByNumber(blockNum rpctypes.BlockNumber) (*ethtypes.Block, error)
 	EthBlockFromTendermintBlock(resBlock *tmrpctypes.ResultBlock, blockRes *tmrpctypes.ResultBlockResults) (*ethtypes.Block, error)
+	GetBlobSidecars(blockNrOrHash rpctypes.BlockNumberOrHash) ([]*rpctypes.RPCBlobSidecar, error)
 
 	// Account Info
 	GetCode(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error)
@@ -110,8 +120,11 @@ type EVMBackend interface {
 	ChainConfig() *params.ChainConfig
 	GlobalMinGasPrice() (sdkmath.LegacyDec, error)
 	BaseFee(blockRes *tmrpctypes.ResultBlockResults) (*big.Int, error)
+	BlobBaseFee() (*hexutil.Big, error)
+	EthConfig() (*rpctypes.EthConfig, error)
 	CurrentHeader() (*ethtypes.Header, error)
 	PendingTransactions() ([]*sdk.Tx, error)
+	TxPoolContent() (*rpctypes.TxPoolContent, error)
 	GetCoinbase() (sdk.AccAddress, error)
 	FeeHistory(blockCount math.HexOrDecimal64, lastBlock rpc.BlockNumber, rewardPercentiles []float64) (*rpctypes.FeeHistoryResult, error)
 	SuggestGasTipCap(baseFee *big.Int) (*big.Int, error)
@@ -132,16 +145,19 @@ type EVMBackend interface {
 	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error)
 	DoCall(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber, overrides *json.RawMessage) (*evmtypes.MsgEthereumTxResponse, error)
 	GasPrice() (*hexutil.Big, error)
+	FeeTokenGasPrices() ([]rpctypes.FeeTokenGasPrice, error)
 
 	// Filter API
 	GetLogs(hash common.Hash) ([][]*ethtypes.Log, error)
 	GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error)
+	GetLogsByBlockResult(blockRes *tmrpctypes.ResultBlockResults) ([][]*ethtypes.Log, error)
 	BloomStatus() (uint64, uint64)
 
 	// Tracing
 	TraceTransaction(hash common.Hash, config *rpctypes.TraceConfig) (interface{}, error)
 	TraceBlock(height rpctypes.BlockNumber, config *rpctypes.TraceConfig, block *tmrpctypes.ResultBlock) ([]*evmtypes.TxTraceResult, error)
 	TraceCall(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumberOrHash, config *rpctypes.TraceConfig) (interface{}, error)
+	GetInternalTransactions(blockNrOrHash rpctypes.BlockNumberOrHash) ([]*rpctypes.RPCInternalTransaction, error)
 }
 
 var _ BackendI = (*Backend)(nil)
@@ -167,6 +183,8 @@ type Backend struct {
 	allowUnprotectedTxs bool
 	indexer             ethermint.EVMTxIndexer
 	processBlocker      ProcessBlocker
+	cache               *rpctypes.ResponseCache
+	keystoreDir         string
 }
 
 // NewBackend creates a new Backend instance for cosmos and ethereum namespaces
@@ -187,6 +205,11 @@ func NewBackend(
 		panic(err)
 	}
 
+	cache, err := rpctypes.NewResponseCache(appConf.JSONRPC.ResponseCacheSize)
+	if err != nil {
+		panic(err)
+	}
+
 	b := &Backend{
 		ctx:                 context.Background(),
 		clientCtx:           clientCtx,
@@ -196,6 +219,8 @@ func NewBackend(
 		cfg:                 appConf,
 		allowUnprotectedTxs: allowUnprotectedTxs,
 		indexer:             indexer,
+		cache:               cache,
+		keystoreDir:         keystoreDir(ctx, appConf.JSONRPC.KeystoreDir),
 	}
 	b.processBlocker = b.processBlock
 	return b
@@ -85,6 +85,7 @@ func (suite *BackendTestSuite) SetupTest() {
 	suite.backend.clientCtx.Client = mocks.NewClient(suite.T())
 	suite.backend.queryClient.FeeMarket = mocks.NewFeeMarketQueryClient(suite.T())
 	suite.backend.ctx = rpctypes.ContextWithHeight(1)
+	suite.backend.keystoreDir = filepath.Join(baseDir, nodeDirName, "keystore")
 
 	// Add codec
 	encCfg := app.MakeConfigForTest()
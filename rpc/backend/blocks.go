@@ -65,6 +65,13 @@ func (b *Backend) BlockNumber() (hexutil.Uint64, error) {
 // block number. Depending on fullTx it either returns the full transaction
 // objects or if false only the hashes of the transactions.
 func (b *Backend) GetBlockByNumber(blockNum rpctypes.BlockNumber, fullTx bool) (map[string]interface{}, error) {
+	// only the explicit heights designate the same block over time
+	if blockNum >= 0 {
+		if res, ok := b.cache.Get("eth_getBlockByNumber", blockNum, fullTx); ok {
+			return res.(map[string]interface{}), nil
+		}
+	}
+
 	resBlock, err := b.TendermintBlockByNumber(blockNum)
 	if err != nil {
 		return nil, nil
@@ -87,6 +94,9 @@ func (b *Backend) GetBlockByNumber(blockNum rpctypes.BlockNumber, fullTx bool) (
 		return nil, err
 	}
 
+	if blockNum >= 0 {
+		b.cacheResponse(resBlock.Block.Height, res, "eth_getBlockByNumber", blockNum, fullTx)
+	}
 	return res, nil
 }
 
@@ -288,7 +298,7 @@ func (b *Backend) EthMsgsFromTendermintBlock(
 				continue
 			}
 
-			ethMsg.Hash = ethMsg.AsTransaction().Hash().Hex()
+			ethMsg.Hash = ethMsg.ComputeHash().Hex()
 			result = append(result, ethMsg)
 		}
 	}
@@ -397,9 +407,8 @@ func (b *Backend) RPCBlockFromTendermintBlock(
 			continue
 		}
 
-		tx := ethMsg.AsTransaction()
-		rpcTx, err := rpctypes.NewRPCTransaction(
-			tx,
+		rpcTx, err := rpctypes.NewTransactionFromMsg(
+			ethMsg,
 			common.BytesToHash(block.Hash()),
 			uint64(block.Height),
 			uint64(txIndex),
@@ -407,7 +416,7 @@ func (b *Backend) RPCBlockFromTendermintBlock(
 			b.chainID,
 		)
 		if err != nil {
-			b.logger.Debug("NewTransactionFromData for receipt failed", "hash", tx.Hash().Hex(), "error", err.Error())
+			b.logger.Debug("NewTransactionFromData for receipt failed", "hash", ethMsg.Hash, "error", err.Error())
 			continue
 		}
 		ethRPCTxs = append(ethRPCTxs, rpcTx)
@@ -511,6 +520,10 @@ func (b *Backend) EthBlockFromTendermintBlock(
 
 	txs := make([]*ethtypes.Transaction, len(msgs))
 	for i, ethMsg := range msgs {
+		// go-ethereum blocks can't hold set-code transactions
+		if ethMsg.TxType() == evmtypes.SetCodeTxType {
+			return nil, errors.Wrapf(evmtypes.ErrTxTypeNotSupported, "block %d contains the set-code transaction %s", height, ethMsg.Hash)
+		}
 		txs[i] = ethMsg.AsTransaction()
 	}
 
@@ -518,3 +531,49 @@ func (b *Backend) EthBlockFromTendermintBlock(
 	ethBlock := ethtypes.NewBlock(ethHeader, txs, nil, nil, trie.NewStackTrie(nil))
 	return ethBlock, nil
 }
+
+// GetBlobSidecars returns the sidecars of the blob transactions included in the
+// block. The sidecars are stripped from the block transactions, they are read
+// from the local blob store maintained along the indexer, and only the ones of
+// the transactions that went through the mempool of the node are available.
+// Blocks out of the blob retention window have no sidecars.
+func (b *Backend) GetBlobSidecars(blockNrOrHash rpctypes.BlockNumberOrHash) ([]*rpctypes.RPCBlobSidecar, error) {
+	blockNum, err := b.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	resBlock, err := b.TendermintBlockByNumber(blockNum)
+	if err != nil {
+		return nil, err
+	}
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, nil
+	}
+	height := resBlock.Block.Height
+
+	if retention := b.cfg.JSONRPC.BlobRetention; retention != 0 {
+		latest, err := b.BlockNumber()
+		if err != nil {
+			return nil, err
+		}
+		if uint64(latest) >= retention && uint64(height) <= uint64(latest)-retention {
+			return nil, nil
+		}
+	}
+
+	if b.indexer == nil || b.indexer.BlobStore() == nil {
+		return nil, errors.New("blob sidecars are only served with the custom indexer enabled")
+	}
+	sidecars, err := b.indexer.BlobStore().GetBlobSidecars(height)
+	if err != nil {
+		return nil, err
+	}
+
+	blockHash := common.BytesToHash(resBlock.Block.Hash())
+	result := make([]*rpctypes.RPCBlobSidecar, len(sidecars))
+	for i, sidecar := range sidecars {
+		result[i] = rpctypes.NewRPCBlobSidecar(sidecar, blockHash)
+	}
+	return result, nil
+}
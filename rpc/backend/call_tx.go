@@ -23,6 +23,7 @@ import (
 	"math/big"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
@@ -32,6 +33,7 @@ import (
 	rpctypes "github.com/evmos/ethermint/rpc/types"
 	ethermint "github.com/evmos/ethermint/types"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	feemarkettypes "github.com/evmos/ethermint/x/feemarket/types"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -63,7 +65,8 @@ func (b *Backend) Resend(args evmtypes.TransactionArgs, gasPrice *hexutil.Big, g
 
 	signer := ethtypes.LatestSigner(cfg)
 
-	matchTx := args.ToTransaction().AsTransaction()
+	matchMsg := args.ToTransaction()
+	matchTx := matchMsg.AsTransaction()
 
 	// Before replacing the old transaction, ensure the _new_ transaction fee is reasonable.
 	price := matchTx.GasPrice()
@@ -84,22 +87,19 @@ func (b *Backend) Resend(args evmtypes.TransactionArgs, gasPrice *hexutil.Big, g
 	}
 
 	for _, tx := range pending {
-		// FIXME does Resend api possible at all?  https://github.com/evmos/ethermint/issues/905
 		p, err := evmtypes.UnwrapEthereumMsg(tx, common.Hash{})
 		if err != nil {
 			// not valid ethereum tx
 			continue
 		}
 
-		pTx := p.AsTransaction()
-
-		wantSigHash := signer.Hash(matchTx)
-		pFrom, err := ethtypes.Sender(signer, pTx)
+		wantSigHash := matchMsg.SigHash(signer)
+		pFrom, err := p.GetSenderLegacy(signer.ChainID())
 		if err != nil {
 			continue
 		}
 
-		if pFrom == *args.From && signer.Hash(pTx) == wantSigHash {
+		if pFrom == *args.From && p.SigHash(signer) == wantSigHash {
 			// Match. Re-sign and send the transaction.
 			if gasPrice != nil && (*big.Int)(gasPrice).Sign() != 0 {
 				args.GasPrice = gasPrice
@@ -117,23 +117,32 @@ func (b *Backend) Resend(args evmtypes.TransactionArgs, gasPrice *hexutil.Big, g
 
 // SendRawTransaction send a raw Ethereum transaction.
 func (b *Backend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
-	// RLP decode raw transaction bytes
-	tx := &ethtypes.Transaction{}
-	if err := tx.UnmarshalBinary(data); err != nil {
-		b.logger.Error("transaction decoding failed", "error", err.Error())
-		return common.Hash{}, err
-	}
+	ethereumTx := &evmtypes.MsgEthereumTx{}
+	if len(data) > 0 && data[0] == evmtypes.SetCodeTxType {
+		// go-ethereum can't decode the set-code transactions, they are always
+		// replay-protected.
+		if err := ethereumTx.UnmarshalBinary(data, b.chainID); err != nil {
+			b.logger.Error("transaction decoding failed", "error", err.Error())
+			return common.Hash{}, err
+		}
+	} else {
+		// RLP decode raw transaction bytes
+		tx := &ethtypes.Transaction{}
+		if err := tx.UnmarshalBinary(data); err != nil {
+			b.logger.Error("transaction decoding failed", "error", err.Error())
+			return common.Hash{}, err
+		}
 
-	// check the local node config in case unprotected txs are disabled
-	if !b.UnprotectedAllowed() && !tx.Protected() {
-		// Ensure only eip155 signed transactions are submitted if EIP155Required is set.
-		return common.Hash{}, errors.New("only replay-protected (EIP-155) transactions allowed over RPC")
-	}
+		// check the local node config in case unprotected txs are disabled
+		if !b.UnprotectedAllowed() && !tx.Protected() {
+			// Ensure only eip155 signed transactions are submitted if EIP155Required is set.
+			return common.Hash{}, errors.New("only replay-protected (EIP-155) transactions allowed over RPC")
+		}
 
-	ethereumTx := &evmtypes.MsgEthereumTx{}
-	if err := ethereumTx.FromSignedEthereumTx(tx, b.chainID); err != nil {
-		b.logger.Error("transaction converting failed", "error", err.Error())
-		return common.Hash{}, err
+		if err := ethereumTx.FromSignedEthereumTx(tx, b.chainID); err != nil {
+			b.logger.Error("transaction converting failed", "error", err.Error())
+			return common.Hash{}, err
+		}
 	}
 
 	if err := ethereumTx.ValidateBasic(); err != nil {
@@ -161,7 +170,7 @@ func (b *Backend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
 		return common.Hash{}, err
 	}
 
-	txHash := ethereumTx.AsTransaction().Hash()
+	txHash := ethereumTx.ComputeHash()
 
 	syncCtx := b.clientCtx.WithBroadcastMode(flags.BroadcastSync)
 	rsp, err := syncCtx.BroadcastTx(txBytes)
@@ -454,3 +463,33 @@ func (b *Backend) GasPrice() (*hexutil.Big, error) {
 
 	return (*hexutil.Big)(result), nil
 }
+
+// FeeTokenGasPrices returns the gas price in the evm denom followed by its conversion in
+// each fee token of the fee market.
+func (b *Backend) FeeTokenGasPrices() ([]rpctypes.FeeTokenGasPrice, error) {
+	gasPrice, err := b.GasPrice()
+	if err != nil {
+		return nil, err
+	}
+	params, err := b.queryClient.Params(b.ctx, &evmtypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	res, err := b.queryClient.FeeMarket.FeeTokenPrices(b.ctx, &feemarkettypes.QueryFeeTokenPricesRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	payments := append([]feemarkettypes.FeePayment{
+		feemarkettypes.NewFeePayment(params.Params.EvmDenom, sdkmath.LegacyOneDec()),
+	}, res.Prices...)
+	prices := make([]rpctypes.FeeTokenGasPrice, len(payments))
+	for i, payment := range payments {
+		prices[i] = rpctypes.FeeTokenGasPrice{
+			Denom:    payment.Denom,
+			Price:    payment.Price.String(),
+			GasPrice: (*hexutil.Big)(payment.ConvertFee(sdkmath.NewIntFromBigInt(gasPrice.ToInt())).Amount.BigInt()),
+		}
+	}
+	return prices, nil
+}
@@ -18,6 +18,7 @@ package backend
 import (
 	"fmt"
 	"math/big"
+	"sort"
 	"strconv"
 	"sync"
 
@@ -25,6 +26,7 @@ import (
 	cmtrpcclient "github.com/cometbft/cometbft/rpc/client"
 	cmtrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/common/math"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -107,6 +109,48 @@ func (b *Backend) BaseFee(blockRes *cmtrpctypes.ResultBlockResults) (*big.Int, e
 	return res.BaseFee.BigInt(), nil
 }
 
+// BlobBaseFee returns the EIP-4844 blob base fee of the next block.
+func (b *Backend) BlobBaseFee() (*hexutil.Big, error) {
+	res, err := b.queryClient.FeeMarket.BlobBaseFee(b.ctx, &feemarkettypes.QueryBlobBaseFeeRequest{})
+	if err != nil {
+		return nil, err
+	}
+	if res.BlobBaseFee == nil {
+		return nil, errors.New("blob base fee is not enabled")
+	}
+	return (*hexutil.Big)(res.BlobBaseFee.BigInt()), nil
+}
+
+// EthConfig returns the hard fork active at the latest block and the next scheduled one.
+func (b *Backend) EthConfig() (*rpctypes.EthConfig, error) {
+	header, err := b.CurrentHeader()
+	if err != nil {
+		return nil, err
+	}
+	res, err := b.queryClient.Params(rpctypes.ContextWithHeight(header.Number.Int64()), &evmtypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	config := &rpctypes.EthConfig{ChainID: (*hexutil.Big)(b.chainID)}
+	for _, fork := range res.Params.ChainConfig.Forks() {
+		if !fork.IsScheduled() {
+			continue
+		}
+		forkConfig := &rpctypes.ForkConfig{
+			Name:            fork.Name,
+			ActivationBlock: (*hexutil.Big)(fork.Block),
+			ActivationTime:  (*hexutil.Uint64)(fork.Time),
+		}
+		if !fork.IsActive(header.Number, header.Time) {
+			config.Next = forkConfig
+			break
+		}
+		config.Current = forkConfig
+	}
+	return config, nil
+}
+
 // CurrentHeader returns the latest block header
 func (b *Backend) CurrentHeader() (*ethtypes.Header, error) {
 	return b.HeaderByNumber(rpctypes.EthLatestBlockNumber)
@@ -136,6 +180,87 @@ func (b *Backend) PendingTransactions() ([]*sdk.Tx, error) {
 	return result, nil
 }
 
+// TxPoolContent returns the Ethereum transactions of the mempool with their priority at the
+// current base fee. Like in the app-side mempool, a later transaction replaces an earlier one
+// with the same sender and nonce.
+func (b *Backend) TxPoolContent() (*rpctypes.TxPoolContent, error) {
+	txs, err := b.PendingTransactions()
+	if err != nil {
+		return nil, err
+	}
+
+	var baseFee *big.Int
+	if res, err := b.queryClient.BaseFee(b.ctx, &evmtypes.QueryBaseFeeRequest{}); err == nil && res.BaseFee != nil {
+		baseFee = res.BaseFee.BigInt()
+	}
+
+	senders := make(map[common.Address]map[uint64]*rpctypes.RPCPoolTransaction)
+	for _, tx := range txs {
+		for _, msg := range (*tx).GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				// not valid ethereum tx
+				break
+			}
+
+			txData, err := evmtypes.UnpackTxData(ethMsg.Data)
+			if err != nil {
+				continue
+			}
+
+			rpcTx, err := rpctypes.NewTransactionFromMsg(ethMsg, common.Hash{}, uint64(0), uint64(0), nil, b.chainID)
+			if err != nil {
+				return nil, err
+			}
+
+			if senders[rpcTx.From] == nil {
+				senders[rpcTx.From] = make(map[uint64]*rpctypes.RPCPoolTransaction)
+			}
+			senders[rpcTx.From][txData.GetNonce()] = &rpctypes.RPCPoolTransaction{
+				RPCTransaction: rpcTx,
+				Priority:       hexutil.Uint64(evmtypes.GetTxPriority(txData, baseFee)),
+			}
+		}
+	}
+
+	content := &rpctypes.TxPoolContent{
+		Pending: make(map[common.Address]map[uint64]*rpctypes.RPCPoolTransaction),
+		Queued:  make(map[common.Address]map[uint64]*rpctypes.RPCPoolTransaction),
+	}
+	for from, txs := range senders {
+		nonce, err := b.getAccountNonce(from, false, 0, b.logger)
+		if err != nil {
+			return nil, err
+		}
+
+		nonces := make([]uint64, 0, len(txs))
+		for n := range txs {
+			nonces = append(nonces, n)
+		}
+		sort.Slice(nonces, func(i, j int) bool { return nonces[i] < nonces[j] })
+		for _, n := range nonces {
+			var group map[common.Address]map[uint64]*rpctypes.RPCPoolTransaction
+			switch {
+			case n < nonce:
+				// already included in a block
+				continue
+			case n == nonce:
+				group = content.Pending
+				nonce++
+			default:
+				group = content.Queued
+			}
+
+			if group[from] == nil {
+				group[from] = make(map[uint64]*rpctypes.RPCPoolTransaction)
+			}
+			group[from][n] = txs[n]
+		}
+	}
+
+	return content, nil
+}
+
 // GetCoinbase is the address that staking rewards will be send to (alias for Etherbase).
 func (b *Backend) GetCoinbase() (sdk.AccAddress, error) {
 	node, err := b.clientCtx.GetNode()
@@ -203,6 +328,12 @@ func (b *Backend) FeeHistory(
 	blockStart := blockEnd + 1 - blocks
 	oldestBlock := (*hexutil.Big)(big.NewInt(blockStart))
 
+	// serve the fee history kept by the fee market when it covers the request, it
+	// doesn't depend on the archive state
+	if feeHistory, ok := b.storedFeeHistory(blockStart, blockEnd, rewardPercentiles); ok {
+		return feeHistory, nil
+	}
+
 	// prepare space
 	reward := make([][]*hexutil.Big, blocks)
 	rewardCount := len(rewardPercentiles)
@@ -212,6 +343,8 @@ func (b *Backend) FeeHistory(
 
 	thisBaseFee := make([]*hexutil.Big, blocks+1)
 	thisGasUsedRatio := make([]float64, blocks)
+	thisBurntFees := make([]*hexutil.Big, blocks)
+	thisPriorityFees := make([]*hexutil.Big, blocks)
 
 	// rewards should only be calculated if reward percentiles were included
 	calculateRewards := rewardCount != 0
@@ -265,6 +398,8 @@ func (b *Backend) FeeHistory(
 					thisBaseFee[index+1] = (*hexutil.Big)(oneFeeHistory.NextBaseFee)
 				}
 				thisGasUsedRatio[index] = oneFeeHistory.GasUsedRatio
+				thisBurntFees[index] = (*hexutil.Big)(oneFeeHistory.BurntFees)
+				thisPriorityFees[index] = (*hexutil.Big)(oneFeeHistory.PriorityFees)
 				if calculateRewards {
 					for j := 0; j < rewardCount; j++ {
 						reward[index][j] = (*hexutil.Big)(oneFeeHistory.Reward[j])
@@ -290,6 +425,8 @@ func (b *Backend) FeeHistory(
 		OldestBlock:  oldestBlock,
 		BaseFee:      thisBaseFee,
 		GasUsedRatio: thisGasUsedRatio,
+		BurntFees:    thisBurntFees,
+		PriorityFees: thisPriorityFees,
 	}
 
 	if calculateRewards {
@@ -299,6 +436,58 @@ func (b *Backend) FeeHistory(
 	return &feeHistory, nil
 }
 
+// storedFeeHistory returns the fee history of the blocks from blockStart to blockEnd from
+// the entries kept by the fee market, false if some blocks aren't kept anymore.
+func (b *Backend) storedFeeHistory(blockStart, blockEnd int64, rewardPercentiles []float64) (*rpctypes.FeeHistoryResult, bool) {
+	res, err := b.queryClient.FeeMarket.FeeHistory(b.ctx, &feemarkettypes.QueryFeeHistoryRequest{
+		FromHeight: blockStart,
+		ToHeight:   blockEnd,
+	})
+	if err != nil || int64(len(res.Entries)) != blockEnd-blockStart+1 {
+		return nil, false
+	}
+	blocks := len(res.Entries)
+	feeHistory := &rpctypes.FeeHistoryResult{
+		OldestBlock:  (*hexutil.Big)(big.NewInt(blockStart)),
+		BaseFee:      make([]*hexutil.Big, blocks+1),
+		GasUsedRatio: make([]float64, blocks),
+		BurntFees:    make([]*hexutil.Big, blocks),
+		PriorityFees: make([]*hexutil.Big, blocks),
+	}
+	if len(rewardPercentiles) > 0 {
+		feeHistory.Reward = make([][]*hexutil.Big, blocks)
+	}
+	for i, entry := range res.Entries {
+		feeHistory.BaseFee[i] = (*hexutil.Big)(entry.BaseFee.BigInt())
+		if entry.GasLimit > 0 {
+			feeHistory.GasUsedRatio[i] = float64(entry.GasUsed) / float64(entry.GasLimit)
+		}
+		burnt, priority := b.blockFees(entry.Height)
+		feeHistory.BurntFees[i], feeHistory.PriorityFees[i] = (*hexutil.Big)(burnt), (*hexutil.Big)(priority)
+		if feeHistory.Reward != nil {
+			feeHistory.Reward[i] = make([]*hexutil.Big, len(rewardPercentiles))
+			for j, p := range rewardPercentiles {
+				feeHistory.Reward[i][j] = (*hexutil.Big)(entry.RewardAt(p).BigInt())
+			}
+		}
+	}
+
+	// the base fee of the block following the range, as computed by the fee market at the
+	// end of the last one
+	last := res.Entries[blocks-1]
+	nextBaseFee := new(big.Int)
+	if cfg := b.ChainConfig(); cfg.IsLondon(big.NewInt(last.Height + 1)) {
+		if last.NextBaseFee.IsNil() {
+			// recorded before the fee market kept the next base fee
+			return nil, false
+		}
+		nextBaseFee = last.NextBaseFee.BigInt()
+	}
+	feeHistory.BaseFee[blocks] = (*hexutil.Big)(nextBaseFee)
+
+	return feeHistory, true
+}
+
 // SuggestGasTipCap returns the suggested tip cap
 // Although we don't support tx prioritization yet, but we return a positive value to help client to
 // mitigate the base fee changes.
@@ -369,6 +369,8 @@ func (suite *BackendTestSuite) TestFeeHistory() {
 				var header metadata.MD
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterParams(queryClient, &header, 1)
+				fQueryClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				RegisterFeeHistoryError(fQueryClient, 1, 1, 1)
 				RegisterBlockError(client, ethrpc.BlockNumber(1).Int64())
 			},
 			1,
@@ -386,6 +388,8 @@ func (suite *BackendTestSuite) TestFeeHistory() {
 				var header metadata.MD
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterParams(queryClient, &header, 1)
+				fQueryClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				RegisterFeeHistoryError(fQueryClient, 1, 1, 1)
 				RegisterBlock(client, ethrpc.BlockNumber(1).Int64(), nil)
 				RegisterBlockResultsError(client, 1)
 			},
@@ -411,14 +415,19 @@ func (suite *BackendTestSuite) TestFeeHistory() {
 				RegisterValidatorAccount(queryClient, validator)
 				RegisterConsensusParams(client, 1)
 				fQueryClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
-				RegisterFeeMarketParams(fQueryClient, 1)
+				RegisterFeeHistoryError(fQueryClient, 1, 1, 1)
+				RegisterBlockFeesError(fQueryClient, 1, 1)
+				RegisterNextBaseFeeError(fQueryClient, 1)
+				RegisterFeeMarketBaseFeeError(fQueryClient, 2)
 			},
 			1,
 			1,
 			&rpc.FeeHistoryResult{
 				OldestBlock:  (*hexutil.Big)(big.NewInt(1)),
-				BaseFee:      []*hexutil.Big{(*hexutil.Big)(big.NewInt(0)), (*hexutil.Big)(new(big.Int).SetBits([]big.Word{}))},
+				BaseFee:      []*hexutil.Big{(*hexutil.Big)(big.NewInt(0)), (*hexutil.Big)(big.NewInt(0))},
 				GasUsedRatio: []float64{0},
+				BurntFees:    []*hexutil.Big{(*hexutil.Big)(big.NewInt(0))},
+				PriorityFees: []*hexutil.Big{(*hexutil.Big)(big.NewInt(0))},
 				Reward:       [][]*hexutil.Big{{(*hexutil.Big)(big.NewInt(0)), (*hexutil.Big)(big.NewInt(0)), (*hexutil.Big)(big.NewInt(0)), (*hexutil.Big)(big.NewInt(0))}},
 			},
 			sdk.AccAddress(tests.GenerateAddress().Bytes()),
@@ -441,7 +450,9 @@ func (suite *BackendTestSuite) TestFeeHistory() {
 				RegisterConsensusParams(client, 1)
 				RegisterParams(queryClient, &header, 1)
 				RegisterParamsWithoutHeader(queryClient, 1)
-				RegisterFeeMarketParams(fQueryClient, 1)
+				RegisterFeeHistoryError(fQueryClient, 1, 1, 1)
+				RegisterBlockFeesError(fQueryClient, 1, 1)
+				RegisterNextBaseFee(fQueryClient, 1, baseFee)
 			},
 			1,
 			1,
@@ -449,6 +460,77 @@ func (suite *BackendTestSuite) TestFeeHistory() {
 				OldestBlock:  (*hexutil.Big)(big.NewInt(1)),
 				BaseFee:      []*hexutil.Big{(*hexutil.Big)(big.NewInt(1)), (*hexutil.Big)(big.NewInt(1))},
 				GasUsedRatio: []float64{0},
+				BurntFees:    []*hexutil.Big{(*hexutil.Big)(big.NewInt(0))},
+				PriorityFees: []*hexutil.Big{(*hexutil.Big)(big.NewInt(0))},
+				Reward:       [][]*hexutil.Big{{(*hexutil.Big)(big.NewInt(0)), (*hexutil.Big)(big.NewInt(0)), (*hexutil.Big)(big.NewInt(0)), (*hexutil.Big)(big.NewInt(0))}},
+			},
+			sdk.AccAddress(tests.GenerateAddress().Bytes()),
+			true,
+			nil,
+		},
+		{
+			"pass - next base fee computed by the moving window fee market",
+			func(validator sdk.AccAddress) {
+				var header metadata.MD
+				baseFee := sdkmath.NewInt(1)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				fQueryClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				suite.backend.cfg.JSONRPC.FeeHistoryCap = 2
+				RegisterBlock(client, ethrpc.BlockNumber(1).Int64(), nil)
+				RegisterBlockResults(client, 1)
+				RegisterBaseFee(queryClient, baseFee)
+				RegisterValidatorAccount(queryClient, validator)
+				RegisterConsensusParams(client, 1)
+				RegisterParams(queryClient, &header, 1)
+				RegisterParamsWithoutHeader(queryClient, 1)
+				RegisterFeeHistoryError(fQueryClient, 1, 1, 1)
+				RegisterBlockFeesError(fQueryClient, 1, 1)
+				// the window of the previous blocks raises the base fee of an empty block, which
+				// the EIP-1559 formula would lower
+				RegisterNextBaseFee(fQueryClient, 1, sdkmath.NewInt(3))
+			},
+			1,
+			1,
+			&rpc.FeeHistoryResult{
+				OldestBlock:  (*hexutil.Big)(big.NewInt(1)),
+				BaseFee:      []*hexutil.Big{(*hexutil.Big)(big.NewInt(1)), (*hexutil.Big)(big.NewInt(3))},
+				GasUsedRatio: []float64{0},
+				BurntFees:    []*hexutil.Big{(*hexutil.Big)(big.NewInt(0))},
+				PriorityFees: []*hexutil.Big{(*hexutil.Big)(big.NewInt(0))},
+				Reward:       [][]*hexutil.Big{{(*hexutil.Big)(big.NewInt(0)), (*hexutil.Big)(big.NewInt(0)), (*hexutil.Big)(big.NewInt(0)), (*hexutil.Big)(big.NewInt(0))}},
+			},
+			sdk.AccAddress(tests.GenerateAddress().Bytes()),
+			true,
+			nil,
+		},
+		{
+			"pass - stored fee history of the moving window fee market",
+			func(validator sdk.AccAddress) {
+				var header metadata.MD
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				fQueryClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				suite.backend.cfg.JSONRPC.FeeHistoryCap = 2
+				RegisterParams(queryClient, &header, 1)
+				RegisterParamsWithoutHeader(queryClient, 1)
+				RegisterBlockFeesError(fQueryClient, 1, 1)
+				RegisterFeeHistory(fQueryClient, 1, 1, 1, feemarkettypes.FeeHistoryEntry{
+					Height:      1,
+					BaseFee:     sdkmath.NewInt(1),
+					GasWanted:   0,
+					GasUsed:     50,
+					GasLimit:    100,
+					NextBaseFee: sdkmath.NewInt(3),
+				})
+			},
+			1,
+			1,
+			&rpc.FeeHistoryResult{
+				OldestBlock:  (*hexutil.Big)(big.NewInt(1)),
+				BaseFee:      []*hexutil.Big{(*hexutil.Big)(big.NewInt(1)), (*hexutil.Big)(big.NewInt(3))},
+				GasUsedRatio: []float64{0.5},
+				BurntFees:    []*hexutil.Big{(*hexutil.Big)(big.NewInt(0))},
+				PriorityFees: []*hexutil.Big{(*hexutil.Big)(big.NewInt(0))},
 				Reward:       [][]*hexutil.Big{{(*hexutil.Big)(big.NewInt(0)), (*hexutil.Big)(big.NewInt(0)), (*hexutil.Big)(big.NewInt(0)), (*hexutil.Big)(big.NewInt(0))}},
 			},
 			sdk.AccAddress(tests.GenerateAddress().Bytes()),
@@ -471,7 +553,9 @@ func (suite *BackendTestSuite) TestFeeHistory() {
 				RegisterConsensusParams(client, 1)
 				RegisterParams(queryClient, &header, 1)
 				RegisterParamsWithoutHeader(queryClient, 1)
-				RegisterFeeMarketParams(fQueryClient, 1)
+				RegisterFeeHistoryError(fQueryClient, 1, 1, 1)
+				RegisterBlockFeesError(fQueryClient, 1, 1)
+				RegisterNextBaseFee(fQueryClient, 1, baseFee)
 			},
 			1,
 			1,
@@ -479,6 +563,8 @@ func (suite *BackendTestSuite) TestFeeHistory() {
 				OldestBlock:  (*hexutil.Big)(big.NewInt(1)),
 				BaseFee:      []*hexutil.Big{(*hexutil.Big)(big.NewInt(1)), (*hexutil.Big)(big.NewInt(0))},
 				GasUsedRatio: []float64{0},
+				BurntFees:    []*hexutil.Big{(*hexutil.Big)(big.NewInt(0))},
+				PriorityFees: []*hexutil.Big{(*hexutil.Big)(big.NewInt(0))},
 				Reward:       [][]*hexutil.Big{{(*hexutil.Big)(big.NewInt(0)), (*hexutil.Big)(big.NewInt(0)), (*hexutil.Big)(big.NewInt(0)), (*hexutil.Big)(big.NewInt(0))}},
 			},
 			sdk.AccAddress(tests.GenerateAddress().Bytes()),
@@ -520,3 +606,37 @@ func (suite *BackendTestSuite) TestFeeHistory() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestFeeHistoryStoredRewards() {
+	suite.SetupTest()
+	var header metadata.MD
+	queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+	fQueryClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+	suite.backend.cfg.JSONRPC.FeeHistoryCap = 2
+	RegisterParams(queryClient, &header, 1)
+	RegisterParamsWithoutHeader(queryClient, 1)
+	RegisterBlockFeesError(fQueryClient, 1, 1)
+	// the block is pruned: it isn't queried, its rewards are only kept by the fee history
+	RegisterFeeHistory(fQueryClient, 1, 1, 1, feemarkettypes.FeeHistoryEntry{
+		Height:      1,
+		BaseFee:     sdkmath.NewInt(1),
+		GasUsed:     1000,
+		GasLimit:    2000,
+		NextBaseFee: sdkmath.NewInt(1),
+		TxRewards: feemarkettypes.SortTxRewards([]feemarkettypes.TxReward{
+			{GasUsed: 450, Reward: sdkmath.NewInt(30)},
+			{GasUsed: 100, Reward: sdkmath.NewInt(10)},
+			{GasUsed: 450, Reward: sdkmath.NewInt(20)},
+		}),
+	})
+
+	feeHistory, err := suite.backend.FeeHistory(1, 1, []float64{7.5, 10.1, 33, 55.5, 99})
+	suite.Require().NoError(err)
+	suite.Require().Equal([][]*hexutil.Big{{
+		(*hexutil.Big)(big.NewInt(10)),
+		(*hexutil.Big)(big.NewInt(20)),
+		(*hexutil.Big)(big.NewInt(20)),
+		(*hexutil.Big)(big.NewInt(30)),
+		(*hexutil.Big)(big.NewInt(30)),
+	}}, feeHistory.Reward)
+}
@@ -0,0 +1,142 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package backend
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evmos/ethermint/rpc/signer"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// externalSignerTimeout is the timeout of the account listings of the external signer, the
+// signatures have none since the signer may wait for its user to approve them.
+const externalSignerTimeout = 10 * time.Second
+
+// externalSigners are the connections to the external signers by endpoint, shared by the backends
+// of all the namespaces.
+var (
+	externalSignersMtx sync.Mutex
+	externalSigners    = make(map[string]*signer.ExternalSigner)
+)
+
+// dialExternalSigner returns the connection to the external signer of the configuration, or nil
+// if there's none or it can't be reached. The connection is retried on the next use on failure.
+// The signer is dialed without holding the lock, so that an unreachable endpoint doesn't block
+// the other requests, the connection of the first caller being kept if several dial it.
+func (b *Backend) dialExternalSigner() *signer.ExternalSigner {
+	endpoint := b.cfg.JSONRPC.ExternalSigner
+	if endpoint == "" {
+		return nil
+	}
+
+	externalSignersMtx.Lock()
+	ext, ok := externalSigners[endpoint]
+	externalSignersMtx.Unlock()
+	if ok {
+		return ext
+	}
+
+	ctx, cancel := context.WithTimeout(b.ctx, externalSignerTimeout)
+	defer cancel()
+	ext, err := signer.Dial(ctx, endpoint)
+	if err != nil {
+		b.logger.Error("failed to connect to the external signer", "error", err.Error())
+		return nil
+	}
+
+	externalSignersMtx.Lock()
+	defer externalSignersMtx.Unlock()
+	if existing, ok := externalSigners[endpoint]; ok {
+		ext.Close()
+		return existing
+	}
+	externalSigners[endpoint] = ext
+	return ext
+}
+
+// externalSigner returns the external signer if it holds the key of the address, otherwise nil so
+// that the keys of the node are used instead, also when the signer can't be reached.
+func (b *Backend) externalSigner(address common.Address) *signer.ExternalSigner {
+	ext := b.dialExternalSigner()
+	if ext == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(b.ctx, externalSignerTimeout)
+	defer cancel()
+
+	ok, err := ext.Contains(ctx, address)
+	if err != nil {
+		b.logger.Error("failed to list the accounts of the external signer", "endpoint", ext.Endpoint(), "error", err.Error())
+		return nil
+	}
+	if !ok {
+		return nil
+	}
+	return ext
+}
+
+// externalSignerAddresses returns the addresses of the external signer accounts missing from the
+// list.
+func (b *Backend) externalSignerAddresses(addrs []common.Address) []common.Address {
+	ext := b.dialExternalSigner()
+	if ext == nil {
+		return addrs
+	}
+
+	ctx, cancel := context.WithTimeout(b.ctx, externalSignerTimeout)
+	defer cancel()
+
+	external, err := ext.Accounts(ctx)
+	if err != nil {
+		b.logger.Error("failed to list the accounts of the external signer", "endpoint", ext.Endpoint(), "error", err.Error())
+		return addrs
+	}
+	return appendMissingAddresses(addrs, external)
+}
+
+// externalSigningContext returns the context of the signing requests of the external signer,
+// canceled after the configured timeout if any.
+func (b *Backend) externalSigningContext() (context.Context, context.CancelFunc) {
+	if timeout := b.cfg.JSONRPC.ExternalSignerTimeout; timeout > 0 {
+		return context.WithTimeout(b.ctx, timeout)
+	}
+	return context.WithCancel(b.ctx)
+}
+
+// signExternally has the external signer sign the transaction of the message, which is replaced by
+// the signed one.
+func (b *Backend) signExternally(ext *signer.ExternalSigner, msg *evmtypes.MsgEthereumTx, ethSigner ethtypes.Signer) error {
+	if msg.TxType() == evmtypes.SetCodeTxType {
+		return errorsmod.Wrap(evmtypes.ErrTxTypeNotSupported, "the external signer can't sign set-code transactions")
+	}
+	from := common.BytesToAddress(msg.GetFrom())
+	ctx, cancel := b.externalSigningContext()
+	defer cancel()
+
+	signed, err := ext.SignTransaction(ctx, from, msg.AsTransaction(), ethSigner.ChainID())
+	if err != nil {
+		return err
+	}
+	return msg.FromSignedEthereumTx(signed, ethSigner.ChainID())
+}
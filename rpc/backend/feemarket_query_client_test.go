@@ -1,6 +1,7 @@
 package backend
 
 import (
+	sdkmath "cosmossdk.io/math"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/evmos/ethermint/rpc/backend/mocks"
 	rpc "github.com/evmos/ethermint/rpc/types"
@@ -19,3 +20,37 @@ func RegisterFeeMarketParamsError(feeMarketClient *mocks.FeeMarketQueryClient, h
 	feeMarketClient.On("Params", rpc.ContextWithHeight(height), &feemarkettypes.QueryParamsRequest{}).
 		Return(nil, sdkerrors.ErrInvalidRequest)
 }
+
+// BlockFees
+func RegisterBlockFeesError(feeMarketClient *mocks.FeeMarketQueryClient, height, blockHeight int64) {
+	feeMarketClient.On("BlockFees", rpc.ContextWithHeight(height), &feemarkettypes.QueryBlockFeesRequest{Height: blockHeight}).
+		Return(nil, sdkerrors.ErrInvalidRequest)
+}
+
+// FeeHistory
+func RegisterFeeHistoryError(feeMarketClient *mocks.FeeMarketQueryClient, height, from, to int64) {
+	feeMarketClient.On("FeeHistory", rpc.ContextWithHeight(height), &feemarkettypes.QueryFeeHistoryRequest{FromHeight: from, ToHeight: to}).
+		Return(nil, sdkerrors.ErrInvalidRequest)
+}
+
+func RegisterFeeHistory(feeMarketClient *mocks.FeeMarketQueryClient, height, from, to int64, entries ...feemarkettypes.FeeHistoryEntry) {
+	feeMarketClient.On("FeeHistory", rpc.ContextWithHeight(height), &feemarkettypes.QueryFeeHistoryRequest{FromHeight: from, ToHeight: to}).
+		Return(&feemarkettypes.QueryFeeHistoryResponse{Entries: entries}, nil)
+}
+
+// NextBaseFee
+func RegisterNextBaseFee(feeMarketClient *mocks.FeeMarketQueryClient, height int64, baseFee sdkmath.Int) {
+	feeMarketClient.On("NextBaseFee", rpc.ContextWithHeight(height), &feemarkettypes.QueryNextBaseFeeRequest{}).
+		Return(&feemarkettypes.QueryNextBaseFeeResponse{BaseFee: &baseFee}, nil)
+}
+
+func RegisterNextBaseFeeError(feeMarketClient *mocks.FeeMarketQueryClient, height int64) {
+	feeMarketClient.On("NextBaseFee", rpc.ContextWithHeight(height), &feemarkettypes.QueryNextBaseFeeRequest{}).
+		Return(nil, sdkerrors.ErrInvalidRequest)
+}
+
+// BaseFee
+func RegisterFeeMarketBaseFeeError(feeMarketClient *mocks.FeeMarketQueryClient, height int64) {
+	feeMarketClient.On("BaseFee", rpc.ContextWithHeight(height), &feemarkettypes.QueryBaseFeeRequest{}).
+		Return(nil, sdkerrors.ErrInvalidRequest)
+}
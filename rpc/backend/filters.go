@@ -16,6 +16,7 @@
 package backend
 
 import (
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
@@ -35,13 +36,34 @@ func (b *Backend) GetLogs(hash common.Hash) ([][]*ethtypes.Log, error) {
 
 // GetLogsByHeight returns all the logs from all the ethereum transactions in a block.
 func (b *Backend) GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error) {
+	if height != nil {
+		if logs, ok := b.cache.Get("eth_getLogs", *height); ok {
+			return logs.([][]*ethtypes.Log), nil
+		}
+	}
+
 	// NOTE: we query the state in case the tx result logs are not persisted after an upgrade.
 	blockRes, err := b.TendermintBlockResultByNumber(height)
 	if err != nil {
 		return nil, err
 	}
+	return b.GetLogsByBlockResult(blockRes)
+}
+
+// GetLogsByBlockResult returns all the logs from all the ethereum transactions of a block
+// result, the logs of the committed blocks are cached.
+func (b *Backend) GetLogsByBlockResult(blockRes *tmrpctypes.ResultBlockResults) ([][]*ethtypes.Log, error) {
+	if logs, ok := b.cache.Get("eth_getLogs", blockRes.Height); ok {
+		return logs.([][]*ethtypes.Log), nil
+	}
+
+	logs, err := GetLogsFromBlockResults(blockRes)
+	if err != nil {
+		return nil, err
+	}
 
-	return GetLogsFromBlockResults(blockRes)
+	b.cacheResponse(blockRes.Height, logs, "eth_getLogs", blockRes.Height)
+	return logs, nil
 }
 
 // BloomStatus returns the BloomBitsBlocks and the number of processed sections maintained
@@ -0,0 +1,233 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package backend
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+	"github.com/evmos/ethermint/server/config"
+)
+
+// keystores are the keystores of the personal namespace accounts by directory. They're shared
+// by the backends of all the namespaces, so that the accounts unlocked through the personal
+// namespace can sign through the eth one.
+var (
+	keystoresMtx sync.Mutex
+	keystores    = make(map[string]*keystore.KeyStore)
+)
+
+// keystoreDir returns the keystore directory of the configuration, relative to the node home
+// directory if not absolute.
+func keystoreDir(ctx *server.Context, dir string) string {
+	if dir == "" {
+		dir = config.DefaultKeystoreDir
+	}
+	if filepath.IsAbs(dir) || ctx.Config == nil {
+		return dir
+	}
+	return filepath.Join(ctx.Config.RootDir, dir)
+}
+
+// keystore returns the keystore of the personal namespace accounts, opened on first use.
+func (b *Backend) keystore() *keystore.KeyStore {
+	keystoresMtx.Lock()
+	defer keystoresMtx.Unlock()
+
+	ks, ok := keystores[b.keystoreDir]
+	if !ok {
+		ks = keystore.NewKeyStore(b.keystoreDir, keystore.StandardScryptN, keystore.StandardScryptP)
+		keystores[b.keystoreDir] = ks
+	}
+	return ks
+}
+
+// errKeyringPassword is returned when a password is provided for a key of the keyring, which isn't
+// encrypted with a password of the personal namespace and can't verify it.
+var errKeyringPassword = errors.New("the keys of the keyring aren't protected by a password, it must be empty")
+
+// signer returns the signer holding the key of the address, the keyring or else the keystore.
+// The keys of the keystore are decrypted with the passphrase if provided, otherwise they must be
+// unlocked. The keys of the keyring only accept an empty passphrase.
+func (b *Backend) signer(address common.Address, passphrase *string) (keyring.Signer, error) {
+	if b.inKeyring(address) {
+		if passphrase != nil && *passphrase != "" {
+			return nil, errKeyringPassword
+		}
+		return b.clientCtx.Keyring, nil
+	}
+
+	ks := b.keystore()
+	if ks.HasAddress(address) {
+		return keystoreSigner{ks: ks, passphrase: passphrase}, nil
+	}
+
+	b.logger.Error("failed to find key in keyring or keystore", "address", address.String())
+	return nil, fmt.Errorf("failed to find key in the node's keyring or keystore; %s", keystore.ErrNoMatch)
+}
+
+// inKeyring checks if the keyring holds the key of the address.
+func (b *Backend) inKeyring(address common.Address) bool {
+	if b.clientCtx.Keyring == nil {
+		return false
+	}
+	_, err := b.clientCtx.Keyring.KeyByAddress(sdk.AccAddress(address.Bytes()))
+	return err == nil
+}
+
+// NewAccount creates a new account in the keystore, encrypted with the password.
+func (b *Backend) NewAccount(password string) (common.Address, error) {
+	account, err := b.keystore().NewAccount(password)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	b.logger.Info("Your new key was generated", "address", account.Address.String())
+	b.logger.Info("Please backup your key file!", "path", account.URL.Path)
+	b.logger.Info("Please remember your password!")
+	return account.Address, nil
+}
+
+// ImportKeystore imports the Web3 Secret Storage JSON of a key, decrypted with the password, into
+// the keystore, encrypted with the new password.
+func (b *Backend) ImportKeystore(keyJSON []byte, password, newPassword string) (common.Address, error) {
+	account, err := b.keystore().Import(keyJSON, password, newPassword)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	b.logger.Info("key successfully imported", "address", account.Address.String(), "path", account.URL.Path)
+	return account.Address, nil
+}
+
+// ExportKeystore exports the key of the keystore account, decrypted with the password, as Web3
+// Secret Storage JSON, encrypted with the new password.
+func (b *Backend) ExportKeystore(address common.Address, password, newPassword string) ([]byte, error) {
+	return b.keystore().Export(accounts.Account{Address: address}, password, newPassword)
+}
+
+// UnlockAccount decrypts the key of the keystore account with the password, so that it can sign
+// without it for the duration, or until the node stops if zero. The keys of the keyring are
+// always unlocked, and only accept an empty password.
+func (b *Backend) UnlockAccount(address common.Address, password string, duration time.Duration) error {
+	if b.inKeyring(address) {
+		if password != "" {
+			return errKeyringPassword
+		}
+		return nil
+	}
+	return b.keystore().TimedUnlock(accounts.Account{Address: address}, password, duration)
+}
+
+// LockAccount removes the decrypted key of the keystore account from memory. The keys of the
+// keyring can't be locked.
+func (b *Backend) LockAccount(address common.Address) error {
+	if b.inKeyring(address) {
+		return errors.New("the keys of the keyring can't be locked")
+	}
+	return b.keystore().Lock(address)
+}
+
+// Wallets returns the wallets of the keystore accounts.
+func (b *Backend) Wallets() []accounts.Wallet {
+	return b.keystore().Wallets()
+}
+
+// keystoreAddresses returns the addresses of the keystore accounts missing from the list.
+func (b *Backend) keystoreAddresses(addrs []common.Address) []common.Address {
+	accounts := b.keystore().Accounts()
+	keystoreAddrs := make([]common.Address, len(accounts))
+	for i, account := range accounts {
+		keystoreAddrs[i] = account.Address
+	}
+	return appendMissingAddresses(addrs, keystoreAddrs)
+}
+
+// appendMissingAddresses appends the addresses missing from the list.
+func appendMissingAddresses(addrs, more []common.Address) []common.Address {
+	seen := make(map[common.Address]bool, len(addrs))
+	for _, addr := range addrs {
+		seen[addr] = true
+	}
+	for _, addr := range more {
+		if !seen[addr] {
+			seen[addr] = true
+			addrs = append(addrs, addr)
+		}
+	}
+	return addrs
+}
+
+// keystoreSigner is the keyring.Signer of the keystore accounts, so that they sign the same
+// digests as the keys of the keyring.
+type keystoreSigner struct {
+	ks         *keystore.KeyStore
+	passphrase *string
+}
+
+var _ keyring.Signer = keystoreSigner{}
+
+func (s keystoreSigner) Sign(uid string, _ []byte, _ signingtypes.SignMode) ([]byte, cryptotypes.PubKey, error) {
+	return nil, nil, fmt.Errorf("keystore accounts can only sign by address, not by name %s", uid)
+}
+
+// SignByAddress signs the digest, or the keccak256 hash of the message if it isn't a digest like
+// the ethsecp256k1 keys of the keyring.
+func (s keystoreSigner) SignByAddress(
+	address sdk.Address, msg []byte, _ signingtypes.SignMode,
+) ([]byte, cryptotypes.PubKey, error) {
+	digest := msg
+	if len(digest) != crypto.DigestLength {
+		digest = crypto.Keccak256(msg)
+	}
+
+	account := accounts.Account{Address: common.BytesToAddress(address.Bytes())}
+	var (
+		sig []byte
+		err error
+	)
+	if s.passphrase != nil {
+		sig, err = s.ks.SignHashWithPassphrase(account, *s.passphrase, digest)
+	} else {
+		sig, err = s.ks.SignHash(account, digest)
+	}
+	if err != nil {
+		if errors.Is(err, keystore.ErrLocked) {
+			return nil, nil, fmt.Errorf("account %s is locked, unlock it or provide its password", account.Address)
+		}
+		return nil, nil, err
+	}
+
+	pubKey, err := crypto.SigToPub(digest, sig)
+	if err != nil {
+		return nil, nil, err
+	}
+	return sig, &ethsecp256k1.PubKey{Key: crypto.CompressPubkey(pubKey)}, nil
+}
@@ -0,0 +1,76 @@
+package backend
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/ethermint/tests"
+)
+
+func (suite *BackendTestSuite) TestUnlockAccount() {
+	from, priv := tests.NewAddrKey()
+	testCases := []struct {
+		name         string
+		registerMock func() common.Address
+		password     string
+		expPass      bool
+	}{
+		{
+			"pass - keyring key with empty password",
+			func() common.Address {
+				armor := crypto.EncryptArmorPrivKey(priv, "", "eth_secp256k1")
+				suite.Require().NoError(suite.backend.clientCtx.Keyring.ImportPrivKey("test_key", armor, ""))
+				return from
+			},
+			"",
+			true,
+		},
+		{
+			"fail - keyring key with password",
+			func() common.Address {
+				armor := crypto.EncryptArmorPrivKey(priv, "", "eth_secp256k1")
+				suite.Require().NoError(suite.backend.clientCtx.Keyring.ImportPrivKey("test_key", armor, ""))
+				return from
+			},
+			"password",
+			false,
+		},
+		{
+			"pass - keystore key",
+			func() common.Address {
+				addr, err := suite.backend.NewAccount("password")
+				suite.Require().NoError(err)
+				return addr
+			},
+			"password",
+			true,
+		},
+		{
+			"fail - keystore key with wrong password",
+			func() common.Address {
+				addr, err := suite.backend.NewAccount("password")
+				suite.Require().NoError(err)
+				return addr
+			},
+			"wrong",
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			addr := tc.registerMock()
+
+			err := suite.backend.UnlockAccount(addr, tc.password, time.Minute)
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
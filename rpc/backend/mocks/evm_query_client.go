@@ -1,4 +1,4 @@
-// Code generated by mockery v2.14.0. DO NOT EDIT.
+// Code generated by mockery v2.53.7. DO NOT EDIT.
 
 package mocks
 
@@ -12,7 +12,7 @@ import (
 	types "github.com/evmos/ethermint/x/evm/types"
 )
 
-// EVMQueryClient is an autogenerated mock type for the EVMQueryClient type
+// EVMQueryClient is an autogenerated mock type for the QueryClient type
 type EVMQueryClient struct {
 	mock.Mock
 }
@@ -28,7 +28,15 @@ func (_m *EVMQueryClient) Account(ctx context.Context, in *types.QueryAccountReq
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
+	if len(ret) == 0 {
+		panic("no return value specified for Account")
+	}
+
 	var r0 *types.QueryAccountResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryAccountRequest, ...grpc.CallOption) (*types.QueryAccountResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
 	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryAccountRequest, ...grpc.CallOption) *types.QueryAccountResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
@@ -37,7 +45,6 @@ func (_m *EVMQueryClient) Account(ctx context.Context, in *types.QueryAccountReq
 		}
 	}
 
-	var r1 error
 	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryAccountRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
@@ -58,7 +65,15 @@ func (_m *EVMQueryClient) Balance(ctx context.Context, in *types.QueryBalanceReq
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
+	if len(ret) == 0 {
+		panic("no return value specified for Balance")
+	}
+
 	var r0 *types.QueryBalanceResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryBalanceRequest, ...grpc.CallOption) (*types.QueryBalanceResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
 	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryBalanceRequest, ...grpc.CallOption) *types.QueryBalanceResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
@@ -67,7 +82,6 @@ func (_m *EVMQueryClient) Balance(ctx context.Context, in *types.QueryBalanceReq
 		}
 	}
 
-	var r1 error
 	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryBalanceRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
@@ -88,7 +102,15 @@ func (_m *EVMQueryClient) BaseFee(ctx context.Context, in *types.QueryBaseFeeReq
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
+	if len(ret) == 0 {
+		panic("no return value specified for BaseFee")
+	}
+
 	var r0 *types.QueryBaseFeeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryBaseFeeRequest, ...grpc.CallOption) (*types.QueryBaseFeeResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
 	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryBaseFeeRequest, ...grpc.CallOption) *types.QueryBaseFeeResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
@@ -97,7 +119,6 @@ func (_m *EVMQueryClient) BaseFee(ctx context.Context, in *types.QueryBaseFeeReq
 		}
 	}
 
-	var r1 error
 	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryBaseFeeRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
@@ -118,7 +139,15 @@ func (_m *EVMQueryClient) Code(ctx context.Context, in *types.QueryCodeRequest,
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
+	if len(ret) == 0 {
+		panic("no return value specified for Code")
+	}
+
 	var r0 *types.QueryCodeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryCodeRequest, ...grpc.CallOption) (*types.QueryCodeResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
 	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryCodeRequest, ...grpc.CallOption) *types.QueryCodeResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
@@ -127,7 +156,6 @@ func (_m *EVMQueryClient) Code(ctx context.Context, in *types.QueryCodeRequest,
 		}
 	}
 
-	var r1 error
 	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryCodeRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
@@ -148,7 +176,15 @@ func (_m *EVMQueryClient) CosmosAccount(ctx context.Context, in *types.QueryCosm
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
+	if len(ret) == 0 {
+		panic("no return value specified for CosmosAccount")
+	}
+
 	var r0 *types.QueryCosmosAccountResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryCosmosAccountRequest, ...grpc.CallOption) (*types.QueryCosmosAccountResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
 	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryCosmosAccountRequest, ...grpc.CallOption) *types.QueryCosmosAccountResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
@@ -157,7 +193,6 @@ func (_m *EVMQueryClient) CosmosAccount(ctx context.Context, in *types.QueryCosm
 		}
 	}
 
-	var r1 error
 	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryCosmosAccountRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
@@ -178,7 +213,15 @@ func (_m *EVMQueryClient) EstimateGas(ctx context.Context, in *types.EthCallRequ
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
+	if len(ret) == 0 {
+		panic("no return value specified for EstimateGas")
+	}
+
 	var r0 *types.EstimateGasResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.EthCallRequest, ...grpc.CallOption) (*types.EstimateGasResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
 	if rf, ok := ret.Get(0).(func(context.Context, *types.EthCallRequest, ...grpc.CallOption) *types.EstimateGasResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
@@ -187,7 +230,6 @@ func (_m *EVMQueryClient) EstimateGas(ctx context.Context, in *types.EthCallRequ
 		}
 	}
 
-	var r1 error
 	if rf, ok := ret.Get(1).(func(context.Context, *types.EthCallRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
@@ -208,7 +250,15 @@ func (_m *EVMQueryClient) EthCall(ctx context.Context, in *types.EthCallRequest,
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
+	if len(ret) == 0 {
+		panic("no return value specified for EthCall")
+	}
+
 	var r0 *types.MsgEthereumTxResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.EthCallRequest, ...grpc.CallOption) (*types.MsgEthereumTxResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
 	if rf, ok := ret.Get(0).(func(context.Context, *types.EthCallRequest, ...grpc.CallOption) *types.MsgEthereumTxResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
@@ -217,7 +267,6 @@ func (_m *EVMQueryClient) EthCall(ctx context.Context, in *types.EthCallRequest,
 		}
 	}
 
-	var r1 error
 	if rf, ok := ret.Get(1).(func(context.Context, *types.EthCallRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
@@ -238,7 +287,15 @@ func (_m *EVMQueryClient) Params(ctx context.Context, in *types.QueryParamsReque
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
+	if len(ret) == 0 {
+		panic("no return value specified for Params")
+	}
+
 	var r0 *types.QueryParamsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryParamsRequest, ...grpc.CallOption) (*types.QueryParamsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
 	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryParamsRequest, ...grpc.CallOption) *types.QueryParamsResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
@@ -247,7 +304,6 @@ func (_m *EVMQueryClient) Params(ctx context.Context, in *types.QueryParamsReque
 		}
 	}
 
-	var r1 error
 	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryParamsRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
@@ -257,6 +313,413 @@ func (_m *EVMQueryClient) Params(ctx context.Context, in *types.QueryParamsReque
 	return r0, r1
 }
 
+// PostAddBalanceStateDB provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) PostAddBalanceStateDB(ctx context.Context, in *types.AddBalanceRequest, opts ...grpc.CallOption) (*types.AddBalanceResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PostAddBalanceStateDB")
+	}
+
+	var r0 *types.AddBalanceResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.AddBalanceRequest, ...grpc.CallOption) (*types.AddBalanceResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.AddBalanceRequest, ...grpc.CallOption) *types.AddBalanceResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.AddBalanceResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.AddBalanceRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PostDeleteAccountStateDB provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) PostDeleteAccountStateDB(ctx context.Context, in *types.DeleteAccountRequest, opts ...grpc.CallOption) (*types.DeleteAccountResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PostDeleteAccountStateDB")
+	}
+
+	var r0 *types.DeleteAccountResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.DeleteAccountRequest, ...grpc.CallOption) (*types.DeleteAccountResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.DeleteAccountRequest, ...grpc.CallOption) *types.DeleteAccountResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.DeleteAccountResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.DeleteAccountRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PostSetAccountStateDB provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) PostSetAccountStateDB(ctx context.Context, in *types.SetAccountRequest, opts ...grpc.CallOption) (*types.SetAccountResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PostSetAccountStateDB")
+	}
+
+	var r0 *types.SetAccountResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.SetAccountRequest, ...grpc.CallOption) (*types.SetAccountResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.SetAccountRequest, ...grpc.CallOption) *types.SetAccountResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.SetAccountResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.SetAccountRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PostSetCodeStateDB provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) PostSetCodeStateDB(ctx context.Context, in *types.SetCodeRequest, opts ...grpc.CallOption) (*types.SetCodeResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PostSetCodeStateDB")
+	}
+
+	var r0 *types.SetCodeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.SetCodeRequest, ...grpc.CallOption) (*types.SetCodeResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.SetCodeRequest, ...grpc.CallOption) *types.SetCodeResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.SetCodeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.SetCodeRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PostSetStateStateDB provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) PostSetStateStateDB(ctx context.Context, in *types.SetStateRequest, opts ...grpc.CallOption) (*types.SetStateResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PostSetStateStateDB")
+	}
+
+	var r0 *types.SetStateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.SetStateRequest, ...grpc.CallOption) (*types.SetStateResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.SetStateRequest, ...grpc.CallOption) *types.SetStateResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.SetStateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.SetStateRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PostSubBalanceStateDB provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) PostSubBalanceStateDB(ctx context.Context, in *types.SubBalanceRequest, opts ...grpc.CallOption) (*types.SubBalanceResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PostSubBalanceStateDB")
+	}
+
+	var r0 *types.SubBalanceResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.SubBalanceRequest, ...grpc.CallOption) (*types.SubBalanceResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.SubBalanceRequest, ...grpc.CallOption) *types.SubBalanceResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.SubBalanceResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.SubBalanceRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// QueryGetAccountStateDB provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) QueryGetAccountStateDB(ctx context.Context, in *types.GetAccountRequest, opts ...grpc.CallOption) (*types.GetAccountResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QueryGetAccountStateDB")
+	}
+
+	var r0 *types.GetAccountResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.GetAccountRequest, ...grpc.CallOption) (*types.GetAccountResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.GetAccountRequest, ...grpc.CallOption) *types.GetAccountResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.GetAccountResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.GetAccountRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// QueryGetBalanceStateDB provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) QueryGetBalanceStateDB(ctx context.Context, in *types.GetBalanceRequest, opts ...grpc.CallOption) (*types.GetBalanceResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QueryGetBalanceStateDB")
+	}
+
+	var r0 *types.GetBalanceResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.GetBalanceRequest, ...grpc.CallOption) (*types.GetBalanceResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.GetBalanceRequest, ...grpc.CallOption) *types.GetBalanceResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.GetBalanceResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.GetBalanceRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// QueryGetCodeStateDB provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) QueryGetCodeStateDB(ctx context.Context, in *types.GetCodeRequest, opts ...grpc.CallOption) (*types.GetCodeResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QueryGetCodeStateDB")
+	}
+
+	var r0 *types.GetCodeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.GetCodeRequest, ...grpc.CallOption) (*types.GetCodeResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.GetCodeRequest, ...grpc.CallOption) *types.GetCodeResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.GetCodeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.GetCodeRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// QueryGetHashStateDB provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) QueryGetHashStateDB(ctx context.Context, in *types.GetHashRequest, opts ...grpc.CallOption) (*types.GetHashResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QueryGetHashStateDB")
+	}
+
+	var r0 *types.GetHashResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.GetHashRequest, ...grpc.CallOption) (*types.GetHashResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.GetHashRequest, ...grpc.CallOption) *types.GetHashResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.GetHashResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.GetHashRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// QueryGetStateStateDB provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) QueryGetStateStateDB(ctx context.Context, in *types.GetStateRequest, opts ...grpc.CallOption) (*types.GetStateResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QueryGetStateStateDB")
+	}
+
+	var r0 *types.GetStateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.GetStateRequest, ...grpc.CallOption) (*types.GetStateResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.GetStateRequest, ...grpc.CallOption) *types.GetStateResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.GetStateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.GetStateRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Storage provides a mock function with given fields: ctx, in, opts
 func (_m *EVMQueryClient) Storage(ctx context.Context, in *types.QueryStorageRequest, opts ...grpc.CallOption) (*types.QueryStorageResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -268,7 +731,15 @@ func (_m *EVMQueryClient) Storage(ctx context.Context, in *types.QueryStorageReq
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
+	if len(ret) == 0 {
+		panic("no return value specified for Storage")
+	}
+
 	var r0 *types.QueryStorageResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryStorageRequest, ...grpc.CallOption) (*types.QueryStorageResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
 	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryStorageRequest, ...grpc.CallOption) *types.QueryStorageResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
@@ -277,7 +748,6 @@ func (_m *EVMQueryClient) Storage(ctx context.Context, in *types.QueryStorageReq
 		}
 	}
 
-	var r1 error
 	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryStorageRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
@@ -298,7 +768,15 @@ func (_m *EVMQueryClient) TraceBlock(ctx context.Context, in *types.QueryTraceBl
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
+	if len(ret) == 0 {
+		panic("no return value specified for TraceBlock")
+	}
+
 	var r0 *types.QueryTraceBlockResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryTraceBlockRequest, ...grpc.CallOption) (*types.QueryTraceBlockResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
 	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryTraceBlockRequest, ...grpc.CallOption) *types.QueryTraceBlockResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
@@ -307,7 +785,6 @@ func (_m *EVMQueryClient) TraceBlock(ctx context.Context, in *types.QueryTraceBl
 		}
 	}
 
-	var r1 error
 	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryTraceBlockRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
@@ -317,8 +794,8 @@ func (_m *EVMQueryClient) TraceBlock(ctx context.Context, in *types.QueryTraceBl
 	return r0, r1
 }
 
-// TraceTx provides a mock function with given fields: ctx, in, opts
-func (_m *EVMQueryClient) TraceTx(ctx context.Context, in *types.QueryTraceTxRequest, opts ...grpc.CallOption) (*types.QueryTraceTxResponse, error) {
+// TraceCall provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) TraceCall(ctx context.Context, in *types.QueryTraceCallRequest, opts ...grpc.CallOption) (*types.QueryTraceCallResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -328,17 +805,24 @@ func (_m *EVMQueryClient) TraceTx(ctx context.Context, in *types.QueryTraceTxReq
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *types.QueryTraceTxResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryTraceTxRequest, ...grpc.CallOption) *types.QueryTraceTxResponse); ok {
+	if len(ret) == 0 {
+		panic("no return value specified for TraceCall")
+	}
+
+	var r0 *types.QueryTraceCallResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryTraceCallRequest, ...grpc.CallOption) (*types.QueryTraceCallResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryTraceCallRequest, ...grpc.CallOption) *types.QueryTraceCallResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*types.QueryTraceTxResponse)
+			r0 = ret.Get(0).(*types.QueryTraceCallResponse)
 		}
 	}
 
-	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryTraceTxRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryTraceCallRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -347,8 +831,8 @@ func (_m *EVMQueryClient) TraceTx(ctx context.Context, in *types.QueryTraceTxReq
 	return r0, r1
 }
 
-// TraceCall provides a mock function with given fields: ctx, in, opts
-func (_m *EVMQueryClient) TraceCall(ctx context.Context, in *types.QueryTraceCallRequest, opts ...grpc.CallOption) (*types.QueryTraceCallResponse, error) {
+// TraceTx provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) TraceTx(ctx context.Context, in *types.QueryTraceTxRequest, opts ...grpc.CallOption) (*types.QueryTraceTxResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -358,17 +842,24 @@ func (_m *EVMQueryClient) TraceCall(ctx context.Context, in *types.QueryTraceCal
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *types.QueryTraceCallResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryTraceCallRequest, ...grpc.CallOption) *types.QueryTraceCallResponse); ok {
+	if len(ret) == 0 {
+		panic("no return value specified for TraceTx")
+	}
+
+	var r0 *types.QueryTraceTxResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryTraceTxRequest, ...grpc.CallOption) (*types.QueryTraceTxResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryTraceTxRequest, ...grpc.CallOption) *types.QueryTraceTxResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*types.QueryTraceCallResponse)
+			r0 = ret.Get(0).(*types.QueryTraceTxResponse)
 		}
 	}
 
-	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryTraceCallRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryTraceTxRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -388,7 +879,15 @@ func (_m *EVMQueryClient) ValidatorAccount(ctx context.Context, in *types.QueryV
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
+	if len(ret) == 0 {
+		panic("no return value specified for ValidatorAccount")
+	}
+
 	var r0 *types.QueryValidatorAccountResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryValidatorAccountRequest, ...grpc.CallOption) (*types.QueryValidatorAccountResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
 	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryValidatorAccountRequest, ...grpc.CallOption) *types.QueryValidatorAccountResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
@@ -397,7 +896,6 @@ func (_m *EVMQueryClient) ValidatorAccount(ctx context.Context, in *types.QueryV
 		}
 	}
 
-	var r1 error
 	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryValidatorAccountRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
@@ -407,13 +905,12 @@ func (_m *EVMQueryClient) ValidatorAccount(ctx context.Context, in *types.QueryV
 	return r0, r1
 }
 
-type mockConstructorTestingTNewEVMQueryClient interface {
+// NewEVMQueryClient creates a new instance of EVMQueryClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewEVMQueryClient(t interface {
 	mock.TestingT
 	Cleanup(func())
-}
-
-// NewEVMQueryClient creates a new instance of EVMQueryClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-func NewEVMQueryClient(t mockConstructorTestingTNewEVMQueryClient) *EVMQueryClient {
+}) *EVMQueryClient {
 	mock := &EVMQueryClient{}
 	mock.Mock.Test(t)
 
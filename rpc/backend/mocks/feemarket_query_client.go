@@ -1,4 +1,4 @@
-// Code generated by mockery v2.14.1. DO NOT EDIT.
+// Code generated by mockery v2.53.7. DO NOT EDIT.
 
 package mocks
 
@@ -28,7 +28,15 @@ func (_m *FeeMarketQueryClient) BaseFee(ctx context.Context, in *types.QueryBase
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
+	if len(ret) == 0 {
+		panic("no return value specified for BaseFee")
+	}
+
 	var r0 *types.QueryBaseFeeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryBaseFeeRequest, ...grpc.CallOption) (*types.QueryBaseFeeResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
 	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryBaseFeeRequest, ...grpc.CallOption) *types.QueryBaseFeeResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
@@ -37,7 +45,6 @@ func (_m *FeeMarketQueryClient) BaseFee(ctx context.Context, in *types.QueryBase
 		}
 	}
 
-	var r1 error
 	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryBaseFeeRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
@@ -47,6 +54,80 @@ func (_m *FeeMarketQueryClient) BaseFee(ctx context.Context, in *types.QueryBase
 	return r0, r1
 }
 
+// BlobBaseFee provides a mock function with given fields: ctx, in, opts
+func (_m *FeeMarketQueryClient) BlobBaseFee(ctx context.Context, in *types.QueryBlobBaseFeeRequest, opts ...grpc.CallOption) (*types.QueryBlobBaseFeeResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BlobBaseFee")
+	}
+
+	var r0 *types.QueryBlobBaseFeeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryBlobBaseFeeRequest, ...grpc.CallOption) (*types.QueryBlobBaseFeeResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryBlobBaseFeeRequest, ...grpc.CallOption) *types.QueryBlobBaseFeeResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryBlobBaseFeeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryBlobBaseFeeRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BlockFees provides a mock function with given fields: ctx, in, opts
+func (_m *FeeMarketQueryClient) BlockFees(ctx context.Context, in *types.QueryBlockFeesRequest, opts ...grpc.CallOption) (*types.QueryBlockFeesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BlockFees")
+	}
+
+	var r0 *types.QueryBlockFeesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryBlockFeesRequest, ...grpc.CallOption) (*types.QueryBlockFeesResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryBlockFeesRequest, ...grpc.CallOption) *types.QueryBlockFeesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryBlockFeesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryBlockFeesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // BlockGas provides a mock function with given fields: ctx, in, opts
 func (_m *FeeMarketQueryClient) BlockGas(ctx context.Context, in *types.QueryBlockGasRequest, opts ...grpc.CallOption) (*types.QueryBlockGasResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -58,7 +139,15 @@ func (_m *FeeMarketQueryClient) BlockGas(ctx context.Context, in *types.QueryBlo
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
+	if len(ret) == 0 {
+		panic("no return value specified for BlockGas")
+	}
+
 	var r0 *types.QueryBlockGasResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryBlockGasRequest, ...grpc.CallOption) (*types.QueryBlockGasResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
 	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryBlockGasRequest, ...grpc.CallOption) *types.QueryBlockGasResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
@@ -67,7 +156,6 @@ func (_m *FeeMarketQueryClient) BlockGas(ctx context.Context, in *types.QueryBlo
 		}
 	}
 
-	var r1 error
 	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryBlockGasRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
@@ -77,6 +165,117 @@ func (_m *FeeMarketQueryClient) BlockGas(ctx context.Context, in *types.QueryBlo
 	return r0, r1
 }
 
+// FeeHistory provides a mock function with given fields: ctx, in, opts
+func (_m *FeeMarketQueryClient) FeeHistory(ctx context.Context, in *types.QueryFeeHistoryRequest, opts ...grpc.CallOption) (*types.QueryFeeHistoryResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FeeHistory")
+	}
+
+	var r0 *types.QueryFeeHistoryResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryFeeHistoryRequest, ...grpc.CallOption) (*types.QueryFeeHistoryResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryFeeHistoryRequest, ...grpc.CallOption) *types.QueryFeeHistoryResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryFeeHistoryResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryFeeHistoryRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FeeTokenPrices provides a mock function with given fields: ctx, in, opts
+func (_m *FeeMarketQueryClient) FeeTokenPrices(ctx context.Context, in *types.QueryFeeTokenPricesRequest, opts ...grpc.CallOption) (*types.QueryFeeTokenPricesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FeeTokenPrices")
+	}
+
+	var r0 *types.QueryFeeTokenPricesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryFeeTokenPricesRequest, ...grpc.CallOption) (*types.QueryFeeTokenPricesResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryFeeTokenPricesRequest, ...grpc.CallOption) *types.QueryFeeTokenPricesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryFeeTokenPricesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryFeeTokenPricesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NextBaseFee provides a mock function with given fields: ctx, in, opts
+func (_m *FeeMarketQueryClient) NextBaseFee(ctx context.Context, in *types.QueryNextBaseFeeRequest, opts ...grpc.CallOption) (*types.QueryNextBaseFeeResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NextBaseFee")
+	}
+
+	var r0 *types.QueryNextBaseFeeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryNextBaseFeeRequest, ...grpc.CallOption) (*types.QueryNextBaseFeeResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryNextBaseFeeRequest, ...grpc.CallOption) *types.QueryNextBaseFeeResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryNextBaseFeeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryNextBaseFeeRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Params provides a mock function with given fields: ctx, in, opts
 func (_m *FeeMarketQueryClient) Params(ctx context.Context, in *types.QueryParamsRequest, opts ...grpc.CallOption) (*types.QueryParamsResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -88,7 +287,15 @@ func (_m *FeeMarketQueryClient) Params(ctx context.Context, in *types.QueryParam
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
+	if len(ret) == 0 {
+		panic("no return value specified for Params")
+	}
+
 	var r0 *types.QueryParamsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryParamsRequest, ...grpc.CallOption) (*types.QueryParamsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
 	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryParamsRequest, ...grpc.CallOption) *types.QueryParamsResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
@@ -97,7 +304,6 @@ func (_m *FeeMarketQueryClient) Params(ctx context.Context, in *types.QueryParam
 		}
 	}
 
-	var r1 error
 	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryParamsRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
@@ -107,13 +313,12 @@ func (_m *FeeMarketQueryClient) Params(ctx context.Context, in *types.QueryParam
 	return r0, r1
 }
 
-type mockConstructorTestingTNewQueryClient interface {
+// NewFeeMarketQueryClient creates a new instance of FeeMarketQueryClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFeeMarketQueryClient(t interface {
 	mock.TestingT
 	Cleanup(func())
-}
-
-// NewQueryClient creates a new instance of QueryClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-func NewFeeMarketQueryClient(t mockConstructorTestingTNewQueryClient) *FeeMarketQueryClient {
+}) *FeeMarketQueryClient {
 	mock := &FeeMarketQueryClient{}
 	mock.Mock.Test(t)
 
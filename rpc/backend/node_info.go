@@ -16,7 +16,7 @@
 package backend
 
 import (
-	"fmt"
+	"errors"
 	"math/big"
 	"time"
 
@@ -25,23 +25,22 @@ import (
 	cmttypes "github.com/cometbft/cometbft/types"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
-	sdkcrypto "github.com/cosmos/cosmos-sdk/crypto"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdkconfig "github.com/cosmos/cosmos-sdk/server/config"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto"
-	"github.com/evmos/ethermint/crypto/ethsecp256k1"
 	rpctypes "github.com/evmos/ethermint/rpc/types"
 	"github.com/evmos/ethermint/server/config"
 	ethermint "github.com/evmos/ethermint/types"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 )
 
-// Accounts returns the list of accounts available to this node.
+// Accounts returns the list of accounts available to this node, in the keyring and the keystore.
 func (b *Backend) Accounts() ([]common.Address, error) {
 	addresses := make([]common.Address, 0) // return [] instead of nil if empty
 
@@ -59,7 +58,7 @@ func (b *Backend) Accounts() ([]common.Address, error) {
 		addresses = append(addresses, common.BytesToAddress(addressBytes))
 	}
 
-	return addresses, nil
+	return b.externalSignerAddresses(b.keystoreAddresses(addresses)), nil
 }
 
 // Syncing returns false in case the node is currently not syncing with the network. It can be up to date or has not
@@ -186,43 +185,36 @@ func (b *Backend) SetEtherbase(etherbase common.Address) bool {
 	return true
 }
 
-// ImportRawKey armors and encrypts a given raw hex encoded ECDSA key and stores it into the key directory.
-// The name of the key will have the format "personal_<length-keys>", where <length-keys> is the total number of
-// keys stored on the keyring.
-//
-// NOTE: The key will be both armored and encrypted using the same passphrase.
+// ImportRawKey stores a given raw hex encoded ECDSA key into the keystore, encrypted with the
+// password, as a Web3 Secret Storage file.
 func (b *Backend) ImportRawKey(privkey, password string) (common.Address, error) {
 	priv, err := crypto.HexToECDSA(privkey)
 	if err != nil {
 		return common.Address{}, err
 	}
 
-	privKey := &ethsecp256k1.PrivKey{Key: crypto.FromECDSA(priv)}
-
-	addr := sdk.AccAddress(privKey.PubKey().Address().Bytes())
-	ethereumAddr := common.BytesToAddress(addr)
+	ethereumAddr := crypto.PubkeyToAddress(priv.PublicKey)
 
 	// return if the key has already been imported
-	if _, err := b.clientCtx.Keyring.KeyByAddress(addr); err == nil {
+	if b.inKeyring(ethereumAddr) {
 		return ethereumAddr, nil
 	}
 
-	// ignore error as we only care about the length of the list
-	list, _ := b.clientCtx.Keyring.List()
-	privKeyName := fmt.Sprintf("personal_%d", len(list))
-
-	armor := sdkcrypto.EncryptArmorPrivKey(privKey, password, ethsecp256k1.KeyType)
-
-	if err := b.clientCtx.Keyring.ImportPrivKey(privKeyName, armor, password); err != nil {
+	account, err := b.keystore().ImportECDSA(priv, password)
+	if errors.Is(err, keystore.ErrAccountAlreadyExists) {
+		return ethereumAddr, nil
+	}
+	if err != nil {
 		return common.Address{}, err
 	}
 
-	b.logger.Info("key successfully imported", "name", privKeyName, "address", ethereumAddr.String())
+	b.logger.Info("key successfully imported", "address", ethereumAddr.String(), "path", account.URL.Path)
 
 	return ethereumAddr, nil
 }
 
-// ListAccounts will return a list of addresses for accounts this node manages.
+// ListAccounts will return a list of addresses for accounts this node manages, in the keyring
+// and the keystore.
 func (b *Backend) ListAccounts() ([]common.Address, error) {
 	addrs := []common.Address{}
 
@@ -239,7 +231,7 @@ func (b *Backend) ListAccounts() ([]common.Address, error) {
 		addrs = append(addrs, common.BytesToAddress(pubKey.Address()))
 	}
 
-	return addrs, nil
+	return b.keystoreAddresses(addrs), nil
 }
 
 // NewAccount will create a new account and returns the address for the new account.
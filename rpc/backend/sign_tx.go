@@ -22,25 +22,45 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
-	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 
+	"github.com/evmos/ethermint/rpc/signer"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 )
 
-// SendTransaction sends transaction based on received args using Node's key to sign it
+// SendTransaction sends transaction based on received args using Node's key to sign it, or the
+// external signer if it holds the key.
 func (b *Backend) SendTransaction(args evmtypes.TransactionArgs) (common.Hash, error) {
-	// Look up the wallet containing the requested signer
-	_, err := b.clientCtx.Keyring.KeyByAddress(sdk.AccAddress(args.GetFrom().Bytes()))
-	if err != nil {
-		b.logger.Error("failed to find key in keyring", "address", args.GetFrom(), "error", err.Error())
-		return common.Hash{}, fmt.Errorf("failed to find key in the node's keyring; %s; %s", keystore.ErrNoMatch, err.Error())
+	return b.sendTransaction(args, nil)
+}
+
+// SendTransactionWithPassphrase sends transaction based on received args using Node's key to
+// sign it, the key of a keystore account is decrypted with the passphrase.
+func (b *Backend) SendTransactionWithPassphrase(args evmtypes.TransactionArgs, passphrase string) (common.Hash, error) {
+	return b.sendTransaction(args, &passphrase)
+}
+
+func (b *Backend) sendTransaction(args evmtypes.TransactionArgs, passphrase *string) (common.Hash, error) {
+	// Look up the wallet containing the requested signer, the external signer has no passphrase
+	var (
+		ext           *signer.ExternalSigner
+		keyringSigner keyring.Signer
+		err           error
+	)
+	if passphrase == nil {
+		ext = b.externalSigner(args.GetFrom())
+	}
+	if ext == nil {
+		if keyringSigner, err = b.signer(args.GetFrom(), passphrase); err != nil {
+			return common.Hash{}, err
+		}
 	}
 
 	if args.ChainID != nil && (b.chainID).Cmp((*big.Int)(args.ChainID)) != 0 {
@@ -70,10 +90,15 @@ func (b *Backend) SendTransaction(args evmtypes.TransactionArgs) (common.Hash, e
 		return common.Hash{}, err
 	}
 
-	signer := ethtypes.MakeSigner(b.ChainConfig(), new(big.Int).SetUint64(uint64(bn)), h.Time)
+	ethSigner := ethtypes.MakeSigner(b.ChainConfig(), new(big.Int).SetUint64(uint64(bn)), h.Time)
 
 	// Sign transaction
-	if err := msg.Sign(signer, b.clientCtx.Keyring); err != nil {
+	if ext != nil {
+		err = b.signExternally(ext, msg, ethSigner)
+	} else {
+		err = msg.Sign(ethSigner, keyringSigner)
+	}
+	if err != nil {
 		b.logger.Debug("failed to sign tx", "error", err.Error())
 		return common.Hash{}, err
 	}
@@ -108,7 +133,7 @@ func (b *Backend) SendTransaction(args evmtypes.TransactionArgs) (common.Hash, e
 		return common.Hash{}, errors.New("only replay-protected (EIP-155) transactions allowed over RPC")
 	}
 
-	txHash := ethTx.Hash()
+	txHash := msg.ComputeHash()
 
 	// Broadcast transaction in sync mode (default)
 	// NOTE: If error is encountered on the node, the broadcast will not return an error
@@ -126,18 +151,36 @@ func (b *Backend) SendTransaction(args evmtypes.TransactionArgs) (common.Hash, e
 	return txHash, nil
 }
 
-// Sign signs the provided data using the private key of address via Geth's signature standard.
+// Sign signs the provided data using the private key of address via Geth's signature standard, or
+// has the external signer sign it as text if it holds the key.
 func (b *Backend) Sign(address common.Address, data hexutil.Bytes) (hexutil.Bytes, error) {
+	return b.sign(address, data, nil)
+}
+
+// SignWithPassphrase signs the provided data like Sign, the key of a keystore account is
+// decrypted with the passphrase.
+func (b *Backend) SignWithPassphrase(address common.Address, data hexutil.Bytes, passphrase string) (hexutil.Bytes, error) {
+	return b.sign(address, data, &passphrase)
+}
+
+func (b *Backend) sign(address common.Address, data hexutil.Bytes, passphrase *string) (hexutil.Bytes, error) {
 	from := sdk.AccAddress(address.Bytes())
 
-	_, err := b.clientCtx.Keyring.KeyByAddress(from)
+	if passphrase == nil {
+		if ext := b.externalSigner(address); ext != nil {
+			ctx, cancel := b.externalSigningContext()
+			defer cancel()
+			return ext.SignText(ctx, address, data)
+		}
+	}
+
+	keyringSigner, err := b.signer(address, passphrase)
 	if err != nil {
-		b.logger.Error("failed to find key in keyring", "address", address.String())
-		return nil, fmt.Errorf("%s; %s", keystore.ErrNoMatch, err.Error())
+		return nil, err
 	}
 
 	// Sign the requested hash with the wallet
-	signature, _, err := b.clientCtx.Keyring.SignByAddress(from, data, signingtypes.SignMode_SIGN_MODE_TEXTUAL)
+	signature, _, err := keyringSigner.SignByAddress(from, data, signingtypes.SignMode_SIGN_MODE_TEXTUAL)
 	if err != nil {
 		b.logger.Error("keyring.SignByAddress failed", "address", address.Hex())
 		return nil, err
@@ -151,10 +194,15 @@ func (b *Backend) Sign(address common.Address, data hexutil.Bytes) (hexutil.Byte
 func (b *Backend) SignTypedData(address common.Address, typedData apitypes.TypedData) (hexutil.Bytes, error) {
 	from := sdk.AccAddress(address.Bytes())
 
-	_, err := b.clientCtx.Keyring.KeyByAddress(from)
+	if ext := b.externalSigner(address); ext != nil {
+		ctx, cancel := b.externalSigningContext()
+		defer cancel()
+		return ext.SignTypedData(ctx, address, typedData)
+	}
+
+	keyringSigner, err := b.signer(address, nil)
 	if err != nil {
-		b.logger.Error("failed to find key in keyring", "address", address.String())
-		return nil, fmt.Errorf("%s; %s", keystore.ErrNoMatch, err.Error())
+		return nil, err
 	}
 
 	sigHash, _, err := apitypes.TypedDataAndHash(typedData)
@@ -163,7 +211,7 @@ func (b *Backend) SignTypedData(address common.Address, typedData apitypes.Typed
 	}
 
 	// Sign the requested hash with the wallet
-	signature, _, err := b.clientCtx.Keyring.SignByAddress(from, sigHash, signingtypes.SignMode_SIGN_MODE_TEXTUAL)
+	signature, _, err := keyringSigner.SignByAddress(from, sigHash, signingtypes.SignMode_SIGN_MODE_TEXTUAL)
 	if err != nil {
 		b.logger.Error("keyring.SignByAddress failed", "address", address.Hex())
 		return nil, err
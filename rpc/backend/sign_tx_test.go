@@ -1,7 +1,12 @@
 package backend
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net/http/httptest"
+	"sync"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/crypto"
@@ -11,9 +16,11 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	goethcrypto "github.com/ethereum/go-ethereum/crypto"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
 	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/evmos/ethermint/crypto/ethsecp256k1"
 	"github.com/evmos/ethermint/rpc/backend/mocks"
+	"github.com/evmos/ethermint/rpc/signer"
 	"github.com/evmos/ethermint/tests"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 	"google.golang.org/grpc/metadata"
@@ -213,6 +220,99 @@ func (suite *BackendTestSuite) TestSign() {
 	}
 }
 
+func (suite *BackendTestSuite) TestSignWithPassphrase() {
+	from, priv := tests.NewAddrKey()
+	testCases := []struct {
+		name       string
+		passphrase string
+		expPass    bool
+	}{
+		{"pass - keyring key with empty passphrase", "", true},
+		{"fail - keyring key with passphrase", "password", false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			armor := crypto.EncryptArmorPrivKey(priv, "", "eth_secp256k1")
+			suite.Require().NoError(suite.backend.clientCtx.Keyring.ImportPrivKey("test_key", armor, ""))
+
+			_, err := suite.backend.SignWithPassphrase(from, hexutil.Bytes{0x88}, tc.passphrase)
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, errKeyringPassword)
+			}
+		})
+	}
+}
+
+// slowExternalSigner is an external signer whose signing requests wait for an approval that
+// never comes.
+type slowExternalSigner struct {
+	addr common.Address
+}
+
+func (s *slowExternalSigner) List() []common.Address {
+	return []common.Address{s.addr}
+}
+
+func (s *slowExternalSigner) SignData(ctx context.Context, _ string, _ common.MixedcaseAddress, _ hexutil.Bytes) (hexutil.Bytes, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-time.After(time.Minute):
+		return nil, errors.New("request not canceled")
+	}
+}
+
+func (suite *BackendTestSuite) TestSignExternalSignerTimeout() {
+	from := tests.GenerateAddress()
+	srv := ethrpc.NewServer()
+	suite.Require().NoError(srv.RegisterName("account", &slowExternalSigner{addr: from}))
+	httpSrv := httptest.NewServer(srv)
+	defer httpSrv.Close()
+	defer srv.Stop()
+
+	suite.backend.cfg.JSONRPC.ExternalSigner = httpSrv.URL
+	suite.backend.cfg.JSONRPC.ExternalSignerTimeout = 100 * time.Millisecond
+
+	start := time.Now()
+	_, err := suite.backend.Sign(from, hexutil.Bytes{0x88})
+	suite.Require().ErrorIs(err, context.DeadlineExceeded)
+	suite.Require().Less(time.Since(start), 10*time.Second)
+}
+
+func (suite *BackendTestSuite) TestDialExternalSignerConcurrently() {
+	srv := ethrpc.NewServer()
+	suite.Require().NoError(srv.RegisterName("account", &slowExternalSigner{addr: tests.GenerateAddress()}))
+	httpSrv := httptest.NewServer(srv)
+	defer httpSrv.Close()
+	defer srv.Stop()
+	suite.backend.cfg.JSONRPC.ExternalSigner = httpSrv.URL
+
+	// the callers dialing the signer at the same time share the connection kept first
+	signers := make([]*signer.ExternalSigner, 8)
+	var wg sync.WaitGroup
+	for i := range signers {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			signers[i] = suite.backend.dialExternalSigner()
+		}(i)
+	}
+	wg.Wait()
+
+	externalSignersMtx.Lock()
+	kept := externalSigners[httpSrv.URL]
+	delete(externalSigners, httpSrv.URL)
+	externalSignersMtx.Unlock()
+	suite.Require().NotNil(kept)
+	for _, ext := range signers {
+		suite.Require().Same(kept, ext)
+	}
+}
+
 func (suite *BackendTestSuite) TestSignTypedData() {
 	from, priv := tests.NewAddrKey()
 	testCases := []struct {
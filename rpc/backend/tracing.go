@@ -24,6 +24,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	rpctypes "github.com/evmos/ethermint/rpc/types"
+	ethermint "github.com/evmos/ethermint/types"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 	"github.com/pkg/errors"
 )
@@ -31,6 +32,10 @@ import (
 // TraceTransaction returns the structured logs created during the execution of EVM
 // and returns them as a JSON object.
 func (b *Backend) TraceTransaction(hash common.Hash, config *rpctypes.TraceConfig) (interface{}, error) {
+	if res, ok := b.cache.Get("debug_traceTransaction", hash, config); ok {
+		return res, nil
+	}
+
 	// Get transaction by hash
 	transaction, err := b.GetTxByEthHash(hash)
 	if err != nil {
@@ -126,6 +131,7 @@ func (b *Backend) TraceTransaction(hash common.Hash, config *rpctypes.TraceConfi
 		return nil, err
 	}
 
+	b.cacheResponse(transaction.Height, decodedResult, "debug_traceTransaction", hash, config)
 	return decodedResult, nil
 }
 
@@ -268,3 +274,51 @@ func (b *Backend) TraceCall(
 
 	return decodedResult, nil
 }
+
+// GetInternalTransactions returns the internal transactions of the block: the value transfers,
+// contract creations and self-destructs of the nested call frames of its transactions. They are
+// read from the custom indexer, or from the block results if it's disabled, and are only
+// available when the node traces them.
+func (b *Backend) GetInternalTransactions(blockNrOrHash rpctypes.BlockNumberOrHash) ([]*rpctypes.RPCInternalTransaction, error) {
+	blockNum, err := b.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	resBlock, err := b.TendermintBlockByNumber(blockNum)
+	if err != nil {
+		return nil, err
+	}
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, nil
+	}
+	height := resBlock.Block.Height
+
+	var internalTxs []*ethermint.InternalTxResult
+	if b.indexer != nil {
+		internalTxs, err = b.indexer.GetInternalTxsByBlock(height)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		blockRes, err := b.TendermintBlockResultByNumber(&height)
+		if err != nil {
+			return nil, err
+		}
+		for _, txResult := range blockRes.TxsResults {
+			txInternalTxs, err := evmtypes.DecodeInternalTxsFromEvents(txResult.Events, height)
+			if err != nil {
+				b.logger.Debug("failed to decode internal txs", "height", height, "error", err.Error())
+				continue
+			}
+			internalTxs = append(internalTxs, txInternalTxs...)
+		}
+	}
+
+	blockHash := common.BytesToHash(resBlock.Block.Hash())
+	result := make([]*rpctypes.RPCInternalTransaction, len(internalTxs))
+	for i, internalTx := range internalTxs {
+		result[i] = rpctypes.NewRPCInternalTransaction(internalTx, &blockHash)
+	}
+	return result, nil
+}
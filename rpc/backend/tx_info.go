@@ -148,6 +148,10 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 	hexTx := hash.Hex()
 	b.logger.Debug("eth_getTransactionReceipt", "hash", hexTx)
 
+	if receipt, ok := b.cache.Get("eth_getTransactionReceipt", hash); ok {
+		return receipt.(map[string]interface{}), nil
+	}
+
 	res, err := b.GetTxByEthHash(hash)
 	if err != nil {
 		b.logger.Debug("tx not found", "hash", hexTx, "error", err.Error())
@@ -241,7 +245,7 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 		// sender and receiver (contract or EOA) addreses
 		"from": from,
 		"to":   txData.GetTo(),
-		"type": hexutil.Uint(ethMsg.AsTransaction().Type()),
+		"type": hexutil.Uint(txData.TxType()),
 	}
 
 	if logs == nil {
@@ -253,16 +257,30 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 		receipt["contractAddress"] = crypto.CreateAddress(from, txData.GetNonce())
 	}
 
-	if dynamicTx, ok := txData.(*evmtypes.DynamicFeeTx); ok {
+	switch blobTx := txData.(type) {
+	case *evmtypes.DynamicFeeTx, *evmtypes.SetCodeTx:
 		baseFee, err := b.BaseFee(blockRes)
 		if err != nil {
 			// tolerate the error for pruned node.
 			b.logger.Error("fetch basefee failed, node is pruned?", "height", res.Height, "error", err)
 		} else {
-			receipt["effectiveGasPrice"] = hexutil.Big(*dynamicTx.EffectiveGasPrice(baseFee))
+			receipt["effectiveGasPrice"] = hexutil.Big(*txData.EffectiveGasPrice(baseFee))
+		}
+	case *evmtypes.BlobTx:
+		baseFee, err := b.BaseFee(blockRes)
+		if err != nil {
+			// tolerate the error for pruned node.
+			b.logger.Error("fetch basefee failed, node is pruned?", "height", res.Height, "error", err)
+		} else {
+			receipt["effectiveGasPrice"] = hexutil.Big(*txData.EffectiveGasPrice(baseFee))
+		}
+		receipt["blobGasUsed"] = hexutil.Uint64(blobTx.BlobGas())
+		if blobBaseFee := rpctypes.BlobBaseFeeFromEvents(blockRes.FinalizeBlockEvents); blobBaseFee != nil {
+			receipt["blobGasPrice"] = (*hexutil.Big)(blobBaseFee)
 		}
 	}
 
+	b.cacheResponse(res.Height, receipt, "eth_getTransactionReceipt", hash)
 	return receipt, nil
 }
 
@@ -29,9 +29,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
-	"github.com/ethereum/go-ethereum/common/math"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/params"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
@@ -94,8 +92,10 @@ func (b *Backend) getAccountNonce(accAddr common.Address, pending bool, height i
 		return nonce, nil
 	}
 
-	// add the uncommitted txs to the nonce counter
+	// add the uncommitted txs to the nonce counter, only the ones executable in sequence as the
+	// mempool may hold replacements and queued txs
 	// only supports `MsgEthereumTx` style tx
+	pendingNonces := make(map[uint64]bool)
 	for _, tx := range pendingTxs {
 		for _, msg := range (*tx).GetMsgs() {
 			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
@@ -109,53 +109,36 @@ func (b *Backend) getAccountNonce(accAddr common.Address, pending bool, height i
 				continue
 			}
 			if sender == accAddr {
-				nonce++
+				txData, err := evmtypes.UnpackTxData(ethMsg.Data)
+				if err != nil {
+					continue
+				}
+				pendingNonces[txData.GetNonce()] = true
 			}
 		}
 	}
 
+	for pendingNonces[nonce] {
+		nonce++
+	}
+
 	return nonce, nil
 }
 
-// CalcBaseFee calculates the basefee of the header.
-func CalcBaseFee(config *params.ChainConfig, parent *ethtypes.Header, p feemarkettypes.Params) *big.Int {
-	// If the current block is the first EIP-1559 block, return the InitialBaseFee.
-	if !config.IsLondon(parent.Number) {
-		return new(big.Int).SetUint64(params.InitialBaseFee)
-	}
-
-	parentGasTarget := parent.GasLimit / uint64(p.ElasticityMultiplier)
-	// If the parent gasUsed is the same as the target, the baseFee remains unchanged.
-	if parent.GasUsed == parentGasTarget {
-		return new(big.Int).Set(parent.BaseFee)
+// nextBaseFee returns the base fee of the block following the given height, as computed
+// by the base fee algorithm of the fee market at the end of the block. It falls back to
+// the base fee of the following block for the blocks committed before the fee market
+// recorded it, zero if that block doesn't exist yet.
+func (b *Backend) nextBaseFee(height int64) *big.Int {
+	res, err := b.queryClient.FeeMarket.NextBaseFee(types.ContextWithHeight(height), &feemarkettypes.QueryNextBaseFeeRequest{})
+	if err == nil && res.BaseFee != nil {
+		return res.BaseFee.BigInt()
 	}
-
-	var (
-		num   = new(big.Int)
-		denom = new(big.Int)
-	)
-
-	if parent.GasUsed > parentGasTarget {
-		// If the parent block used more gas than its target, the baseFee should increase.
-		// max(1, parentBaseFee * gasUsedDelta / parentGasTarget / baseFeeChangeDenominator)
-		num.SetUint64(parent.GasUsed - parentGasTarget)
-		num.Mul(num, parent.BaseFee)
-		num.Div(num, denom.SetUint64(parentGasTarget))
-		num.Div(num, denom.SetUint64(uint64(p.BaseFeeChangeDenominator)))
-		baseFeeDelta := math.BigMax(num, common.Big1)
-
-		return num.Add(parent.BaseFee, baseFeeDelta)
+	next, err := b.queryClient.FeeMarket.BaseFee(types.ContextWithHeight(height+1), &feemarkettypes.QueryBaseFeeRequest{})
+	if err == nil && next.BaseFee != nil {
+		return next.BaseFee.BigInt()
 	}
-
-	// Otherwise if the parent block used less gas than its target, the baseFee should decrease.
-	// max(0, parentBaseFee * gasUsedDelta / parentGasTarget / baseFeeChangeDenominator)
-	num.SetUint64(parentGasTarget - parent.GasUsed)
-	num.Mul(num, parent.BaseFee)
-	num.Div(num, denom.SetUint64(parentGasTarget))
-	num.Div(num, denom.SetUint64(uint64(p.BaseFeeChangeDenominator)))
-	baseFee := num.Sub(parent.BaseFee, num)
-	minGasPrice := p.MinGasPrice.TruncateInt().BigInt()
-	return math.BigMax(baseFee, minGasPrice)
+	return new(big.Int)
 }
 
 // output: targetOneFeeHistory
@@ -186,25 +169,11 @@ func (b *Backend) processBlock(
 	}
 
 	if cfg.IsLondon(big.NewInt(blockHeight + 1)) {
-		var header ethtypes.Header
-		header.Number = new(big.Int).SetInt64(blockHeight)
-		baseFee, ok := (*ethBlock)["baseFeePerGas"].(*hexutil.Big)
-		if !ok || baseFee == nil {
-			header.BaseFee = big.NewInt(0)
-		} else {
-			header.BaseFee = baseFee.ToInt()
-		}
-		header.GasLimit = uint64(gasLimitUint64)
-		header.GasUsed = gasUsedBig.ToInt().Uint64()
-		ctx := types.ContextWithHeight(blockHeight)
-		params, err := b.queryClient.FeeMarket.Params(ctx, &feemarkettypes.QueryParamsRequest{})
-		if err != nil {
-			return err
-		}
-		targetOneFeeHistory.NextBaseFee = CalcBaseFee(cfg, &header, params.Params)
+		targetOneFeeHistory.NextBaseFee = b.nextBaseFee(blockHeight)
 	} else {
 		targetOneFeeHistory.NextBaseFee = new(big.Int)
 	}
+	targetOneFeeHistory.BurntFees, targetOneFeeHistory.PriorityFees = b.blockFees(blockHeight)
 	gasusedfloat, _ := new(big.Float).SetInt(gasUsedBig.ToInt()).Float64()
 
 	if gasLimitUint64 <= 0 {
@@ -243,10 +212,14 @@ func (b *Backend) processBlock(
 			if !ok {
 				continue
 			}
-			tx := ethMsg.AsTransaction()
-			reward := tx.EffectiveGasTipValue(blockBaseFee)
-			if reward == nil {
-				reward = big.NewInt(0)
+			txData, err := evmtypes.UnpackTxData(ethMsg.Data)
+			if err != nil {
+				continue
+			}
+			// the tip is computed by the tx data, go-ethereum can't represent the set-code transactions
+			reward := new(big.Int).Set(txData.EffectiveGasPrice(blockBaseFee))
+			if blockBaseFee != nil {
+				reward.Sub(reward, blockBaseFee)
 			}
 			sorter = append(sorter, txGasAndReward{gasUsed: txGasUsed, reward: reward})
 		}
@@ -275,6 +248,23 @@ func (b *Backend) processBlock(
 	return nil
 }
 
+// blockFees returns the part of the fees burned and the tips paid to the proposer in the
+// evm denom at the given height, zero if the fee market didn't record them.
+func (b *Backend) blockFees(height int64) (burnt, priority *big.Int) {
+	burnt, priority = new(big.Int), new(big.Int)
+	// the fees of the recent blocks are kept by the latest state, which pruned nodes have
+	res, err := b.queryClient.FeeMarket.BlockFees(b.ctx, &feemarkettypes.QueryBlockFeesRequest{Height: height})
+	if err != nil {
+		return burnt, priority
+	}
+	params, err := b.queryClient.Params(b.ctx, &evmtypes.QueryParamsRequest{})
+	if err != nil {
+		return burnt, priority
+	}
+	denom := params.Params.EvmDenom
+	return res.BlockFees.Burned.AmountOf(denom).BigInt(), res.BlockFees.PriorityFees.AmountOf(denom).BigInt()
+}
+
 // ShouldIgnoreGasUsed returns true if the gasUsed in result should be ignored
 // workaround for issue: https://github.com/cosmos/cosmos-sdk/issues/10832
 func ShouldIgnoreGasUsed(res *abci.ExecTxResult) bool {
@@ -310,3 +300,17 @@ func GetHexProofs(proof *crypto.ProofOps) []string {
 	}
 	return proofs
 }
+
+// cacheResponse caches the response of the method called with the params, if it is about a
+// committed block.
+func (b *Backend) cacheResponse(height int64, res interface{}, method string, params ...interface{}) {
+	if b.cache == nil {
+		return
+	}
+
+	latest, err := b.BlockNumber()
+	if err != nil || height > int64(latest) {
+		return
+	}
+	b.cache.Add(res, method, params...)
+}
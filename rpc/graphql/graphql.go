@@ -0,0 +1,1348 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+// Package graphql serves the EIP-1767 GraphQL schema of go-ethereum, resolved through the backend
+// of the eth namespace, so that the nested blocks, transactions, receipts and logs are fetched in
+// a single round trip.
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"sync"
+
+	"cosmossdk.io/log"
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethfilters "github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/rlp"
+
+	"github.com/evmos/ethermint/rpc/backend"
+	"github.com/evmos/ethermint/rpc/namespaces/ethereum/eth/filters"
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+var errInvalidBlockRange = errors.New("invalid from and to block combination: from > to")
+
+// Backend is the backend of the resolvers, the one of the eth namespace with the caps of its log
+// filters.
+type Backend interface {
+	backend.EVMBackend
+	filters.Backend
+}
+
+// Long is the GraphQL scalar of the 64 bits integers.
+type Long int64
+
+// ImplementsGraphQLType returns true if Long implements the provided GraphQL type.
+func (b Long) ImplementsGraphQLType(name string) bool { return name == "Long" }
+
+// UnmarshalGraphQL unmarshals the provided GraphQL query data, decimal or 0x prefixed hex.
+func (b *Long) UnmarshalGraphQL(input interface{}) error {
+	switch input := input.(type) {
+	case string:
+		if strings.HasPrefix(input, "0x") {
+			value, err := hexutil.DecodeUint64(input)
+			*b = Long(value)
+			return err
+		}
+		value, err := strconv.ParseInt(input, 10, 64)
+		*b = Long(value)
+		return err
+	case int32:
+		*b = Long(input)
+	case int64:
+		*b = Long(input)
+	case float64:
+		*b = Long(input)
+	default:
+		return fmt.Errorf("unexpected type %T for Long", input)
+	}
+	return nil
+}
+
+// numberOrHash returns the block number parameter of the backend queries.
+func numberOrHash(number rpctypes.BlockNumber) rpctypes.BlockNumberOrHash {
+	return rpctypes.BlockNumberOrHash{BlockNumber: &number}
+}
+
+// BlockNumberArgs are the arguments of the accessors taking an optional block number.
+type BlockNumberArgs struct {
+	Block *Long
+}
+
+// NumberOrLatest returns the block number argument, or the latest block if none or negative.
+func (a BlockNumberArgs) NumberOrLatest() rpctypes.BlockNumberOrHash {
+	if a.Block != nil && *a.Block >= 0 {
+		return numberOrHash(rpctypes.BlockNumber(*a.Block))
+	}
+	return numberOrHash(rpctypes.EthLatestBlockNumber)
+}
+
+// Account is an account at a block.
+type Account struct {
+	r             *Resolver
+	address       common.Address
+	blockNrOrHash rpctypes.BlockNumberOrHash
+}
+
+func (a *Account) Address(_ context.Context) common.Address {
+	return a.address
+}
+
+func (a *Account) Balance(_ context.Context) (hexutil.Big, error) {
+	balance, err := a.r.backend.GetBalance(a.address, a.blockNrOrHash)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return *balance, nil
+}
+
+func (a *Account) TransactionCount(_ context.Context) (hexutil.Uint64, error) {
+	blockNum, err := a.r.backend.BlockNumberFromTendermint(a.blockNrOrHash)
+	if err != nil {
+		return 0, err
+	}
+	nonce, err := a.r.backend.GetTransactionCount(a.address, blockNum)
+	if err != nil {
+		return 0, err
+	}
+	return *nonce, nil
+}
+
+func (a *Account) Code(_ context.Context) (hexutil.Bytes, error) {
+	return a.r.backend.GetCode(a.address, a.blockNrOrHash)
+}
+
+func (a *Account) Storage(_ context.Context, args struct{ Slot common.Hash }) (common.Hash, error) {
+	value, err := a.r.backend.GetStorageAt(a.address, args.Slot.Hex(), a.blockNrOrHash)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.BytesToHash(value), nil
+}
+
+// Log is a log emitted by a transaction.
+type Log struct {
+	r           *Resolver
+	transaction *Transaction
+	log         *ethtypes.Log
+}
+
+func (l *Log) Transaction(_ context.Context) *Transaction {
+	return l.transaction
+}
+
+func (l *Log) Account(_ context.Context, args BlockNumberArgs) *Account {
+	return &Account{r: l.r, address: l.log.Address, blockNrOrHash: args.NumberOrLatest()}
+}
+
+func (l *Log) Index(_ context.Context) hexutil.Uint64 {
+	return hexutil.Uint64(l.log.Index)
+}
+
+func (l *Log) Topics(_ context.Context) []common.Hash {
+	return l.log.Topics
+}
+
+func (l *Log) Data(_ context.Context) hexutil.Bytes {
+	return l.log.Data
+}
+
+// AccessTuple is an entry of an EIP-2930 access list.
+type AccessTuple struct {
+	address     common.Address
+	storageKeys []common.Hash
+}
+
+func (at *AccessTuple) Address(_ context.Context) common.Address {
+	return at.address
+}
+
+func (at *AccessTuple) StorageKeys(_ context.Context) []common.Hash {
+	return at.storageKeys
+}
+
+// Withdrawal is an EIP-4895 withdrawal, which the chain doesn't have.
+type Withdrawal struct{}
+
+func (w *Withdrawal) Index(_ context.Context) hexutil.Uint64     { return 0 }
+func (w *Withdrawal) Validator(_ context.Context) hexutil.Uint64 { return 0 }
+func (w *Withdrawal) Address(_ context.Context) common.Address   { return common.Address{} }
+func (w *Withdrawal) Amount(_ context.Context) hexutil.Uint64    { return 0 }
+
+// Transaction is a transaction of a block or of the mempool, only the hash is required, the
+// rest is fetched on first use.
+type Transaction struct {
+	r    *Resolver
+	hash common.Hash
+
+	mu sync.Mutex
+	// mu protects the following fields
+	msg     *evmtypes.MsgEthereumTx
+	block   *Block // nil if pending
+	index   uint64
+	receipt *ethtypes.Receipt
+}
+
+// resolve returns the message of the transaction and its block, nil if pending, looking up the
+// committed transactions first, then the mempool.
+func (t *Transaction) resolve(_ context.Context) (*evmtypes.MsgEthereumTx, *Block, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.msg != nil {
+		return t.msg, t.block, nil
+	}
+
+	if res, err := t.r.backend.GetTxByEthHash(t.hash); err == nil {
+		block := &Block{r: t.r, numberOrHash: numberOrHash(rpctypes.BlockNumber(res.Height))}
+		txs, err := block.Transactions(context.Background())
+		if err != nil {
+			return nil, nil, err
+		}
+		if txs != nil {
+			for _, tx := range *txs {
+				if tx.hash == t.hash {
+					t.msg, t.block, t.index = tx.msg, block, tx.index
+					return t.msg, t.block, nil
+				}
+			}
+		}
+	}
+
+	pending, err := t.r.pendingTransactions()
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, tx := range pending {
+		if tx.hash == t.hash {
+			t.msg = tx.msg
+			break
+		}
+	}
+	return t.msg, nil, nil
+}
+
+// tx returns the go-ethereum representation of the transaction, nil if it doesn't exist. The
+// set-code transactions are represented by the dynamic fee transaction with the same fields
+// but the authorizations, their type, hash, sender and encoding are resolved from the message.
+func (t *Transaction) tx(ctx context.Context) (*ethtypes.Transaction, *Block, error) {
+	msg, block, err := t.resolve(ctx)
+	if err != nil || msg == nil {
+		return nil, nil, err
+	}
+	return msg.AsTransaction(), block, nil
+}
+
+// getReceipt returns the receipt of the transaction, nil if pending.
+func (t *Transaction) getReceipt(ctx context.Context) (*ethtypes.Receipt, error) {
+	_, block, err := t.resolve(ctx)
+	if err != nil || block == nil {
+		return nil, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.receipt != nil {
+		return t.receipt, nil
+	}
+
+	fields, err := t.r.backend.GetTransactionReceipt(t.hash)
+	if err != nil || fields == nil {
+		return nil, err
+	}
+	// the receipt is decoded from its JSON-RPC representation, to resolve the same values
+	bz, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	receipt := new(ethtypes.Receipt)
+	if err := json.Unmarshal(bz, receipt); err != nil {
+		return nil, fmt.Errorf("failed to decode the receipt of %s: %w", t.hash, err)
+	}
+	t.receipt = receipt
+	return receipt, nil
+}
+
+func (t *Transaction) Hash(_ context.Context) common.Hash {
+	return t.hash
+}
+
+func (t *Transaction) InputData(ctx context.Context) (hexutil.Bytes, error) {
+	tx, _, err := t.tx(ctx)
+	if err != nil || tx == nil {
+		return hexutil.Bytes{}, err
+	}
+	return tx.Data(), nil
+}
+
+func (t *Transaction) Gas(ctx context.Context) (hexutil.Uint64, error) {
+	tx, _, err := t.tx(ctx)
+	if err != nil || tx == nil {
+		return 0, err
+	}
+	return hexutil.Uint64(tx.Gas()), nil
+}
+
+func (t *Transaction) GasPrice(ctx context.Context) (hexutil.Big, error) {
+	tx, block, err := t.tx(ctx)
+	if err != nil || tx == nil {
+		return hexutil.Big{}, err
+	}
+	if block != nil && tx.Type() != ethtypes.LegacyTxType && tx.Type() != ethtypes.AccessListTxType {
+		if baseFee, err := block.BaseFeePerGas(ctx); err == nil && baseFee != nil {
+			return hexutil.Big(*effectiveGasPrice(tx, baseFee.ToInt())), nil
+		}
+	}
+	return hexutil.Big(*tx.GasPrice()), nil
+}
+
+// effectiveGasPrice returns the gas price paid by the transaction with the base fee, which is
+// min(gasTipCap + baseFee, gasFeeCap).
+func effectiveGasPrice(tx *ethtypes.Transaction, baseFee *big.Int) *big.Int {
+	price := new(big.Int).Add(tx.GasTipCap(), baseFee)
+	if price.Cmp(tx.GasFeeCap()) > 0 {
+		return tx.GasFeeCap()
+	}
+	return price
+}
+
+func (t *Transaction) EffectiveGasPrice(ctx context.Context) (*hexutil.Big, error) {
+	tx, block, err := t.tx(ctx)
+	if err != nil || tx == nil || block == nil {
+		return nil, err
+	}
+	baseFee, err := block.BaseFeePerGas(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if baseFee == nil {
+		return (*hexutil.Big)(tx.GasPrice()), nil
+	}
+	return (*hexutil.Big)(effectiveGasPrice(tx, baseFee.ToInt())), nil
+}
+
+func (t *Transaction) MaxFeePerGas(ctx context.Context) (*hexutil.Big, error) {
+	tx, _, err := t.tx(ctx)
+	if err != nil || tx == nil || tx.Type() == ethtypes.LegacyTxType || tx.Type() == ethtypes.AccessListTxType {
+		return nil, err
+	}
+	return (*hexutil.Big)(tx.GasFeeCap()), nil
+}
+
+func (t *Transaction) MaxPriorityFeePerGas(ctx context.Context) (*hexutil.Big, error) {
+	tx, _, err := t.tx(ctx)
+	if err != nil || tx == nil || tx.Type() == ethtypes.LegacyTxType || tx.Type() == ethtypes.AccessListTxType {
+		return nil, err
+	}
+	return (*hexutil.Big)(tx.GasTipCap()), nil
+}
+
+func (t *Transaction) MaxFeePerBlobGas(ctx context.Context) (*hexutil.Big, error) {
+	tx, _, err := t.tx(ctx)
+	if err != nil || tx == nil || tx.Type() != ethtypes.BlobTxType {
+		return nil, err
+	}
+	return (*hexutil.Big)(tx.BlobGasFeeCap()), nil
+}
+
+func (t *Transaction) BlobVersionedHashes(ctx context.Context) (*[]common.Hash, error) {
+	tx, _, err := t.tx(ctx)
+	if err != nil || tx == nil || tx.Type() != ethtypes.BlobTxType {
+		return nil, err
+	}
+	hashes := tx.BlobHashes()
+	return &hashes, nil
+}
+
+func (t *Transaction) EffectiveTip(ctx context.Context) (*hexutil.Big, error) {
+	tx, block, err := t.tx(ctx)
+	if err != nil || tx == nil || block == nil {
+		return nil, err
+	}
+	baseFee, err := block.BaseFeePerGas(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if baseFee == nil {
+		return (*hexutil.Big)(tx.GasPrice()), nil
+	}
+	tip, err := tx.EffectiveGasTip(baseFee.ToInt())
+	if err != nil {
+		return nil, err
+	}
+	return (*hexutil.Big)(tip), nil
+}
+
+func (t *Transaction) Value(ctx context.Context) (hexutil.Big, error) {
+	tx, _, err := t.tx(ctx)
+	if err != nil || tx == nil {
+		return hexutil.Big{}, err
+	}
+	return hexutil.Big(*tx.Value()), nil
+}
+
+func (t *Transaction) Nonce(ctx context.Context) (hexutil.Uint64, error) {
+	tx, _, err := t.tx(ctx)
+	if err != nil || tx == nil {
+		return 0, err
+	}
+	return hexutil.Uint64(tx.Nonce()), nil
+}
+
+func (t *Transaction) To(ctx context.Context, args BlockNumberArgs) (*Account, error) {
+	tx, _, err := t.tx(ctx)
+	if err != nil || tx == nil || tx.To() == nil {
+		return nil, err
+	}
+	return &Account{r: t.r, address: *tx.To(), blockNrOrHash: args.NumberOrLatest()}, nil
+}
+
+func (t *Transaction) From(ctx context.Context, args BlockNumberArgs) (*Account, error) {
+	msg, _, err := t.resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if msg == nil {
+		return &Account{r: t.r, blockNrOrHash: args.NumberOrLatest()}, nil
+	}
+	from, err := msg.GetSenderLegacy(t.r.backend.ChainConfig().ChainID)
+	if err != nil {
+		return nil, err
+	}
+	return &Account{r: t.r, address: from, blockNrOrHash: args.NumberOrLatest()}, nil
+}
+
+func (t *Transaction) Block(ctx context.Context) (*Block, error) {
+	_, block, err := t.resolve(ctx)
+	return block, err
+}
+
+func (t *Transaction) Index(ctx context.Context) (*hexutil.Uint64, error) {
+	_, block, err := t.resolve(ctx)
+	if err != nil || block == nil {
+		return nil, err
+	}
+	index := hexutil.Uint64(t.index)
+	return &index, nil
+}
+
+func (t *Transaction) Status(ctx context.Context) (*hexutil.Uint64, error) {
+	receipt, err := t.getReceipt(ctx)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	status := hexutil.Uint64(receipt.Status)
+	return &status, nil
+}
+
+func (t *Transaction) GasUsed(ctx context.Context) (*hexutil.Uint64, error) {
+	receipt, err := t.getReceipt(ctx)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	gasUsed := hexutil.Uint64(receipt.GasUsed)
+	return &gasUsed, nil
+}
+
+func (t *Transaction) CumulativeGasUsed(ctx context.Context) (*hexutil.Uint64, error) {
+	receipt, err := t.getReceipt(ctx)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	gasUsed := hexutil.Uint64(receipt.CumulativeGasUsed)
+	return &gasUsed, nil
+}
+
+func (t *Transaction) BlobGasUsed(ctx context.Context) (*hexutil.Uint64, error) {
+	tx, _, err := t.tx(ctx)
+	if err != nil || tx == nil || tx.Type() != ethtypes.BlobTxType {
+		return nil, err
+	}
+	receipt, err := t.getReceipt(ctx)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	blobGasUsed := hexutil.Uint64(tx.BlobGas())
+	return &blobGasUsed, nil
+}
+
+func (t *Transaction) BlobGasPrice(ctx context.Context) (*hexutil.Big, error) {
+	tx, _, err := t.tx(ctx)
+	if err != nil || tx == nil || tx.Type() != ethtypes.BlobTxType {
+		return nil, err
+	}
+	receipt, err := t.getReceipt(ctx)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	return (*hexutil.Big)(receipt.BlobGasPrice), nil
+}
+
+func (t *Transaction) CreatedContract(ctx context.Context, args BlockNumberArgs) (*Account, error) {
+	receipt, err := t.getReceipt(ctx)
+	if err != nil || receipt == nil || receipt.ContractAddress == (common.Address{}) {
+		return nil, err
+	}
+	return &Account{r: t.r, address: receipt.ContractAddress, blockNrOrHash: args.NumberOrLatest()}, nil
+}
+
+func (t *Transaction) Logs(ctx context.Context) (*[]*Log, error) {
+	receipt, err := t.getReceipt(ctx)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	logs := make([]*Log, len(receipt.Logs))
+	for i, log := range receipt.Logs {
+		logs[i] = &Log{r: t.r, transaction: t, log: log}
+	}
+	return &logs, nil
+}
+
+func (t *Transaction) Type(ctx context.Context) (*hexutil.Uint64, error) {
+	msg, _, err := t.resolve(ctx)
+	if err != nil || msg == nil {
+		return nil, err
+	}
+	txType := hexutil.Uint64(msg.TxType())
+	return &txType, nil
+}
+
+func (t *Transaction) AccessList(ctx context.Context) (*[]*AccessTuple, error) {
+	tx, _, err := t.tx(ctx)
+	if err != nil || tx == nil {
+		return nil, err
+	}
+	accessList := tx.AccessList()
+	tuples := make([]*AccessTuple, len(accessList))
+	for i, tuple := range accessList {
+		tuples[i] = &AccessTuple{address: tuple.Address, storageKeys: tuple.StorageKeys}
+	}
+	return &tuples, nil
+}
+
+func (t *Transaction) R(ctx context.Context) (hexutil.Big, error) {
+	tx, _, err := t.tx(ctx)
+	if err != nil || tx == nil {
+		return hexutil.Big{}, err
+	}
+	_, r, _ := tx.RawSignatureValues()
+	return hexutil.Big(*r), nil
+}
+
+func (t *Transaction) S(ctx context.Context) (hexutil.Big, error) {
+	tx, _, err := t.tx(ctx)
+	if err != nil || tx == nil {
+		return hexutil.Big{}, err
+	}
+	_, _, s := tx.RawSignatureValues()
+	return hexutil.Big(*s), nil
+}
+
+func (t *Transaction) V(ctx context.Context) (hexutil.Big, error) {
+	tx, _, err := t.tx(ctx)
+	if err != nil || tx == nil {
+		return hexutil.Big{}, err
+	}
+	v, _, _ := tx.RawSignatureValues()
+	return hexutil.Big(*v), nil
+}
+
+func (t *Transaction) YParity(ctx context.Context) (*hexutil.Big, error) {
+	tx, _, err := t.tx(ctx)
+	if err != nil || tx == nil || tx.Type() == ethtypes.LegacyTxType {
+		return nil, err
+	}
+	v, _, _ := tx.RawSignatureValues()
+	return (*hexutil.Big)(v), nil
+}
+
+func (t *Transaction) Raw(ctx context.Context) (hexutil.Bytes, error) {
+	msg, _, err := t.resolve(ctx)
+	if err != nil || msg == nil {
+		return hexutil.Bytes{}, err
+	}
+	return msg.MarshalBinary()
+}
+
+func (t *Transaction) RawReceipt(ctx context.Context) (hexutil.Bytes, error) {
+	receipt, err := t.getReceipt(ctx)
+	if err != nil || receipt == nil {
+		return hexutil.Bytes{}, err
+	}
+	return receipt.MarshalBinary()
+}
+
+// rpcBlock is the block returned by eth_getBlockByNumber, which the block fields resolve to.
+type rpcBlock struct {
+	Number           hexutil.Uint64      `json:"number"`
+	Hash             hexutil.Bytes       `json:"hash"`
+	ParentHash       common.Hash         `json:"parentHash"`
+	Nonce            ethtypes.BlockNonce `json:"nonce"`
+	UncleHash        common.Hash         `json:"sha3Uncles"`
+	Bloom            ethtypes.Bloom      `json:"logsBloom"`
+	StateRoot        hexutil.Bytes       `json:"stateRoot"`
+	Miner            common.Address      `json:"miner"`
+	MixHash          common.Hash         `json:"mixHash"`
+	Difficulty       hexutil.Big         `json:"difficulty"`
+	ExtraData        hexutil.Bytes       `json:"extraData"`
+	GasLimit         hexutil.Uint64      `json:"gasLimit"`
+	GasUsed          hexutil.Uint64      `json:"gasUsed"`
+	Timestamp        hexutil.Uint64      `json:"timestamp"`
+	TransactionsRoot common.Hash         `json:"transactionsRoot"`
+	ReceiptsRoot     common.Hash         `json:"receiptsRoot"`
+	TotalDifficulty  hexutil.Big         `json:"totalDifficulty"`
+	BaseFee          *hexutil.Big        `json:"baseFeePerGas"`
+}
+
+// Block is a block, fetched on first use by number or hash.
+type Block struct {
+	r            *Resolver
+	numberOrHash rpctypes.BlockNumberOrHash
+
+	mu sync.Mutex
+	// mu protects the following fields
+	resolved bool
+	block    *rpcBlock // nil if the block doesn't exist
+	txs      []*Transaction
+}
+
+// resolve returns the block, nil if it doesn't exist, fetching it with its transactions if
+// needed.
+func (b *Block) resolve(_ context.Context) (*rpcBlock, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.resolved {
+		return b.block, nil
+	}
+
+	var (
+		resBlock *tmrpctypes.ResultBlock
+		err      error
+	)
+	if b.numberOrHash.BlockHash != nil {
+		resBlock, err = b.r.backend.TendermintBlockByHash(*b.numberOrHash.BlockHash)
+	} else {
+		resBlock, err = b.r.backend.TendermintBlockByNumber(*b.numberOrHash.BlockNumber)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if resBlock == nil || resBlock.Block == nil {
+		b.resolved = true
+		return nil, nil
+	}
+
+	blockRes, err := b.r.backend.TendermintBlockResultByNumber(&resBlock.Block.Height)
+	if err != nil {
+		return nil, err
+	}
+	fields, err := b.r.backend.RPCBlockFromTendermintBlock(resBlock, blockRes, false)
+	if err != nil {
+		return nil, err
+	}
+	// the block is decoded from its JSON-RPC representation, to resolve the same values
+	bz, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	block := new(rpcBlock)
+	if err := json.Unmarshal(bz, block); err != nil {
+		return nil, fmt.Errorf("failed to decode block %d: %w", resBlock.Block.Height, err)
+	}
+
+	msgs := b.r.backend.EthMsgsFromTendermintBlock(resBlock, blockRes)
+	b.txs = make([]*Transaction, len(msgs))
+	for i, msg := range msgs {
+		b.txs[i] = &Transaction{r: b.r, hash: common.HexToHash(msg.Hash), msg: msg, block: b, index: uint64(i)}
+	}
+	b.block, b.resolved = block, true
+	return block, nil
+}
+
+// resolveExisting returns the block, or an error if it doesn't exist.
+func (b *Block) resolveExisting(ctx context.Context) (*rpcBlock, error) {
+	block, err := b.resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if block == nil {
+		return nil, errors.New("block not found")
+	}
+	return block, nil
+}
+
+// header returns the go-ethereum representation of the block header.
+func (b *rpcBlock) header() *ethtypes.Header {
+	return &ethtypes.Header{
+		ParentHash:  b.ParentHash,
+		UncleHash:   b.UncleHash,
+		Coinbase:    b.Miner,
+		Root:        common.BytesToHash(b.StateRoot),
+		TxHash:      b.TransactionsRoot,
+		ReceiptHash: b.ReceiptsRoot,
+		Bloom:       b.Bloom,
+		Difficulty:  b.Difficulty.ToInt(),
+		Number:      new(big.Int).SetUint64(uint64(b.Number)),
+		GasLimit:    uint64(b.GasLimit),
+		GasUsed:     uint64(b.GasUsed),
+		Time:        uint64(b.Timestamp),
+		Extra:       b.ExtraData,
+		MixDigest:   b.MixHash,
+		Nonce:       b.Nonce,
+		BaseFee:     b.BaseFee.ToInt(),
+	}
+}
+
+func (b *Block) Number(ctx context.Context) (hexutil.Uint64, error) {
+	block, err := b.resolveExisting(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return block.Number, nil
+}
+
+func (b *Block) Hash(ctx context.Context) (common.Hash, error) {
+	block, err := b.resolveExisting(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.BytesToHash(block.Hash), nil
+}
+
+func (b *Block) Parent(ctx context.Context) (*Block, error) {
+	block, err := b.resolveExisting(ctx)
+	if err != nil || block.Number <= 1 {
+		return nil, err
+	}
+	return b.r.existingBlock(ctx, numberOrHash(rpctypes.BlockNumber(block.Number-1)))
+}
+
+func (b *Block) Nonce(ctx context.Context) (hexutil.Bytes, error) {
+	block, err := b.resolveExisting(ctx)
+	if err != nil {
+		return hexutil.Bytes{}, err
+	}
+	return block.Nonce[:], nil
+}
+
+func (b *Block) TransactionsRoot(ctx context.Context) (common.Hash, error) {
+	block, err := b.resolveExisting(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return block.TransactionsRoot, nil
+}
+
+func (b *Block) TransactionCount(ctx context.Context) (*hexutil.Uint64, error) {
+	txs, err := b.Transactions(ctx)
+	if err != nil || txs == nil {
+		return nil, err
+	}
+	count := hexutil.Uint64(len(*txs))
+	return &count, nil
+}
+
+func (b *Block) StateRoot(ctx context.Context) (common.Hash, error) {
+	block, err := b.resolveExisting(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.BytesToHash(block.StateRoot), nil
+}
+
+func (b *Block) ReceiptsRoot(ctx context.Context) (common.Hash, error) {
+	block, err := b.resolveExisting(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return block.ReceiptsRoot, nil
+}
+
+func (b *Block) Miner(ctx context.Context, args BlockNumberArgs) (*Account, error) {
+	block, err := b.resolveExisting(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Account{r: b.r, address: block.Miner, blockNrOrHash: args.NumberOrLatest()}, nil
+}
+
+func (b *Block) ExtraData(ctx context.Context) (hexutil.Bytes, error) {
+	block, err := b.resolveExisting(ctx)
+	if err != nil {
+		return hexutil.Bytes{}, err
+	}
+	return block.ExtraData, nil
+}
+
+func (b *Block) GasLimit(ctx context.Context) (hexutil.Uint64, error) {
+	block, err := b.resolveExisting(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return block.GasLimit, nil
+}
+
+func (b *Block) GasUsed(ctx context.Context) (hexutil.Uint64, error) {
+	block, err := b.resolveExisting(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return block.GasUsed, nil
+}
+
+func (b *Block) BaseFeePerGas(ctx context.Context) (*hexutil.Big, error) {
+	block, err := b.resolveExisting(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return block.BaseFee, nil
+}
+
+// NextBaseFeePerGas is only known for the latest block, as the base fee of the fee market.
+func (b *Block) NextBaseFeePerGas(ctx context.Context) (*hexutil.Big, error) {
+	block, err := b.resolveExisting(ctx)
+	if err != nil || block.BaseFee == nil {
+		return nil, err
+	}
+	latest, err := b.r.backend.BlockNumber()
+	if err != nil || block.Number != latest {
+		return nil, err
+	}
+	header, err := b.r.backend.CurrentHeader()
+	if err != nil || header.BaseFee == nil {
+		return nil, err
+	}
+	return (*hexutil.Big)(header.BaseFee), nil
+}
+
+func (b *Block) Timestamp(ctx context.Context) (hexutil.Uint64, error) {
+	block, err := b.resolveExisting(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return block.Timestamp, nil
+}
+
+func (b *Block) LogsBloom(ctx context.Context) (hexutil.Bytes, error) {
+	block, err := b.resolveExisting(ctx)
+	if err != nil {
+		return hexutil.Bytes{}, err
+	}
+	return block.Bloom.Bytes(), nil
+}
+
+func (b *Block) MixHash(ctx context.Context) (common.Hash, error) {
+	block, err := b.resolveExisting(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return block.MixHash, nil
+}
+
+func (b *Block) Difficulty(ctx context.Context) (hexutil.Big, error) {
+	block, err := b.resolveExisting(ctx)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return block.Difficulty, nil
+}
+
+func (b *Block) TotalDifficulty(ctx context.Context) (hexutil.Big, error) {
+	block, err := b.resolveExisting(ctx)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return block.TotalDifficulty, nil
+}
+
+// OmmerCount is zero, there are no ommers with the instant finality of the chain.
+func (b *Block) OmmerCount(ctx context.Context) (*hexutil.Uint64, error) {
+	if _, err := b.resolveExisting(ctx); err != nil {
+		return nil, err
+	}
+	count := hexutil.Uint64(0)
+	return &count, nil
+}
+
+func (b *Block) Ommers(ctx context.Context) (*[]*Block, error) {
+	if _, err := b.resolveExisting(ctx); err != nil {
+		return nil, err
+	}
+	return &[]*Block{}, nil
+}
+
+func (b *Block) OmmerAt(_ context.Context, _ struct{ Index Long }) *Block {
+	return nil
+}
+
+func (b *Block) OmmerHash(ctx context.Context) (common.Hash, error) {
+	block, err := b.resolveExisting(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return block.UncleHash, nil
+}
+
+func (b *Block) Transactions(ctx context.Context) (*[]*Transaction, error) {
+	block, err := b.resolve(ctx)
+	if err != nil || block == nil {
+		return nil, err
+	}
+	return &b.txs, nil
+}
+
+func (b *Block) TransactionAt(ctx context.Context, args struct{ Index Long }) (*Transaction, error) {
+	txs, err := b.Transactions(ctx)
+	if err != nil || txs == nil || args.Index < 0 || int(args.Index) >= len(*txs) {
+		return nil, err
+	}
+	return (*txs)[args.Index], nil
+}
+
+// BlockFilterCriteria are the criteria of the logs of a block.
+type BlockFilterCriteria struct {
+	Addresses *[]common.Address
+	Topics    *[][]common.Hash
+}
+
+func (b *Block) Logs(ctx context.Context, args struct{ Filter BlockFilterCriteria }) ([]*Log, error) {
+	hash, err := b.Hash(ctx)
+	if err != nil {
+		return nil, err
+	}
+	criteria := ethfilters.FilterCriteria{BlockHash: &hash}
+	if args.Filter.Addresses != nil {
+		criteria.Addresses = *args.Filter.Addresses
+	}
+	if args.Filter.Topics != nil {
+		criteria.Topics = *args.Filter.Topics
+	}
+	return b.r.runFilter(ctx, filters.NewBlockFilter(b.r.logger, b.r.backend, criteria))
+}
+
+func (b *Block) Account(ctx context.Context, args struct{ Address common.Address }) (*Account, error) {
+	block, err := b.resolveExisting(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Account{
+		r:             b.r,
+		address:       args.Address,
+		blockNrOrHash: numberOrHash(rpctypes.BlockNumber(block.Number)),
+	}, nil
+}
+
+func (b *Block) Call(ctx context.Context, args struct{ Data CallData }) (*CallResult, error) {
+	block, err := b.resolveExisting(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return b.r.call(args.Data, rpctypes.BlockNumber(block.Number))
+}
+
+func (b *Block) EstimateGas(ctx context.Context, args struct{ Data CallData }) (hexutil.Uint64, error) {
+	block, err := b.resolveExisting(ctx)
+	if err != nil {
+		return 0, err
+	}
+	blockNum := rpctypes.BlockNumber(block.Number)
+	return b.r.backend.EstimateGas(args.Data.transactionArgs(), &blockNum)
+}
+
+func (b *Block) RawHeader(ctx context.Context) (hexutil.Bytes, error) {
+	block, err := b.resolveExisting(ctx)
+	if err != nil {
+		return hexutil.Bytes{}, err
+	}
+	return rlp.EncodeToBytes(block.header())
+}
+
+func (b *Block) Raw(ctx context.Context) (hexutil.Bytes, error) {
+	block, err := b.resolveExisting(ctx)
+	if err != nil {
+		return hexutil.Bytes{}, err
+	}
+	txs := make([]*ethtypes.Transaction, len(b.txs))
+	for i, tx := range b.txs {
+		// go-ethereum blocks can't hold set-code transactions
+		if tx.msg.TxType() == evmtypes.SetCodeTxType {
+			return hexutil.Bytes{}, fmt.Errorf("block contains the set-code transaction %s: %w", tx.hash, evmtypes.ErrTxTypeNotSupported)
+		}
+		txs[i] = tx.msg.AsTransaction()
+	}
+	return rlp.EncodeToBytes(ethtypes.NewBlockWithHeader(block.header()).WithBody(txs, nil))
+}
+
+// WithdrawalsRoot is null, the chain has no withdrawals.
+func (b *Block) WithdrawalsRoot(_ context.Context) *common.Hash {
+	return nil
+}
+
+func (b *Block) Withdrawals(_ context.Context) *[]*Withdrawal {
+	return nil
+}
+
+// BlobGasUsed is the blob gas of the blob transactions of the block.
+func (b *Block) BlobGasUsed(ctx context.Context) (*hexutil.Uint64, error) {
+	txs, err := b.Transactions(ctx)
+	if err != nil || txs == nil {
+		return nil, err
+	}
+	var blobGasUsed uint64
+	for _, tx := range *txs {
+		if tx.msg.TxType() == ethtypes.BlobTxType {
+			blobGasUsed += tx.msg.AsTransaction().BlobGas()
+		}
+	}
+	used := hexutil.Uint64(blobGasUsed)
+	return &used, nil
+}
+
+func (b *Block) ExcessBlobGas(_ context.Context) *hexutil.Uint64 {
+	return nil
+}
+
+// CallData are the arguments of call and estimateGas.
+type CallData struct {
+	From                 *common.Address
+	To                   *common.Address
+	Gas                  *Long
+	GasPrice             *hexutil.Big
+	MaxFeePerGas         *hexutil.Big
+	MaxPriorityFeePerGas *hexutil.Big
+	Value                *hexutil.Big
+	Data                 *hexutil.Bytes
+}
+
+// transactionArgs returns the arguments of the call as the ones of eth_call.
+func (c CallData) transactionArgs() evmtypes.TransactionArgs {
+	args := evmtypes.TransactionArgs{
+		From:                 c.From,
+		To:                   c.To,
+		GasPrice:             c.GasPrice,
+		MaxFeePerGas:         c.MaxFeePerGas,
+		MaxPriorityFeePerGas: c.MaxPriorityFeePerGas,
+		Value:                c.Value,
+		Data:                 c.Data,
+	}
+	if c.Gas != nil {
+		gas := hexutil.Uint64(*c.Gas)
+		args.Gas = &gas
+	}
+	return args
+}
+
+// CallResult is the result of call.
+type CallResult struct {
+	data    hexutil.Bytes
+	gasUsed hexutil.Uint64
+	status  hexutil.Uint64
+}
+
+func (c *CallResult) Data() hexutil.Bytes {
+	return c.data
+}
+
+func (c *CallResult) GasUsed() hexutil.Uint64 {
+	return c.gasUsed
+}
+
+func (c *CallResult) Status() hexutil.Uint64 {
+	return c.status
+}
+
+// Pending is the state of the mempool.
+type Pending struct {
+	r *Resolver
+}
+
+func (p *Pending) TransactionCount(_ context.Context) (hexutil.Uint64, error) {
+	txs, err := p.r.pendingTransactions()
+	return hexutil.Uint64(len(txs)), err
+}
+
+func (p *Pending) Transactions(_ context.Context) (*[]*Transaction, error) {
+	txs, err := p.r.pendingTransactions()
+	if err != nil {
+		return nil, err
+	}
+	return &txs, nil
+}
+
+func (p *Pending) Account(_ context.Context, args struct{ Address common.Address }) *Account {
+	return &Account{r: p.r, address: args.Address, blockNrOrHash: numberOrHash(rpctypes.EthPendingBlockNumber)}
+}
+
+func (p *Pending) Call(_ context.Context, args struct{ Data CallData }) (*CallResult, error) {
+	return p.r.call(args.Data, rpctypes.EthPendingBlockNumber)
+}
+
+func (p *Pending) EstimateGas(_ context.Context, args struct{ Data CallData }) (hexutil.Uint64, error) {
+	blockNum := rpctypes.EthPendingBlockNumber
+	return p.r.backend.EstimateGas(args.Data.transactionArgs(), &blockNum)
+}
+
+// Resolver is the root of the GraphQL queries and mutations.
+type Resolver struct {
+	backend Backend
+	logger  log.Logger
+}
+
+// existingBlock returns the block, nil if it doesn't exist.
+func (r *Resolver) existingBlock(ctx context.Context, blockNrOrHash rpctypes.BlockNumberOrHash) (*Block, error) {
+	block := &Block{r: r, numberOrHash: blockNrOrHash}
+	b, err := block.resolve(ctx)
+	if err != nil || b == nil {
+		return nil, err
+	}
+	return block, nil
+}
+
+// pendingTransactions returns the Ethereum transactions of the mempool.
+func (r *Resolver) pendingTransactions() ([]*Transaction, error) {
+	pending, err := r.backend.PendingTransactions()
+	if err != nil {
+		return nil, err
+	}
+	txs := []*Transaction{}
+	for _, tx := range pending {
+		for _, msg := range (*tx).GetMsgs() {
+			if ethMsg, ok := msg.(*evmtypes.MsgEthereumTx); ok {
+				txs = append(txs, &Transaction{r: r, hash: common.HexToHash(ethMsg.Hash), msg: ethMsg})
+			}
+		}
+	}
+	return txs, nil
+}
+
+// call executes the call on the state of the block.
+func (r *Resolver) call(data CallData, blockNum rpctypes.BlockNumber) (*CallResult, error) {
+	res, err := r.backend.DoCall(data.transactionArgs(), blockNum, nil)
+	if err != nil {
+		return nil, err
+	}
+	status := hexutil.Uint64(1)
+	if res.Failed() {
+		status = 0
+	}
+	return &CallResult{data: res.Ret, gasUsed: hexutil.Uint64(res.GasUsed), status: status}, nil
+}
+
+// runFilter runs the log filter, capped like eth_getLogs.
+func (r *Resolver) runFilter(ctx context.Context, filter *filters.Filter) ([]*Log, error) {
+	logsCap := int(r.backend.RPCLogsCap())
+	logs, err := filter.Logs(ctx, logsCap, int64(r.backend.RPCBlockRangeCap()))
+	if err != nil {
+		return nil, err
+	}
+	// the filters of a single block aren't capped
+	if len(logs) > logsCap {
+		return nil, fmt.Errorf("query returned more than %d results", logsCap)
+	}
+
+	txs := make(map[common.Hash]*Transaction)
+	res := make([]*Log, len(logs))
+	for i, log := range logs {
+		tx, ok := txs[log.TxHash]
+		if !ok {
+			tx = &Transaction{r: r, hash: log.TxHash}
+			txs[log.TxHash] = tx
+		}
+		res[i] = &Log{r: r, transaction: tx, log: log}
+	}
+	return res, nil
+}
+
+func (r *Resolver) Block(ctx context.Context, args struct {
+	Number *Long
+	Hash   *common.Hash
+}) (*Block, error) {
+	switch {
+	case args.Number != nil && args.Hash != nil:
+		return nil, errors.New("only one of number or hash must be specified")
+	case args.Hash != nil:
+		return r.existingBlock(ctx, rpctypes.BlockNumberOrHash{BlockHash: args.Hash})
+	case args.Number != nil:
+		if *args.Number < 0 {
+			return nil, nil
+		}
+		return r.existingBlock(ctx, numberOrHash(rpctypes.BlockNumber(*args.Number)))
+	default:
+		return r.existingBlock(ctx, numberOrHash(rpctypes.EthLatestBlockNumber))
+	}
+}
+
+// Blocks returns the blocks of the range, which is capped like eth_getLogs.
+func (r *Resolver) Blocks(ctx context.Context, args struct {
+	From *Long
+	To   *Long
+}) ([]*Block, error) {
+	if args.From == nil {
+		return nil, errors.New("from block number must be specified")
+	}
+	from := rpctypes.BlockNumber(*args.From)
+
+	latest, err := r.backend.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+	to := rpctypes.BlockNumber(latest)
+	if args.To != nil && rpctypes.BlockNumber(*args.To) < to {
+		to = rpctypes.BlockNumber(*args.To)
+	}
+	if to < from {
+		return nil, errInvalidBlockRange
+	}
+	if blockLimit := rpctypes.BlockNumber(r.backend.RPCBlockRangeCap()); to-from > blockLimit {
+		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
+	}
+
+	blocks := []*Block{}
+	for number := from; number <= to; number++ {
+		block, err := r.existingBlock(ctx, numberOrHash(number))
+		if err != nil {
+			return nil, err
+		}
+		if block == nil {
+			break
+		}
+		blocks = append(blocks, block)
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+	}
+	return blocks, nil
+}
+
+func (r *Resolver) Pending(_ context.Context) *Pending {
+	return &Pending{r}
+}
+
+func (r *Resolver) Transaction(ctx context.Context, args struct{ Hash common.Hash }) (*Transaction, error) {
+	tx := &Transaction{r: r, hash: args.Hash}
+	msg, _, err := tx.resolve(ctx)
+	if err != nil || msg == nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+func (r *Resolver) SendRawTransaction(_ context.Context, args struct{ Data hexutil.Bytes }) (common.Hash, error) {
+	return r.backend.SendRawTransaction(args.Data)
+}
+
+// FilterCriteria are the criteria of the logs of a range of blocks.
+type FilterCriteria struct {
+	FromBlock *Long
+	ToBlock   *Long
+	Addresses *[]common.Address
+	Topics    *[][]common.Hash
+}
+
+// Logs returns the logs of the range of blocks, capped like eth_getLogs.
+func (r *Resolver) Logs(ctx context.Context, args struct{ Filter FilterCriteria }) ([]*Log, error) {
+	begin := int64(rpctypes.EthLatestBlockNumber)
+	if args.Filter.FromBlock != nil {
+		begin = int64(*args.Filter.FromBlock)
+	}
+	end := int64(rpctypes.EthLatestBlockNumber)
+	if args.Filter.ToBlock != nil {
+		end = int64(*args.Filter.ToBlock)
+	}
+	if begin > 0 && end > 0 && begin > end {
+		return nil, errInvalidBlockRange
+	}
+	var addresses []common.Address
+	if args.Filter.Addresses != nil {
+		addresses = *args.Filter.Addresses
+	}
+	var topics [][]common.Hash
+	if args.Filter.Topics != nil {
+		topics = *args.Filter.Topics
+	}
+	return r.runFilter(ctx, filters.NewRangeFilter(r.logger, r.backend, begin, end, addresses, topics))
+}
+
+func (r *Resolver) GasPrice(_ context.Context) (hexutil.Big, error) {
+	price, err := r.backend.GasPrice()
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return *price, nil
+}
+
+func (r *Resolver) MaxPriorityFeePerGas(_ context.Context) (hexutil.Big, error) {
+	header, err := r.backend.CurrentHeader()
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	tipCap, err := r.backend.SuggestGasTipCap(header.BaseFee)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return hexutil.Big(*tipCap), nil
+}
+
+func (r *Resolver) ChainID(_ context.Context) (hexutil.Big, error) {
+	chainID, err := r.backend.ChainID()
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return *chainID, nil
+}
+
+// SyncState is the progress of the synchronization of the node.
+type SyncState struct {
+	startingBlock hexutil.Uint64
+	currentBlock  hexutil.Uint64
+	highestBlock  hexutil.Uint64
+}
+
+func (s *SyncState) StartingBlock() hexutil.Uint64 {
+	return s.startingBlock
+}
+
+func (s *SyncState) CurrentBlock() hexutil.Uint64 {
+	return s.currentBlock
+}
+
+// HighestBlock is the current block, the node doesn't know the height of its peers.
+func (s *SyncState) HighestBlock() hexutil.Uint64 {
+	return s.highestBlock
+}
+
+// Syncing returns null if the node is synced, otherwise its progress like eth_syncing.
+func (r *Resolver) Syncing() (*SyncState, error) {
+	res, err := r.backend.Syncing()
+	if err != nil {
+		return nil, err
+	}
+	progress, ok := res.(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+	current, _ := progress["currentBlock"].(hexutil.Uint64)
+	starting, _ := progress["startingBlock"].(hexutil.Uint64)
+	return &SyncState{startingBlock: starting, currentBlock: current, highestBlock: current}, nil
+}
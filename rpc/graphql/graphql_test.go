@@ -0,0 +1,158 @@
+package graphql
+
+import (
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/log"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/graph-gophers/graphql-go"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/rpc/ratelimit"
+	"github.com/evmos/ethermint/server/config"
+)
+
+// stubBackend answers the chain queries, the other methods of the backend aren't implemented.
+type stubBackend struct {
+	Backend
+}
+
+func (stubBackend) ChainID() (*hexutil.Big, error)       { return (*hexutil.Big)(big.NewInt(9000)), nil }
+func (stubBackend) GasPrice() (*hexutil.Big, error)      { return (*hexutil.Big)(big.NewInt(10)), nil }
+func (stubBackend) BlockNumber() (hexutil.Uint64, error) { return 100, nil }
+func (stubBackend) RPCBlockRangeCap() int32              { return 10 }
+
+// query sends the query to the handler and returns the status code and body of the response.
+func query(t *testing.T, h http.Handler, query string) (int, string) {
+	body := `{"query":` + strings.ReplaceAll(`"`+query+`"`, "\n", " ") + `}`
+	req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec.Code, rec.Body.String()
+}
+
+func TestQuery(t *testing.T) {
+	// the resolvers are checked against the schema when parsing it
+	h, err := NewHandler(log.NewNopLogger(), stubBackend{}, nil)
+	require.NoError(t, err)
+
+	code, res := query(t, h, "{ chainID gasPrice }")
+	require.Equal(t, http.StatusOK, code)
+	require.JSONEq(t, `{"data":{"chainID":"0x2328","gasPrice":"0xa"}}`, res)
+
+	code, res = query(t, h, "{ blocks(from: 50, to: 70) { number } }")
+	require.Equal(t, http.StatusBadRequest, code)
+	require.Contains(t, res, "maximum [from, to] blocks distance: 10")
+
+	code, res = query(t, h, "{ blocks(from: 70, to: 50) { number } }")
+	require.Equal(t, http.StatusBadRequest, code)
+	require.Contains(t, res, "invalid from and to block combination")
+
+	code, _ = query(t, h, "{ unknown }")
+	require.Equal(t, http.StatusBadRequest, code)
+}
+
+func TestLimiter(t *testing.T) {
+	cfg := *config.DefaultJSONRPCConfig()
+	cfg.DeniedMethods = []string{"eth_sendRawTransaction"}
+	cfg.RateLimit = 0.001
+	cfg.RateLimitBurst = 3
+	limiter, err := ratelimit.NewLimiter(cfg)
+	require.NoError(t, err)
+	// the backend doesn't implement SendRawTransaction, the mutation must not reach it
+	h, err := NewHandler(log.NewNopLogger(), stubBackend{}, limiter)
+	require.NoError(t, err)
+
+	code, res := query(t, h, `mutation { sendRawTransaction(data: \"0x01\") }`)
+	require.Equal(t, http.StatusBadRequest, code)
+	require.JSONEq(t, `{"errors":[{"message":"method eth_sendRawTransaction is not allowed","extensions":{"code":-32004}}]}`, res)
+
+	// each field costs the weight of its method, the burst is exhausted by the third one
+	code, _ = query(t, h, "{ chainID gasPrice }")
+	require.Equal(t, http.StatusOK, code)
+	code, res = query(t, h, "{ a: chainID b: chainID }")
+	require.Equal(t, http.StatusBadRequest, code)
+	require.JSONEq(t, `{"errors":[{"message":"rate limit exceeded","extensions":{"code":-32005}}]}`, res)
+}
+
+func TestQueryMethods(t *testing.T) {
+	s, err := graphql.ParseSchema(schema, &Resolver{})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name       string
+		query      string
+		operation  string
+		expMethods []string
+		expErr     bool
+	}{
+		{"scalar fields", "{ chainID gasPrice }", "", []string{"eth_chainId", "eth_gasPrice"}, false},
+		{
+			"nested fields",
+			`query { block(hash: "0x00") { number parent { hash } transactions { status logs { data } from { balance } } } }`,
+			"",
+			[]string{"eth_getBlockByHash", "eth_getBlockByHash", "eth_getTransactionReceipt", "eth_getTransactionReceipt", "eth_getBalance"},
+			false,
+		},
+		{
+			"aliases, arguments and directives",
+			`query Q($n: Long = 1) { b: block(number: $n) @include(if: true) { call(data: {to: "0x01", data: "0x"}) { data } } }`,
+			"",
+			[]string{"eth_getBlockByNumber", "eth_call"},
+			false,
+		},
+		{
+			"fragments",
+			`{ pending { ...P ... on Pending { estimateGas(data: {}) } } } fragment P on Pending { account(address: "0x01") { code } }`,
+			"",
+			[]string{"eth_getCode", "eth_estimateGas"},
+			false,
+		},
+		{"mutation", `mutation M { sendRawTransaction(data: "0x01") }`, "", []string{"eth_sendRawTransaction"}, false},
+		{"named operation", `query A { chainID } mutation B { sendRawTransaction(data: "0x") }`, "B", []string{"eth_sendRawTransaction"}, false},
+		{"ambiguous operation", "query A { chainID } query B { gasPrice }", "", nil, true},
+		{"unknown fragment", "{ ...F }", "", nil, true},
+		{"syntax error", "{ chainID", "", nil, true},
+		{"cyclic fragments", "{ ...F } fragment F on Query { ...F }", "", nil, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			methods, err := queryMethods(s.ASTSchema(), tc.query, tc.operation)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expMethods, methods)
+		})
+	}
+}
+
+func TestLong(t *testing.T) {
+	testCases := []struct {
+		input  interface{}
+		exp    Long
+		expErr bool
+	}{
+		{"0x10", 16, false},
+		{"16", 16, false},
+		{int32(16), 16, false},
+		{float64(16), 16, false},
+		{"0xz", 0, true},
+		{true, 0, true},
+	}
+	for _, tc := range testCases {
+		var l Long
+		err := l.UnmarshalGraphQL(tc.input)
+		if tc.expErr {
+			require.Error(t, err, tc.input)
+		} else {
+			require.NoError(t, err, tc.input)
+			require.Equal(t, tc.exp, l)
+		}
+	}
+}
@@ -0,0 +1,189 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package graphql
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/graph-gophers/graphql-go/types"
+)
+
+const (
+	// maxSelectionDepth bounds the nesting of the selection sets and fragment spreads walked to
+	// list the methods of a query
+	maxSelectionDepth = 64
+	// maxSelections bounds the number of selections walked, the fragments being expanded at each
+	// of their spreads
+	maxSelections = 10000
+)
+
+// Limiter checks the methods of the JSON-RPC namespace resolving the fields of a query against the
+// method lists and rate limits of the JSON-RPC server.
+type Limiter interface {
+	AllowMethods(r *http.Request, methods []string) error
+}
+
+// fieldMethods are the JSON-RPC methods equivalent to the fields resolved through the backend, by
+// type and field. The other fields are read from the objects already fetched.
+var fieldMethods = map[string]string{
+	"Query.block":                   "eth_getBlockByNumber",
+	"Query.block(hash)":             "eth_getBlockByHash",
+	"Query.blocks":                  "eth_getBlockByNumber",
+	"Query.transaction":             "eth_getTransactionByHash",
+	"Query.logs":                    "eth_getLogs",
+	"Query.gasPrice":                "eth_gasPrice",
+	"Query.maxPriorityFeePerGas":    "eth_maxPriorityFeePerGas",
+	"Query.syncing":                 "eth_syncing",
+	"Query.chainID":                 "eth_chainId",
+	"Mutation.sendRawTransaction":   "eth_sendRawTransaction",
+	"Block.parent":                  "eth_getBlockByHash",
+	"Block.logs":                    "eth_getLogs",
+	"Block.call":                    "eth_call",
+	"Block.estimateGas":             "eth_estimateGas",
+	"Pending.transactionCount":      "eth_getBlockTransactionCountByNumber",
+	"Pending.transactions":          "eth_getBlockByNumber",
+	"Pending.call":                  "eth_call",
+	"Pending.estimateGas":           "eth_estimateGas",
+	"Transaction.block":             "eth_getBlockByHash",
+	"Transaction.status":            "eth_getTransactionReceipt",
+	"Transaction.gasUsed":           "eth_getTransactionReceipt",
+	"Transaction.cumulativeGasUsed": "eth_getTransactionReceipt",
+	"Transaction.blobGasUsed":       "eth_getTransactionReceipt",
+	"Transaction.blobGasPrice":      "eth_getTransactionReceipt",
+	"Transaction.createdContract":   "eth_getTransactionReceipt",
+	"Transaction.logs":              "eth_getTransactionReceipt",
+	"Transaction.rawReceipt":        "eth_getTransactionReceipt",
+	"Log.transaction":               "eth_getTransactionByHash",
+	"Account.balance":               "eth_getBalance",
+	"Account.transactionCount":      "eth_getTransactionCount",
+	"Account.code":                  "eth_getCode",
+	"Account.storage":               "eth_getStorageAt",
+}
+
+// queryMethods returns the JSON-RPC methods equivalent to the fields of the operation of the query
+// resolved through the backend, once per field so that each of them is charged.
+func queryMethods(schema *types.Schema, query, operationName string) ([]string, error) {
+	doc, err := parseDocument(query)
+	if err != nil {
+		return nil, err
+	}
+
+	var op *operation
+	for _, o := range doc.operations {
+		if operationName == "" || o.name == operationName {
+			if op != nil {
+				return nil, errors.New("more than one operation in query document and no operation name given")
+			}
+			op = o
+		}
+	}
+	if op == nil {
+		return nil, fmt.Errorf("no operation %q in query document", operationName)
+	}
+
+	root, ok := schema.EntryPoints[op.kind]
+	if !ok {
+		return nil, fmt.Errorf("no %s operations are supported", op.kind)
+	}
+	w := &methodWalker{schema: schema, fragments: doc.fragments}
+	if err := w.walk(root.TypeName(), op.selections, 0); err != nil {
+		return nil, err
+	}
+	return w.methods, nil
+}
+
+// methodWalker lists the methods of the fields of the selection sets.
+type methodWalker struct {
+	schema    *types.Schema
+	fragments map[string]*fragment
+	methods   []string
+	walked    int
+}
+
+func (w *methodWalker) walk(typeName string, selections []*selection, depth int) error {
+	if depth > maxSelectionDepth {
+		return errors.New("query is nested too deeply")
+	}
+
+	for _, sel := range selections {
+		if w.walked++; w.walked > maxSelections {
+			return errors.New("query has too many selections")
+		}
+		switch {
+		case sel.spread != "":
+			frag, ok := w.fragments[sel.spread]
+			if !ok {
+				return fmt.Errorf("unknown fragment %q", sel.spread)
+			}
+			if err := w.walk(frag.typeName, frag.selections, depth+1); err != nil {
+				return err
+			}
+		case sel.field == "":
+			// inline fragment
+			fragType := typeName
+			if sel.typeCondition != "" {
+				fragType = sel.typeCondition
+			}
+			if err := w.walk(fragType, sel.selections, depth+1); err != nil {
+				return err
+			}
+		default:
+			key := typeName + "." + sel.field
+			if key == "Query.block" && slices.Contains(sel.arguments, "hash") {
+				key = "Query.block(hash)"
+			}
+			if method := fieldMethods[key]; method != "" {
+				w.methods = append(w.methods, method)
+			}
+			if len(sel.selections) == 0 {
+				continue
+			}
+			if err := w.walk(w.fieldType(typeName, sel.field), sel.selections, depth+1); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// fieldType returns the name of the type of the field, unwrapped from the lists and non-null
+// types, or an empty one if the field is unknown, like the introspection fields.
+func (w *methodWalker) fieldType(typeName, field string) string {
+	obj, ok := w.schema.Types[typeName].(*types.ObjectTypeDefinition)
+	if !ok {
+		return ""
+	}
+	def := obj.Fields.Get(field)
+	if def == nil {
+		return ""
+	}
+	t := def.Type
+	for {
+		switch wrapped := t.(type) {
+		case *types.NonNull:
+			t = wrapped.OfType
+		case *types.List:
+			t = wrapped.OfType
+		case types.NamedType:
+			return wrapped.TypeName()
+		default:
+			return ""
+		}
+	}
+}
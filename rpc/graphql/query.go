@@ -0,0 +1,309 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package graphql
+
+import (
+	"fmt"
+	"strings"
+)
+
+// document is the outline of a GraphQL query document read by the limiter: the selection sets of
+// its operations and fragments, without the values of the arguments, variables and directives.
+// The documents are still parsed and validated by the schema before being executed.
+type document struct {
+	operations []*operation
+	fragments  map[string]*fragment
+}
+
+type operation struct {
+	kind       string // query, mutation or subscription
+	name       string
+	selections []*selection
+}
+
+type fragment struct {
+	typeName   string
+	selections []*selection
+}
+
+// selection is a field, a fragment spread or an inline fragment if neither the field nor the
+// spread are set.
+type selection struct {
+	field         string
+	arguments     []string
+	spread        string
+	typeCondition string
+	selections    []*selection
+}
+
+// token kinds of the lexer
+const (
+	tokenEOF = iota
+	tokenName
+	tokenPunct
+	tokenValue // numbers and strings
+)
+
+type token struct {
+	kind  int
+	value string
+}
+
+// parser reads the outline of a GraphQL document.
+type parser struct {
+	src string
+	pos int
+	tok token
+}
+
+// parseDocument parses the outline of the GraphQL query document.
+func parseDocument(query string) (doc *document, err error) {
+	p := &parser{src: query}
+	defer func() {
+		if r := recover(); r != nil {
+			perr, ok := r.(parseError)
+			if !ok {
+				panic(r)
+			}
+			doc, err = nil, perr
+		}
+	}()
+
+	doc = &document{fragments: make(map[string]*fragment)}
+	p.next()
+	for p.tok.kind != tokenEOF {
+		switch {
+		case p.is(tokenPunct, "{"):
+			doc.operations = append(doc.operations, &operation{kind: "query", selections: p.selectionSet()})
+		case p.is(tokenName, "fragment"):
+			p.next()
+			name := p.name()
+			p.expect(tokenName, "on")
+			frag := &fragment{typeName: p.name()}
+			p.directives()
+			frag.selections = p.selectionSet()
+			doc.fragments[name] = frag
+		case p.tok.kind == tokenName:
+			op := &operation{kind: p.tok.value}
+			if op.kind != "query" && op.kind != "mutation" && op.kind != "subscription" {
+				p.fail("unexpected %q", op.kind)
+			}
+			p.next()
+			if p.tok.kind == tokenName {
+				op.name = p.name()
+			}
+			if p.is(tokenPunct, "(") {
+				p.skipParens()
+			}
+			p.directives()
+			op.selections = p.selectionSet()
+			doc.operations = append(doc.operations, op)
+		default:
+			p.fail("unexpected %q", p.tok.value)
+		}
+	}
+	return doc, nil
+}
+
+type parseError string
+
+func (e parseError) Error() string { return string(e) }
+
+func (p *parser) fail(format string, args ...interface{}) {
+	panic(parseError("syntax error: " + fmt.Sprintf(format, args...)))
+}
+
+func (p *parser) is(kind int, value string) bool {
+	return p.tok.kind == kind && p.tok.value == value
+}
+
+func (p *parser) expect(kind int, value string) {
+	if !p.is(kind, value) {
+		p.fail("expected %q, got %q", value, p.tok.value)
+	}
+	p.next()
+}
+
+func (p *parser) name() string {
+	if p.tok.kind != tokenName {
+		p.fail("expected name, got %q", p.tok.value)
+	}
+	name := p.tok.value
+	p.next()
+	return name
+}
+
+func (p *parser) selectionSet() []*selection {
+	p.expect(tokenPunct, "{")
+	var selections []*selection
+	for !p.is(tokenPunct, "}") {
+		if p.tok.kind == tokenEOF {
+			p.fail("unexpected end of query")
+		}
+		selections = append(selections, p.selection())
+	}
+	p.next()
+	return selections
+}
+
+func (p *parser) selection() *selection {
+	sel := &selection{}
+	if p.is(tokenPunct, "...") {
+		p.next()
+		switch {
+		case p.is(tokenName, "on"):
+			p.next()
+			sel.typeCondition = p.name()
+		case p.tok.kind == tokenName:
+			sel.spread = p.name()
+			p.directives()
+			return sel
+		}
+		p.directives()
+		sel.selections = p.selectionSet()
+		return sel
+	}
+
+	sel.field = p.name()
+	if p.is(tokenPunct, ":") {
+		// the alias is followed by the field name
+		p.next()
+		sel.field = p.name()
+	}
+	if p.is(tokenPunct, "(") {
+		sel.arguments = p.skipParens()
+	}
+	p.directives()
+	if p.is(tokenPunct, "{") {
+		sel.selections = p.selectionSet()
+	}
+	return sel
+}
+
+// directives skips the directives, with their arguments.
+func (p *parser) directives() {
+	for p.is(tokenPunct, "@") {
+		p.next()
+		p.name()
+		if p.is(tokenPunct, "(") {
+			p.skipParens()
+		}
+	}
+}
+
+// skipParens skips the arguments, or variable definitions, in parentheses and returns their names.
+func (p *parser) skipParens() []string {
+	var names []string
+	depth := 0
+	for {
+		switch {
+		case p.tok.kind == tokenEOF:
+			p.fail("unexpected end of query")
+		case p.tok.kind == tokenPunct && (p.tok.value == "(" || p.tok.value == "[" || p.tok.value == "{"):
+			depth++
+		case p.tok.kind == tokenPunct && (p.tok.value == ")" || p.tok.value == "]" || p.tok.value == "}"):
+			depth--
+			if depth == 0 {
+				p.next()
+				return names
+			}
+		case p.tok.kind == tokenName && depth == 1:
+			name := p.tok.value
+			p.next()
+			if p.is(tokenPunct, ":") {
+				names = append(names, name)
+			}
+			continue
+		}
+		p.next()
+	}
+}
+
+// next reads the next token, skipping the whitespaces, commas and comments.
+func (p *parser) next() {
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == ',':
+			p.pos++
+		case c == '#':
+			for p.pos < len(p.src) && p.src[p.pos] != '\n' && p.src[p.pos] != '\r' {
+				p.pos++
+			}
+		default:
+			p.tok = p.scan()
+			return
+		}
+	}
+	p.tok = token{kind: tokenEOF}
+}
+
+func (p *parser) scan() token {
+	start := p.pos
+	c := p.src[p.pos]
+	switch {
+	case isNameStart(c):
+		for p.pos < len(p.src) && (isNameStart(p.src[p.pos]) || isDigit(p.src[p.pos])) {
+			p.pos++
+		}
+		return token{kind: tokenName, value: p.src[start:p.pos]}
+	case isDigit(c) || c == '-':
+		p.pos++
+		for p.pos < len(p.src) && (isDigit(p.src[p.pos]) || strings.IndexByte(".eE+-", p.src[p.pos]) >= 0) {
+			p.pos++
+		}
+		return token{kind: tokenValue, value: p.src[start:p.pos]}
+	case strings.HasPrefix(p.src[p.pos:], `"""`):
+		end := strings.Index(strings.ReplaceAll(p.src[p.pos+3:], `\"""`, "xxxx"), `"""`)
+		if end < 0 {
+			p.fail("unterminated string")
+		}
+		p.pos += 3 + end + 3
+		return token{kind: tokenValue, value: p.src[start:p.pos]}
+	case c == '"':
+		p.pos++
+		for p.pos < len(p.src) && p.src[p.pos] != '"' {
+			if p.src[p.pos] == '\\' {
+				p.pos++
+			}
+			if p.pos < len(p.src) && p.src[p.pos] == '\n' {
+				p.fail("unterminated string")
+			}
+			p.pos++
+		}
+		if p.pos >= len(p.src) {
+			p.fail("unterminated string")
+		}
+		p.pos++
+		return token{kind: tokenValue, value: p.src[start:p.pos]}
+	case strings.HasPrefix(p.src[p.pos:], "..."):
+		p.pos += 3
+		return token{kind: tokenPunct, value: "..."}
+	case strings.IndexByte("!$():=@[]{}|&", c) >= 0:
+		p.pos++
+		return token{kind: tokenPunct, value: string(c)}
+	}
+	p.fail("unexpected character %q", c)
+	return token{}
+}
+
+func isNameStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
@@ -0,0 +1,96 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package graphql
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"cosmossdk.io/log"
+	"github.com/graph-gophers/graphql-go"
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+)
+
+// request is the body of the GraphQL queries.
+type request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// handler serves the GraphQL queries over HTTP.
+type handler struct {
+	schema  *graphql.Schema
+	limiter Limiter
+}
+
+// NewHandler returns the HTTP handler of the GraphQL queries, resolved through the backend. The
+// fields of the queries are checked by the limiter, if any, as the equivalent JSON-RPC methods.
+func NewHandler(logger log.Logger, backend Backend, limiter Limiter) (http.Handler, error) {
+	s, err := graphql.ParseSchema(schema, &Resolver{backend: backend, logger: logger})
+	if err != nil {
+		return nil, err
+	}
+	return &handler{schema: s, limiter: limiter}, nil
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req request
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var res *graphql.Response
+	if err := h.allow(r, req); err != nil {
+		res = &graphql.Response{Errors: []*gqlerrors.QueryError{err}}
+	} else {
+		res = h.schema.Exec(r.Context(), req.Query, req.OperationName, req.Variables)
+	}
+	bz, err := json.Marshal(res)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	// like go-ethereum, the queries with errors are bad requests
+	if len(res.Errors) > 0 {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	_, _ = w.Write(bz)
+}
+
+// allow checks the methods of the fields of the query with the limiter, and returns the error
+// rejecting it, with the JSON-RPC error code as "code" extension, if any.
+func (h *handler) allow(r *http.Request, req request) *gqlerrors.QueryError {
+	if h.limiter == nil {
+		return nil
+	}
+
+	methods, err := queryMethods(h.schema.ASTSchema(), req.Query, req.OperationName)
+	if err != nil {
+		return gqlerrors.Errorf("%s", err)
+	}
+	if err := h.limiter.AllowMethods(r, methods); err != nil {
+		qerr := gqlerrors.Errorf("%s", err)
+		if rpcErr, ok := err.(interface{ ErrorCode() int }); ok {
+			qerr.Extensions = map[string]interface{}{"code": rpcErr.ErrorCode()}
+		}
+		return qerr
+	}
+	return nil
+}
@@ -0,0 +1,256 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package admin
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"cosmossdk.io/log"
+
+	"github.com/evmos/ethermint/server/config"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// enclaveHandshakeTimeout bounds the handshake made with each SGX executor to report its status.
+const enclaveHandshakeTimeout = 5 * time.Second
+
+var (
+	errNoApp       = errors.New("the application doesn't run in the same process")
+	errNoWebsocket = errors.New("the websocket server isn't managed by this node")
+)
+
+// MinGasPricesSetter is implemented by the applications able to update the minimum gas prices
+// of their mempool at runtime.
+type MinGasPricesSetter interface {
+	SetMinGasPrices(minGasPrices sdk.DecCoins)
+}
+
+// WebsocketServer is the websocket server started and stopped by the admin API.
+type WebsocketServer interface {
+	Listen(addr string) (string, error)
+	Stop() (bool, error)
+}
+
+// Node is the handle on the services of the node managed by the admin API, each one is optional.
+type Node struct {
+	App       MinGasPricesSetter
+	Websocket WebsocketServer
+}
+
+type nodeKey struct{}
+
+// ContextWithNode returns a copy of the context carrying the node handle.
+func ContextWithNode(ctx context.Context, node Node) context.Context {
+	return context.WithValue(ctx, nodeKey{}, node)
+}
+
+// NodeFromContext returns the node handle carried by the context, if any.
+func NodeFromContext(ctx context.Context) Node {
+	if ctx == nil {
+		return Node{}
+	}
+	node, _ := ctx.Value(nodeKey{}).(Node)
+	return node
+}
+
+// AppInfo is the version of the application run by the node.
+type AppInfo struct {
+	Name             string `json:"name"`
+	Version          string `json:"version"`
+	GitCommit        string `json:"commit"`
+	CosmosSdkVersion string `json:"cosmosSdkVersion"`
+	GoVersion        string `json:"go"`
+}
+
+// EnclaveStatus is the status of a SGX executor of the node.
+type EnclaveStatus struct {
+	Address   string `json:"address"`
+	Reachable bool   `json:"reachable"`
+	// EnclaveVersion is the software version reported by the enclave
+	EnclaveVersion string `json:"enclaveVersion,omitempty"`
+	// ProtocolVersion is the enclave protocol version negotiated with the node
+	ProtocolVersion uint32 `json:"protocolVersion,omitempty"`
+	Error           string `json:"error,omitempty"`
+}
+
+// NodeInfo is the result of admin_nodeInfo.
+type NodeInfo struct {
+	*coretypes.ResultStatus
+	App      AppInfo         `json:"app"`
+	Enclaves []EnclaveStatus `json:"enclaves"`
+}
+
+// API is the admin prefixed set of APIs, managing the node. It's served to the callers
+// authenticated with a JWT token only.
+type API struct {
+	ctx       *server.Context
+	logger    log.Logger
+	clientCtx client.Context
+	node      Node
+}
+
+// NewAPI creates an instance of the admin API.
+func NewAPI(ctx *server.Context, clientCtx client.Context, node Node) *API {
+	return &API{
+		ctx:       ctx,
+		logger:    ctx.Logger.With("api", "admin"),
+		clientCtx: clientCtx,
+		node:      node,
+	}
+}
+
+// NodeInfo returns the CometBFT status of the node, the version of the application and the
+// status of the SGX executors.
+func (api *API) NodeInfo(ctx context.Context) (*NodeInfo, error) {
+	api.logger.Debug("admin_nodeInfo")
+	status, err := api.clientCtx.Client.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	appConf, err := config.GetConfig(api.ctx.Viper)
+	if err != nil {
+		return nil, err
+	}
+
+	info := version.NewInfo()
+	return &NodeInfo{
+		ResultStatus: status,
+		App: AppInfo{
+			Name:             info.Name,
+			Version:          info.Version,
+			GitCommit:        info.GitCommit,
+			CosmosSdkVersion: info.CosmosSdkVersion,
+			GoVersion:        info.GoVersion,
+		},
+		Enclaves: enclaveStatuses(ctx, appConf.EVM.SgxExecutors),
+	}, nil
+}
+
+// Peers returns the peers the node is connected to.
+func (api *API) Peers(ctx context.Context) ([]coretypes.Peer, error) {
+	api.logger.Debug("admin_peers")
+	netClient, ok := api.clientCtx.Client.(tmrpcclient.NetworkClient)
+	if !ok {
+		return nil, errors.New("the client doesn't expose the network information")
+	}
+	netInfo, err := netClient.NetInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return netInfo.Peers, nil
+}
+
+// SetMinGasPrice updates the minimum gas prices of the transactions accepted in the mempool,
+// formatted like the minimum-gas-prices of the node configuration, e.g. "10aphoton". It takes
+// effect immediately, until the node restarts.
+func (api *API) SetMinGasPrice(minGasPrices string) (bool, error) {
+	api.logger.Debug("admin_setMinGasPrice", "min-gas-prices", minGasPrices)
+	if api.node.App == nil {
+		return false, errNoApp
+	}
+	prices, err := sdk.ParseDecCoins(minGasPrices)
+	if err != nil {
+		return false, err
+	}
+
+	api.node.App.SetMinGasPrices(prices)
+	api.logger.Info("minimum gas prices updated", "min-gas-prices", prices.String())
+	return true, nil
+}
+
+// StartRPC starts the websocket server on the address, the configured one if not provided, and
+// returns the address it listens on.
+func (api *API) StartRPC(addr *string) (string, error) {
+	api.logger.Debug("admin_startRPC")
+	if api.node.Websocket == nil {
+		return "", errNoWebsocket
+	}
+
+	var listenAddr string
+	if addr != nil {
+		listenAddr = *addr
+	}
+	listenAddr, err := api.node.Websocket.Listen(listenAddr)
+	if err != nil {
+		return "", err
+	}
+	api.logger.Info("websocket server started", "address", listenAddr)
+	return listenAddr, nil
+}
+
+// StopRPC stops the websocket server and closes its connections, it returns false if the server
+// isn't running.
+func (api *API) StopRPC() (bool, error) {
+	api.logger.Debug("admin_stopRPC")
+	if api.node.Websocket == nil {
+		return false, errNoWebsocket
+	}
+
+	stopped, err := api.node.Websocket.Stop()
+	if stopped {
+		api.logger.Info("websocket server stopped")
+	}
+	return stopped, err
+}
+
+// enclaveStatuses handshakes with each SGX executor to report its status.
+func enclaveStatuses(ctx context.Context, addrs []string) []EnclaveStatus {
+	statuses := make([]EnclaveStatus, len(addrs))
+	for i, addr := range addrs {
+		statuses[i] = enclaveStatus(ctx, addr)
+	}
+	return statuses
+}
+
+func enclaveStatus(ctx context.Context, addr string) EnclaveStatus {
+	status := EnclaveStatus{Address: addr}
+
+	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		status.Error = err.Error()
+		return status
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(ctx, enclaveHandshakeTimeout)
+	defer cancel()
+	res, err := evmtypes.NewEnclaveClient(conn).Handshake(ctx, evmtypes.NewEnclaveHandshakeRequest())
+	if err != nil {
+		status.Error = err.Error()
+		return status
+	}
+
+	status.Reachable = true
+	status.EnclaveVersion = res.EnclaveVersion
+	if err := res.Validate(); err != nil {
+		status.Error = err.Error()
+		return status
+	}
+	status.ProtocolVersion = res.NegotiatedVersion()
+	return status
+}
@@ -126,6 +126,14 @@ func (a *API) TraceCall(
 	return a.backend.TraceCall(args, blockNrOrHash, config)
 }
 
+// GetInternalTransactions returns the value transfers, contract creations and self-destructs
+// performed by the nested call frames of the transactions of the block. They are only available
+// when the node traces them.
+func (a *API) GetInternalTransactions(blockNrOrHash rpctypes.BlockNumberOrHash) ([]*rpctypes.RPCInternalTransaction, error) {
+	a.logger.Debug("debug_getInternalTransactions", "block number or hash", blockNrOrHash)
+	return a.backend.GetInternalTransactions(blockNrOrHash)
+}
+
 // BlockProfile turns on goroutine profiling for nsec seconds and writes profile data to
 // file. It uses a profile rate of 1 for most accurate information. If a different rate is
 // desired, set the rate and write the profile manually.
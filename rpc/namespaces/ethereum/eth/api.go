@@ -18,6 +18,8 @@ package eth
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"math/big"
 
 	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 
@@ -52,6 +54,7 @@ type EthereumAPI interface {
 	GetBlockByHash(hash common.Hash, fullTx bool) (map[string]interface{}, error)
 	GetBlockTransactionCountByHash(hash common.Hash) *hexutil.Uint
 	GetBlockTransactionCountByNumber(blockNum rpctypes.BlockNumber) *hexutil.Uint
+	GetBlobSidecars(blockNrOrHash rpctypes.BlockNumberOrHash) ([]*rpctypes.RPCBlobSidecar, error)
 
 	// Reading Transactions
 	//
@@ -92,10 +95,14 @@ type EthereumAPI interface {
 	//
 	// Returns information on the Ethereum network and internal settings.
 	ProtocolVersion() hexutil.Uint
-	GasPrice() (*hexutil.Big, error)
+	GasPrice(denom *string) (*hexutil.Big, error)
+	FeeTokenGasPrices() ([]rpctypes.FeeTokenGasPrice, error)
 	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error)
+	EstimateFee(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (*rpctypes.FeeEstimate, error)
 	FeeHistory(blockCount math.HexOrDecimal64, lastBlock rpc.BlockNumber, rewardPercentiles []float64) (*rpctypes.FeeHistoryResult, error)
 	MaxPriorityFeePerGas() (*hexutil.Big, error)
+	BlobBaseFee() (*hexutil.Big, error)
+	Config() (*rpctypes.EthConfig, error)
 	ChainId() (*hexutil.Big, error)
 
 	// Getting Uncles
@@ -210,6 +217,13 @@ func (e *PublicAPI) GetBlockTransactionCountByNumber(blockNum rpctypes.BlockNumb
 	return e.backend.GetBlockTransactionCountByNumber(blockNum)
 }
 
+// GetBlobSidecars returns the sidecars of the blob transactions included in the
+// block identified by number or hash.
+func (e *PublicAPI) GetBlobSidecars(blockNrOrHash rpctypes.BlockNumberOrHash) ([]*rpctypes.RPCBlobSidecar, error) {
+	e.logger.Debug("eth_getBlobSidecars", "block number or hash", blockNrOrHash)
+	return e.backend.GetBlobSidecars(blockNrOrHash)
+}
+
 // GetTransactionByBlockHashAndIndex returns the transaction identified by hash and index.
 func (e *PublicAPI) GetTransactionByBlockHashAndIndex(hash common.Hash, idx hexutil.Uint) (*rpctypes.RPCTransaction, error) {
 	e.logger.Debug("eth_getTransactionByBlockHashAndIndex", "hash", hash.Hex(), "index", idx)
@@ -313,10 +327,29 @@ func (e *PublicAPI) ProtocolVersion() hexutil.Uint {
 	return hexutil.Uint(ethermint.ProtocolVersion)
 }
 
-// GasPrice returns the current gas price based on Ethermint's gas price oracle.
-func (e *PublicAPI) GasPrice() (*hexutil.Big, error) {
-	e.logger.Debug("eth_gasPrice")
-	return e.backend.GasPrice()
+// GasPrice returns the current gas price based on Ethermint's gas price oracle, in the
+// given fee token if any.
+func (e *PublicAPI) GasPrice(denom *string) (*hexutil.Big, error) {
+	e.logger.Debug("eth_gasPrice", "denom", denom)
+	if denom == nil {
+		return e.backend.GasPrice()
+	}
+	prices, err := e.backend.FeeTokenGasPrices()
+	if err != nil {
+		return nil, err
+	}
+	for _, price := range prices {
+		if price.Denom == *denom {
+			return price.GasPrice, nil
+		}
+	}
+	return nil, fmt.Errorf("denom %s can't pay for gas", *denom)
+}
+
+// FeeTokenGasPrices returns the current gas price in each denom accepted for the gas.
+func (e *PublicAPI) FeeTokenGasPrices() ([]rpctypes.FeeTokenGasPrice, error) {
+	e.logger.Debug("eth_feeTokenGasPrices")
+	return e.backend.FeeTokenGasPrices()
 }
 
 // EstimateGas returns an estimate of gas usage for the given smart contract call.
@@ -325,6 +358,25 @@ func (e *PublicAPI) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *
 	return e.backend.EstimateGas(args, blockNrOptional)
 }
 
+// EstimateFee returns an estimate of gas usage for the given smart contract call, and
+// its fee at the current gas price in each denom accepted for the gas.
+func (e *PublicAPI) EstimateFee(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (*rpctypes.FeeEstimate, error) {
+	e.logger.Debug("eth_estimateFee")
+	gas, err := e.backend.EstimateGas(args, blockNrOptional)
+	if err != nil {
+		return nil, err
+	}
+	prices, err := e.backend.FeeTokenGasPrices()
+	if err != nil {
+		return nil, err
+	}
+	for i := range prices {
+		fee := new(big.Int).Mul(prices[i].GasPrice.ToInt(), new(big.Int).SetUint64(uint64(gas)))
+		prices[i].Fee = (*hexutil.Big)(fee)
+	}
+	return &rpctypes.FeeEstimate{Gas: gas, Fees: prices}, nil
+}
+
 func (e *PublicAPI) FeeHistory(blockCount math.HexOrDecimal64,
 	lastBlock rpc.BlockNumber,
 	rewardPercentiles []float64,
@@ -347,6 +399,18 @@ func (e *PublicAPI) MaxPriorityFeePerGas() (*hexutil.Big, error) {
 	return (*hexutil.Big)(tipcap), nil
 }
 
+// Config returns the hard fork active at the latest block and the next scheduled one.
+func (e *PublicAPI) Config() (*rpctypes.EthConfig, error) {
+	e.logger.Debug("eth_config")
+	return e.backend.EthConfig()
+}
+
+// BlobBaseFee returns the blob base fee of the next block.
+func (e *PublicAPI) BlobBaseFee() (*hexutil.Big, error) {
+	e.logger.Debug("eth_blobBaseFee")
+	return e.backend.BlobBaseFee()
+}
+
 // ChainId is the EIP-155 replay-protection chain id for the current ethereum chain config.
 func (e *PublicAPI) ChainId() (*hexutil.Big, error) { //nolint
 	e.logger.Debug("eth_chainId")
@@ -476,17 +540,28 @@ func (e *PublicAPI) FillTransaction(args evmtypes.TransactionArgs) (*rpctypes.Si
 	}
 
 	// Assemble the transaction and obtain rlp
-	tx := args.ToTransaction().AsTransaction()
+	msg := args.ToTransaction()
 
-	data, err := tx.MarshalBinary()
+	data, err := msg.MarshalBinary()
 	if err != nil {
 		return nil, err
 	}
 
-	return &rpctypes.SignTransactionResult{
+	result := &rpctypes.SignTransactionResult{
 		Raw: data,
-		Tx:  tx,
-	}, nil
+		Tx:  msg.AsTransaction(),
+	}
+	if msg.TxType() == evmtypes.SetCodeTxType {
+		chainID, err := e.backend.ChainID()
+		if err != nil {
+			return nil, err
+		}
+		result.Tx, err = rpctypes.NewTransactionFromMsg(msg, common.Hash{}, 0, 0, nil, chainID.ToInt())
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
 }
 
 // Resend accepts an existing transaction and a new gas price and limit. It will remove
@@ -56,6 +56,7 @@ type Backend interface {
 	TendermintBlockResultByNumber(height *int64) (*coretypes.ResultBlockResults, error)
 	GetLogs(blockHash common.Hash) ([][]*ethtypes.Log, error)
 	GetLogsByHeight(*int64) ([][]*ethtypes.Log, error)
+	GetLogsByBlockResult(blockRes *coretypes.ResultBlockResults) ([][]*ethtypes.Log, error)
 	BlockBloom(blockRes *coretypes.ResultBlockResults) (ethtypes.Bloom, error)
 
 	BloomStatus() (uint64, uint64)
@@ -320,8 +321,14 @@ func (api *PublicFilterAPI) GetFilterChanges(id rpc.ID) (interface{}, error) {
 
 	switch f.typ {
 	case filters.PendingTransactionsSubscription:
-		var hashes []common.Hash
-		hashes, f.offset = api.events.TxStream().ReadAllNonBlocking(f.offset)
+		var (
+			txs    []stream.RPCTx
+			hashes []common.Hash
+		)
+		txs, f.offset = api.events.TxStream().ReadAllNonBlocking(f.offset)
+		for _, tx := range txs {
+			hashes = append(hashes, tx.Hash)
+		}
 		return returnHashes(hashes), nil
 	case filters.BlocksSubscription:
 		var headers []stream.RPCHeader
@@ -21,7 +21,6 @@ import (
 	"fmt"
 	"math/big"
 
-	"github.com/evmos/ethermint/rpc/backend"
 	"github.com/evmos/ethermint/rpc/types"
 
 	"cosmossdk.io/log"
@@ -193,13 +192,15 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 	return logs, nil
 }
 
-// blockLogs returns the logs matching the filter criteria within a single block.
+// blockLogs returns the logs matching the filter criteria within a single block. The logs
+// are only decoded, or taken from the cache of the backend, if the bloom of the block
+// matches.
 func (f *Filter) blockLogs(blockRes *tmrpctypes.ResultBlockResults, bloom ethtypes.Bloom) ([]*ethtypes.Log, error) {
 	if !bloomFilter(bloom, f.criteria.Addresses, f.criteria.Topics) {
 		return []*ethtypes.Log{}, nil
 	}
 
-	logsList, err := backend.GetLogsFromBlockResults(blockRes)
+	logsList, err := f.backend.GetLogsByBlockResult(blockRes)
 	if err != nil {
 		return []*ethtypes.Log{}, errors.Wrapf(err, "failed to fetch logs block number %d", blockRes.Height)
 	}
@@ -17,20 +17,16 @@ package personal
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
-	"os"
+	"math"
 	"time"
 
 	"github.com/evmos/ethermint/rpc/backend"
 
-	"github.com/evmos/ethermint/crypto/hd"
-	ethermint "github.com/evmos/ethermint/types"
-
 	"cosmossdk.io/log"
 
-	"github.com/cosmos/cosmos-sdk/crypto/keyring"
-	sdk "github.com/cosmos/cosmos-sdk/types"
-
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -41,9 +37,8 @@ import (
 
 // PrivateAccountAPI is the personal_ prefixed set of APIs in the Web3 JSON-RPC spec.
 type PrivateAccountAPI struct {
-	backend    backend.EVMBackend
-	logger     log.Logger
-	hdPathIter ethermint.HDPathIterator
+	backend backend.EVMBackend
+	logger  log.Logger
 }
 
 // NewAPI creates an instance of the public Personal Eth API.
@@ -51,26 +46,17 @@ func NewAPI(
 	logger log.Logger,
 	backend backend.EVMBackend,
 ) *PrivateAccountAPI {
-	cfg := sdk.GetConfig()
-	basePath := cfg.GetFullBIP44Path()
-
-	iterator, err := ethermint.NewHDPathIterator(basePath, true)
-	if err != nil {
-		panic(err)
-	}
-
 	return &PrivateAccountAPI{
-		logger:     logger.With("api", "personal"),
-		hdPathIter: iterator,
-		backend:    backend,
+		logger:  logger.With("api", "personal"),
+		backend: backend,
 	}
 }
 
-// ImportRawKey armors and encrypts a given raw hex encoded ECDSA key and stores it into the key directory.
-// The name of the key will have the format "personal_<length-keys>", where <length-keys> is the total number of
-// keys stored on the keyring.
-//
-// NOTE: The key will be both armored and encrypted using the same passphrase.
+// defaultUnlockDuration is the time an account stays unlocked if no duration is provided.
+const defaultUnlockDuration = 300 * time.Second
+
+// ImportRawKey stores the given raw hex encoded ECDSA key into the keystore, encrypted with the
+// password.
 func (api *PrivateAccountAPI) ImportRawKey(privkey, password string) (common.Address, error) {
 	api.logger.Debug("personal_importRawKey")
 	return api.backend.ImportRawKey(privkey, password)
@@ -86,51 +72,67 @@ func (api *PrivateAccountAPI) ListAccounts() ([]common.Address, error) {
 // It removes the key corresponding to the given address from the API's local keys.
 func (api *PrivateAccountAPI) LockAccount(address common.Address) bool {
 	api.logger.Debug("personal_lockAccount", "address", address.String())
-	api.logger.Info("personal_lockAccount not supported")
-	// TODO: Not supported. See underlying issue  https://github.com/99designs/keyring/issues/85
-	return false
+	return api.backend.LockAccount(address) == nil
 }
 
-// NewAccount will create a new account and returns the address for the new account.
+// NewAccount will create a new account in the keystore, encrypted with the password, and
+// returns the address for the new account.
 func (api *PrivateAccountAPI) NewAccount(password string) (common.Address, error) {
 	api.logger.Debug("personal_newAccount")
+	return api.backend.NewAccount(password)
+}
 
-	name := "key_" + time.Now().UTC().Format(time.RFC3339)
-
-	// create the mnemonic and save the account
-	hdPath := api.hdPathIter()
+// ImportKeystore imports a key from its Web3 Secret Storage (keystore v3) JSON, decrypted with
+// the password, into the keystore, encrypted with the new password.
+func (api *PrivateAccountAPI) ImportKeystore(keyJSON json.RawMessage, password, newPassword string) (common.Address, error) {
+	api.logger.Debug("personal_importKeystore")
 
-	info, err := api.backend.NewMnemonic(name, keyring.English, hdPath.String(), password, hd.EthSecp256k1)
-	if err != nil {
-		return common.Address{}, err
+	// the JSON can also be provided as a string
+	var str string
+	if err := json.Unmarshal(keyJSON, &str); err == nil {
+		keyJSON = json.RawMessage(str)
 	}
+	return api.backend.ImportKeystore(keyJSON, password, newPassword)
+}
 
-	pubKey, err := info.GetPubKey()
-	if err != nil {
-		return common.Address{}, err
-	}
-	addr := common.BytesToAddress(pubKey.Address().Bytes())
-	api.logger.Info("Your new key was generated", "address", addr.String())
-	api.logger.Info("Please backup your key file!", "path", os.Getenv("HOME")+"/.ethermint/"+name) // TODO: pass the correct binary
-	api.logger.Info("Please remember your password!")
-	return addr, nil
+// ExportKeystore exports the key of a keystore account, decrypted with the password, as Web3
+// Secret Storage (keystore v3) JSON, encrypted with the new password.
+func (api *PrivateAccountAPI) ExportKeystore(addr common.Address, password, newPassword string) (json.RawMessage, error) {
+	api.logger.Debug("personal_exportKeystore", "address", addr.String())
+	return api.backend.ExportKeystore(addr, password, newPassword)
 }
 
 // UnlockAccount will unlock the account associated with the given address with
 // the given password for duration seconds. If duration is nil it will use a
-// default of 300 seconds. It returns an indication if the account was unlocked.
-func (api *PrivateAccountAPI) UnlockAccount(_ context.Context, addr common.Address, _ string, _ *uint64) (bool, error) {
+// default of 300 seconds, zero unlocks the account until the node stops. The keys
+// of the keyring are always unlocked and require an empty password. It returns an
+// indication if the account was unlocked.
+func (api *PrivateAccountAPI) UnlockAccount(_ context.Context, addr common.Address, password string, duration *uint64) (bool, error) {
 	api.logger.Debug("personal_unlockAccount", "address", addr.String())
-	// TODO: Not supported. See underlying issue  https://github.com/99designs/keyring/issues/85
-	return false, nil
+
+	const maxDuration = uint64(math.MaxInt64 / int64(time.Second))
+	d := defaultUnlockDuration
+	if duration != nil {
+		if *duration > maxDuration {
+			return false, errors.New("unlock duration too large")
+		}
+		d = time.Duration(*duration) * time.Second
+	}
+
+	if err := api.backend.UnlockAccount(addr, password, d); err != nil {
+		api.logger.Debug("failed to unlock account", "address", addr.String(), "error", err.Error())
+		return false, err
+	}
+	return true, nil
 }
 
 // SendTransaction will create a transaction from the given arguments and
-// tries to sign it with the key associated with args.To. If the given password isn't
-// able to decrypt the key it fails.
-func (api *PrivateAccountAPI) SendTransaction(_ context.Context, args evmtypes.TransactionArgs, _ string) (common.Hash, error) {
-	api.logger.Debug("personal_sendTransaction", "address", args.To.String())
-	return api.backend.SendTransaction(args)
+// tries to sign it with the key associated with args.From. If the given password isn't
+// able to decrypt the key of a keystore account it fails, the keys of the keyring require
+// an empty password.
+func (api *PrivateAccountAPI) SendTransaction(_ context.Context, args evmtypes.TransactionArgs, password string) (common.Hash, error) {
+	api.logger.Debug("personal_sendTransaction", "address", args.GetFrom().String())
+	return api.backend.SendTransactionWithPassphrase(args, password)
 }
 
 // Sign calculates an Ethereum ECDSA signature for:
@@ -139,12 +141,13 @@ func (api *PrivateAccountAPI) SendTransaction(_ context.Context, args evmtypes.T
 // Note, the produced signature conforms to the secp256k1 curve R, S and V values,
 // where the V value will be 27 or 28 for legacy reasons.
 //
-// The key used to calculate the signature is decrypted with the given password.
+// The key used to calculate the signature is decrypted with the given password, the keys
+// of the keyring require an empty password.
 //
 // https://github.com/ethereum/go-ethereum/wiki/Management-APIs#personal_sign
-func (api *PrivateAccountAPI) Sign(_ context.Context, data hexutil.Bytes, addr common.Address, _ string) (hexutil.Bytes, error) {
+func (api *PrivateAccountAPI) Sign(_ context.Context, data hexutil.Bytes, addr common.Address, password string) (hexutil.Bytes, error) {
 	api.logger.Debug("personal_sign", "data", data, "address", addr.String())
-	return api.backend.Sign(addr, data)
+	return api.backend.SignWithPassphrase(addr, data, password)
 }
 
 // EcRecover returns the address for the account that was used to create the signature.
@@ -203,9 +206,22 @@ type RawWallet struct {
 	Accounts []accounts.Account `json:"accounts,omitempty"`
 }
 
-// ListWallets will return a list of wallets this node manages.
+// ListWallets will return a list of wallets this node manages, the keystore accounts.
 func (api *PrivateAccountAPI) ListWallets() []RawWallet {
 	api.logger.Debug("personal_ListWallets")
-	api.logger.Info("currently wallet level that manages accounts is not supported")
-	return ([]RawWallet)(nil)
+	wallets := make([]RawWallet, 0) // return [] instead of nil if empty
+	for _, wallet := range api.backend.Wallets() {
+		status, failure := wallet.Status()
+
+		raw := RawWallet{
+			URL:      wallet.URL().String(),
+			Status:   status,
+			Accounts: wallet.Accounts(),
+		}
+		if failure != nil {
+			raw.Failure = failure.Error()
+		}
+		wallets = append(wallets, raw)
+	}
+	return wallets
 }
@@ -16,51 +16,129 @@
 package txpool
 
 import (
+	"fmt"
+
 	"cosmossdk.io/log"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 
+	"github.com/evmos/ethermint/rpc/backend"
 	"github.com/evmos/ethermint/rpc/types"
 )
 
 // PublicAPI offers and API for the transaction pool. It only operates on data that is non-confidential.
-// NOTE: For more info about the current status of this endpoints see https://github.com/evmos/ethermint/issues/124
+// Only the Ethereum transactions of the mempool are listed, with their priority.
 type PublicAPI struct {
-	logger log.Logger
+	logger  log.Logger
+	backend backend.EVMBackend
 }
 
 // NewPublicAPI creates a new tx pool service that gives information about the transaction pool.
-func NewPublicAPI(logger log.Logger) *PublicAPI {
+func NewPublicAPI(logger log.Logger, backend backend.EVMBackend) *PublicAPI {
 	return &PublicAPI{
-		logger: logger.With("module", "txpool"),
+		logger:  logger.With("module", "txpool"),
+		backend: backend,
 	}
 }
 
 // Content returns the transactions contained within the transaction pool
-func (api *PublicAPI) Content() (map[string]map[string]map[string]*types.RPCTransaction, error) {
+func (api *PublicAPI) Content() (map[string]map[string]map[string]*types.RPCPoolTransaction, error) {
 	api.logger.Debug("txpool_content")
-	content := map[string]map[string]map[string]*types.RPCTransaction{
-		"pending": make(map[string]map[string]*types.RPCTransaction),
-		"queued":  make(map[string]map[string]*types.RPCTransaction),
+	pool, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
+	content := map[string]map[string]map[string]*types.RPCPoolTransaction{
+		"pending": formatContent(pool.Pending, func(tx *types.RPCPoolTransaction) *types.RPCPoolTransaction { return tx }),
+		"queued":  formatContent(pool.Queued, func(tx *types.RPCPoolTransaction) *types.RPCPoolTransaction { return tx }),
+	}
+	return content, nil
+}
+
+// ContentFrom returns the transactions contained within the transaction pool from the given address
+func (api *PublicAPI) ContentFrom(address common.Address) (map[string]map[string]*types.RPCPoolTransaction, error) {
+	api.logger.Debug("txpool_contentFrom", "address", address)
+	pool, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
+	content := map[string]map[string]*types.RPCPoolTransaction{
+		"pending": make(map[string]*types.RPCPoolTransaction),
+		"queued":  make(map[string]*types.RPCPoolTransaction),
+	}
+	for nonce, tx := range pool.Pending[address] {
+		content["pending"][fmt.Sprintf("%d", nonce)] = tx
+	}
+	for nonce, tx := range pool.Queued[address] {
+		content["queued"][fmt.Sprintf("%d", nonce)] = tx
 	}
 	return content, nil
 }
 
 // Inspect returns the content of the transaction pool and flattens it into an
+// easily inspectable list.
 func (api *PublicAPI) Inspect() (map[string]map[string]map[string]string, error) {
 	api.logger.Debug("txpool_inspect")
+	pool, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
 	content := map[string]map[string]map[string]string{
-		"pending": make(map[string]map[string]string),
-		"queued":  make(map[string]map[string]string),
+		"pending": formatContent(pool.Pending, inspect),
+		"queued":  formatContent(pool.Queued, inspect),
 	}
 	return content, nil
 }
 
 // Status returns the number of pending and queued transaction in the pool.
-func (api *PublicAPI) Status() map[string]hexutil.Uint {
+func (api *PublicAPI) Status() (map[string]hexutil.Uint, error) {
 	api.logger.Debug("txpool_status")
+	pool, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
+	var pending, queued int
+	for _, txs := range pool.Pending {
+		pending += len(txs)
+	}
+	for _, txs := range pool.Queued {
+		queued += len(txs)
+	}
 	return map[string]hexutil.Uint{
-		"pending": hexutil.Uint(0),
-		"queued":  hexutil.Uint(0),
+		"pending": hexutil.Uint(pending),
+		"queued":  hexutil.Uint(queued),
+	}, nil
+}
+
+// formatContent keys the transactions by sender address and nonce strings, like geth.
+func formatContent[T any](
+	txs map[common.Address]map[uint64]*types.RPCPoolTransaction,
+	format func(*types.RPCPoolTransaction) T,
+) map[string]map[string]T {
+	content := make(map[string]map[string]T, len(txs))
+	for from, senderTxs := range txs {
+		dump := make(map[string]T, len(senderTxs))
+		for nonce, tx := range senderTxs {
+			dump[fmt.Sprintf("%d", nonce)] = format(tx)
+		}
+		content[from.Hex()] = dump
+	}
+	return content
+}
+
+// inspect summarizes a transaction, like geth, with its priority.
+func inspect(tx *types.RPCPoolTransaction) string {
+	to := "contract creation"
+	if tx.To != nil {
+		to = tx.To.Hex()
 	}
+	return fmt.Sprintf(
+		"%s: %v wei + %v gas × %v wei, priority %d",
+		to, tx.Value.ToInt(), uint64(tx.Gas), tx.GasPrice.ToInt(), uint64(tx.Priority),
+	)
 }
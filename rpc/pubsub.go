@@ -0,0 +1,138 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package rpc
+
+import (
+	"context"
+
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/client"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	rpcfilters "github.com/evmos/ethermint/rpc/namespaces/ethereum/eth/filters"
+	"github.com/evmos/ethermint/rpc/stream"
+)
+
+// PubSubService serves the eth_subscribe subscriptions on the go-ethereum RPC server, to the
+// transports supporting notifications like IPC. The websocket server has its own
+// implementation, whose subscriptions can be resumed.
+type PubSubService struct {
+	api *pubSubAPI
+}
+
+// NewPubSubService creates the PubSubService, to be registered in the eth namespace.
+func NewPubSubService(clientCtx client.Context, logger log.Logger, stream *stream.RPCStream) *PubSubService {
+	return &PubSubService{api: newPubSubAPI(clientCtx, logger, stream, false)}
+}
+
+// NewHeads notifies the headers of the new blocks.
+func (s *PubSubService) NewHeads(ctx context.Context) (*rpc.Subscription, error) {
+	return notifyStream(ctx, s.api, s.api.events.HeaderStream(), func(header stream.RPCHeader) (interface{}, bool) {
+		return header.EthHeader, true
+	})
+}
+
+// Logs notifies the logs of the new blocks matching the criteria.
+func (s *PubSubService) Logs(ctx context.Context, crit *filters.FilterCriteria) (*rpc.Subscription, error) {
+	if crit == nil {
+		crit = &filters.FilterCriteria{}
+	}
+	return notifyStream(ctx, s.api, s.api.events.LogStream(), func(ethLog *ethtypes.Log) (interface{}, bool) {
+		logs := rpcfilters.FilterLogs([]*ethtypes.Log{ethLog}, crit.FromBlock, crit.ToBlock, crit.Addresses, crit.Topics)
+		return ethLog, len(logs) > 0
+	})
+}
+
+// NewPendingTransactions notifies the hashes of the transactions entering the mempool, or the
+// full transactions if fullTx is true.
+func (s *PubSubService) NewPendingTransactions(ctx context.Context, fullTx *bool) (*rpc.Subscription, error) {
+	result, err := s.api.pendingTxResult(fullTx != nil && *fullTx)
+	if err != nil {
+		return nil, err
+	}
+	return notifyStream(ctx, s.api, s.api.events.TxStream(), result)
+}
+
+// Syncing notifies the sync status of the node.
+func (s *PubSubService) Syncing(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return nil, rpc.ErrNotificationsUnsupported
+	}
+
+	sub := notifier.CreateSubscription()
+	s.api.watchSyncing(subscriptionContext(sub), func(res interface{}) error {
+		return notifier.Notify(sub.ID, res)
+	})
+	return sub, nil
+}
+
+// InternalTransactions notifies the internal transactions of the new blocks matching the
+// criteria, if the node traces them.
+func (s *PubSubService) InternalTransactions(ctx context.Context, crit *internalTxsCriteria) (*rpc.Subscription, error) {
+	if crit == nil {
+		crit = &internalTxsCriteria{}
+	}
+	return notifyStream(ctx, s.api, s.api.events.InternalTxStream(), crit.result)
+}
+
+// notifyStream notifies the new items of the stream to a subscription of the connection of the
+// context, in a new goroutine. The result function returns the notification result of an item,
+// if any.
+func notifyStream[V any](
+	ctx context.Context,
+	api *pubSubAPI,
+	s *stream.Stream[V],
+	result func(V) (interface{}, bool),
+) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return nil, rpc.ErrNotificationsUnsupported
+	}
+
+	sub := notifier.CreateSubscription()
+	//nolint: errcheck
+	go s.SubscribeFrom(subscriptionContext(sub), -1, func(items []V, _ int) error {
+		for _, item := range items {
+			res, ok := result(item)
+			if !ok {
+				continue
+			}
+			if err := notifier.Notify(sub.ID, res); err != nil {
+				api.logger.Debug("error notifying subscription", "subscription", sub.ID, "error", err.Error())
+				return err
+			}
+		}
+		return nil
+	}, func(missed int) error {
+		api.logger.Debug("subscriber fell behind the stream", "subscription", sub.ID, "missed", missed)
+		return nil
+	})
+	return sub, nil
+}
+
+// subscriptionContext returns a context canceled when the subscription is unsubscribed or its
+// connection is closed.
+func subscriptionContext(sub *rpc.Subscription) context.Context {
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-sub.Err()
+		cancel()
+	}()
+	return ctx
+}
@@ -0,0 +1,469 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package ratelimit
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"golang.org/x/time/rate"
+
+	"github.com/ethereum/go-ethereum/metrics"
+
+	"github.com/evmos/ethermint/server/config"
+)
+
+const (
+	// APIKeyHeader is the HTTP header carrying the API key of a client
+	APIKeyHeader = "X-API-Key"
+
+	// forwardedHeader carries the token of the requests forwarded by the websocket server, which
+	// are limited on the websocket connection instead
+	forwardedHeader = "X-Ethermint-Forwarded"
+	// authenticatedHeader carries the token of the websocket connections authenticated on their
+	// handshake, whose admin calls don't need a JWT token of their own
+	authenticatedHeader = "X-Ethermint-Authenticated"
+
+	// adminNamespace is the namespace of the methods requiring a JWT token
+	adminNamespace = "admin"
+	// jwtExpiryTimeout is the maximum drift between the issuance of a JWT token and its use, same
+	// as the go-ethereum engine API
+	jwtExpiryTimeout = 60 * time.Second
+
+	// ErrCodeUnauthorized is the JSON-RPC error code of the requests with an unknown API key, or
+	// of the admin calls without a valid JWT token
+	ErrCodeUnauthorized = -32001
+	// ErrCodeMethodNotAllowed is the JSON-RPC error code of the denied methods, "method not
+	// supported" in EIP-1474
+	ErrCodeMethodNotAllowed = -32004
+	// ErrCodeLimitExceeded is the JSON-RPC error code of the rate limited requests, "limit
+	// exceeded" in EIP-1474
+	ErrCodeLimitExceeded = -32005
+
+	// maxRequestContentLength is the max size of the requests read by the limiter, same as the
+	// go-ethereum HTTP server
+	maxRequestContentLength = 5 * 1024 * 1024
+
+	// idleTimeout is the time after which the bucket of an inactive client is dropped
+	idleTimeout = 10 * time.Minute
+)
+
+var (
+	unauthorizedCounter = metrics.NewRegisteredCounter("rpc/ratelimit/unauthorized", nil)
+	deniedCounter       = metrics.NewRegisteredCounter("rpc/ratelimit/denied", nil)
+	limitedCounter      = metrics.NewRegisteredCounter("rpc/ratelimit/limited", nil)
+
+	errUnauthorized  = &Error{code: ErrCodeUnauthorized, message: "invalid API key"}
+	errMissingJWT    = &Error{code: ErrCodeUnauthorized, message: "missing JWT token"}
+	errLimitExceeded = &Error{code: ErrCodeLimitExceeded, message: "rate limit exceeded"}
+)
+
+// Error is the JSON-RPC error of a rejected request.
+type Error struct {
+	code    int
+	message string
+}
+
+func (e *Error) Error() string { return e.message }
+
+// ErrorCode returns the JSON-RPC error code.
+func (e *Error) ErrorCode() int { return e.code }
+
+// bucket is the token bucket of a client.
+type bucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// Limiter rejects the JSON-RPC requests of unknown API keys, of denied methods and the admin
+// calls without a valid JWT token, and limits the requests of each client IP, or API key, with a
+// token bucket where each method call costs its weight.
+type Limiter struct {
+	enabled bool
+
+	rate      rate.Limit
+	burst     int
+	keyRate   rate.Limit
+	keyBurst  int
+	apiKeys   map[string]bool
+	weights   map[string]int
+	allowed   []string
+	denied    []string
+	namespace map[string]bool // namespaces restricted by the allowed methods
+	jwtSecret []byte
+	token     string
+
+	mtx       sync.Mutex
+	buckets   map[string]*bucket
+	lastPrune time.Time
+}
+
+// NewLimiter returns a Limiter applying the rate limits, API keys, method lists and JWT secret
+// of the JSON-RPC configuration. The path of the JWT secret must be resolved by the caller.
+func NewLimiter(cfg config.JSONRPCConfig) (*Limiter, error) {
+	weights, err := config.ParseMethodWeights(cfg.MethodWeights)
+	if err != nil {
+		return nil, err
+	}
+
+	var jwtSecret []byte
+	if cfg.AuthJWTSecret != "" {
+		if jwtSecret, err = ReadJWTSecret(cfg.AuthJWTSecret); err != nil {
+			return nil, err
+		}
+	}
+
+	token := make([]byte, 32)
+	if _, err := rand.Read(token); err != nil {
+		return nil, err
+	}
+
+	l := &Limiter{
+		enabled: cfg.RateLimit > 0 || cfg.APIKeyRateLimit > 0 || len(cfg.APIKeys) > 0 ||
+			len(cfg.AllowedMethods) > 0 || len(cfg.DeniedMethods) > 0 || len(jwtSecret) > 0,
+		rate:      rate.Limit(cfg.RateLimit),
+		burst:     cfg.RateLimitBurst,
+		keyRate:   rate.Limit(cfg.APIKeyRateLimit),
+		keyBurst:  cfg.APIKeyRateLimitBurst,
+		apiKeys:   make(map[string]bool, len(cfg.APIKeys)),
+		weights:   weights,
+		allowed:   cfg.AllowedMethods,
+		denied:    cfg.DeniedMethods,
+		namespace: make(map[string]bool),
+		jwtSecret: jwtSecret,
+		token:     hex.EncodeToString(token),
+		buckets:   make(map[string]*bucket),
+	}
+	for _, key := range cfg.APIKeys {
+		l.apiKeys[key] = true
+	}
+	for _, pattern := range cfg.AllowedMethods {
+		l.namespace[namespaceOf(pattern)] = true
+	}
+	return l, nil
+}
+
+// Handler returns the handler applying the limiter to the requests of the next one.
+func (l *Limiter) Handler(next http.Handler) http.Handler {
+	if !l.enabled {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestContentLength+1))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		// the oversized requests are rejected by the next handler
+		r.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body), r.Body))
+
+		if err := l.Allow(r, body); err != nil {
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(ErrorResponse(body, err))
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// MarkForwarded marks a request forwarded on behalf of a client already checked by the limiter.
+func (l *Limiter) MarkForwarded(r *http.Request) {
+	r.Header.Set(forwardedHeader, l.token)
+}
+
+// Enabled checks if the limiter checks the requests, otherwise they're all allowed.
+func (l *Limiter) Enabled() bool {
+	return l.enabled
+}
+
+// Allow checks the JSON-RPC request, or batch, in the body sent by the client of the HTTP
+// request, and returns the Error rejecting it, if any. A request never costs more than the
+// burst of its client.
+func (l *Limiter) Allow(r *http.Request, body []byte) error {
+	calls, _ := parseCalls(body)
+	methods := make([]string, len(calls))
+	for i, call := range calls {
+		methods[i] = call.Method
+	}
+	return l.AllowMethods(r, methods)
+}
+
+// AllowMethods checks the calls of the methods sent at once by the client of the HTTP request,
+// like the fields of a GraphQL query, and returns the Error rejecting them, if any.
+func (l *Limiter) AllowMethods(r *http.Request, methods []string) error {
+	if !l.enabled || subtle.ConstantTimeCompare([]byte(r.Header.Get(forwardedHeader)), []byte(l.token)) == 1 {
+		return nil
+	}
+
+	client, limit, burst := "ip:"+clientIP(r), l.rate, l.burst
+	if key := r.Header.Get(APIKeyHeader); key != "" {
+		if !l.apiKeys[key] {
+			unauthorizedCounter.Inc(1)
+			return errUnauthorized
+		}
+		client, limit, burst = "key:"+key, l.keyRate, l.keyBurst
+	}
+
+	// invalid requests are rejected by the server, but still cost one
+	cost := 1
+	if len(methods) > 0 {
+		cost = 0
+	}
+	authenticated := false
+	for _, method := range methods {
+		if !l.methodAllowed(method) {
+			deniedCounter.Inc(1)
+			return &Error{code: ErrCodeMethodNotAllowed, message: fmt.Sprintf("method %s is not allowed", method)}
+		}
+		if namespaceOf(method) == adminNamespace && !authenticated {
+			if err := l.Authenticate(r); err != nil {
+				unauthorizedCounter.Inc(1)
+				return err
+			}
+			authenticated = true
+		}
+		cost += l.weight(method)
+	}
+
+	if limit == 0 {
+		return nil
+	}
+	if !l.take(client, limit, burst, min(cost, burst)) {
+		limitedCounter.Inc(1)
+		return errLimitExceeded
+	}
+	return nil
+}
+
+// Authenticate checks the JWT token of the "Authorization: Bearer" header of the request, which
+// must be signed with the HS256 algorithm and the JWT secret, and issued within the last minute,
+// like the go-ethereum engine API.
+func (l *Limiter) Authenticate(r *http.Request) error {
+	if subtle.ConstantTimeCompare([]byte(r.Header.Get(authenticatedHeader)), []byte(l.token)) == 1 {
+		return nil
+	}
+	if len(l.jwtSecret) == 0 {
+		return &Error{code: ErrCodeUnauthorized, message: "no JWT secret configured"}
+	}
+
+	strToken, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || strToken == "" {
+		return errMissingJWT
+	}
+
+	// the issuance is checked below with a drift allowance, instead of the claims validation
+	var claims jwt.RegisteredClaims
+	token, err := jwt.ParseWithClaims(strToken, &claims, func(*jwt.Token) (interface{}, error) {
+		return l.jwtSecret, nil
+	}, jwt.WithValidMethods([]string{"HS256"}), jwt.WithoutClaimsValidation())
+
+	var msg string
+	switch {
+	case err != nil:
+		msg = err.Error()
+	case !token.Valid:
+		msg = "invalid JWT token"
+	case !claims.VerifyExpiresAt(time.Now(), false):
+		msg = "JWT token is expired"
+	case claims.IssuedAt == nil:
+		msg = "missing JWT issued-at"
+	case time.Since(claims.IssuedAt.Time) > jwtExpiryTimeout:
+		msg = "stale JWT token"
+	case time.Until(claims.IssuedAt.Time) > jwtExpiryTimeout:
+		msg = "future JWT token"
+	default:
+		return nil
+	}
+	return &Error{code: ErrCodeUnauthorized, message: msg}
+}
+
+// MarkAuthenticated marks a websocket handshake request whose JWT token was checked, so that the
+// admin calls of the connection are allowed after the expiry of the token.
+func (l *Limiter) MarkAuthenticated(r *http.Request) {
+	r.Header.Set(authenticatedHeader, l.token)
+}
+
+// ReadJWTSecret reads the hex encoded 32 bytes JWT secret of the file.
+func ReadJWTSecret(path string) ([]byte, error) {
+	data, err := os.ReadFile(path) // #nosec G304 -- the path comes from the node configuration
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the JWT secret: %w", err)
+	}
+	secret, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(string(data)), "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid JWT secret: %w", err)
+	}
+	if len(secret) != 32 {
+		return nil, fmt.Errorf("invalid JWT secret length %d, expected 32 bytes", len(secret))
+	}
+	return secret, nil
+}
+
+// methodAllowed checks the method against the denied methods, then against the allowed ones
+// of its namespace, if any.
+func (l *Limiter) methodAllowed(method string) bool {
+	for _, pattern := range l.denied {
+		if matches(pattern, method) {
+			return false
+		}
+	}
+
+	if !l.namespace[namespaceOf(method)] {
+		return true
+	}
+	for _, pattern := range l.allowed {
+		if matches(pattern, method) {
+			return true
+		}
+	}
+	return false
+}
+
+// weight returns the weight of the method, or of the longest pattern matching it.
+func (l *Limiter) weight(method string) int {
+	if w, ok := l.weights[method]; ok {
+		return w
+	}
+
+	weight, length := 1, 0
+	for pattern, w := range l.weights {
+		if len(pattern) > length && matches(pattern, method) {
+			weight, length = w, len(pattern)
+		}
+	}
+	return weight
+}
+
+// take removes the tokens from the bucket of the client, and drops the buckets of the inactive
+// clients from time to time.
+func (l *Limiter) take(client string, limit rate.Limit, burst, tokens int) bool {
+	now := time.Now()
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	if now.Sub(l.lastPrune) > idleTimeout {
+		for c, b := range l.buckets {
+			if now.Sub(b.lastSeen) > idleTimeout {
+				delete(l.buckets, c)
+			}
+		}
+		l.lastPrune = now
+	}
+
+	b, ok := l.buckets[client]
+	if !ok {
+		b = &bucket{limiter: rate.NewLimiter(limit, burst)}
+		l.buckets[client] = b
+	}
+	b.lastSeen = now
+	return b.limiter.AllowN(now, tokens)
+}
+
+// clientIP returns the IP of the client of the request.
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// matches checks if the method matches the pattern, which can end with a "*" wildcard.
+func matches(pattern, method string) bool {
+	if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+		return strings.HasPrefix(method, prefix)
+	}
+	return pattern == method
+}
+
+// namespaceOf returns the namespace of a method.
+func namespaceOf(method string) string {
+	namespace, _, _ := strings.Cut(method, "_")
+	return namespace
+}
+
+// call is the part of a JSON-RPC request read by the limiter.
+type call struct {
+	ID     json.RawMessage `json:"id"`
+	Method string          `json:"method"`
+}
+
+// parseCalls parses the JSON-RPC request, or batch, of the body.
+func parseCalls(body []byte) ([]call, bool) {
+	body = bytes.TrimLeft(body, " \t\r\n")
+	if len(body) > 0 && body[0] == '[' {
+		var calls []call
+		if err := json.Unmarshal(body, &calls); err != nil {
+			return nil, true
+		}
+		return calls, true
+	}
+
+	var c call
+	if err := json.Unmarshal(body, &c); err != nil {
+		return nil, false
+	}
+	return []call{c}, false
+}
+
+type errorResponse struct {
+	Version string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Error   errorObject     `json:"error"`
+}
+
+type errorObject struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// ErrorResponse returns the JSON-RPC response rejecting the request, or each call of the batch,
+// in the body with the error.
+func ErrorResponse(body []byte, err error) interface{} {
+	obj := errorObject{Code: -32000, Message: err.Error()}
+	if rpcErr, ok := err.(interface{ ErrorCode() int }); ok {
+		obj.Code = rpcErr.ErrorCode()
+	}
+
+	calls, batch := parseCalls(body)
+	if !batch || len(calls) == 0 {
+		var id json.RawMessage
+		if len(calls) > 0 {
+			id = calls[0].ID
+		}
+		return errorResponse{Version: "2.0", ID: id, Error: obj}
+	}
+
+	responses := make([]errorResponse, len(calls))
+	for i, c := range calls {
+		responses[i] = errorResponse{Version: "2.0", ID: c.ID, Error: obj}
+	}
+	return responses
+}
@@ -0,0 +1,219 @@
+package ratelimit
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/server/config"
+)
+
+// serve sends the body to the limiter in front of a handler answering "ok", from the client IP
+// with the API key, and returns the JSON-RPC error code, or 0 if the request went through.
+func serve(t *testing.T, h http.Handler, ip, apiKey, body string) int {
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	req.RemoteAddr = ip + ":1234"
+	if apiKey != "" {
+		req.Header.Set(APIKeyHeader, apiKey)
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	if rec.Body.String() == "ok" {
+		return 0
+	}
+	var res errorResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	return res.Error.Code
+}
+
+func newHandler(t *testing.T, cfg config.JSONRPCConfig) http.Handler {
+	limiter, err := NewLimiter(cfg)
+	require.NoError(t, err)
+	return limiter.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	}))
+}
+
+func request(method string) string {
+	return `{"jsonrpc":"2.0","id":1,"method":"` + method + `","params":[]}`
+}
+
+func TestRateLimit(t *testing.T) {
+	cfg := *config.DefaultJSONRPCConfig()
+	cfg.RateLimit = 0.001
+	cfg.RateLimitBurst = 12
+	h := newHandler(t, cfg)
+
+	// eth_getLogs costs 10, the batch costs 2
+	require.Zero(t, serve(t, h, "10.0.0.1", "", request("eth_getLogs")))
+	require.Zero(t, serve(t, h, "10.0.0.1", "", "["+request("eth_chainId")+","+request("eth_blockNumber")+"]"))
+	require.Equal(t, ErrCodeLimitExceeded, serve(t, h, "10.0.0.1", "", request("eth_chainId")))
+
+	// the buckets are per client IP, an expensive request costs at most the burst
+	require.Zero(t, serve(t, h, "10.0.0.2", "", request("debug_traceTransaction")))
+	require.Equal(t, ErrCodeLimitExceeded, serve(t, h, "10.0.0.2", "", request("eth_chainId")))
+}
+
+func TestAPIKeys(t *testing.T) {
+	cfg := *config.DefaultJSONRPCConfig()
+	cfg.RateLimit = 0.001
+	cfg.RateLimitBurst = 1
+	cfg.APIKeys = []string{"secret"}
+	h := newHandler(t, cfg)
+
+	require.Equal(t, ErrCodeUnauthorized, serve(t, h, "10.0.0.1", "unknown", request("eth_chainId")))
+
+	// the requests with a key aren't limited by IP, the API key rate limit is disabled
+	for i := 0; i < 3; i++ {
+		require.Zero(t, serve(t, h, "10.0.0.1", "secret", request("eth_chainId")))
+	}
+	require.Zero(t, serve(t, h, "10.0.0.1", "", request("eth_chainId")))
+	require.Equal(t, ErrCodeLimitExceeded, serve(t, h, "10.0.0.1", "", request("eth_chainId")))
+}
+
+func TestMethodLists(t *testing.T) {
+	cfg := *config.DefaultJSONRPCConfig()
+	cfg.AllowedMethods = []string{"debug_traceTransaction", "debug_getRaw*"}
+	cfg.DeniedMethods = []string{"personal_*", "eth_sendTransaction"}
+	h := newHandler(t, cfg)
+
+	testCases := []struct {
+		method  string
+		allowed bool
+	}{
+		{"eth_chainId", true},
+		{"eth_sendTransaction", false},
+		{"personal_listAccounts", false},
+		{"debug_traceTransaction", true},
+		{"debug_getRawBlock", true},
+		{"debug_traceBlockByNumber", false},
+	}
+	for _, tc := range testCases {
+		code := serve(t, h, "10.0.0.1", "", request(tc.method))
+		if tc.allowed {
+			require.Zero(t, code, tc.method)
+		} else {
+			require.Equal(t, ErrCodeMethodNotAllowed, code, tc.method)
+		}
+	}
+}
+
+func TestErrorResponse(t *testing.T) {
+	res, err := json.Marshal(ErrorResponse([]byte(`[{"id":1,"method":"a_b"},{"id":"x","method":"a_c"}]`), errLimitExceeded))
+	require.NoError(t, err)
+	require.JSONEq(t, `[
+		{"jsonrpc":"2.0","id":1,"error":{"code":-32005,"message":"rate limit exceeded"}},
+		{"jsonrpc":"2.0","id":"x","error":{"code":-32005,"message":"rate limit exceeded"}}
+	]`, string(res))
+
+	res, err = json.Marshal(ErrorResponse([]byte(`invalid`), errLimitExceeded))
+	require.NoError(t, err)
+	require.JSONEq(t, `{"jsonrpc":"2.0","id":null,"error":{"code":-32005,"message":"rate limit exceeded"}}`, string(res))
+}
+
+func TestForwarded(t *testing.T) {
+	cfg := *config.DefaultJSONRPCConfig()
+	cfg.DeniedMethods = []string{"eth_chainId"}
+	limiter, err := NewLimiter(cfg)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	body := []byte(request("eth_chainId"))
+	require.Error(t, limiter.Allow(req, body))
+	req.Header.Set(forwardedHeader, "forged")
+	require.Error(t, limiter.Allow(req, body))
+	limiter.MarkForwarded(req)
+	require.NoError(t, limiter.Allow(req, body))
+}
+
+// jwtToken returns a JWT token signed with the secret and method, issued at the time.
+func jwtToken(t *testing.T, method jwt.SigningMethod, secret []byte, iat time.Time) string {
+	token, err := jwt.NewWithClaims(method, jwt.RegisteredClaims{IssuedAt: jwt.NewNumericDate(iat)}).SignedString(secret)
+	require.NoError(t, err)
+	return token
+}
+
+func TestAuthenticate(t *testing.T) {
+	secret := make([]byte, 32)
+	secret[0] = 1
+	path := filepath.Join(t.TempDir(), "jwtsecret")
+	require.NoError(t, os.WriteFile(path, []byte("0x"+hex.EncodeToString(secret)+"\n"), 0o600))
+
+	cfg := *config.DefaultJSONRPCConfig()
+	cfg.AuthJWTSecret = path
+	limiter, err := NewLimiter(cfg)
+	require.NoError(t, err)
+
+	now := time.Now()
+	testCases := []struct {
+		name    string
+		auth    string
+		expPass bool
+	}{
+		{"valid token", "Bearer " + jwtToken(t, jwt.SigningMethodHS256, secret, now), true},
+		{"drifted token", "Bearer " + jwtToken(t, jwt.SigningMethodHS256, secret, now.Add(30*time.Second)), true},
+		{"missing token", "", false},
+		{"not a bearer token", jwtToken(t, jwt.SigningMethodHS256, secret, now), false},
+		{"wrong secret", "Bearer " + jwtToken(t, jwt.SigningMethodHS256, []byte("wrong"), now), false},
+		{"wrong method", "Bearer " + jwtToken(t, jwt.SigningMethodHS512, secret, now), false},
+		{"stale token", "Bearer " + jwtToken(t, jwt.SigningMethodHS256, secret, now.Add(-2*time.Minute)), false},
+		{"future token", "Bearer " + jwtToken(t, jwt.SigningMethodHS256, secret, now.Add(2*time.Minute)), false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			if tc.auth != "" {
+				req.Header.Set("Authorization", tc.auth)
+			}
+			err := limiter.Allow(req, []byte(request("admin_nodeInfo")))
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Equal(t, ErrCodeUnauthorized, err.(*Error).ErrorCode())
+			}
+			// only the admin calls are authenticated
+			require.NoError(t, limiter.Allow(req, []byte(request("eth_chainId"))))
+		})
+	}
+
+	// the websocket connections are authenticated on their handshake
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	body := []byte("[" + request("eth_chainId") + "," + request("admin_peers") + "]")
+	require.Error(t, limiter.Allow(req, body))
+	req.Header.Set(authenticatedHeader, "forged")
+	require.Error(t, limiter.Allow(req, body))
+	limiter.MarkAuthenticated(req)
+	require.NoError(t, limiter.Allow(req, body))
+}
+
+func TestReadJWTSecret(t *testing.T) {
+	dir := t.TempDir()
+	write := func(content string) string {
+		path := filepath.Join(dir, "jwtsecret")
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+		return path
+	}
+
+	secret, err := ReadJWTSecret(write(strings.Repeat("ab", 32)))
+	require.NoError(t, err)
+	require.Len(t, secret, 32)
+
+	_, err = ReadJWTSecret(write("ab"))
+	require.Error(t, err)
+	_, err = ReadJWTSecret(write("not hex"))
+	require.Error(t, err)
+	_, err = ReadJWTSecret(filepath.Join(dir, "missing"))
+	require.Error(t, err)
+}
@@ -0,0 +1,193 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+// Package signer is the client of the external signers, like clef, serving the account_* API
+// described in EIP-3030, so that the keys signing the transactions sent through the JSON-RPC
+// server don't need to be on the node.
+package signer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// ExternalSigner signs with the keys held by an external signer, reached over HTTP, websocket or
+// IPC.
+type ExternalSigner struct {
+	client   *ethrpc.Client
+	endpoint string
+}
+
+// Dial connects to the external signer at the endpoint, an URL or the path of an IPC socket.
+func Dial(ctx context.Context, endpoint string) (*ExternalSigner, error) {
+	client, err := ethrpc.DialContext(ctx, endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the external signer %s: %w", endpoint, err)
+	}
+	return &ExternalSigner{client: client, endpoint: endpoint}, nil
+}
+
+// Endpoint returns the endpoint of the external signer.
+func (s *ExternalSigner) Endpoint() string {
+	return s.endpoint
+}
+
+// Close closes the connection to the external signer.
+func (s *ExternalSigner) Close() {
+	s.client.Close()
+}
+
+// Version returns the version of the external API of the signer.
+func (s *ExternalSigner) Version(ctx context.Context) (string, error) {
+	var version string
+	if err := s.client.CallContext(ctx, &version, "account_version"); err != nil {
+		return "", err
+	}
+	return version, nil
+}
+
+// Accounts returns the addresses of the keys held by the external signer.
+func (s *ExternalSigner) Accounts(ctx context.Context) ([]common.Address, error) {
+	var addrs []common.Address
+	if err := s.client.CallContext(ctx, &addrs, "account_list"); err != nil {
+		return nil, err
+	}
+	return addrs, nil
+}
+
+// Contains checks if the external signer holds the key of the address. The accounts aren't
+// cached, since the signer can add or remove them at any time.
+func (s *ExternalSigner) Contains(ctx context.Context, address common.Address) (bool, error) {
+	addrs, err := s.Accounts(ctx)
+	if err != nil {
+		return false, err
+	}
+	for _, addr := range addrs {
+		if addr == address {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// signTransactionResult is the result of account_signTransaction.
+type signTransactionResult struct {
+	Raw hexutil.Bytes `json:"raw"`
+}
+
+// SignTransaction has the external signer sign the transaction of the address with the chain
+// id. The signer may change the transaction, like clef when its user edits it, so the returned
+// transaction must be used as is and is only checked to be signed by the address.
+func (s *ExternalSigner) SignTransaction(
+	ctx context.Context, address common.Address, tx *ethtypes.Transaction, chainID *big.Int,
+) (*ethtypes.Transaction, error) {
+	data := hexutil.Bytes(tx.Data())
+	args := apitypes.SendTxArgs{
+		From:  common.NewMixedcaseAddress(address),
+		Gas:   hexutil.Uint64(tx.Gas()),
+		Value: hexutil.Big(*tx.Value()),
+		Nonce: hexutil.Uint64(tx.Nonce()),
+		Data:  &data,
+	}
+	if to := tx.To(); to != nil {
+		mixedTo := common.NewMixedcaseAddress(*to)
+		args.To = &mixedTo
+	}
+	if chainID != nil {
+		args.ChainID = (*hexutil.Big)(chainID)
+	}
+
+	switch tx.Type() {
+	case ethtypes.LegacyTxType:
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+	case ethtypes.AccessListTxType:
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+		accessList := tx.AccessList()
+		args.AccessList = &accessList
+	case ethtypes.DynamicFeeTxType:
+		args.MaxFeePerGas = (*hexutil.Big)(tx.GasFeeCap())
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(tx.GasTipCap())
+		accessList := tx.AccessList()
+		args.AccessList = &accessList
+	default:
+		return nil, fmt.Errorf("transaction type %d isn't supported by the external signer", tx.Type())
+	}
+
+	var res signTransactionResult
+	if err := s.client.CallContext(ctx, &res, "account_signTransaction", &args); err != nil {
+		return nil, err
+	}
+
+	signed := new(ethtypes.Transaction)
+	if err := signed.UnmarshalBinary(res.Raw); err != nil {
+		return nil, fmt.Errorf("invalid transaction signed by the external signer: %w", err)
+	}
+	sender, err := ethtypes.LatestSignerForChainID(signed.ChainId()).Sender(signed)
+	if err != nil {
+		return nil, fmt.Errorf("invalid transaction signed by the external signer: %w", err)
+	}
+	if sender != address {
+		return nil, fmt.Errorf("transaction signed by %s instead of %s", sender, address)
+	}
+	return signed, nil
+}
+
+// SignText has the external signer sign the text with the EIP-191 personal message prefix, the
+// signature has a V of 27 or 28.
+func (s *ExternalSigner) SignText(ctx context.Context, address common.Address, text []byte) (hexutil.Bytes, error) {
+	var sig hexutil.Bytes
+	if err := s.client.CallContext(
+		ctx, &sig, "account_signData", accounts.MimetypeTextPlain, common.NewMixedcaseAddress(address), hexutil.Bytes(text),
+	); err != nil {
+		return nil, err
+	}
+	return legacySignature(sig)
+}
+
+// SignTypedData has the external signer sign the EIP-712 typed data, the signature has a V of
+// 27 or 28.
+func (s *ExternalSigner) SignTypedData(
+	ctx context.Context, address common.Address, typedData apitypes.TypedData,
+) (hexutil.Bytes, error) {
+	var sig hexutil.Bytes
+	if err := s.client.CallContext(
+		ctx, &sig, "account_signTypedData", common.NewMixedcaseAddress(address), typedData,
+	); err != nil {
+		return nil, err
+	}
+	return legacySignature(sig)
+}
+
+// legacySignature returns the signature with a V of 27 or 28, like the signatures of the node
+// keys, whether the external signer returned it in this form like clef or not.
+func legacySignature(sig hexutil.Bytes) (hexutil.Bytes, error) {
+	if len(sig) != crypto.SignatureLength {
+		return nil, errors.New("invalid signature returned by the external signer")
+	}
+	if sig[crypto.RecoveryIDOffset] < 27 {
+		sig[crypto.RecoveryIDOffset] += 27
+	}
+	return sig, nil
+}
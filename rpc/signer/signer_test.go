@@ -0,0 +1,203 @@
+package signer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// standInSigner is an in-process stand-in for clef, signing everything with its key whatever the
+// requested account.
+type standInSigner struct {
+	key *ecdsa.PrivateKey
+}
+
+func (s *standInSigner) Version() string {
+	return "6.0.0"
+}
+
+func (s *standInSigner) List() []common.Address {
+	return []common.Address{crypto.PubkeyToAddress(s.key.PublicKey)}
+}
+
+func (s *standInSigner) SignTransaction(args apitypes.SendTxArgs) (map[string]interface{}, error) {
+	tx, err := ethtypes.SignTx(args.ToTransaction(), ethtypes.LatestSignerForChainID(args.ChainID.ToInt()), s.key)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"raw": hexutil.Bytes(raw), "tx": tx}, nil
+}
+
+// SignData returns a V of 27 or 28 like clef.
+func (s *standInSigner) SignData(contentType string, _ common.MixedcaseAddress, data hexutil.Bytes) (hexutil.Bytes, error) {
+	if contentType != accounts.MimetypeTextPlain {
+		return nil, ethrpc.ErrNoResult
+	}
+	sig, err := crypto.Sign(accounts.TextHash(data), s.key)
+	if err != nil {
+		return nil, err
+	}
+	sig[crypto.RecoveryIDOffset] += 27
+	return sig, nil
+}
+
+// SignTypedData returns a V of 0 or 1.
+func (s *standInSigner) SignTypedData(_ common.MixedcaseAddress, typedData apitypes.TypedData) (hexutil.Bytes, error) {
+	hash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return nil, err
+	}
+	return crypto.Sign(hash, s.key)
+}
+
+func setupSigner(t *testing.T) (*ExternalSigner, common.Address) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	srv := ethrpc.NewServer()
+	require.NoError(t, srv.RegisterName("account", &standInSigner{key: key}))
+	httpSrv := httptest.NewServer(srv)
+	t.Cleanup(httpSrv.Close)
+	t.Cleanup(srv.Stop)
+
+	signer, err := Dial(context.Background(), httpSrv.URL)
+	require.NoError(t, err)
+	t.Cleanup(signer.Close)
+	return signer, crypto.PubkeyToAddress(key.PublicKey)
+}
+
+func TestAccounts(t *testing.T) {
+	signer, addr := setupSigner(t)
+	ctx := context.Background()
+
+	version, err := signer.Version(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "6.0.0", version)
+
+	addrs, err := signer.Accounts(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []common.Address{addr}, addrs)
+
+	ok, err := signer.Contains(ctx, addr)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ok, err = signer.Contains(ctx, common.HexToAddress("0x1"))
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestSignTransaction(t *testing.T) {
+	signer, addr := setupSigner(t)
+	ctx := context.Background()
+	chainID := big.NewInt(9000)
+	to := common.HexToAddress("0x2")
+
+	testCases := []struct {
+		name string
+		tx   *ethtypes.Transaction
+	}{
+		{
+			"legacy",
+			ethtypes.NewTx(&ethtypes.LegacyTx{Nonce: 1, GasPrice: big.NewInt(10), Gas: 21000, To: &to, Value: big.NewInt(1)}),
+		},
+		{
+			"access list",
+			ethtypes.NewTx(&ethtypes.AccessListTx{
+				ChainID: chainID, Nonce: 2, GasPrice: big.NewInt(10), Gas: 30000, To: &to, Value: big.NewInt(1),
+				AccessList: ethtypes.AccessList{{Address: to, StorageKeys: []common.Hash{{1}}}},
+			}),
+		},
+		{
+			"dynamic fee contract creation",
+			ethtypes.NewTx(&ethtypes.DynamicFeeTx{
+				ChainID: chainID, Nonce: 3, GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(10), Gas: 100000,
+				Value: big.NewInt(0), Data: []byte{0x60, 0x00},
+			}),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			signed, err := signer.SignTransaction(ctx, addr, tc.tx, chainID)
+			require.NoError(t, err)
+			require.Equal(t, tc.tx.Type(), signed.Type())
+			require.Equal(t, chainID, signed.ChainId())
+			require.Equal(t, tc.tx.Nonce(), signed.Nonce())
+			require.Equal(t, tc.tx.To(), signed.To())
+			require.Equal(t, hexutil.Bytes(tc.tx.Data()).String(), hexutil.Bytes(signed.Data()).String())
+			require.Equal(t, tc.tx.AccessList(), signed.AccessList())
+
+			sender, err := ethtypes.LatestSignerForChainID(chainID).Sender(signed)
+			require.NoError(t, err)
+			require.Equal(t, addr, sender)
+		})
+	}
+
+	// the stand-in signs with its own key
+	_, err := signer.SignTransaction(ctx, to, testCases[0].tx, chainID)
+	require.ErrorContains(t, err, "instead of")
+
+	blobTx := ethtypes.NewTx(&ethtypes.BlobTx{
+		ChainID: uint256.NewInt(9000), GasTipCap: uint256.NewInt(1), GasFeeCap: uint256.NewInt(10),
+		Value: uint256.NewInt(0), BlobFeeCap: uint256.NewInt(1),
+	})
+	_, err = signer.SignTransaction(ctx, addr, blobTx, chainID)
+	require.ErrorContains(t, err, "isn't supported")
+}
+
+func TestSignText(t *testing.T) {
+	signer, addr := setupSigner(t)
+
+	text := []byte("hello")
+	sig, err := signer.SignText(context.Background(), addr, text)
+	require.NoError(t, err)
+	require.Contains(t, []byte{27, 28}, sig[crypto.RecoveryIDOffset])
+
+	sig[crypto.RecoveryIDOffset] -= 27
+	pubKey, err := crypto.SigToPub(accounts.TextHash(text), sig)
+	require.NoError(t, err)
+	require.Equal(t, addr, crypto.PubkeyToAddress(*pubKey))
+}
+
+func TestSignTypedData(t *testing.T) {
+	signer, addr := setupSigner(t)
+
+	typedData := apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {{Name: "name", Type: "string"}, {Name: "chainId", Type: "uint256"}},
+			"Mail":         {{Name: "contents", Type: "string"}},
+		},
+		PrimaryType: "Mail",
+		Domain:      apitypes.TypedDataDomain{Name: "ethermint", ChainId: math.NewHexOrDecimal256(9000)},
+		Message:     apitypes.TypedDataMessage{"contents": "hello"},
+	}
+	sig, err := signer.SignTypedData(context.Background(), addr, typedData)
+	require.NoError(t, err)
+	require.Contains(t, []byte{27, 28}, sig[crypto.RecoveryIDOffset])
+
+	hash, _, err := apitypes.TypedDataAndHash(typedData)
+	require.NoError(t, err)
+	sig[crypto.RecoveryIDOffset] -= 27
+	pubKey, err := crypto.SigToPub(hash, sig)
+	require.NoError(t, err)
+	require.Equal(t, addr, crypto.PubkeyToAddress(*pubKey))
+}
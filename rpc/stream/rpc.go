@@ -14,6 +14,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/evmos/ethermint/rpc/types"
+	ethermint "github.com/evmos/ethermint/types"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 )
 
@@ -27,6 +28,8 @@ const (
 	txStreamCapacity        = 1024 * 32
 	logStreamSegmentSize    = 2048
 	logStreamCapacity       = 2048 * 32
+	internalTxSegmentSize   = 2048
+	internalTxCapacity      = 2048 * 32
 )
 
 var (
@@ -45,15 +48,24 @@ type RPCHeader struct {
 	Hash      common.Hash
 }
 
-// RPCStream provides data streams for newHeads, logs, and pendingTransactions.
+// RPCTx is a decoded Ethereum transaction of the tx stream.
+type RPCTx struct {
+	Msg  *evmtypes.MsgEthereumTx
+	Hash common.Hash
+}
+
+// RPCStream provides data streams for newHeads, logs, pendingTransactions and
+// internalTransactions.
 type RPCStream struct {
 	evtClient rpcclient.EventsClient
 	logger    log.Logger
 	txDecoder sdk.TxDecoder
 
 	headerStream *Stream[RPCHeader]
-	txStream     *Stream[common.Hash]
+	txStream     *Stream[RPCTx]
 	logStream    *Stream[*ethtypes.Log]
+	// internal transactions of the committed transactions, if the node traces them
+	internalTxStream *Stream[*ethermint.InternalTxResult]
 
 	wg sync.WaitGroup
 }
@@ -65,8 +77,10 @@ func NewRPCStreams(evtClient rpcclient.EventsClient, logger log.Logger, txDecode
 		txDecoder: txDecoder,
 
 		headerStream: NewStream[RPCHeader](headerStreamSegmentSize, headerStreamCapacity),
-		txStream:     NewStream[common.Hash](txStreamSegmentSize, txStreamCapacity),
+		txStream:     NewStream[RPCTx](txStreamSegmentSize, txStreamCapacity),
 		logStream:    NewStream[*ethtypes.Log](logStreamSegmentSize, logStreamCapacity),
+
+		internalTxStream: NewStream[*ethermint.InternalTxResult](internalTxSegmentSize, internalTxCapacity),
 	}
 
 	ctx := context.Background()
@@ -109,7 +123,7 @@ func (s *RPCStream) HeaderStream() *Stream[RPCHeader] {
 	return s.headerStream
 }
 
-func (s *RPCStream) TxStream() *Stream[common.Hash] {
+func (s *RPCStream) TxStream() *Stream[RPCTx] {
 	return s.txStream
 }
 
@@ -117,6 +131,10 @@ func (s *RPCStream) LogStream() *Stream[*ethtypes.Log] {
 	return s.logStream
 }
 
+func (s *RPCStream) InternalTxStream() *Stream[*ethermint.InternalTxResult] {
+	return s.internalTxStream
+}
+
 func (s *RPCStream) start(
 	wg *sync.WaitGroup,
 	chBlocks <-chan coretypes.ResultEvent,
@@ -168,13 +186,13 @@ func (s *RPCStream) start(
 				continue
 			}
 
-			var hashes []common.Hash
+			var txs []RPCTx
 			for _, msg := range tx.GetMsgs() {
 				if ethTx, ok := msg.(*evmtypes.MsgEthereumTx); ok {
-					hashes = append(hashes, ethTx.AsTransaction().Hash())
+					txs = append(txs, RPCTx{Msg: ethTx, Hash: ethTx.ComputeHash()})
 				}
 			}
-			s.txStream.Add(hashes...)
+			s.txStream.Add(txs...)
 		case ev, ok := <-chLogs:
 			if !ok {
 				chLogs = nil
@@ -199,6 +217,13 @@ func (s *RPCStream) start(
 			}
 
 			s.logStream.Add(txLogs...)
+
+			internalTxs, err := evmtypes.DecodeInternalTxsFromEvents(dataTx.TxResult.Result.Events, dataTx.TxResult.Height)
+			if err != nil {
+				s.logger.Error("fail to decode internal txs", "error", err.Error())
+				continue
+			}
+			s.internalTxStream.Add(internalTxs...)
 		}
 
 		if chBlocks == nil && chTx == nil && chLogs == nil {
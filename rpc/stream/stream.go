@@ -69,16 +69,35 @@ func (s *Stream[V]) Add(vs ...V) int {
 // it only stops if the context is canceled.
 // it returns the last id of the items.
 func (s *Stream[V]) Subscribe(ctx context.Context, callback func([]V, int) error) error {
+	return s.SubscribeFrom(ctx, -1, callback, nil)
+}
+
+// SubscribeFrom subscribes the stream like Subscribe, starting after the item of id offset,
+// negative offset means from the end.
+// if the items following offset are pruned before being read, i.e. the subscriber is slower
+// than the stream, onMissed is called with the number of missed items, then the subscription
+// resumes from the earliest item.
+func (s *Stream[V]) SubscribeFrom(
+	ctx context.Context,
+	offset int,
+	callback func([]V, int) error,
+	onMissed func(int) error,
+) error {
 	var (
 		items  []V
-		offset = -1
+		missed int
 	)
 	for {
-		items, offset = s.ReadBlocking(ctx, offset)
+		items, offset, missed = s.readBlocking(ctx, offset)
 		if len(items) == 0 {
 			// canceled
 			break
 		}
+		if missed > 0 && onMissed != nil {
+			if err := onMissed(missed); err != nil {
+				return err
+			}
+		}
 		if err := callback(items, offset); err != nil {
 			return err
 		}
@@ -92,7 +111,8 @@ func (s *Stream[V]) ReadNonBlocking(offset int) ([]V, int) {
 	s.mutex.RLock()
 	defer s.mutex.RUnlock()
 
-	return s.doRead(offset)
+	items, offset, _ := s.doRead(offset)
+	return items, offset
 }
 
 // ReadAllNonBlocking returns all items in the stream, without blocking.
@@ -105,7 +125,7 @@ func (s *Stream[V]) ReadAllNonBlocking(offset int) ([]V, int) {
 		items  []V
 	)
 	for {
-		items, offset = s.doRead(offset)
+		items, offset, _ = s.doRead(offset)
 		if len(items) == 0 {
 			break
 		}
@@ -120,14 +140,23 @@ func (s *Stream[V]) ReadAllNonBlocking(offset int) ([]V, int) {
 // negative offset means read from the end.
 // it also returns the largest id of the items, if there are no new items, returns the id of the last item.
 func (s *Stream[V]) ReadBlocking(ctx context.Context, offset int) ([]V, int) {
+	items, offset, _ := s.readBlocking(ctx, offset)
+	return items, offset
+}
+
+// readBlocking is ReadBlocking, also returning the number of missed items.
+func (s *Stream[V]) readBlocking(ctx context.Context, offset int) ([]V, int, int) {
 	s.mutex.RLock()
 	defer s.mutex.RUnlock()
 
-	var items []V
+	var (
+		items  []V
+		missed int
+	)
 	for {
-		items, offset = s.doRead(offset)
+		items, offset, missed = s.doRead(offset)
 		if len(items) > 0 {
-			return items, offset
+			return items, offset, missed
 		}
 
 		s.mutex.RUnlock()
@@ -136,7 +165,7 @@ func (s *Stream[V]) ReadBlocking(ctx context.Context, offset int) ([]V, int) {
 
 		if !r {
 			// context canceled
-			return nil, 0
+			return nil, 0, 0
 		}
 	}
 }
@@ -150,38 +179,42 @@ func (s *Stream[V]) lastID() int {
 	return (s.segmentOffset+s.segments.Length()-1)*s.segmentSize + len(s.segments.Tail())
 }
 
-// doRead is the underlying logic of Read.
-func (s *Stream[V]) doRead(offset int) ([]V, int) {
+// doRead is the underlying logic of Read, it also returns the number of items following
+// offset which were pruned, and are missed by the reader.
+func (s *Stream[V]) doRead(offset int) ([]V, int, int) {
 	if s.segments.Length() == 0 {
-		return nil, s.lastID()
+		return nil, s.lastID(), 0
 	}
 
 	if offset < 0 {
-		return nil, s.lastID()
+		return nil, s.lastID(), 0
 	}
 
 	segment := offset / s.segmentSize
+	start := offset % s.segmentSize
+	missed := 0
 	if segment >= s.segmentOffset {
 		segment -= s.segmentOffset
 	} else {
-		// the target segment is pruned, ajust to earliest segment
-		segment = 0
+		// the target segment is pruned, ajust to the first item of the earliest segment
+		missed = s.segmentOffset*s.segmentSize - offset
+		segment, start = 0, 0
 	}
 
 	if segment >= s.segments.Length() {
 		// offset is in the future
-		return nil, s.lastID()
+		return nil, s.lastID(), 0
 	}
 
 	seg := s.segments.Get(segment)
-	items := seg[offset%s.segmentSize:]
+	items := seg[start:]
 	if len(items) == 0 {
-		return nil, s.lastID()
+		return nil, s.lastID(), 0
 	}
 
 	// copy the slice
 	clone := make([]V, len(items))
 	copy(clone, items)
 
-	return clone, (s.segmentOffset+segment)*s.segmentSize + len(seg)
+	return clone, (s.segmentOffset+segment)*s.segmentSize + len(seg), missed
 }
@@ -118,3 +118,45 @@ func TestStreamReadFromEnd(t *testing.T) {
 	require.Equal(t, []int{2}, items)
 	require.Equal(t, 2, offset)
 }
+
+func TestStreamSubscribeFrom(t *testing.T) {
+	stream := NewStream[int](4, 8)
+
+	// items 1..16 have values 0..15, the first segment is pruned
+	for i := 0; i < 16; i++ {
+		stream.Add(i)
+	}
+
+	testCases := []struct {
+		name      string
+		offset    int
+		expMissed int
+		expItems  []int
+	}{
+		{"within the capacity", 9, 0, []int{9, 10, 11, 12, 13, 14, 15}},
+		{"pruned in the middle of a segment", 2, 2, []int{4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}},
+		{"from the end", -1, 0, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+
+			var (
+				missed int
+				items  []int
+			)
+			require.NoError(t, stream.SubscribeFrom(ctx, tc.offset, func(chunk []int, offset int) error {
+				items = append(items, chunk...)
+				require.Equal(t, chunk[len(chunk)-1]+1, offset)
+				return nil
+			}, func(n int) error {
+				missed += n
+				return nil
+			}))
+			require.Equal(t, tc.expMissed, missed)
+			require.Equal(t, tc.expItems, items)
+		})
+	}
+}
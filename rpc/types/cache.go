@@ -0,0 +1,90 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"encoding/json"
+
+	lru "github.com/hashicorp/golang-lru"
+
+	"github.com/ethereum/go-ethereum/metrics"
+)
+
+var (
+	cacheHitCounter  = metrics.NewRegisteredCounter("rpc/cache/hits", nil)
+	cacheMissCounter = metrics.NewRegisteredCounter("rpc/cache/misses", nil)
+)
+
+// ResponseCache is a size-bounded LRU cache of the JSON-RPC responses about committed blocks,
+// which never change thanks to the instant finality. A nil cache caches nothing.
+type ResponseCache struct {
+	cache *lru.Cache
+}
+
+// NewResponseCache returns a cache of up to size responses, or nil if size is 0.
+func NewResponseCache(size int) (*ResponseCache, error) {
+	if size == 0 {
+		return nil, nil
+	}
+
+	cache, err := lru.New(size)
+	if err != nil {
+		return nil, err
+	}
+	return &ResponseCache{cache: cache}, nil
+}
+
+// Get returns the cached response of the method called with the params.
+func (c *ResponseCache) Get(method string, params ...interface{}) (interface{}, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	key, ok := cacheKey(method, params)
+	if !ok {
+		return nil, false
+	}
+
+	res, ok := c.cache.Get(key)
+	if ok {
+		cacheHitCounter.Inc(1)
+	} else {
+		cacheMissCounter.Inc(1)
+	}
+	return res, ok
+}
+
+// Add caches the response of the method called with the params. The caller must check that
+// the response is about a committed block, and must not modify it afterwards.
+func (c *ResponseCache) Add(res interface{}, method string, params ...interface{}) {
+	if c == nil {
+		return
+	}
+
+	if key, ok := cacheKey(method, params); ok {
+		c.cache.Add(key, res)
+	}
+}
+
+// cacheKey returns the method followed by the JSON encoding of the params, which is canonical
+// for the params types of the cached methods.
+func cacheKey(method string, params []interface{}) (string, bool) {
+	bz, err := json.Marshal(params)
+	if err != nil {
+		return "", false
+	}
+	return method + string(bz), true
+}
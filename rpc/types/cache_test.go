@@ -0,0 +1,45 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestResponseCache(t *testing.T) {
+	cache, err := NewResponseCache(2)
+	require.NoError(t, err)
+
+	hash := common.HexToHash("0x01")
+	cache.Add("block", "eth_getBlockByNumber", BlockNumber(1), true)
+	cache.Add("receipt", "eth_getTransactionReceipt", hash)
+
+	res, ok := cache.Get("eth_getBlockByNumber", BlockNumber(1), true)
+	require.True(t, ok)
+	require.Equal(t, "block", res)
+
+	// the params are part of the key
+	_, ok = cache.Get("eth_getBlockByNumber", BlockNumber(1), false)
+	require.False(t, ok)
+	_, ok = cache.Get("eth_getBlockByHash", BlockNumber(1), true)
+	require.False(t, ok)
+
+	// the least recently used response is evicted
+	cache.Add("logs", "eth_getLogs", int64(1))
+	_, ok = cache.Get("eth_getTransactionReceipt", hash)
+	require.False(t, ok)
+	_, ok = cache.Get("eth_getBlockByNumber", BlockNumber(1), true)
+	require.True(t, ok)
+}
+
+func TestNilResponseCache(t *testing.T) {
+	cache, err := NewResponseCache(0)
+	require.NoError(t, err)
+	require.Nil(t, cache)
+
+	cache.Add("block", "eth_getBlockByNumber", BlockNumber(1), true)
+	_, ok := cache.Get("eth_getBlockByNumber", BlockNumber(1), true)
+	require.False(t, ok)
+}
@@ -67,9 +67,91 @@ type RPCTransaction struct {
 	Type             hexutil.Uint64       `json:"type"`
 	Accesses         *ethtypes.AccessList `json:"accessList,omitempty"`
 	ChainID          *hexutil.Big         `json:"chainId,omitempty"`
-	V                *hexutil.Big         `json:"v"`
-	R                *hexutil.Big         `json:"r"`
-	S                *hexutil.Big         `json:"s"`
+	// AuthorizationList is only set for the set-code transactions
+	AuthorizationList []evmtypes.RPCSetCodeAuthorization `json:"authorizationList,omitempty"`
+	// MaxFeePerBlobGas and BlobVersionedHashes are only set for the blob transactions
+	MaxFeePerBlobGas    *hexutil.Big  `json:"maxFeePerBlobGas,omitempty"`
+	BlobVersionedHashes []common.Hash `json:"blobVersionedHashes,omitempty"`
+	V                   *hexutil.Big  `json:"v"`
+	R                   *hexutil.Big  `json:"r"`
+	S                   *hexutil.Big  `json:"s"`
+}
+
+// RPCPoolTransaction represents a mempool transaction with its priority, i.e. its effective
+// tip per gas above the base fee in units of the priority reduction.
+type RPCPoolTransaction struct {
+	*RPCTransaction
+	Priority hexutil.Uint64 `json:"priority"`
+}
+
+// TxPoolContent groups the Ethereum transactions of the mempool by sender and nonce. The
+// pending transactions are executable in sequence from the sender nonce, the queued ones wait
+// for a nonce gap to be filled.
+type TxPoolContent struct {
+	Pending map[common.Address]map[uint64]*RPCPoolTransaction
+	Queued  map[common.Address]map[uint64]*RPCPoolTransaction
+}
+
+// RPCBlobSidecar represents the sidecar of a blob transaction that will
+// serialize to the RPC representation.
+type RPCBlobSidecar struct {
+	BlockHash           common.Hash     `json:"blockHash"`
+	BlockNumber         hexutil.Uint64  `json:"blockNumber"`
+	TxHash              common.Hash     `json:"transactionHash"`
+	TxIndex             hexutil.Uint64  `json:"transactionIndex"`
+	BlobVersionedHashes []common.Hash   `json:"blobVersionedHashes"`
+	Blobs               []hexutil.Bytes `json:"blobs"`
+	Commitments         []hexutil.Bytes `json:"commitments"`
+	Proofs              []hexutil.Bytes `json:"proofs"`
+}
+
+// RPCInternalTransaction represents an internal transaction, a value transfer,
+// contract creation or self-destruct performed by a nested call frame of a
+// transaction, that will serialize to the RPC representation. The block hash
+// is omitted in the subscription notifications.
+type RPCInternalTransaction struct {
+	BlockHash   *common.Hash   `json:"blockHash,omitempty"`
+	BlockNumber hexutil.Uint64 `json:"blockNumber"`
+	TxHash      common.Hash    `json:"transactionHash"`
+	TxIndex     hexutil.Uint64 `json:"transactionIndex"`
+	Index       hexutil.Uint64 `json:"index"`
+	Type        string         `json:"type"`
+	From        common.Address `json:"from"`
+	To          common.Address `json:"to"`
+	Value       *hexutil.Big   `json:"value"`
+	Depth       hexutil.Uint64 `json:"depth"`
+}
+
+// ForkConfig describes a hard fork and its activation in the RPC representation.
+type ForkConfig struct {
+	Name            string          `json:"name"`
+	ActivationBlock *hexutil.Big    `json:"activationBlock,omitempty"`
+	ActivationTime  *hexutil.Uint64 `json:"activationTime,omitempty"`
+}
+
+// EthConfig is the result of eth_config, reporting the hard fork active at the latest
+// block and the next scheduled one, if any.
+type EthConfig struct {
+	ChainID *hexutil.Big `json:"chainId"`
+	Current *ForkConfig  `json:"current"`
+	Next    *ForkConfig  `json:"next"`
+}
+
+// FeeTokenGasPrice is the gas price in a denom accepted for the gas of ethereum
+// transactions.
+type FeeTokenGasPrice struct {
+	Denom string `json:"denom"`
+	// Price is the amount of the denom paid per unit of the evm denom, including the markup.
+	Price    string       `json:"price"`
+	GasPrice *hexutil.Big `json:"gasPrice"`
+	Fee      *hexutil.Big `json:"fee,omitempty"`
+}
+
+// FeeEstimate is the result of eth_estimateFee, the estimated gas and its fee in each
+// accepted denom.
+type FeeEstimate struct {
+	Gas  hexutil.Uint64     `json:"gas"`
+	Fees []FeeTokenGasPrice `json:"fees"`
 }
 
 // StateOverride is the collection of overridden accounts.
@@ -168,18 +250,24 @@ type FeeHistoryResult struct {
 	Reward       [][]*hexutil.Big `json:"reward,omitempty"`
 	BaseFee      []*hexutil.Big   `json:"baseFeePerGas,omitempty"`
 	GasUsedRatio []float64        `json:"gasUsedRatio"`
+	BurntFees    []*hexutil.Big   `json:"burntFees,omitempty"`
+	PriorityFees []*hexutil.Big   `json:"priorityFees,omitempty"`
 }
 
 // SignTransactionResult represents a RLP encoded signed transaction.
 type SignTransactionResult struct {
-	Raw hexutil.Bytes         `json:"raw"`
-	Tx  *ethtypes.Transaction `json:"tx"`
+	Raw hexutil.Bytes `json:"raw"`
+	// Tx is the *ethtypes.Transaction of the transaction, or its *RPCTransaction
+	// for the set-code transactions, which go-ethereum can't represent.
+	Tx interface{} `json:"tx"`
 }
 
 type OneFeeHistory struct {
 	BaseFee, NextBaseFee *big.Int   // base fee for each block
 	Reward               []*big.Int // each element of the array will have the tip provided to miners for the percentile given
 	GasUsedRatio         float64    // the ratio of gas used to the gas limit for each block
+	BurntFees            *big.Int   // the part of the base fees burned in the evm denom
+	PriorityFees         *big.Int   // the tips paid to the proposer in the evm denom
 }
 
 type TraceConfig struct {
@@ -28,6 +28,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 
+	ethermint "github.com/evmos/ethermint/types"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 	feemarkettypes "github.com/evmos/ethermint/x/feemarket/types"
 
@@ -56,7 +57,7 @@ func RawTxToEthTx(clientCtx client.Context, txBz tmtypes.Tx) ([]*evmtypes.MsgEth
 		if !ok {
 			return nil, fmt.Errorf("invalid message type %T, expected %T", msg, &evmtypes.MsgEthereumTx{})
 		}
-		ethTx.Hash = ethTx.AsTransaction().Hash().Hex()
+		ethTx.Hash = ethTx.ComputeHash().Hex()
 		ethTxs[i] = ethTx
 	}
 	return ethTxs, nil
@@ -168,7 +169,27 @@ func NewTransactionFromMsg(
 	chainID *big.Int,
 ) (*RPCTransaction, error) {
 	tx := msg.AsTransaction()
-	return NewRPCTransaction(tx, blockHash, blockNumber, index, baseFee, chainID)
+	result, err := NewRPCTransaction(tx, blockHash, blockNumber, index, baseFee, chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	// go-ethereum can't represent the set-code transactions, AsTransaction
+	// returns them as dynamic fee ones with a different hash and sender.
+	if auths := msg.GetSetCodeAuthorizations(); auths != nil {
+		from, err := msg.GetSenderLegacy(chainID)
+		if err != nil {
+			return nil, err
+		}
+		result.Type = hexutil.Uint64(evmtypes.SetCodeTxType)
+		result.Hash = msg.ComputeHash()
+		result.From = from
+		result.AuthorizationList = make([]evmtypes.RPCSetCodeAuthorization, len(auths))
+		for i, auth := range auths {
+			result.AuthorizationList[i] = evmtypes.NewRPCSetCodeAuthorization(auth)
+		}
+	}
+	return result, nil
 }
 
 // NewTransactionFromData returns a transaction that will serialize to the RPC
@@ -214,7 +235,7 @@ func NewRPCTransaction(
 		al := tx.AccessList()
 		result.Accesses = &al
 		result.ChainID = (*hexutil.Big)(tx.ChainId())
-	case ethtypes.DynamicFeeTxType:
+	case ethtypes.DynamicFeeTxType, ethtypes.BlobTxType:
 		al := tx.AccessList()
 		result.Accesses = &al
 		result.ChainID = (*hexutil.Big)(tx.ChainId())
@@ -228,10 +249,84 @@ func NewRPCTransaction(
 		} else {
 			result.GasPrice = (*hexutil.Big)(tx.GasFeeCap())
 		}
+		if tx.Type() == ethtypes.BlobTxType {
+			result.MaxFeePerBlobGas = (*hexutil.Big)(tx.BlobGasFeeCap())
+			result.BlobVersionedHashes = tx.BlobHashes()
+		}
 	}
 	return result, nil
 }
 
+// NewRPCBlobSidecar returns a blob sidecar that will serialize to the RPC
+// representation.
+func NewRPCBlobSidecar(sidecar *ethermint.BlobSidecarResult, blockHash common.Hash) *RPCBlobSidecar {
+	result := &RPCBlobSidecar{
+		BlockHash:           blockHash,
+		BlockNumber:         hexutil.Uint64(sidecar.Height),
+		TxHash:              common.HexToHash(sidecar.EthTxHash),
+		TxIndex:             hexutil.Uint64(sidecar.EthTxIndex),
+		BlobVersionedHashes: make([]common.Hash, len(sidecar.BlobHashes)),
+		Blobs:               make([]hexutil.Bytes, len(sidecar.Blobs)),
+		Commitments:         make([]hexutil.Bytes, len(sidecar.Commitments)),
+		Proofs:              make([]hexutil.Bytes, len(sidecar.Proofs)),
+	}
+	for i, hash := range sidecar.BlobHashes {
+		result.BlobVersionedHashes[i] = common.HexToHash(hash)
+	}
+	for i, blob := range sidecar.Blobs {
+		result.Blobs[i] = blob
+	}
+	for i, commitment := range sidecar.Commitments {
+		result.Commitments[i] = commitment
+	}
+	for i, proof := range sidecar.Proofs {
+		result.Proofs[i] = proof
+	}
+	return result
+}
+
+// NewRPCInternalTransaction returns an internal transaction that will
+// serialize to the RPC representation.
+func NewRPCInternalTransaction(internalTx *ethermint.InternalTxResult, blockHash *common.Hash) *RPCInternalTransaction {
+	value, ok := new(big.Int).SetString(internalTx.Value, 10)
+	if !ok {
+		value = new(big.Int)
+	}
+	return &RPCInternalTransaction{
+		BlockHash:   blockHash,
+		BlockNumber: hexutil.Uint64(internalTx.Height),
+		TxHash:      common.HexToHash(internalTx.EthTxHash),
+		TxIndex:     hexutil.Uint64(internalTx.EthTxIndex),
+		Index:       hexutil.Uint64(internalTx.Index),
+		Type:        internalTx.Type,
+		From:        common.HexToAddress(internalTx.From),
+		To:          common.HexToAddress(internalTx.To),
+		Value:       (*hexutil.Big)(value),
+		Depth:       hexutil.Uint64(internalTx.Depth),
+	}
+}
+
+// BlobBaseFeeFromEvents parses the feemarket blob base fee from cosmos events
+func BlobBaseFeeFromEvents(events []abci.Event) *big.Int {
+	for _, event := range events {
+		if event.Type != feemarkettypes.EventTypeFeeMarket {
+			continue
+		}
+
+		for _, attr := range event.Attributes {
+			if attr.Key == feemarkettypes.AttributeKeyBlobBaseFee {
+				result, success := new(big.Int).SetString(attr.Value, 10)
+				if success {
+					return result
+				}
+
+				return nil
+			}
+		}
+	}
+	return nil
+}
+
 // BaseFeeFromEvents parses the feemarket basefee from cosmos events
 func BaseFeeFromEvents(events []abci.Event) *big.Int {
 	for _, event := range events {
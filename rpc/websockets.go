@@ -26,6 +26,8 @@ import (
 	"net/http"
 	"strconv"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/gorilla/mux"
@@ -33,6 +35,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/filters"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -40,12 +43,36 @@ import (
 	"cosmossdk.io/log"
 
 	rpcfilters "github.com/evmos/ethermint/rpc/namespaces/ethereum/eth/filters"
+	"github.com/evmos/ethermint/rpc/ratelimit"
 	"github.com/evmos/ethermint/rpc/stream"
+	rpctypes "github.com/evmos/ethermint/rpc/types"
 	"github.com/evmos/ethermint/server/config"
+	ethermint "github.com/evmos/ethermint/types"
+)
+
+const (
+	// resumeTimeout is the time a subscription can be resumed after its connection is closed
+	resumeTimeout = 5 * time.Minute
+
+	// syncingPollInterval is the interval at which the sync status is polled for the syncing
+	// subscriptions
+	syncingPollInterval = time.Second
+)
+
+var (
+	errSlowConsumer     = errors.New("subscriber fell behind the stream")
+	errWebsocketRunning = errors.New("websocket server already running")
 )
 
 type WebsocketsServer interface {
+	// Start serves the websocket server on its configured address in the background.
 	Start()
+	// Listen serves the websocket server on the address, the configured one if empty, and returns
+	// the address it listens on. It fails if the server is already running.
+	Listen(addr string) (string, error)
+	// Stop closes the websocket server and its connections, it returns false if the server isn't
+	// running.
+	Stop() (bool, error)
 }
 
 type SubscriptionResponseJSON struct {
@@ -63,6 +90,36 @@ type SubscriptionNotification struct {
 type SubscriptionResult struct {
 	Subscription rpc.ID      `json:"subscription"`
 	Result       interface{} `json:"result"`
+	// Offset is the id of the result in its stream, to resume the subscription from
+	Offset int `json:"offset,omitempty"`
+}
+
+// SyncingResult is the result of the syncing subscription while the node is catching up, it's
+// false otherwise.
+type SyncingResult struct {
+	Syncing bool          `json:"syncing"`
+	Status  SyncingStatus `json:"status"`
+}
+
+// SyncingStatus is the progress of the node catching up, from the height at which it started.
+type SyncingStatus struct {
+	StartingBlock hexutil.Uint64 `json:"startingBlock"`
+	CurrentBlock  hexutil.Uint64 `json:"currentBlock"`
+}
+
+type SubscriptionLagNotification struct {
+	Jsonrpc string           `json:"jsonrpc"`
+	Method  string           `json:"method"`
+	Params  *SubscriptionLag `json:"params"`
+}
+
+// SubscriptionLag notifies a subscriber of the results it missed, which were pruned from the
+// stream before being delivered.
+type SubscriptionLag struct {
+	Subscription rpc.ID `json:"subscription"`
+	Missed       int    `json:"missed"`
+	// Dropped is true if the subscription is canceled
+	Dropped bool `json:"dropped"`
 }
 
 type ErrorResponseJSON struct {
@@ -83,9 +140,20 @@ type websocketsServer struct {
 	keyFile  string
 	api      *pubSubAPI
 	logger   log.Logger
+	limiter  *ratelimit.Limiter
+
+	mtx   sync.Mutex
+	srv   *http.Server
+	conns map[*wsConn]struct{} // connections of srv, closed with it
 }
 
-func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, stream *stream.RPCStream, cfg *config.Config) WebsocketsServer {
+func NewWebsocketsServer(
+	clientCtx client.Context,
+	logger log.Logger,
+	stream *stream.RPCStream,
+	cfg *config.Config,
+	limiter *ratelimit.Limiter,
+) WebsocketsServer {
 	logger = logger.With("api", "websocket-server")
 	_, port, _ := net.SplitHostPort(cfg.JSONRPC.Address)
 
@@ -94,21 +162,45 @@ func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, stream *st
 		wsAddr:   cfg.JSONRPC.WsAddress,
 		certFile: cfg.TLS.CertificatePath,
 		keyFile:  cfg.TLS.KeyPath,
-		api:      newPubSubAPI(clientCtx, logger, stream),
+		api:      newPubSubAPI(clientCtx, logger, stream, cfg.JSONRPC.WSDropSlowConsumers),
 		logger:   logger,
+		limiter:  limiter,
 	}
 }
 
 func (s *websocketsServer) Start() {
+	if _, err := s.Listen(""); err != nil {
+		s.logger.Error("failed to start HTTP server for WS", "error", err.Error())
+	}
+}
+
+func (s *websocketsServer) Listen(addr string) (string, error) {
+	if addr == "" {
+		addr = s.wsAddr
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if s.srv != nil {
+		return "", errWebsocketRunning
+	}
+
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return "", err
+	}
+
 	ws := mux.NewRouter()
 	ws.Handle("/", s)
+	srv := &http.Server{Handler: ws} // #nosec G112 -- the websocket connections are long lived
+	s.srv, s.conns = srv, make(map[*wsConn]struct{})
 
 	go func() {
 		var err error
 		if s.certFile == "" || s.keyFile == "" {
-			err = http.ListenAndServe(s.wsAddr, ws) // #nosec G114 -- http functions have no support for timeouts
+			err = srv.Serve(ln)
 		} else {
-			err = http.ListenAndServeTLS(s.wsAddr, s.certFile, s.keyFile, ws) // #nosec G114 -- http functions have no support for timeouts
+			err = srv.ServeTLS(ln, s.certFile, s.keyFile)
 		}
 		if err != nil {
 			if err == http.ErrServerClosed {
@@ -118,6 +210,41 @@ func (s *websocketsServer) Start() {
 			s.logger.Error("failed to start HTTP server for WS", "error", err.Error())
 		}
 	}()
+	return ln.Addr().String(), nil
+}
+
+func (s *websocketsServer) Stop() (bool, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if s.srv == nil {
+		return false, nil
+	}
+
+	// the hijacked connections aren't closed by the HTTP server
+	err := s.srv.Close()
+	for conn := range s.conns {
+		_ = conn.Close()
+	}
+	s.srv, s.conns = nil, nil
+	return true, err
+}
+
+// track registers the connection to be closed when the server is stopped, it returns false if
+// the server was stopped in the meantime.
+func (s *websocketsServer) track(conn *wsConn, srv *http.Server) bool {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if s.srv != srv {
+		return false
+	}
+	s.conns[conn] = struct{}{}
+	return true
+}
+
+func (s *websocketsServer) untrack(conn *wsConn) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	delete(s.conns, conn)
 }
 
 func (s *websocketsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
@@ -127,16 +254,29 @@ func (s *websocketsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		},
 	}
 
+	// the admin calls of the connection are allowed if the handshake carries a valid JWT token
+	if r.Header.Get("Authorization") != "" && s.limiter.Authenticate(r) == nil {
+		s.limiter.MarkAuthenticated(r)
+	}
+
+	srv := r.Context().Value(http.ServerContextKey)
 	conn, err := upgrader.Upgrade(w, r, nil)
 	if err != nil {
 		s.logger.Debug("websocket upgrade failed", "error", err.Error())
 		return
 	}
 
-	s.readLoop(&wsConn{
+	wsConn := &wsConn{
 		mux:  new(sync.Mutex),
 		conn: conn,
-	})
+	}
+	if srv, ok := srv.(*http.Server); !ok || !s.track(wsConn, srv) {
+		_ = wsConn.Close()
+		return
+	}
+	defer s.untrack(wsConn)
+
+	s.readLoop(wsConn, r)
 }
 
 func (s *websocketsServer) sendErrResponse(wsConn *wsConn, msg string) {
@@ -177,13 +317,15 @@ func (w *wsConn) ReadMessage() (messageType int, p []byte, err error) {
 	return w.conn.ReadMessage()
 }
 
-func (s *websocketsServer) readLoop(wsConn *wsConn) {
+// readLoop serves the messages of the connection, the rate limits apply to the client of the
+// upgrade request.
+func (s *websocketsServer) readLoop(wsConn *wsConn, r *http.Request) {
 	// subscriptions of current connection
-	subscriptions := make(map[rpc.ID]context.CancelFunc)
+	subscriptions := make(map[rpc.ID]struct{})
 	defer func() {
-		// cancel all subscriptions when connection closed
-		for _, unsubFn := range subscriptions {
-			unsubFn()
+		// stop all subscriptions when connection closed, they can still be resumed
+		for subID := range subscriptions {
+			s.api.release(wsConn, subID)
 		}
 	}()
 
@@ -195,6 +337,11 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 			return
 		}
 
+		if err := s.limiter.Allow(r, mb); err != nil {
+			_ = wsConn.WriteJSON(ratelimit.ErrorResponse(mb, err))
+			continue
+		}
+
 		if isBatch(mb) {
 			if err := s.tcpGetAndSendResponse(wsConn, mb); err != nil {
 				s.sendErrResponse(wsConn, err.Error())
@@ -244,12 +391,11 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 			}
 
 			subID := rpc.NewID()
-			unsubFn, err := s.api.subscribe(wsConn, subID, params)
-			if err != nil {
+			if err := s.api.subscribe(wsConn, subID, params); err != nil {
 				s.sendErrResponse(wsConn, err.Error())
 				continue
 			}
-			subscriptions[subID] = unsubFn
+			subscriptions[subID] = struct{}{}
 
 			res := &SubscriptionResponseJSON{
 				Jsonrpc: "2.0",
@@ -273,11 +419,8 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 			}
 
 			subID := rpc.ID(id)
-			unsubFn, ok := subscriptions[subID]
-			if ok {
-				delete(subscriptions, subID)
-				unsubFn()
-			}
+			delete(subscriptions, subID)
+			ok = s.api.unsubscribe(wsConn, subID)
 
 			res := &SubscriptionResponseJSON{
 				Jsonrpc: "2.0",
@@ -285,6 +428,46 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 				Result:  ok,
 			}
 
+			if err := wsConn.WriteJSON(res); err != nil {
+				break
+			}
+		case "eth_resumeSubscription":
+			params, ok := s.getParamsAndCheckValid(msg, wsConn)
+			if !ok {
+				continue
+			}
+
+			id, ok := params[0].(string)
+			if !ok {
+				s.sendErrResponse(wsConn, "invalid parameters")
+				continue
+			}
+
+			// the offset of the last result received, defaults to the last one delivered
+			var offset *int
+			if len(params) > 1 {
+				o, ok := params[1].(float64)
+				if !ok {
+					s.sendErrResponse(wsConn, "invalid offset")
+					continue
+				}
+				offset = new(int)
+				*offset = int(o)
+			}
+
+			subID := rpc.ID(id)
+			if err := s.api.resume(wsConn, subID, offset); err != nil {
+				s.sendErrResponse(wsConn, err.Error())
+				continue
+			}
+			subscriptions[subID] = struct{}{}
+
+			res := &SubscriptionResponseJSON{
+				Jsonrpc: "2.0",
+				ID:      connID,
+				Result:  subID,
+			}
+
 			if err := wsConn.WriteJSON(res); err != nil {
 				break
 			}
@@ -322,6 +505,8 @@ func (s *websocketsServer) tcpGetAndSendResponse(wsConn *wsConn, mb []byte) erro
 	}
 
 	req.Header.Set("Content-Type", "application/json")
+	// the message was already checked by the limiter
+	s.limiter.MarkForwarded(req)
 	client := &http.Client{}
 	resp, err := client.Do(req)
 	if err != nil {
@@ -346,62 +531,226 @@ func (s *websocketsServer) tcpGetAndSendResponse(wsConn *wsConn, mb []byte) erro
 
 // pubSubAPI is the eth_ prefixed set of APIs in the Web3 JSON-RPC spec
 type pubSubAPI struct {
-	events    *stream.RPCStream
-	logger    log.Logger
-	clientCtx client.Context
+	events            *stream.RPCStream
+	logger            log.Logger
+	clientCtx         client.Context
+	dropSlowConsumers bool
+
+	mtx           sync.Mutex
+	subscriptions map[rpc.ID]*wsSubscription
+}
+
+// wsSubscription is a subscription of a websocket connection, which can be resumed on another
+// connection, from the offset of the last item delivered.
+type wsSubscription struct {
+	params []interface{}
+	conn   *wsConn // nil once the connection is closed
+	cancel context.CancelFunc
+	// offset is the id of the last item delivered, in the stream of the subscription
+	offset   atomic.Int64
+	closedAt time.Time
 }
 
 // newPubSubAPI creates an instance of the ethereum PubSub API.
-func newPubSubAPI(clientCtx client.Context, logger log.Logger, stream *stream.RPCStream) *pubSubAPI {
+func newPubSubAPI(clientCtx client.Context, logger log.Logger, stream *stream.RPCStream, dropSlowConsumers bool) *pubSubAPI {
 	logger = logger.With("module", "websocket-client")
 	return &pubSubAPI{
-		events:    stream,
-		logger:    logger,
-		clientCtx: clientCtx,
+		events:            stream,
+		logger:            logger,
+		clientCtx:         clientCtx,
+		dropSlowConsumers: dropSlowConsumers,
+		subscriptions:     make(map[rpc.ID]*wsSubscription),
+	}
+}
+
+// subscribe starts a subscription of the connection.
+func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []interface{}) error {
+	api.mtx.Lock()
+	defer api.mtx.Unlock()
+
+	api.pruneClosed()
+
+	sub := &wsSubscription{params: params}
+	if err := api.start(wsConn, subID, sub, -1); err != nil {
+		return err
 	}
+	api.subscriptions[subID] = sub
+	return nil
 }
 
-func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []interface{}) (context.CancelFunc, error) {
-	method, ok := params[0].(string)
+// resume moves the subscription to the connection, delivering the items following the offset,
+// or the last item delivered if nil. Only the items still kept by the stream can be delivered.
+// The subscription must have been released by its closed connection, the subscription ids
+// aren't secret and a live subscription can't be taken over by another connection.
+func (api *pubSubAPI) resume(wsConn *wsConn, subID rpc.ID, offset *int) error {
+	api.mtx.Lock()
+	defer api.mtx.Unlock()
+
+	api.pruneClosed()
+
+	sub, ok := api.subscriptions[subID]
 	if !ok {
-		return nil, errors.New("invalid parameters")
+		return errors.Errorf("subscription %s not found", subID)
+	}
+	if sub.conn != nil {
+		return errors.Errorf("subscription %s is active on another connection", subID)
 	}
 
+	from := int(sub.offset.Load())
+	if offset != nil {
+		from = *offset
+	}
+	return api.start(wsConn, subID, sub, from)
+}
+
+// unsubscribe cancels the subscription, if it belongs to the connection.
+func (api *pubSubAPI) unsubscribe(wsConn *wsConn, subID rpc.ID) bool {
+	api.mtx.Lock()
+	defer api.mtx.Unlock()
+
+	sub, ok := api.subscriptions[subID]
+	if !ok || sub.conn != wsConn {
+		return false
+	}
+	sub.cancel()
+	delete(api.subscriptions, subID)
+	return true
+}
+
+// release stops the subscription when its connection is closed, it can be resumed until the
+// resume timeout.
+func (api *pubSubAPI) release(wsConn *wsConn, subID rpc.ID) {
+	api.mtx.Lock()
+	defer api.mtx.Unlock()
+
+	sub, ok := api.subscriptions[subID]
+	if !ok || sub.conn != wsConn {
+		return
+	}
+	sub.cancel()
+	sub.conn = nil
+	sub.closedAt = time.Now()
+}
+
+// pruneClosed removes the subscriptions which can't be resumed anymore.
+func (api *pubSubAPI) pruneClosed() {
+	now := time.Now()
+	for subID, sub := range api.subscriptions {
+		if sub.conn == nil && now.Sub(sub.closedAt) > resumeTimeout {
+			delete(api.subscriptions, subID)
+		}
+	}
+}
+
+// start delivers the items following the offset to the subscription on the connection,
+// negative offset means from the end of the stream.
+func (api *pubSubAPI) start(wsConn *wsConn, subID rpc.ID, sub *wsSubscription, offset int) error {
+	method, ok := sub.params[0].(string)
+	if !ok {
+		return errors.New("invalid parameters")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
 	switch method {
 	case "newHeads":
 		// TODO: handle extra params
-		return api.subscribeNewHeads(wsConn, subID)
+		subscribeStream(ctx, api, wsConn, subID, sub, api.events.HeaderStream(), offset,
+			func(header stream.RPCHeader) (interface{}, bool) {
+				return header.EthHeader, true
+			})
 	case "logs":
-		if len(params) > 1 {
-			return api.subscribeLogs(wsConn, subID, params[1])
+		var extra interface{}
+		if len(sub.params) > 1 {
+			extra = sub.params[1]
+		}
+		crit, err := api.parseLogsCriteria(extra)
+		if err != nil {
+			cancel()
+			return err
 		}
-		return api.subscribeLogs(wsConn, subID, nil)
+		subscribeStream(ctx, api, wsConn, subID, sub, api.events.LogStream(), offset,
+			func(ethLog *ethtypes.Log) (interface{}, bool) {
+				logs := rpcfilters.FilterLogs([]*ethtypes.Log{ethLog}, crit.FromBlock, crit.ToBlock, crit.Addresses, crit.Topics)
+				return ethLog, len(logs) > 0
+			})
 	case "newPendingTransactions":
-		return api.subscribePendingTransactions(wsConn, subID)
+		var fullTx bool
+		if len(sub.params) > 1 {
+			if fullTx, ok = sub.params[1].(bool); !ok {
+				cancel()
+				return errors.New("invalid full transaction flag")
+			}
+		}
+		result, err := api.pendingTxResult(fullTx)
+		if err != nil {
+			cancel()
+			return err
+		}
+		subscribeStream(ctx, api, wsConn, subID, sub, api.events.TxStream(), offset, result)
 	case "syncing":
-		return api.subscribeSyncing(wsConn, subID)
+		api.subscribeSyncing(ctx, wsConn, subID)
+	case "internalTransactions":
+		var extra interface{}
+		if len(sub.params) > 1 {
+			extra = sub.params[1]
+		}
+		crit, err := parseInternalTxsCriteria(extra)
+		if err != nil {
+			cancel()
+			return err
+		}
+		subscribeStream(ctx, api, wsConn, subID, sub, api.events.InternalTxStream(), offset, crit.result)
 	default:
-		return nil, errors.Errorf("unsupported method %s", method)
+		cancel()
+		return errors.Errorf("unsupported method %s", method)
 	}
+
+	sub.conn = wsConn
+	sub.cancel = cancel
+	return nil
 }
 
-func (api *pubSubAPI) subscribeNewHeads(wsConn *wsConn, subID rpc.ID) (context.CancelFunc, error) {
-	ctx, cancel := context.WithCancel(context.Background())
+// subscribeStream delivers the items of the stream following the offset to the subscription,
+// with their id, in a new goroutine. The result function returns the notification result of
+// an item, if any. When the subscriber falls behind the stream, it's notified of the missed
+// items, and dropped if the API is configured to.
+func subscribeStream[V any](
+	ctx context.Context,
+	api *pubSubAPI,
+	wsConn *wsConn,
+	subID rpc.ID,
+	sub *wsSubscription,
+	s *stream.Stream[V],
+	offset int,
+	result func(V) (interface{}, bool),
+) {
+	if offset < 0 {
+		_, offset = s.ReadNonBlocking(-1)
+	}
+	sub.offset.Store(int64(offset))
+
 	//nolint: errcheck
-	go api.events.HeaderStream().Subscribe(ctx, func(headers []stream.RPCHeader, _ int) error {
-		for _, header := range headers {
+	go s.SubscribeFrom(ctx, offset, func(items []V, last int) error {
+		first := last - len(items) + 1
+		for i, item := range items {
+			res, ok := result(item)
+			if !ok {
+				continue
+			}
+
 			// write to ws conn
-			res := &SubscriptionNotification{
+			notification := &SubscriptionNotification{
 				Jsonrpc: "2.0",
 				Method:  "eth_subscription",
 				Params: &SubscriptionResult{
 					Subscription: subID,
-					Result:       header.EthHeader,
+					Result:       res,
+					Offset:       first + i,
 				},
 			}
 
-			if err := wsConn.WriteJSON(res); err != nil {
-				api.logger.Error("error writing header, will drop peer", "error", err.Error())
+			if err := wsConn.WriteJSON(notification); err != nil {
+				api.logger.Debug("error writing notification, will drop peer", "error", err.Error())
 
 				try(func() {
 					if err != websocket.ErrCloseSent {
@@ -411,10 +760,36 @@ func (api *pubSubAPI) subscribeNewHeads(wsConn *wsConn, subID rpc.ID) (context.C
 				return err
 			}
 		}
+		sub.offset.Store(int64(last))
 		return nil
+	}, func(missed int) error {
+		return api.notifyLag(wsConn, subID, missed)
 	})
+}
+
+// notifyLag notifies the subscriber of the items it missed by falling behind the stream, then
+// drops the subscription if the API is configured to.
+func (api *pubSubAPI) notifyLag(wsConn *wsConn, subID rpc.ID, missed int) error {
+	api.logger.Debug("websocket subscriber fell behind the stream", "subscription", subID, "missed", missed)
 
-	return cancel, nil
+	err := wsConn.WriteJSON(&SubscriptionLagNotification{
+		Jsonrpc: "2.0",
+		Method:  "eth_subscriptionLagged",
+		Params: &SubscriptionLag{
+			Subscription: subID,
+			Missed:       missed,
+			Dropped:      api.dropSlowConsumers,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	if api.dropSlowConsumers {
+		api.unsubscribe(wsConn, subID)
+		return errSlowConsumer
+	}
+	return nil
 }
 
 func try(fn func(), l log.Logger, desc string) {
@@ -434,7 +809,8 @@ func try(fn func(), l log.Logger, desc string) {
 	fn()
 }
 
-func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interface{}) (context.CancelFunc, error) {
+// parseLogsCriteria parses the criteria of a logs subscription.
+func (api *pubSubAPI) parseLogsCriteria(extra interface{}) (filters.FilterCriteria, error) {
 	crit := filters.FilterCriteria{}
 
 	if extra != nil {
@@ -442,25 +818,15 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 		if !ok {
 			err := errors.New("invalid criteria")
 			api.logger.Debug("invalid criteria", "type", fmt.Sprintf("%T", extra))
-			return nil, err
+			return crit, err
 		}
 
 		if params["address"] != nil {
-			switch address := params["address"].(type) {
-			case string:
-				crit.Addresses = []common.Address{common.HexToAddress(address)}
-			case []interface{}:
-				for _, addr := range address {
-					address, ok := addr.(string)
-					if !ok {
-						return nil, errors.New("invalid address")
-					}
-
-					crit.Addresses = append(crit.Addresses, common.HexToAddress(address))
-				}
-			default:
-				return nil, errors.New("invalid addresses; must be address or array of addresses")
+			addresses, err := parseAddresses(params["address"])
+			if err != nil {
+				return crit, err
 			}
+			crit.Addresses = addresses
 		}
 
 		if params["topics"] != nil {
@@ -468,7 +834,7 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 			if !ok {
 				err := errors.Errorf("invalid topics: %s", topics)
 				api.logger.Error("invalid topics", "type", fmt.Sprintf("%T", topics))
-				return nil, err
+				return crit, err
 			}
 
 			crit.Topics = make([][]common.Hash, len(topics))
@@ -493,7 +859,7 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 				// in case we don't have list, but a single topic value
 				if topic, ok := subtopics.(string); ok {
 					if err := addCritTopic(topicIdx, topic); err != nil {
-						return nil, err
+						return crit, err
 					}
 
 					continue
@@ -504,7 +870,7 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 				if !ok {
 					err := errors.New("invalid subtopics")
 					api.logger.Error("invalid subtopic", "type", fmt.Sprintf("%T", subtopics))
-					return nil, err
+					return crit, err
 				}
 
 				subtopicsCollect := make([]common.Hash, len(subtopicsList))
@@ -513,7 +879,7 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 					if !ok {
 						err := errors.Errorf("invalid subtopic: %s", subtopic)
 						api.logger.Error("invalid subtopic", "type", fmt.Sprintf("%T", subtopic))
-						return nil, err
+						return crit, err
 					}
 
 					subtopicsCollect[idx] = common.HexToHash(tstr)
@@ -524,76 +890,194 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 		}
 	}
 
-	ctx, cancel := context.WithCancel(context.Background())
-	//nolint: errcheck
-	go api.events.LogStream().Subscribe(ctx, func(txLogs []*ethtypes.Log, _ int) error {
-		logs := rpcfilters.FilterLogs(txLogs, crit.FromBlock, crit.ToBlock, crit.Addresses, crit.Topics)
-		if len(logs) == 0 {
-			return nil
+	return crit, nil
+}
+
+// pendingTxResult returns the result function of a newPendingTransactions subscription, which
+// notifies the transaction hashes, or the full transactions.
+func (api *pubSubAPI) pendingTxResult(fullTx bool) (func(stream.RPCTx) (interface{}, bool), error) {
+	if !fullTx {
+		return func(tx stream.RPCTx) (interface{}, bool) {
+			return tx.Hash, true
+		}, nil
+	}
+
+	chainID, err := ethermint.ParseChainID(api.clientCtx.ChainID)
+	if err != nil {
+		return nil, err
+	}
+	return func(tx stream.RPCTx) (interface{}, bool) {
+		rpcTx, err := rpctypes.NewTransactionFromMsg(tx.Msg, common.Hash{}, 0, 0, nil, chainID)
+		if err != nil {
+			api.logger.Debug("failed to build transaction", "hash", tx.Hash, "error", err.Error())
+			return nil, false
 		}
+		return rpcTx, true
+	}, nil
+}
 
-		for _, ethLog := range logs {
-			res := &SubscriptionNotification{
-				Jsonrpc: "2.0",
-				Method:  "eth_subscription",
-				Params: &SubscriptionResult{
-					Subscription: subID,
-					Result:       ethLog,
-				},
-			}
+// internalTxsCriteria is the criteria of an internalTransactions subscription, an empty list of
+// addresses matches any address.
+type internalTxsCriteria struct {
+	From []common.Address
+	To   []common.Address
+}
 
-			err := wsConn.WriteJSON(res)
-			if err != nil {
-				try(func() {
-					if err != websocket.ErrCloseSent {
-						_ = wsConn.Close()
-					}
-				}, api.logger, "closing websocket peer sub")
+// matches returns true if the internal transaction matches the criteria.
+func (crit internalTxsCriteria) matches(internalTx *rpctypes.RPCInternalTransaction) bool {
+	return includesAddress(crit.From, internalTx.From) && includesAddress(crit.To, internalTx.To)
+}
 
-				return err
-			}
+func includesAddress(addresses []common.Address, address common.Address) bool {
+	if len(addresses) == 0 {
+		return true
+	}
+	for _, addr := range addresses {
+		if addr == address {
+			return true
 		}
-		return nil
-	})
+	}
+	return false
+}
 
-	return cancel, nil
+// result returns the notification result of the internal transaction, if it matches the criteria.
+func (crit internalTxsCriteria) result(internalTx *ethermint.InternalTxResult) (interface{}, bool) {
+	rpcInternalTx := rpctypes.NewRPCInternalTransaction(internalTx, nil)
+	return rpcInternalTx, crit.matches(rpcInternalTx)
 }
 
-func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID) (context.CancelFunc, error) {
-	ctx, cancel := context.WithCancel(context.Background())
-	//nolint: errcheck
-	go api.events.TxStream().Subscribe(ctx, func(items []common.Hash, _ int) error {
-		for _, hash := range items {
-			// write to ws conn
-			res := &SubscriptionNotification{
-				Jsonrpc: "2.0",
-				Method:  "eth_subscription",
-				Params: &SubscriptionResult{
-					Subscription: subID,
-					Result:       hash,
-				},
+// UnmarshalJSON parses the criteria like the websocket subscription parameter.
+func (crit *internalTxsCriteria) UnmarshalJSON(data []byte) error {
+	var extra interface{}
+	if err := json.Unmarshal(data, &extra); err != nil {
+		return err
+	}
+	parsed, err := parseInternalTxsCriteria(extra)
+	if err != nil {
+		return err
+	}
+	*crit = parsed
+	return nil
+}
+
+// parseInternalTxsCriteria parses the criteria of an internalTransactions subscription.
+func parseInternalTxsCriteria(extra interface{}) (internalTxsCriteria, error) {
+	crit := internalTxsCriteria{}
+
+	if extra != nil {
+		params, ok := extra.(map[string]interface{})
+		if !ok {
+			return crit, errors.New("invalid criteria")
+		}
+
+		var err error
+		if params["from"] != nil {
+			if crit.From, err = parseAddresses(params["from"]); err != nil {
+				return crit, err
+			}
+		}
+		if params["to"] != nil {
+			if crit.To, err = parseAddresses(params["to"]); err != nil {
+				return crit, err
 			}
+		}
+	}
 
-			err := wsConn.WriteJSON(res)
-			if err != nil {
-				api.logger.Debug("error writing header, will drop peer", "error", err.Error())
+	return crit, nil
+}
 
-				try(func() {
-					if err != websocket.ErrCloseSent {
-						_ = wsConn.Close()
-					}
-				}, api.logger, "closing websocket peer sub")
-				return err
+// parseAddresses parses an address or an array of addresses of the criteria of a subscription.
+func parseAddresses(param interface{}) ([]common.Address, error) {
+	switch address := param.(type) {
+	case string:
+		return []common.Address{common.HexToAddress(address)}, nil
+	case []interface{}:
+		addresses := make([]common.Address, 0, len(address))
+		for _, addr := range address {
+			address, ok := addr.(string)
+			if !ok {
+				return nil, errors.New("invalid address")
 			}
+
+			addresses = append(addresses, common.HexToAddress(address))
+		}
+		return addresses, nil
+	default:
+		return nil, errors.New("invalid addresses; must be address or array of addresses")
+	}
+}
+
+// subscribeSyncing notifies the subscriber of the sync status of the node, in a new goroutine.
+func (api *pubSubAPI) subscribeSyncing(ctx context.Context, wsConn *wsConn, subID rpc.ID) {
+	api.watchSyncing(ctx, func(res interface{}) error {
+		notification := &SubscriptionNotification{
+			Jsonrpc: "2.0",
+			Method:  "eth_subscription",
+			Params: &SubscriptionResult{
+				Subscription: subID,
+				Result:       res,
+			},
+		}
+		if err := wsConn.WriteJSON(notification); err != nil {
+			api.logger.Debug("error writing syncing status, will drop peer", "error", err.Error())
+
+			try(func() {
+				if err != websocket.ErrCloseSent {
+					_ = wsConn.Close()
+				}
+			}, api.logger, "closing websocket peer sub")
+			return err
 		}
 		return nil
 	})
-
-	return cancel, nil
 }
 
-func (api *pubSubAPI) subscribeSyncing(_ *wsConn, _ rpc.ID) (context.CancelFunc, error) {
-	return nil, errors.New("syncing subscription is not implemented")
+// watchSyncing calls notify with the sync status of the node, on subscription, when it changes,
+// and with the progress while catching up, in a new goroutine, until the context is done or
+// notify fails.
+func (api *pubSubAPI) watchSyncing(ctx context.Context, notify func(interface{}) error) {
+	go func() {
+		ticker := time.NewTicker(syncingPollInterval)
+		defer ticker.Stop()
+
+		var (
+			last     interface{}
+			starting hexutil.Uint64
+		)
+		for {
+			status, err := api.clientCtx.Client.Status(ctx)
+			if err != nil {
+				api.logger.Debug("failed to fetch the node status", "error", err.Error())
+			} else {
+				var res interface{} = false
+				if status.SyncInfo.CatchingUp {
+					if last == false || last == nil {
+						starting = hexutil.Uint64(status.SyncInfo.LatestBlockHeight)
+					}
+					res = SyncingResult{
+						Syncing: true,
+						Status: SyncingStatus{
+							StartingBlock: starting,
+							CurrentBlock:  hexutil.Uint64(status.SyncInfo.LatestBlockHeight),
+						},
+					}
+				}
+
+				if res != last {
+					if err := notify(res); err != nil {
+						return
+					}
+					last = res
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
 }
 
 // copy from github.com/ethereum/go-ethereum/rpc/json.go
@@ -19,6 +19,8 @@ import (
 	"errors"
 	"fmt"
 	"path"
+	"strconv"
+	stdstrings "strings"
 	"time"
 
 	sdkmath "cosmossdk.io/math"
@@ -55,6 +57,17 @@ const (
 
 	DefaultMaxTxGasWanted = 0
 
+	// DefaultMempoolPriceBump is the default minimum fee increase, in percent, to replace a
+	// pending transaction, same as geth
+	DefaultMempoolPriceBump uint64 = 10
+
+	// DefaultMempoolMaxNonceGap is the default maximum distance between the nonce of a queued
+	// transaction and the next nonce of its sender
+	DefaultMempoolMaxNonceGap uint64 = 16
+
+	// DefaultSgxExecutorAddress is the default address of the SGX executor
+	DefaultSgxExecutorAddress = "localhost:9092"
+
 	DefaultGasCap uint64 = 25000000
 
 	DefaultFilterCap int32 = 200
@@ -63,10 +76,38 @@ const (
 
 	DefaultLogsCap int32 = 10000
 
+	// DefaultRateLimit is the default number of requests per second of a client IP (0=unlimited)
+	DefaultRateLimit float64 = 0
+
+	// DefaultRateLimitBurst is the default number of requests a client IP can make at once
+	DefaultRateLimitBurst = 100
+
+	// DefaultAPIKeyRateLimit is the default number of requests per second of an API key (0=unlimited)
+	DefaultAPIKeyRateLimit float64 = 0
+
+	// DefaultAPIKeyRateLimitBurst is the default number of requests an API key can make at once
+	DefaultAPIKeyRateLimitBurst = 1000
+
+	// DefaultResponseCacheSize is the default number of responses about committed blocks cached
+	// by each JSON-RPC namespace
+	DefaultResponseCacheSize = 4096
+
+	// DefaultKeystoreDir is the default directory of the keystore of the personal namespace
+	// accounts, relative to the node home directory
+	DefaultKeystoreDir = "keystore"
+
 	DefaultBlockRangeCap int32 = 10000
 
+	// DefaultBlobRetention is the default number of recent blocks whose blob
+	// sidecars are kept in the local blob store
+	DefaultBlobRetention uint64 = 4096
+
 	DefaultEVMTimeout = 5 * time.Second
 
+	// DefaultExternalSignerTimeout is the default timeout of the signing requests of the external
+	// signer, which may wait for a manual approval
+	DefaultExternalSignerTimeout = 2 * time.Minute
+
 	// default 1.0 eth
 	DefaultTxFeeCap float64 = 1.0
 
@@ -104,6 +145,9 @@ var (
 	DefaultRosettaGasPrices = sdk.NewDecCoins(sdk.NewDecCoin(DefaultRosettaDenomToSuggest, sdkmath.NewInt(4_000_000)))
 
 	evmTracers = []string{"json", "markdown", "struct", "access_list"}
+
+	// DefaultMethodWeights are the default costs of the expensive JSON-RPC methods in the rate limits
+	DefaultMethodWeights = []string{"debug_trace*=20", "eth_getLogs=10"}
 )
 
 // Config defines the server's top level configuration. It includes the default app config
@@ -124,6 +168,29 @@ type EVMConfig struct {
 	Tracer string `mapstructure:"tracer"`
 	// MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 	MaxTxGasWanted uint64 `mapstructure:"max-tx-gas-wanted"`
+	// SgxExecutors defines the addresses of the SGX executors. The first one is the primary
+	// executor for block execution, the second one its failover, and the remaining ones serve
+	// read-only queries.
+	SgxExecutors []string `mapstructure:"sgx-executors"`
+	// ShadowExecution defines if every message executed by the SGX enclave is cross-checked
+	// against the in-process EVM, reporting any divergence without affecting consensus.
+	ShadowExecution bool `mapstructure:"shadow-execution"`
+	// SgxBlockSession defines if the transactions of a block are executed in a single session of
+	// the primary SGX executor.
+	SgxBlockSession bool `mapstructure:"sgx-block-session"`
+	// TraceInternalTxs defines if the internal transactions of the block transactions are traced
+	// and emitted as events, for the indexer and the JSON-RPC server. It executes every transaction
+	// a second time on the in-process EVM, the run of the shadow execution if enabled.
+	TraceInternalTxs bool `mapstructure:"trace-internal-txs"`
+	// ParallelWorkers defines the number of workers the EVM transactions of a block are
+	// pre-executed with, pre-execution is disabled with less than two.
+	ParallelWorkers int `mapstructure:"parallel-workers"`
+	// MempoolPriceBump defines the minimum fee increase, in percent, for a transaction to
+	// replace a pending one with the same sender and nonce.
+	MempoolPriceBump uint64 `mapstructure:"mempool-price-bump"`
+	// MempoolMaxNonceGap defines the maximum distance between the nonce of a queued transaction
+	// and the next nonce of its sender.
+	MempoolMaxNonceGap uint64 `mapstructure:"mempool-max-nonce-gap"`
 }
 
 // JSONRPCConfig defines configuration for the EVM RPC server.
@@ -164,12 +231,64 @@ type JSONRPCConfig struct {
 	EnableIndexer bool `mapstructure:"enable-indexer"`
 	// AllowIndexerGap defines if allow block gap for the custom indexer service.
 	AllowIndexerGap bool `mapstructure:"allow-indexer-gap"`
+	// BlobRetention defines the number of recent blocks whose blob sidecars are
+	// kept in the local blob store (0=keep all).
+	BlobRetention uint64 `mapstructure:"blob-retention"`
 	// MetricsAddress defines the metrics server to listen on
 	MetricsAddress string `mapstructure:"metrics-address"`
 	// FixRevertGasRefundHeight defines the upgrade height for fix of revert gas refund logic when transaction reverted
 	FixRevertGasRefundHeight int64 `mapstructure:"fix-revert-gas-refund-height"`
 	// ReturnDataLimit defines maximum number of bytes returned from `eth_call` or similar invocations
 	ReturnDataLimit int64 `mapstructure:"return-data-limit"`
+	// RateLimit defines the number of requests per second allowed to a client IP, weighted by
+	// MethodWeights (0=unlimited).
+	RateLimit float64 `mapstructure:"rate-limit"`
+	// RateLimitBurst defines the number of requests a client IP can make at once.
+	RateLimitBurst int `mapstructure:"rate-limit-burst"`
+	// APIKeys defines the API keys accepted in the X-API-Key header, whose requests are limited
+	// by key instead of by client IP.
+	APIKeys []string `mapstructure:"api-keys"`
+	// APIKeyRateLimit defines the number of requests per second allowed to an API key, weighted
+	// by MethodWeights (0=unlimited).
+	APIKeyRateLimit float64 `mapstructure:"api-key-rate-limit"`
+	// APIKeyRateLimitBurst defines the number of requests an API key can make at once.
+	APIKeyRateLimitBurst int `mapstructure:"api-key-rate-limit-burst"`
+	// MethodWeights defines the cost of methods in the rate limits, as "method=weight" entries,
+	// where the method can end with a "*" wildcard. Other methods cost 1.
+	MethodWeights []string `mapstructure:"method-weights"`
+	// AllowedMethods defines the only methods allowed in the namespaces they belong to, as
+	// "namespace_method" patterns, where the method can end with a "*" wildcard.
+	AllowedMethods []string `mapstructure:"allowed-methods"`
+	// DeniedMethods defines the methods rejected by the server, as "namespace_method" patterns,
+	// where the method can end with a "*" wildcard.
+	DeniedMethods []string `mapstructure:"denied-methods"`
+	// ResponseCacheSize defines the number of responses about committed blocks cached by each
+	// JSON-RPC namespace (0=disabled).
+	ResponseCacheSize int `mapstructure:"response-cache-size"`
+	// WSDropSlowConsumers defines if the websocket subscriptions falling behind their stream are
+	// canceled, after being notified of the missed results.
+	WSDropSlowConsumers bool `mapstructure:"ws-drop-slow-consumers"`
+	// IPCPath defines the path of the unix domain socket the JSON-RPC server is served on, relative
+	// to the node home directory if not absolute (empty=disabled).
+	IPCPath string `mapstructure:"ipc-path"`
+	// AuthJWTSecret defines the path of the file holding the hex encoded 32 bytes secret of the
+	// JWT tokens authenticating the admin namespace calls, relative to the node home directory if
+	// not absolute.
+	AuthJWTSecret string `mapstructure:"auth-jwt-secret"`
+	// KeystoreDir defines the directory of the Web3 Secret Storage (keystore v3) files of the
+	// personal namespace accounts, relative to the node home directory if not absolute, the
+	// DefaultKeystoreDir if empty.
+	KeystoreDir string `mapstructure:"keystore-dir"`
+	// ExternalSigner defines the endpoint of an external signer, like clef, serving the account
+	// API of EIP-3030 over HTTP, websocket or IPC. The accounts it holds sign the transactions and
+	// messages in place of the keys of the node, which remain as fallback.
+	ExternalSigner string `mapstructure:"external-signer"`
+	// ExternalSignerTimeout is the timeout of the signing requests of the external signer,
+	// including their manual approval (0=infinite).
+	ExternalSignerTimeout time.Duration `mapstructure:"external-signer-timeout"`
+	// EnableGraphQL defines if the EIP-1767 GraphQL queries are served at the /graphql path of the
+	// JSON-RPC server.
+	EnableGraphQL bool `mapstructure:"enable-graphql"`
 }
 
 // TLSConfig defines the certificate and matching private key for the server.
@@ -236,8 +355,11 @@ func DefaultConfig() *Config {
 // DefaultEVMConfig returns the default EVM configuration
 func DefaultEVMConfig() *EVMConfig {
 	return &EVMConfig{
-		Tracer:         DefaultEVMTracer,
-		MaxTxGasWanted: DefaultMaxTxGasWanted,
+		Tracer:             DefaultEVMTracer,
+		MaxTxGasWanted:     DefaultMaxTxGasWanted,
+		SgxExecutors:       []string{DefaultSgxExecutorAddress},
+		MempoolPriceBump:   DefaultMempoolPriceBump,
+		MempoolMaxNonceGap: DefaultMempoolMaxNonceGap,
 	}
 }
 
@@ -247,6 +369,27 @@ func (c EVMConfig) Validate() error {
 		return fmt.Errorf("invalid tracer type %s, available types: %v", c.Tracer, evmTracers)
 	}
 
+	if len(c.SgxExecutors) == 0 {
+		return errors.New("at least one SGX executor address must be defined")
+	}
+
+	if c.ParallelWorkers < 0 {
+		return errors.New("parallel workers cannot be negative")
+	}
+
+	// check for duplicates
+	seenExecutors := make(map[string]bool)
+	for _, addr := range c.SgxExecutors {
+		if addr == "" {
+			return errors.New("SGX executor address cannot be empty")
+		}
+		if seenExecutors[addr] {
+			return fmt.Errorf("repeated SGX executor address '%s'", addr)
+		}
+
+		seenExecutors[addr] = true
+	}
+
 	return nil
 }
 
@@ -280,9 +423,18 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		MaxOpenConnections:       DefaultMaxOpenConnections,
 		EnableIndexer:            false,
 		AllowIndexerGap:          true,
+		BlobRetention:            DefaultBlobRetention,
 		MetricsAddress:           DefaultJSONRPCMetricsAddress,
 		FixRevertGasRefundHeight: DefaultFixRevertGasRefundHeight,
 		ReturnDataLimit:          DefaultReturnDataLimit,
+		RateLimit:                DefaultRateLimit,
+		RateLimitBurst:           DefaultRateLimitBurst,
+		APIKeyRateLimit:          DefaultAPIKeyRateLimit,
+		APIKeyRateLimitBurst:     DefaultAPIKeyRateLimitBurst,
+		MethodWeights:            DefaultMethodWeights,
+		ResponseCacheSize:        DefaultResponseCacheSize,
+		KeystoreDir:              DefaultKeystoreDir,
+		ExternalSignerTimeout:    DefaultExternalSignerTimeout,
 	}
 }
 
@@ -324,6 +476,10 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC HTTP idle timeout duration cannot be negative")
 	}
 
+	if c.ExternalSignerTimeout < 0 {
+		return errors.New("JSON-RPC external signer timeout duration cannot be negative")
+	}
+
 	// check for duplicates
 	seenAPIs := make(map[string]bool)
 	for _, api := range c.API {
@@ -334,9 +490,86 @@ func (c JSONRPCConfig) Validate() error {
 		seenAPIs[api] = true
 	}
 
+	if seenAPIs["admin"] && c.AuthJWTSecret == "" {
+		return errors.New("the JSON-RPC admin namespace requires a JWT secret")
+	}
+
+	if c.ResponseCacheSize < 0 {
+		return errors.New("JSON-RPC response cache size cannot be negative")
+	}
+
+	if c.RateLimit < 0 || c.APIKeyRateLimit < 0 {
+		return errors.New("JSON-RPC rate limits cannot be negative")
+	}
+
+	if (c.RateLimit > 0 && c.RateLimitBurst <= 0) || (c.APIKeyRateLimit > 0 && c.APIKeyRateLimitBurst <= 0) {
+		return errors.New("JSON-RPC rate limit bursts must be positive")
+	}
+
+	seenKeys := make(map[string]bool)
+	for _, key := range c.APIKeys {
+		if key == "" {
+			return errors.New("JSON-RPC API key cannot be empty")
+		}
+		if seenKeys[key] {
+			return errors.New("repeated JSON-RPC API key")
+		}
+
+		seenKeys[key] = true
+	}
+
+	if _, err := ParseMethodWeights(c.MethodWeights); err != nil {
+		return err
+	}
+
+	for _, pattern := range append(append([]string{}, c.AllowedMethods...), c.DeniedMethods...) {
+		if err := ValidateMethodPattern(pattern); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidateMethodPattern returns an error if the pattern isn't a "namespace_method" JSON-RPC
+// method, where the method can end with a "*" wildcard.
+func ValidateMethodPattern(pattern string) error {
+	namespace, method, ok := stdstrings.Cut(pattern, "_")
+	if !ok || namespace == "" || stdstrings.Contains(namespace, "*") ||
+		stdstrings.Contains(stdstrings.TrimSuffix(method, "*"), "*") {
+		return fmt.Errorf("invalid JSON-RPC method pattern '%s'", pattern)
+	}
+
 	return nil
 }
 
+// ParseMethodWeights parses the "method=weight" entries of the method weights, by method
+// pattern.
+func ParseMethodWeights(entries []string) (map[string]int, error) {
+	weights := make(map[string]int, len(entries))
+	for _, entry := range entries {
+		pattern, weight, ok := stdstrings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid JSON-RPC method weight '%s', expected 'method=weight'", entry)
+		}
+		if err := ValidateMethodPattern(pattern); err != nil {
+			return nil, err
+		}
+
+		w, err := strconv.Atoi(weight)
+		if err != nil || w <= 0 {
+			return nil, fmt.Errorf("invalid JSON-RPC method weight '%s', the weight must be a positive integer", entry)
+		}
+		if _, ok := weights[pattern]; ok {
+			return nil, fmt.Errorf("repeated JSON-RPC method weight '%s'", pattern)
+		}
+
+		weights[pattern] = w
+	}
+
+	return weights, nil
+}
+
 // DefaultTLSConfig returns the default TLS configuration
 func DefaultTLSConfig() *TLSConfig {
 	return &TLSConfig{
@@ -392,8 +625,15 @@ func GetConfig(v *viper.Viper) (Config, error) {
 	return Config{
 		Config: cfg,
 		EVM: EVMConfig{
-			Tracer:         v.GetString("evm.tracer"),
-			MaxTxGasWanted: v.GetUint64("evm.max-tx-gas-wanted"),
+			Tracer:             v.GetString("evm.tracer"),
+			MaxTxGasWanted:     v.GetUint64("evm.max-tx-gas-wanted"),
+			SgxExecutors:       v.GetStringSlice("evm.sgx-executors"),
+			ShadowExecution:    v.GetBool("evm.shadow-execution"),
+			SgxBlockSession:    v.GetBool("evm.sgx-block-session"),
+			TraceInternalTxs:   v.GetBool("evm.trace-internal-txs"),
+			ParallelWorkers:    v.GetInt("evm.parallel-workers"),
+			MempoolPriceBump:   v.GetUint64("evm.mempool-price-bump"),
+			MempoolMaxNonceGap: v.GetUint64("evm.mempool-max-nonce-gap"),
 		},
 		JSONRPC: JSONRPCConfig{
 			Enable:                   v.GetBool("json-rpc.enable"),
@@ -412,9 +652,26 @@ func GetConfig(v *viper.Viper) (Config, error) {
 			MaxOpenConnections:       v.GetInt("json-rpc.max-open-connections"),
 			EnableIndexer:            v.GetBool("json-rpc.enable-indexer"),
 			AllowIndexerGap:          v.GetBool("json-rpc.allow-indexer-gap"),
+			BlobRetention:            v.GetUint64("json-rpc.blob-retention"),
 			MetricsAddress:           v.GetString("json-rpc.metrics-address"),
 			FixRevertGasRefundHeight: v.GetInt64("json-rpc.fix-revert-gas-refund-height"),
 			ReturnDataLimit:          v.GetInt64("json-rpc.return-data-limit"),
+			RateLimit:                v.GetFloat64("json-rpc.rate-limit"),
+			RateLimitBurst:           v.GetInt("json-rpc.rate-limit-burst"),
+			APIKeys:                  v.GetStringSlice("json-rpc.api-keys"),
+			APIKeyRateLimit:          v.GetFloat64("json-rpc.api-key-rate-limit"),
+			APIKeyRateLimitBurst:     v.GetInt("json-rpc.api-key-rate-limit-burst"),
+			MethodWeights:            v.GetStringSlice("json-rpc.method-weights"),
+			AllowedMethods:           v.GetStringSlice("json-rpc.allowed-methods"),
+			DeniedMethods:            v.GetStringSlice("json-rpc.denied-methods"),
+			ResponseCacheSize:        v.GetInt("json-rpc.response-cache-size"),
+			WSDropSlowConsumers:      v.GetBool("json-rpc.ws-drop-slow-consumers"),
+			IPCPath:                  v.GetString("json-rpc.ipc-path"),
+			AuthJWTSecret:            v.GetString("json-rpc.auth-jwt-secret"),
+			KeystoreDir:              v.GetString("json-rpc.keystore-dir"),
+			ExternalSigner:           v.GetString("json-rpc.external-signer"),
+			ExternalSignerTimeout:    v.GetDuration("json-rpc.external-signer-timeout"),
+			EnableGraphQL:            v.GetBool("json-rpc.enable-graphql"),
 		},
 		TLS: TLSConfig{
 			CertificatePath: v.GetString("tls.certificate-path"),
@@ -12,3 +12,37 @@ func TestDefaultConfig(t *testing.T) {
 	require.Equal(t, cfg.JSONRPC.Address, DefaultJSONRPCAddress)
 	require.Equal(t, cfg.JSONRPC.WsAddress, DefaultJSONRPCWsAddress)
 }
+
+func TestJSONRPCConfigValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(*JSONRPCConfig)
+		expPass  bool
+	}{
+		{"default", func(*JSONRPCConfig) {}, true},
+		{"rate limit", func(c *JSONRPCConfig) { c.RateLimit = 10 }, true},
+		{"negative rate limit", func(c *JSONRPCConfig) { c.RateLimit = -1 }, false},
+		{"rate limit without burst", func(c *JSONRPCConfig) { c.APIKeyRateLimit, c.APIKeyRateLimitBurst = 10, 0 }, false},
+		{"empty API key", func(c *JSONRPCConfig) { c.APIKeys = []string{""} }, false},
+		{"repeated API key", func(c *JSONRPCConfig) { c.APIKeys = []string{"a", "a"} }, false},
+		{"method weight without weight", func(c *JSONRPCConfig) { c.MethodWeights = []string{"eth_call"} }, false},
+		{"zero method weight", func(c *JSONRPCConfig) { c.MethodWeights = []string{"eth_call=0"} }, false},
+		{"method weight without namespace", func(c *JSONRPCConfig) { c.MethodWeights = []string{"*=2"} }, false},
+		{"method patterns", func(c *JSONRPCConfig) { c.DeniedMethods = []string{"debug_*", "eth_call"} }, true},
+		{"wildcard inside a method pattern", func(c *JSONRPCConfig) { c.AllowedMethods = []string{"debug_*Block"} }, false},
+		{"admin namespace without JWT secret", func(c *JSONRPCConfig) { c.API = append(c.API, "admin") }, false},
+		{"admin namespace", func(c *JSONRPCConfig) { c.API, c.AuthJWTSecret = append(c.API, "admin"), "config/jwtsecret" }, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := DefaultJSONRPCConfig()
+			tc.malleate(cfg)
+			if tc.expPass {
+				require.NoError(t, cfg.Validate())
+			} else {
+				require.Error(t, cfg.Validate())
+			}
+		})
+	}
+}
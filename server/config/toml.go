@@ -31,6 +31,50 @@ tracer = "{{ .EVM.Tracer }}"
 # MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 max-tx-gas-wanted = {{ .EVM.MaxTxGasWanted }}
 
+# SgxExecutors defines the addresses of the SGX executors. The first address is the primary
+# executor used for block execution and the second one its failover, used for the sessions opened
+# while the primary is unreachable: a transaction whose session breaks mid-way isn't retried, the
+# node halts at the block instead and executes it again once restarted.
+# Read-only queries (eth_call, eth_estimateGas, traces) are balanced across the remaining addresses.
+# Example: "localhost:9092,localhost:9093,localhost:9094"
+sgx-executors = "{{range $index, $elmt := .EVM.SgxExecutors}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
+# ShadowExecution cross-checks every message executed by the SGX enclave against the in-process EVM.
+# Divergences in return data, gas used, logs and state writes are logged and counted in the
+# 'evm_shadow_divergence' metric, the enclave result is always the one used by consensus.
+shadow-execution = {{ .EVM.ShadowExecution }}
+
+# SgxBlockSession executes the transactions of a block in a single session of the primary SGX
+# executor, the state of every transaction is still written back to the node when it's committed.
+# It requires an executor speaking the enclave protocol version 5, and is ignored in shadow
+# execution mode.
+sgx-block-session = {{ .EVM.SgxBlockSession }}
+
+# TraceInternalTxs traces the value transfers, contract creations and self-destructs of the nested
+# call frames of the block transactions on the in-process EVM, and emits them as events. They are
+# persisted by the custom indexer and served by the debug_getInternalTransactions JSON-RPC method
+# and the internalTransactions websocket subscription. The events are not part of the consensus.
+# The enclave doesn't return the call frames, so every transaction is executed a second time on the
+# in-process EVM, which roughly doubles the execution cost of the blocks. The shadow execution run is
+# reused when it's enabled, the transactions aren't executed a third time.
+trace-internal-txs = {{ .EVM.TraceInternalTxs }}
+
+# ParallelWorkers defines the number of workers the EVM transactions of a block are pre-executed with,
+# concurrently on the consensus SGX executors (<2=disabled). The transactions are still applied in order:
+# a pre-execution is only used if the state it read wasn't changed by the previous transactions of the
+# block, the others are executed again, so the results are identical to the sequential execution.
+# It requires an executor speaking the enclave protocol version 6, and is ignored in shadow execution
+# mode and when the internal transactions are traced.
+parallel-workers = {{ .EVM.ParallelWorkers }}
+
+# MempoolPriceBump defines the minimum fee increase, in percent, for a transaction to replace
+# a pending one with the same sender and nonce.
+mempool-price-bump = {{ .EVM.MempoolPriceBump }}
+
+# MempoolMaxNonceGap defines how far ahead of the next nonce of its sender a transaction can be
+# queued in the mempool, until the nonce gap is filled.
+mempool-max-nonce-gap = {{ .EVM.MempoolMaxNonceGap }}
+
 ###############################################################################
 ###                           JSON RPC Configuration                        ###
 ###############################################################################
@@ -91,6 +135,11 @@ enable-indexer = {{ .JSONRPC.EnableIndexer }}
 # AllowIndexerGap allow block gap for the custom transaction indexer for the EVM (ethereum transactions).
 allow-indexer-gap = {{ .JSONRPC.AllowIndexerGap }}
 
+# BlobRetention is the number of recent blocks whose EIP-4844 blob sidecars are kept in the local
+# blob store maintained along the custom indexer (0=keep all). The sidecars are stripped from the
+# blocks, only the ones of the transactions received in the mempool of the node are stored.
+blob-retention = {{ .JSONRPC.BlobRetention }}
+
 # MetricsAddress defines the EVM Metrics server address to bind to. Pass --metrics in CLI to enable
 # Prometheus metrics path: /debug/metrics/prometheus
 metrics-address = "{{ .JSONRPC.MetricsAddress }}"
@@ -101,6 +150,81 @@ fix-revert-gas-refund-height = {{ .JSONRPC.FixRevertGasRefundHeight }}
 # Maximum number of bytes returned from eth_call or similar invocations.
 return-data-limit = {{ .JSONRPC.ReturnDataLimit }}
 
+# RateLimit defines the number of requests per second allowed to a client IP, weighted by the
+# method weights (0=unlimited).
+rate-limit = {{ .JSONRPC.RateLimit }}
+
+# RateLimitBurst defines the number of requests a client IP can make at once.
+rate-limit-burst = {{ .JSONRPC.RateLimitBurst }}
+
+# APIKeys defines the API keys accepted in the X-API-Key header, whose requests are limited by
+# key instead of by client IP. Requests with an unknown key are rejected.
+api-keys = "{{range $index, $elmt := .JSONRPC.APIKeys}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
+# APIKeyRateLimit defines the number of requests per second allowed to an API key, weighted by
+# the method weights (0=unlimited).
+api-key-rate-limit = {{ .JSONRPC.APIKeyRateLimit }}
+
+# APIKeyRateLimitBurst defines the number of requests an API key can make at once.
+api-key-rate-limit-burst = {{ .JSONRPC.APIKeyRateLimitBurst }}
+
+# MethodWeights defines the cost of methods in the rate limits, other methods cost 1. A request
+# never costs more than the burst.
+# Example: "debug_trace*=20,eth_getLogs=10"
+method-weights = "{{range $index, $elmt := .JSONRPC.MethodWeights}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
+# AllowedMethods defines the only methods allowed in the namespaces they belong to, the other
+# namespaces aren't restricted.
+# Example: "debug_traceTransaction,debug_traceBlock*"
+allowed-methods = "{{range $index, $elmt := .JSONRPC.AllowedMethods}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
+# DeniedMethods defines the methods rejected by the server.
+# Example: "eth_sendTransaction,personal_*"
+denied-methods = "{{range $index, $elmt := .JSONRPC.DeniedMethods}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
+# ResponseCacheSize defines the number of responses about committed blocks (blocks, receipts, logs
+# and transaction traces) cached by each JSON-RPC namespace (0=disabled).
+response-cache-size = {{ .JSONRPC.ResponseCacheSize }}
+
+# WSDropSlowConsumers defines if the websocket subscriptions falling behind their stream are canceled,
+# after being notified of the missed results with an eth_subscriptionLagged notification.
+ws-drop-slow-consumers = {{ .JSONRPC.WSDropSlowConsumers }}
+
+# IPCPath defines the path of the unix domain socket the JSON-RPC server, subscriptions included, is
+# served on, relative to the node home directory if not absolute. The socket is only accessible by
+# the user running the node, and is not subject to the rate limits, API keys and method lists.
+# Example: "data/ethermint.ipc" (empty=disabled)
+ipc-path = "{{ .JSONRPC.IPCPath }}"
+
+# AuthJWTSecret defines the path of the file holding the hex encoded 32 bytes secret of the JWT tokens
+# authenticating the calls of the admin namespace, relative to the node home directory if not absolute.
+# The calls must carry an "Authorization: Bearer <token>" header with a HS256 token issued within the
+# last minute ("iat" claim), like the go-ethereum engine API. Required to enable the admin namespace.
+# Example: "config/jwtsecret", generated with "openssl rand -hex 32"
+auth-jwt-secret = "{{ .JSONRPC.AuthJWTSecret }}"
+
+# KeystoreDir defines the directory of the Web3 Secret Storage (keystore v3) files of the personal namespace
+# accounts, relative to the node home directory if not absolute. The files are compatible with go-ethereum
+# and picked up when copied in the directory.
+keystore-dir = "{{ .JSONRPC.KeystoreDir }}"
+
+# ExternalSigner defines the endpoint of an external signer, like clef, serving the account API of EIP-3030
+# over HTTP, websocket or IPC. The accounts it holds sign the transactions and messages sent through the
+# JSON-RPC server, the keys of the node remain as fallback. Disabled if empty.
+# Example: "http://localhost:8550" or "/path/to/clef.ipc"
+external-signer = "{{ .JSONRPC.ExternalSigner }}"
+
+# ExternalSignerTimeout is the timeout of the signing requests of the external signer, including their
+# manual approval (0=infinite). Default: 2m.
+external-signer-timeout = "{{ .JSONRPC.ExternalSignerTimeout }}"
+
+# EnableGraphQL serves the EIP-1767 GraphQL schema of go-ethereum at the /graphql path of the JSON-RPC
+# server, to fetch blocks, transactions, receipts, logs and accounts in a single query. The log queries
+# are limited like 'eth_getLogs' by logs-cap and block-range-cap. The fields of the queries are checked
+# against the method lists and charged by the rate limits as the equivalent methods of the eth namespace,
+# e.g. the sendRawTransaction mutation as eth_sendRawTransaction and the call fields as eth_call.
+enable-graphql = {{ .JSONRPC.EnableGraphQL }}
+
 ###############################################################################
 ###                             TLS Configuration                           ###
 ###############################################################################
@@ -66,18 +66,42 @@ const (
 	JSONRPCMaxOpenConnections  = "json-rpc.max-open-connections"
 	JSONRPCEnableIndexer       = "json-rpc.enable-indexer"
 	JSONRPCAllowIndexerGap     = "json-rpc.allow-indexer-gap"
+	JSONRPCBlobRetention       = "json-rpc.blob-retention"
 	// JSONRPCEnableMetrics enables EVM RPC metrics server.
 	// Set to `metrics` which is hardcoded flag from go-ethereum.
 	// https://github.com/ethereum/go-ethereum/blob/master/metrics/metrics.go#L35-L55
 	JSONRPCEnableMetrics            = "metrics"
 	JSONRPCFixRevertGasRefundHeight = "json-rpc.fix-revert-gas-refund-height"
 	JSONRPCReturnDataLimit          = "json-rpc.return-data-limit"
+	JSONRPCRateLimit                = "json-rpc.rate-limit"
+	JSONRPCRateLimitBurst           = "json-rpc.rate-limit-burst"
+	JSONRPCAPIKeyRateLimit          = "json-rpc.api-key-rate-limit"
+	JSONRPCAPIKeyRateLimitBurst     = "json-rpc.api-key-rate-limit-burst"
+	JSONRPCMethodWeights            = "json-rpc.method-weights"
+	JSONRPCAllowedMethods           = "json-rpc.allowed-methods"
+	JSONRPCDeniedMethods            = "json-rpc.denied-methods"
+	JSONRPCResponseCacheSize        = "json-rpc.response-cache-size"
+	JSONRPCWSDropSlowConsumers      = "json-rpc.ws-drop-slow-consumers"
+	JSONRPCIPCPath                  = "json-rpc.ipc-path"
+	JSONRPCAuthJWTSecret            = "json-rpc.auth-jwt-secret"
+	JSONRPCKeystoreDir              = "json-rpc.keystore-dir"
+	JSONRPCExternalSigner           = "json-rpc.external-signer"
+	JSONRPCExternalSignerTimeout    = "json-rpc.external-signer-timeout"
+	JSONRPCEnableGraphQL            = "json-rpc.enable-graphql"
 )
 
 // EVM flags
 const (
-	EVMTracer         = "evm.tracer"
-	EVMMaxTxGasWanted = "evm.max-tx-gas-wanted"
+	EVMTracer           = "evm.tracer"
+	EVMMaxTxGasWanted   = "evm.max-tx-gas-wanted"
+	EVMSgxExecutors     = "evm.sgx-executors"
+	EVMShadowExecution  = "evm.shadow-execution"
+	EVMSgxBlockSession  = "evm.sgx-block-session"
+	EVMTraceInternalTxs = "evm.trace-internal-txs"
+	EVMParallelWorkers  = "evm.parallel-workers"
+
+	EVMMempoolPriceBump   = "evm.mempool-price-bump"
+	EVMMempoolMaxNonceGap = "evm.mempool-max-nonce-gap"
 )
 
 // TLS flags
@@ -16,8 +16,13 @@
 package server
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net"
 	"net/http"
+	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/gorilla/mux"
@@ -27,9 +32,14 @@ import (
 	rpcclient "github.com/cometbft/cometbft/rpc/client"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	ethlog "github.com/ethereum/go-ethereum/log"
 	ethrpc "github.com/ethereum/go-ethereum/rpc"
 	"github.com/evmos/ethermint/rpc"
+	"github.com/evmos/ethermint/rpc/backend"
+	"github.com/evmos/ethermint/rpc/graphql"
+	"github.com/evmos/ethermint/rpc/namespaces/ethereum/admin"
+	"github.com/evmos/ethermint/rpc/ratelimit"
 	"github.com/evmos/ethermint/rpc/stream"
 
 	"github.com/evmos/ethermint/server/config"
@@ -41,12 +51,14 @@ const (
 	MaxRetry        = 6
 )
 
-// StartJSONRPC starts the JSON-RPC server
+// StartJSONRPC starts the JSON-RPC server, the application is optional and only used by the admin
+// namespace if it runs in the same process.
 func StartJSONRPC(srvCtx *server.Context,
 	clientCtx client.Context,
 	g *errgroup.Group,
 	config *config.Config,
 	indexer ethermint.EVMTxIndexer,
+	app servertypes.Application,
 ) (*http.Server, chan struct{}, error) {
 	logger := srvCtx.Logger.With("module", "geth")
 
@@ -81,6 +93,28 @@ func StartJSONRPC(srvCtx *server.Context,
 		return nil
 	}))
 
+	// the admin calls are authenticated with the JWT secret
+	jsonRPCConfig := config.JSONRPC
+	if jsonRPCConfig.AuthJWTSecret != "" {
+		jsonRPCConfig.AuthJWTSecret = resolvePath(srvCtx.Config.RootDir, jsonRPCConfig.AuthJWTSecret)
+	}
+	limiter, err := ratelimit.NewLimiter(jsonRPCConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	wsSrv := rpc.NewWebsocketsServer(clientCtx, srvCtx.Logger, rpcStream, config, limiter)
+
+	node := admin.Node{Websocket: wsSrv}
+	if setter, ok := app.(admin.MinGasPricesSetter); ok {
+		node.App = setter
+	}
+	cmdCtx := clientCtx.CmdContext
+	if cmdCtx == nil {
+		cmdCtx = context.Background()
+	}
+	clientCtx = clientCtx.WithCmdContext(admin.ContextWithNode(cmdCtx, node))
+
 	rpcServer := ethrpc.NewServer()
 
 	allowUnprotectedTxs := config.JSONRPC.AllowUnprotectedTxs
@@ -98,9 +132,27 @@ func StartJSONRPC(srvCtx *server.Context,
 			return nil, nil, err
 		}
 	}
+	// the subscriptions are only served to the transports supporting notifications, the websocket
+	// server handles its own.
+	if err := rpcServer.RegisterName("eth", rpc.NewPubSubService(clientCtx, srvCtx.Logger, rpcStream)); err != nil {
+		return nil, nil, err
+	}
 
 	r := mux.NewRouter()
-	r.HandleFunc("/", rpcServer.ServeHTTP).Methods("POST")
+	r.Handle("/", limiter.Handler(rpcServer)).Methods("POST")
+	if config.JSONRPC.EnableGraphQL {
+		evmBackend := backend.NewBackend(srvCtx, srvCtx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+		// the queries are checked field by field, as the equivalent methods of the eth namespace
+		var graphQLLimiter graphql.Limiter
+		if limiter.Enabled() {
+			graphQLLimiter = limiter
+		}
+		graphQLHandler, err := graphql.NewHandler(srvCtx.Logger, evmBackend, graphQLLimiter)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create the GraphQL handler: %w", err)
+		}
+		r.Handle("/graphql", graphQLHandler).Methods("POST")
+	}
 
 	handlerWithCors := cors.Default()
 	if config.API.EnableUnsafeCORS {
@@ -135,9 +187,58 @@ func StartJSONRPC(srvCtx *server.Context,
 		return nil
 	})
 
+	if config.JSONRPC.IPCPath != "" {
+		ipcPath := resolvePath(srvCtx.Config.RootDir, config.JSONRPC.IPCPath)
+		ipcLn, err := ListenIPC(ipcPath)
+		if err != nil {
+			return nil, nil, err
+		}
+		httpSrv.RegisterOnShutdown(func() {
+			if err := ipcLn.Close(); err != nil {
+				srvCtx.Logger.Error("failed to close JSON-RPC IPC listener", "error", err.Error())
+			}
+		})
+
+		g.Go(func() error {
+			srvCtx.Logger.Info("Starting JSON-RPC IPC server", "path", ipcPath)
+			if err := rpcServer.ServeListener(ipcLn); err != nil && !errors.Is(err, net.ErrClosed) {
+				srvCtx.Logger.Error("failed to start JSON-RPC IPC server", "error", err.Error())
+				return err
+			}
+			return nil
+		})
+	}
+
 	srvCtx.Logger.Info("Starting JSON WebSocket server", "address", config.JSONRPC.WsAddress)
 
-	wsSrv := rpc.NewWebsocketsServer(clientCtx, srvCtx.Logger, rpcStream, config)
 	wsSrv.Start()
 	return httpSrv, httpSrvDone, nil
 }
+
+// resolvePath returns the path relative to the node home directory if it's not absolute.
+func resolvePath(rootDir, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(rootDir, path)
+}
+
+// ListenIPC creates the unix domain socket at the path, only accessible by the current user,
+// removing any leftover of a previous run, like go-ethereum does for its IPC endpoint.
+func ListenIPC(path string) (net.Listener, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o751); err != nil {
+		return nil, err
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	ln, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Chmod(path, 0o600); err != nil {
+		ln.Close()
+		return nil, err
+	}
+	return ln, nil
+}
@@ -214,10 +214,33 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Int(srvflags.JSONRPCMaxOpenConnections, config.DefaultMaxOpenConnections, "Sets the maximum number of simultaneous connections for the server listener") //nolint:lll
 	cmd.Flags().Bool(srvflags.JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
 	cmd.Flags().Bool(srvflags.JSONRPCAllowIndexerGap, true, "Allow block gap for the custom tx indexer for json-rpc")
+	cmd.Flags().Uint64(srvflags.JSONRPCBlobRetention, config.DefaultBlobRetention, "Sets the number of recent blocks whose blob sidecars are kept by the custom indexer (0=keep all)") //nolint:lll
 	cmd.Flags().Bool(srvflags.JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
-
-	cmd.Flags().String(srvflags.EVMTracer, config.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
-	cmd.Flags().Uint64(srvflags.EVMMaxTxGasWanted, config.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode")                                 //nolint:lll
+	cmd.Flags().Float64(srvflags.JSONRPCRateLimit, config.DefaultRateLimit, "Sets the number of requests per second allowed to a client IP, weighted by the method weights (0=unlimited)") //nolint:lll
+	cmd.Flags().Int(srvflags.JSONRPCRateLimitBurst, config.DefaultRateLimitBurst, "Sets the number of requests a client IP can make at once")
+	cmd.Flags().Float64(srvflags.JSONRPCAPIKeyRateLimit, config.DefaultAPIKeyRateLimit, "Sets the number of requests per second allowed to an API key, weighted by the method weights (0=unlimited)") //nolint:lll
+	cmd.Flags().Int(srvflags.JSONRPCAPIKeyRateLimitBurst, config.DefaultAPIKeyRateLimitBurst, "Sets the number of requests an API key can make at once")
+	cmd.Flags().StringSlice(srvflags.JSONRPCMethodWeights, config.DefaultMethodWeights, "Sets the cost of methods in the rate limits, as method=weight entries, other methods cost 1") //nolint:lll
+	cmd.Flags().StringSlice(srvflags.JSONRPCAllowedMethods, nil, "Defines the only methods allowed in the namespaces they belong to")
+	cmd.Flags().StringSlice(srvflags.JSONRPCDeniedMethods, nil, "Defines the methods rejected by the JSON-RPC server")
+	cmd.Flags().Int(srvflags.JSONRPCResponseCacheSize, config.DefaultResponseCacheSize, "Sets the number of responses about committed blocks cached by each JSON-RPC namespace (0=disabled)") //nolint:lll
+	cmd.Flags().Bool(srvflags.JSONRPCWSDropSlowConsumers, false, "Cancel the websocket subscriptions falling behind their stream")
+	cmd.Flags().String(srvflags.JSONRPCIPCPath, "", "Sets the path of the unix domain socket the JSON-RPC server is served on, relative to the home directory (empty=disabled)")              //nolint:lll
+	cmd.Flags().String(srvflags.JSONRPCKeystoreDir, config.DefaultKeystoreDir, "Sets the directory of the keystore files of the personal namespace accounts, relative to the home directory") //nolint:lll
+	cmd.Flags().String(srvflags.JSONRPCExternalSigner, "", "Sets the endpoint of an external signer (e.g. clef) signing with its accounts in place of the node keys, disabled if empty")      //nolint:lll
+	cmd.Flags().Duration(srvflags.JSONRPCExternalSignerTimeout, config.DefaultExternalSignerTimeout, "Sets the timeout of the signing requests of the external signer (0=infinite)")          //nolint:lll
+	cmd.Flags().Bool(srvflags.JSONRPCEnableGraphQL, false, "Serves the EIP-1767 GraphQL queries at the /graphql path of the JSON-RPC server")                                                 //nolint:lll
+	cmd.Flags().String(srvflags.JSONRPCAuthJWTSecret, "", "Sets the path of the hex encoded JWT secret authenticating the admin namespace calls, relative to the home directory")             //nolint:lll
+
+	cmd.Flags().String(srvflags.EVMTracer, config.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)")       //nolint:lll
+	cmd.Flags().Uint64(srvflags.EVMMaxTxGasWanted, config.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode")                                       //nolint:lll
+	cmd.Flags().StringSlice(srvflags.EVMSgxExecutors, []string{config.DefaultSgxExecutorAddress}, "the SGX executor addresses: primary, failover, then query executors")                           //nolint:lll
+	cmd.Flags().Bool(srvflags.EVMShadowExecution, false, "cross-check the SGX enclave execution against the in-process EVM and report divergences")                                                //nolint:lll
+	cmd.Flags().Bool(srvflags.EVMSgxBlockSession, false, "execute the transactions of a block in a single SGX executor session")                                                                   //nolint:lll
+	cmd.Flags().Bool(srvflags.EVMTraceInternalTxs, false, "trace the internal transactions of the block transactions and emit them as events, executing them a second time on the in-process EVM") //nolint:lll
+	cmd.Flags().Int(srvflags.EVMParallelWorkers, 0, "the number of workers the EVM transactions of a block are pre-executed with on the SGX executors (<2=disabled)")                              //nolint:lll
+	cmd.Flags().Uint64(srvflags.EVMMempoolPriceBump, config.DefaultMempoolPriceBump, "the minimum fee increase, in percent, to replace a pending transaction")                                     //nolint:lll
+	cmd.Flags().Uint64(srvflags.EVMMempoolMaxNonceGap, config.DefaultMempoolMaxNonceGap, "the maximum nonce gap of the transactions queued in the mempool")                                        //nolint:lll
 
 	cmd.Flags().String(srvflags.TLSCertPath, "", "the cert.pem file path for the server TLS configuration")
 	cmd.Flags().String(srvflags.TLSKeyPath, "", "the key.pem file path for the server TLS configuration")
@@ -424,7 +447,21 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 		}
 
 		idxLogger := logger.With("indexer", "evm")
-		idxer = indexer.NewKVIndexer(idxDB, idxLogger, clientCtx)
+		kvIdxer := indexer.NewKVIndexer(idxDB, idxLogger, clientCtx)
+
+		blobDB, err := OpenBlobStoreDB(home, server.GetAppDBBackend(svrCtx.Viper))
+		if err != nil {
+			logger.Error("failed to open evm blob store DB", "error", err.Error())
+			return err
+		}
+		blobStore := indexer.NewBlobStore(blobDB, idxLogger, clientCtx, config.JSONRPC.BlobRetention)
+		// the sidecars are stripped from the blocks, the app keeps the ones of its mempool
+		if provider, ok := app.(indexer.BlobSidecarProvider); ok {
+			blobStore.SetSidecarProvider(provider)
+		}
+		kvIdxer.SetBlobStore(blobStore)
+
+		idxer = kvIdxer
 		indexerService := NewEVMIndexerService(idxer, clientCtx.Client.(rpcclient.Client), config.JSONRPC.AllowIndexerGap)
 		indexerService.SetLogger(servercmtlog.CometLoggerWrapper{Logger: idxLogger})
 
@@ -460,7 +497,7 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 		defer apiSrv.Close()
 	}
 
-	clientCtx, httpSrv, httpSrvDone, err := startJSONRPCServer(svrCtx, clientCtx, g, config, genDocProvider, idxer)
+	clientCtx, httpSrv, httpSrvDone, err := startJSONRPCServer(svrCtx, clientCtx, g, config, genDocProvider, idxer, app)
 	if httpSrv != nil {
 		defer func() {
 			shutdownCtx, cancelFn := context.WithTimeout(context.Background(), 10*time.Second)
@@ -502,6 +539,12 @@ func OpenIndexerDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error)
 	return dbm.NewDB("evmindexer", backendType, dataDir)
 }
 
+// OpenBlobStoreDB opens the local blob sidecar store db, using the same db backend as the main app
+func OpenBlobStoreDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
+	dataDir := filepath.Join(rootDir, "data")
+	return dbm.NewDB("evmblobs", backendType, dataDir)
+}
+
 func openTraceWriter(traceWriterFile string) (w io.Writer, err error) {
 	if traceWriterFile == "" {
 		return
@@ -652,6 +695,7 @@ func startJSONRPCServer(
 	config config.Config,
 	genDocProvider node.GenesisDocProvider,
 	idxer ethermint.EVMTxIndexer,
+	app types.Application,
 ) (ctx client.Context, httpSrv *http.Server, httpSrvDone chan struct{}, err error) {
 	ctx = clientCtx
 	if !config.JSONRPC.Enable {
@@ -665,7 +709,7 @@ func startJSONRPCServer(
 
 	ctx = clientCtx.WithChainID(genDoc.ChainID)
 	g.Go(func() error {
-		httpSrv, httpSrvDone, err = StartJSONRPC(svrCtx, clientCtx, g, &config, idxer)
+		httpSrv, httpSrvDone, err = StartJSONRPC(svrCtx, clientCtx, g, &config, idxer, app)
 		return err
 	})
 	return
@@ -2,6 +2,7 @@ package rpc
 
 import (
 	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -103,14 +104,14 @@ func TestPersonal_UnlockAccount(t *testing.T) {
 	_, err = CallWithError("personal_sign", []interface{}{hexutil.Bytes{0x88}, addr, ""})
 	require.Error(t, err)
 
-	rpcRes = Call(t, "personal_unlockAccount", []interface{}{addr, ""})
+	rpcRes = Call(t, "personal_unlockAccount", []interface{}{addr, pswd})
 	var unlocked bool
 	err = json.Unmarshal(rpcRes.Result, &unlocked)
 	require.NoError(t, err)
 	require.True(t, unlocked)
 
-	// try to sign, should work now
-	rpcRes = Call(t, "personal_sign", []interface{}{hexutil.Bytes{0x88}, addr, pswd})
+	// try to sign without the password, should work now
+	rpcRes = Call(t, "personal_sign", []interface{}{hexutil.Bytes{0x88}, addr, ""})
 	var res hexutil.Bytes
 	err = json.Unmarshal(rpcRes.Result, &res)
 	require.NoError(t, err)
@@ -126,7 +127,7 @@ func TestPersonal_LockAccount(t *testing.T) {
 	err := json.Unmarshal(rpcRes.Result, &addr)
 	require.NoError(t, err)
 
-	rpcRes = Call(t, "personal_unlockAccount", []interface{}{addr, ""})
+	rpcRes = Call(t, "personal_unlockAccount", []interface{}{addr, pswd})
 	var unlocked bool
 	err = json.Unmarshal(rpcRes.Result, &unlocked)
 	require.NoError(t, err)
@@ -155,8 +156,27 @@ func TestPersonal_InitializeWallet(t *testing.T) {
 
 func TestPersonal_ListWallets(t *testing.T) {
 	rpcRes := Call(t, "personal_listWallets", []interface{}{})
-	var res []hexutil.Bytes
+	var res []map[string]interface{}
 	err := json.Unmarshal(rpcRes.Result, &res)
 	require.NoError(t, err)
-	require.Equal(t, ([]hexutil.Bytes)(nil), res)
+	require.NotNil(t, res)
+}
+
+func TestPersonal_ExportImportKeystore(t *testing.T) {
+	t.Skip("skipping TestPersonal_ExportImportKeystore")
+
+	pswd := "nootwashere"
+	rpcRes := Call(t, "personal_newAccount", []string{pswd})
+	var addr common.Address
+	err := json.Unmarshal(rpcRes.Result, &addr)
+	require.NoError(t, err)
+
+	newPswd := "nootwashereagain"
+	rpcRes = Call(t, "personal_exportKeystore", []interface{}{addr, pswd, newPswd})
+	keyJSON := rpcRes.Result
+	require.Contains(t, string(keyJSON), strings.ToLower(addr.Hex()[2:]))
+
+	// the account already exists in the keystore
+	_, err = CallWithError("personal_importKeystore", []interface{}{keyJSON, newPswd, pswd})
+	require.Error(t, err)
 }
@@ -109,6 +109,36 @@ func TestWsEth_subscribe_newHeads(t *testing.T) {
 	wsUnsubscribe(t, wc, subscribeId) // eth_unsubscribe
 }
 
+func TestWsEth_subscribe_syncing(t *testing.T) {
+	t.Log("test eth_subscribe syncing with Websocket")
+
+	wc, _, err := websocket.DefaultDialer.Dial(wsUrl, nil)
+	require.NoError(t, err)
+	defer wc.Close()
+
+	wsWriteMessage(t, wc, `{"id":1,"method":"eth_subscribe","params":["syncing"]}`)
+
+	time.Sleep(1 * time.Second)
+	mb := readMessage(t, wc)
+	msg := jsonUnmarshal(t, mb)
+	subscribeId, ok := msg["result"].(string)
+	require.True(t, ok)
+	require.True(t, strings.HasPrefix(subscribeId, "0x"))
+
+	// the current sync status is sent right after subscribing
+	mb = readMessage(t, wc)
+	msg = jsonUnmarshal(t, mb)
+	method, ok := msg["method"].(string)
+	require.True(t, ok)
+	require.Equal(t, "eth_subscription", method)
+
+	params, ok := msg["params"].(map[string]interface{})
+	require.True(t, ok)
+	require.Equal(t, false, params["result"])
+
+	wsUnsubscribe(t, wc, subscribeId) // eth_unsubscribe
+}
+
 func TestWsEth_subscribe_log(t *testing.T) {
 	t.Log("test eth_subscribe log with websocket")
 
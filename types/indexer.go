@@ -31,4 +31,21 @@ type EVMTxIndexer interface {
 	GetByTxHash(common.Hash) (*TxResult, error)
 	// GetByBlockAndIndex returns nil if tx not found.
 	GetByBlockAndIndex(int64, int32) (*TxResult, error)
+	// GetInternalTxsByBlock returns the internal transactions of the block,
+	// which are only indexed when they are traced by the node.
+	GetInternalTxsByBlock(int64) ([]*InternalTxResult, error)
+
+	// BlobStore returns the blob sidecar store maintained along the indexer,
+	// nil if it's disabled.
+	BlobStore() EVMBlobStore
+}
+
+// EVMBlobStore defines the interface of the node-local store of the EIP-4844
+// blob sidecars, which only keeps the sidecars of the recent blocks.
+type EVMBlobStore interface {
+	IndexBlock(*tmtypes.Block, []*abci.ExecTxResult) error
+
+	// GetBlobSidecars returns the sidecars of the blob transactions included in
+	// the block, ordered by eth tx index. Returns nil if the block is pruned.
+	GetBlobSidecars(int64) ([]*BlobSidecarResult, error)
 }
@@ -77,33 +77,159 @@ func (m *TxResult) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TxResult proto.InternalMessageInfo
 
+// BlobSidecarResult is the value stored in the node-local blob store, it holds
+// the sidecar of an EIP-4844 blob transaction.
+type BlobSidecarResult struct {
+	// height of the blockchain
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// eth_tx_index is the index in the list of valid eth tx in the block
+	EthTxIndex int32 `protobuf:"varint,2,opt,name=eth_tx_index,json=ethTxIndex,proto3" json:"eth_tx_index,omitempty"`
+	// eth_tx_hash is the hex formatted hash of the blob transaction
+	EthTxHash string `protobuf:"bytes,3,opt,name=eth_tx_hash,json=ethTxHash,proto3" json:"eth_tx_hash,omitempty"`
+	// blob_hashes are the hex formatted versioned hashes of the blobs
+	BlobHashes []string `protobuf:"bytes,4,rep,name=blob_hashes,json=blobHashes,proto3" json:"blob_hashes,omitempty"`
+	// blobs are the blobs of the transaction
+	Blobs [][]byte `protobuf:"bytes,5,rep,name=blobs,proto3" json:"blobs,omitempty"`
+	// commitments are the KZG commitments of the blobs
+	Commitments [][]byte `protobuf:"bytes,6,rep,name=commitments,proto3" json:"commitments,omitempty"`
+	// proofs are the KZG proofs of the blobs
+	Proofs [][]byte `protobuf:"bytes,7,rep,name=proofs,proto3" json:"proofs,omitempty"`
+}
+
+func (m *BlobSidecarResult) Reset()         { *m = BlobSidecarResult{} }
+func (m *BlobSidecarResult) String() string { return proto.CompactTextString(m) }
+func (*BlobSidecarResult) ProtoMessage()    {}
+func (*BlobSidecarResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1197e10a8be8ed28, []int{1}
+}
+func (m *BlobSidecarResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlobSidecarResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlobSidecarResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlobSidecarResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlobSidecarResult.Merge(m, src)
+}
+func (m *BlobSidecarResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlobSidecarResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlobSidecarResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlobSidecarResult proto.InternalMessageInfo
+
+// InternalTxResult is the value stored in eth tx indexer for an internal
+// transaction, a value transfer, contract creation or self-destruct performed
+// by a nested call frame of an eth transaction.
+type InternalTxResult struct {
+	// height of the blockchain
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// eth_tx_index is the index in the list of valid eth tx in the block
+	EthTxIndex int32 `protobuf:"varint,2,opt,name=eth_tx_index,json=ethTxIndex,proto3" json:"eth_tx_index,omitempty"`
+	// eth_tx_hash is the hex formatted hash of the eth transaction
+	EthTxHash string `protobuf:"bytes,3,opt,name=eth_tx_hash,json=ethTxHash,proto3" json:"eth_tx_hash,omitempty"`
+	// index of the internal transaction in the eth transaction, in execution
+	// order
+	Index uint32 `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
+	// type is the opcode of the call frame: CALL, CREATE, CREATE2 or
+	// SELFDESTRUCT
+	Type string `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	// from is the hex formatted address of the caller
+	From string `protobuf:"bytes,6,opt,name=from,proto3" json:"from,omitempty"`
+	// to is the hex formatted address of the callee, the created contract or the
+	// self-destruct beneficiary
+	To string `protobuf:"bytes,7,opt,name=to,proto3" json:"to,omitempty"`
+	// value is the amount transferred, in wei
+	Value string `protobuf:"bytes,8,opt,name=value,proto3" json:"value,omitempty"`
+	// depth of the call frame, the frames entered by the transaction itself have
+	// depth 1
+	Depth uint32 `protobuf:"varint,9,opt,name=depth,proto3" json:"depth,omitempty"`
+}
+
+func (m *InternalTxResult) Reset()         { *m = InternalTxResult{} }
+func (m *InternalTxResult) String() string { return proto.CompactTextString(m) }
+func (*InternalTxResult) ProtoMessage()    {}
+func (*InternalTxResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1197e10a8be8ed28, []int{2}
+}
+func (m *InternalTxResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InternalTxResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InternalTxResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InternalTxResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InternalTxResult.Merge(m, src)
+}
+func (m *InternalTxResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *InternalTxResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_InternalTxResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InternalTxResult proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*TxResult)(nil), "ethermint.types.v1.TxResult")
+	proto.RegisterType((*BlobSidecarResult)(nil), "ethermint.types.v1.BlobSidecarResult")
+	proto.RegisterType((*InternalTxResult)(nil), "ethermint.types.v1.InternalTxResult")
 }
 
 func init() { proto.RegisterFile("ethermint/types/v1/indexer.proto", fileDescriptor_1197e10a8be8ed28) }
 
 var fileDescriptor_1197e10a8be8ed28 = []byte{
-	// 295 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0x31, 0x4b, 0xc3, 0x40,
-	0x18, 0x86, 0x73, 0xb6, 0x4d, 0xe3, 0xa1, 0x83, 0x51, 0x4a, 0x54, 0x88, 0x87, 0x53, 0xa6, 0x84,
-	0xe2, 0xd6, 0xd1, 0x45, 0x5c, 0x8f, 0xba, 0xb8, 0x84, 0xb4, 0xf9, 0xbc, 0x3b, 0xe8, 0xf5, 0x4a,
-	0xef, 0x4b, 0x88, 0xff, 0xc0, 0xd1, 0x9f, 0xe0, 0xcf, 0x71, 0xec, 0xe8, 0x28, 0x2d, 0xfe, 0x0f,
-	0xe9, 0x35, 0x44, 0x70, 0xfb, 0x5e, 0x9e, 0xe7, 0xe3, 0x85, 0x97, 0x32, 0x40, 0x09, 0x6b, 0xad,
-	0x96, 0x98, 0xe1, 0xeb, 0x0a, 0x6c, 0x56, 0x8f, 0x33, 0xb5, 0x2c, 0xa1, 0x81, 0x75, 0xba, 0x5a,
-	0x1b, 0x34, 0x61, 0xd8, 0x19, 0xa9, 0x33, 0xd2, 0x7a, 0x7c, 0x75, 0x21, 0x8c, 0x30, 0x0e, 0x67,
-	0xfb, 0xeb, 0x60, 0xde, 0xfe, 0x10, 0x1a, 0x4c, 0x1b, 0x0e, 0xb6, 0x5a, 0x60, 0x38, 0xa2, 0xbe,
-	0x04, 0x25, 0x24, 0x46, 0x84, 0x91, 0xa4, 0xc7, 0xdb, 0x14, 0x5e, 0xd2, 0x00, 0x9b, 0xdc, 0x55,
-	0x44, 0x47, 0x8c, 0x24, 0xa7, 0x7c, 0x88, 0xcd, 0xe3, 0x3e, 0x86, 0xd7, 0xf4, 0x58, 0x5b, 0xd1,
-	0xb2, 0x9e, 0x63, 0x81, 0xb6, 0xe2, 0x00, 0x19, 0x3d, 0x01, 0x94, 0x79, 0xf7, 0xdb, 0x67, 0x24,
-	0x19, 0x70, 0x0a, 0x28, 0xa7, 0xed, 0xfb, 0x88, 0xfa, 0x2f, 0x85, 0x5a, 0x40, 0x19, 0x0d, 0x18,
-	0x49, 0x02, 0xde, 0xa6, 0x7d, 0xa3, 0x28, 0x6c, 0x5e, 0x59, 0x28, 0x23, 0x9f, 0x91, 0xa4, 0xcf,
-	0x87, 0xa2, 0xb0, 0x4f, 0x16, 0xca, 0x30, 0xa5, 0xe7, 0xf3, 0x4a, 0x57, 0x8b, 0x02, 0x55, 0x0d,
-	0x79, 0x67, 0x0d, 0x9d, 0x75, 0xf6, 0x87, 0x1e, 0x0e, 0xfe, 0xa4, 0xff, 0xf6, 0x71, 0xe3, 0xdd,
-	0x4f, 0x3e, 0xb7, 0x31, 0xd9, 0x6c, 0x63, 0xf2, 0xbd, 0x8d, 0xc9, 0xfb, 0x2e, 0xf6, 0x36, 0xbb,
-	0xd8, 0xfb, 0xda, 0xc5, 0xde, 0x33, 0x13, 0x0a, 0x65, 0x35, 0x4b, 0xe7, 0x46, 0x67, 0x50, 0x6b,
-	0x63, 0xb3, 0x7f, 0xf3, 0xce, 0x7c, 0x37, 0xd5, 0xdd, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc1,
-	0x34, 0xa8, 0x0b, 0x78, 0x01, 0x00, 0x00,
+	// 469 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0x3f, 0x6f, 0xd4, 0x30,
+	0x14, 0x3f, 0xdf, 0xdf, 0xc4, 0x6d, 0x11, 0x35, 0xa7, 0xca, 0x80, 0x94, 0x5a, 0x9d, 0x32, 0x25,
+	0xaa, 0xd8, 0x3a, 0x76, 0xa1, 0x5d, 0xcd, 0xb1, 0xb0, 0x9c, 0x92, 0xcb, 0xbb, 0x38, 0x52, 0x1c,
+	0x9f, 0x62, 0x27, 0x0a, 0xdf, 0x80, 0x91, 0x8f, 0xc0, 0xc7, 0x61, 0xec, 0xc8, 0x88, 0xee, 0x60,
+	0xe3, 0x43, 0x20, 0x3b, 0xd1, 0x15, 0x95, 0x81, 0x89, 0xed, 0xfd, 0xfe, 0x38, 0xef, 0xfd, 0xf4,
+	0xf2, 0x30, 0x03, 0x23, 0xa0, 0x96, 0x45, 0x65, 0x62, 0xf3, 0x71, 0x07, 0x3a, 0x6e, 0xaf, 0xe3,
+	0xa2, 0xca, 0xa0, 0x83, 0x3a, 0xda, 0xd5, 0xca, 0x28, 0x42, 0x8e, 0x8e, 0xc8, 0x39, 0xa2, 0xf6,
+	0xfa, 0xd5, 0x32, 0x57, 0xb9, 0x72, 0x72, 0x6c, 0xab, 0xde, 0x79, 0xf5, 0x13, 0x61, 0x6f, 0xd5,
+	0x71, 0xd0, 0x4d, 0x69, 0xc8, 0x05, 0x9e, 0x0b, 0x28, 0x72, 0x61, 0x28, 0x62, 0x28, 0x9c, 0xf0,
+	0x01, 0x91, 0x97, 0xd8, 0x33, 0xdd, 0xda, 0xb5, 0xa0, 0x63, 0x86, 0xc2, 0x33, 0xbe, 0x30, 0xdd,
+	0xbd, 0x85, 0xe4, 0x35, 0xf6, 0xa5, 0xce, 0x07, 0x6d, 0xe2, 0x34, 0x4f, 0xea, 0xbc, 0x17, 0x19,
+	0x3e, 0x05, 0x23, 0xd6, 0xc7, 0xb7, 0x53, 0x86, 0xc2, 0x19, 0xc7, 0x60, 0xc4, 0x6a, 0x78, 0x7e,
+	0x81, 0xe7, 0xdb, 0xa4, 0x28, 0x21, 0xa3, 0x33, 0x86, 0x42, 0x8f, 0x0f, 0xc8, 0x76, 0xcc, 0x13,
+	0xbd, 0x6e, 0x34, 0x64, 0x74, 0xce, 0x50, 0x38, 0xe5, 0x8b, 0x3c, 0xd1, 0xef, 0x35, 0x64, 0x24,
+	0xc2, 0x2f, 0x36, 0x8d, 0x6c, 0xca, 0xc4, 0x14, 0x2d, 0xac, 0x8f, 0xae, 0x85, 0x73, 0x9d, 0x3f,
+	0x4a, 0x6f, 0x7b, 0xff, 0xcd, 0xf4, 0xd3, 0x97, 0xcb, 0xd1, 0xd5, 0x0f, 0x84, 0xcf, 0x6f, 0x4b,
+	0x95, 0xbe, 0x2b, 0x32, 0xd8, 0x24, 0xf5, 0x3f, 0x02, 0x3f, 0x1d, 0x7c, 0xfc, 0xd7, 0xe0, 0x01,
+	0x3e, 0x19, 0x1c, 0x22, 0xd1, 0xc2, 0x25, 0xf7, 0xb9, 0xef, 0x0c, 0x77, 0x89, 0x16, 0xe4, 0x12,
+	0x9f, 0xa4, 0xa5, 0x4a, 0x9d, 0x0a, 0x9a, 0x4e, 0xd9, 0x24, 0xf4, 0x39, 0xb6, 0xd4, 0x9d, 0x63,
+	0xc8, 0x12, 0xcf, 0x2c, 0xd2, 0x74, 0xc6, 0x26, 0xe1, 0x29, 0xef, 0x01, 0x61, 0xf8, 0x64, 0xa3,
+	0xa4, 0x2c, 0x8c, 0x84, 0xca, 0x68, 0x3a, 0x77, 0xda, 0x9f, 0x94, 0x1d, 0x79, 0x57, 0x2b, 0xb5,
+	0xd5, 0x74, 0xe1, 0xc4, 0x01, 0x0d, 0x31, 0x7f, 0x21, 0xfc, 0xfc, 0xbe, 0x32, 0x50, 0x57, 0x49,
+	0xb9, 0xea, 0xfe, 0x7b, 0xca, 0x25, 0x9e, 0x3d, 0x6e, 0xf6, 0x8c, 0xf7, 0x80, 0x10, 0x3c, 0xb5,
+	0x7f, 0x9d, 0x5b, 0xa9, 0xcf, 0x5d, 0x6d, 0xb9, 0x6d, 0xad, 0xa4, 0x5b, 0xa6, 0xcf, 0x5d, 0x4d,
+	0x9e, 0xe1, 0xb1, 0x51, 0x6e, 0x71, 0x3e, 0x1f, 0x1b, 0x65, 0xbf, 0xd6, 0x26, 0x65, 0x03, 0xd4,
+	0x73, 0x54, 0x0f, 0x2c, 0x9b, 0xc1, 0xce, 0x08, 0xea, 0xf7, 0x3d, 0x1c, 0xe8, 0xe3, 0xde, 0xde,
+	0x7c, 0xdd, 0x07, 0xe8, 0x61, 0x1f, 0xa0, 0xef, 0xfb, 0x00, 0x7d, 0x3e, 0x04, 0xa3, 0x87, 0x43,
+	0x30, 0xfa, 0x76, 0x08, 0x46, 0x1f, 0x58, 0x5e, 0x18, 0xd1, 0xa4, 0xd1, 0x46, 0xc9, 0x18, 0x5a,
+	0xa9, 0x74, 0xfc, 0xe4, 0x68, 0xd2, 0xb9, 0x3b, 0x80, 0x37, 0xbf, 0x07, 0x00, 0x0f, 0xed, 0xd9,
+	0xfd, 0x4e, 0x03, 0x00, 0x00,
 }
 
 func (m *TxResult) Marshal() (dAtA []byte, err error) {
@@ -169,6 +295,160 @@ func (m *TxResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BlobSidecarResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlobSidecarResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlobSidecarResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proofs) > 0 {
+		for iNdEx := len(m.Proofs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proofs[iNdEx])
+			copy(dAtA[i:], m.Proofs[iNdEx])
+			i = encodeVarintIndexer(dAtA, i, uint64(len(m.Proofs[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Commitments) > 0 {
+		for iNdEx := len(m.Commitments) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Commitments[iNdEx])
+			copy(dAtA[i:], m.Commitments[iNdEx])
+			i = encodeVarintIndexer(dAtA, i, uint64(len(m.Commitments[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Blobs) > 0 {
+		for iNdEx := len(m.Blobs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Blobs[iNdEx])
+			copy(dAtA[i:], m.Blobs[iNdEx])
+			i = encodeVarintIndexer(dAtA, i, uint64(len(m.Blobs[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.BlobHashes) > 0 {
+		for iNdEx := len(m.BlobHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BlobHashes[iNdEx])
+			copy(dAtA[i:], m.BlobHashes[iNdEx])
+			i = encodeVarintIndexer(dAtA, i, uint64(len(m.BlobHashes[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.EthTxHash) > 0 {
+		i -= len(m.EthTxHash)
+		copy(dAtA[i:], m.EthTxHash)
+		i = encodeVarintIndexer(dAtA, i, uint64(len(m.EthTxHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EthTxIndex != 0 {
+		i = encodeVarintIndexer(dAtA, i, uint64(m.EthTxIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintIndexer(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InternalTxResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InternalTxResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InternalTxResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Depth != 0 {
+		i = encodeVarintIndexer(dAtA, i, uint64(m.Depth))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintIndexer(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintIndexer(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintIndexer(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintIndexer(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Index != 0 {
+		i = encodeVarintIndexer(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.EthTxHash) > 0 {
+		i -= len(m.EthTxHash)
+		copy(dAtA[i:], m.EthTxHash)
+		i = encodeVarintIndexer(dAtA, i, uint64(len(m.EthTxHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EthTxIndex != 0 {
+		i = encodeVarintIndexer(dAtA, i, uint64(m.EthTxIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintIndexer(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintIndexer(dAtA []byte, offset int, v uint64) int {
 	offset -= sovIndexer(v)
 	base := offset
@@ -210,6 +490,90 @@ func (m *TxResult) Size() (n int) {
 	return n
 }
 
+func (m *BlobSidecarResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovIndexer(uint64(m.Height))
+	}
+	if m.EthTxIndex != 0 {
+		n += 1 + sovIndexer(uint64(m.EthTxIndex))
+	}
+	l = len(m.EthTxHash)
+	if l > 0 {
+		n += 1 + l + sovIndexer(uint64(l))
+	}
+	if len(m.BlobHashes) > 0 {
+		for _, s := range m.BlobHashes {
+			l = len(s)
+			n += 1 + l + sovIndexer(uint64(l))
+		}
+	}
+	if len(m.Blobs) > 0 {
+		for _, b := range m.Blobs {
+			l = len(b)
+			n += 1 + l + sovIndexer(uint64(l))
+		}
+	}
+	if len(m.Commitments) > 0 {
+		for _, b := range m.Commitments {
+			l = len(b)
+			n += 1 + l + sovIndexer(uint64(l))
+		}
+	}
+	if len(m.Proofs) > 0 {
+		for _, b := range m.Proofs {
+			l = len(b)
+			n += 1 + l + sovIndexer(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InternalTxResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovIndexer(uint64(m.Height))
+	}
+	if m.EthTxIndex != 0 {
+		n += 1 + sovIndexer(uint64(m.EthTxIndex))
+	}
+	l = len(m.EthTxHash)
+	if l > 0 {
+		n += 1 + l + sovIndexer(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovIndexer(uint64(m.Index))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovIndexer(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovIndexer(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovIndexer(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovIndexer(uint64(l))
+	}
+	if m.Depth != 0 {
+		n += 1 + sovIndexer(uint64(m.Depth))
+	}
+	return n
+}
+
 func sovIndexer(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -400,6 +764,540 @@ func (m *TxResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BlobSidecarResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIndexer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlobSidecarResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlobSidecarResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthTxIndex", wireType)
+			}
+			m.EthTxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthTxIndex |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobHashes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlobHashes = append(m.BlobHashes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blobs", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blobs = append(m.Blobs, make([]byte, postIndex-iNdEx))
+			copy(m.Blobs[len(m.Blobs)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitments", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitments = append(m.Commitments, make([]byte, postIndex-iNdEx))
+			copy(m.Commitments[len(m.Commitments)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proofs", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proofs = append(m.Proofs, make([]byte, postIndex-iNdEx))
+			copy(m.Proofs[len(m.Proofs)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIndexer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InternalTxResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIndexer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InternalTxResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InternalTxResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthTxIndex", wireType)
+			}
+			m.EthTxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthTxIndex |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+			}
+			m.Depth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Depth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIndexer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipIndexer(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
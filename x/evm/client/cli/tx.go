@@ -19,10 +19,21 @@ import (
 	"bufio"
 	"fmt"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -32,6 +43,8 @@ import (
 	"github.com/evmos/ethermint/x/evm/types"
 )
 
+const flagFeeDenom = "fee-denom"
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -41,7 +54,7 @@ func GetTxCmd() *cobra.Command {
 		SuggestionsMinimumDistance: 2,
 		RunE:                       client.ValidateCmd,
 	}
-	cmd.AddCommand(NewRawTxCmd())
+	cmd.AddCommand(NewRawTxCmd(), NewScheduleForkCmd())
 	return cmd
 }
 
@@ -81,11 +94,31 @@ func NewRawTxCmd() *cobra.Command {
 				return err
 			}
 
-			tx, err := msg.BuildTx(clientCtx.TxConfig.NewTxBuilder(), rsp.Params.EvmDenom)
+			builder := clientCtx.TxConfig.NewTxBuilder()
+			tx, err := msg.BuildTx(builder, rsp.Params.EvmDenom)
 			if err != nil {
 				return err
 			}
 
+			feeDenom, err := cmd.Flags().GetString(flagFeeDenom)
+			if err != nil {
+				return err
+			}
+			if feeDenom != "" {
+				// the fee token isn't covered by the signature of the raw tx, it's signed by the key
+				// of the sender
+				sig, err := msg.SignFeeDenom(feeDenom, clientCtx.Keyring)
+				if err != nil {
+					return errors.Wrap(err, "failed to sign the fee denom with the key of the sender")
+				}
+				option, err := codectypes.NewAnyWithValue(&types.ExtensionOptionsEthereumTx{FeeDenom: feeDenom, FeeDenomSignature: sig})
+				if err != nil {
+					return err
+				}
+				builder.(authtx.ExtensionOptionsTxBuilder).SetExtensionOptions(option)
+				tx = builder.GetTx()
+			}
+
 			if clientCtx.GenerateOnly {
 				json, err := clientCtx.TxConfig.TxJSONEncoder()(tx)
 				if err != nil {
@@ -127,6 +160,91 @@ func NewRawTxCmd() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(flagFeeDenom, "", "fee token of the fee market paying for the gas, instead of the evm denom, signed with the key of the sender")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewScheduleForkCmd submits a governance proposal updating the activation time of a
+// time based hard fork.
+func NewScheduleForkCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule-fork NAME TIME",
+		Short: "Submit a proposal scheduling a hard fork at the given block time",
+		Long: fmt.Sprintf(`Submit a governance proposal with a MsgUpdateParams that activates the hard fork NAME (one of %s)
+at the block time TIME, given either as unix seconds or in RFC3339 format.
+
+The proposal uses the current module parameters, and is checked for the fork ordering before being submitted.`,
+			strings.Join(types.TimeForks, ", ")),
+		Example: fmt.Sprintf(
+			"$ %s tx evm schedule-fork prague 2025-06-01T12:00:00Z --title=\"Prague\" --summary=\"Activate prague\" --deposit=10000000aphoton --from mykey",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			forkTime, err := parseForkTime(args[1])
+			if err != nil {
+				return err
+			}
+			if forkTime <= uint64(time.Now().Unix()) {
+				return fmt.Errorf("fork time %d is in the past", forkTime)
+			}
+
+			rsp, err := rpctypes.NewQueryClient(clientCtx).Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+			params := rsp.Params
+			if err := params.ChainConfig.ScheduleFork(args[0], forkTime); err != nil {
+				return err
+			}
+			if err := params.Validate(); err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+			summary, err := cmd.Flags().GetString(govcli.FlagSummary)
+			if err != nil {
+				return err
+			}
+			metadata, err := cmd.Flags().GetString(govcli.FlagMetadata)
+			if err != nil {
+				return err
+			}
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params:    params,
+			}
+			proposal, err := govv1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), metadata, title, summary, false)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of the proposal")
+	cmd.Flags().String(govcli.FlagSummary, "", "summary of the proposal")
+	cmd.Flags().String(govcli.FlagMetadata, "", "metadata of the proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of the proposal")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -17,7 +17,9 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -60,3 +62,19 @@ func formatKeyToHash(key string) string {
 
 	return ethkey.Hex()
 }
+
+// parseForkTime parses a fork activation time given either as unix seconds or in
+// RFC3339 format.
+func parseForkTime(s string) (uint64, error) {
+	if t, err := strconv.ParseUint(s, 10, 64); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return 0, errors.Wrap(err, "fork time must be unix seconds or RFC3339")
+	}
+	if t.Unix() < 0 {
+		return 0, fmt.Errorf("fork time %s is before the unix epoch", s)
+	}
+	return uint64(t.Unix()), nil
+}
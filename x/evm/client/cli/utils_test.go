@@ -100,3 +100,30 @@ func TestAddressToCosmosAddress(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, baseAddr, ethFormatted)
 }
+
+func TestParseForkTime(t *testing.T) {
+	testCases := []struct {
+		name      string
+		arg       string
+		expected  uint64
+		expectErr bool
+	}{
+		{"unix seconds", "1700000000", 1700000000, false},
+		{"RFC3339", "2023-11-14T22:13:20Z", 1700000000, false},
+		{"RFC3339 with offset", "2023-11-15T00:13:20+02:00", 1700000000, false},
+		{"before epoch", "1969-12-31T23:59:59Z", 0, true},
+		{"invalid", "tomorrow", 0, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			forkTime, err := parseForkTime(tc.arg)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, forkTime)
+		})
+	}
+}
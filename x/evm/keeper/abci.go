@@ -16,22 +16,31 @@
 package keeper
 
 import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
 )
 
-// BeginBlock sets the sdk Context and EIP155 chain id to the Keeper.
+// BeginBlock sets the sdk Context and EIP155 chain id to the Keeper, and opens
+// the SGX block session if enabled.
 func (k *Keeper) BeginBlock(ctx sdk.Context) error {
 	k.WithChainID(ctx)
+	k.sgxFailure = nil
+	k.beginBlockSession(ctx)
 	return nil
 }
 
 // EndBlock also retrieves the bloom filter value from the transient store and commits it to the
 // KVStore. The EVM end block logic doesn't update the validator set, thus it returns
-// an empty slice.
+// an empty slice. The SGX block session is committed last. The block is aborted if an SGX executor
+// failed during its execution.
 func (k *Keeper) EndBlock(ctx sdk.Context) error {
 	// Gas costs are handled within msg handler so costs should be ignored
 	infCtx := ctx.WithGasMeter(types.NewInfiniteGasMeter())
@@ -39,5 +48,23 @@ func (k *Keeper) EndBlock(ctx sdk.Context) error {
 	bloom := ethtypes.BytesToBloom(k.GetBlockBloomTransient(infCtx).Bytes())
 	k.EmitBlockBloomEvent(infCtx, bloom)
 
-	return nil
+	if err := k.sgxFailure; err != nil {
+		k.sgxFailure = nil
+		if k.blockSession != nil {
+			k.abortBlockSession(infCtx)
+		}
+		return errorsmod.Wrap(err, "block aborted")
+	}
+	return k.endBlockSession(infCtx)
+}
+
+// recordSgxFailure records the SGX executor failures of the block being finalized, which abort it
+// at EndBlock: the executors of the other nodes may have executed the message, failing it would
+// fork the node. The block is executed again once the node is restarted.
+func (k *Keeper) recordSgxFailure(ctx sdk.Context, err error) {
+	if k.sgxFailure != nil || ctx.ExecMode() != sdk.ExecModeFinalize || !errors.Is(err, evmtypes.ErrSgxExecutor) {
+		return
+	}
+	k.Logger(ctx).Error("SGX executor failure, aborting the block", "height", ctx.BlockHeight(), "error", err)
+	k.sgxFailure = errorsmod.Wrapf(err, "height %d", ctx.BlockHeight())
 }
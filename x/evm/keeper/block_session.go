@@ -0,0 +1,173 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// errBlockSessionBroken is returned when the connection carrying the block
+// session failed, the block is then aborted.
+var errBlockSessionBroken = errorsmod.Wrap(types.ErrSgxExecutor, "SGX block session broken")
+
+// SetSgxBlockSession enables or disables block sessions. When enabled, the
+// transactions of a block are executed in a single enclave session opened at
+// BeginBlock and closed at EndBlock, if the primary executor supports it. The
+// state of every transaction is still written back to the node when it's
+// committed, so that the ante handlers, refunds and hooks running on the node
+// between the transactions see it and their changes are seen by the enclave.
+// Queries keep using a session per message. Block sessions are not used in
+// shadow execution mode, which compares the state written by every message.
+func (k *Keeper) SetSgxBlockSession(enabled bool) *Keeper {
+	k.sgxBlockSession = enabled
+	return k
+}
+
+// sgxBlockSession is a block session open on one of the consensus executors.
+type sgxBlockSession struct {
+	client  *sgxRPCClient
+	release func()
+
+	id     uint64
+	height int64
+	// broken is set on transport failures, the session can't be used anymore.
+	broken bool
+}
+
+// txClient returns a client for a transaction session joining the block
+// session.
+func (s *sgxBlockSession) txClient() *sgxRPCClient {
+	return &sgxRPCClient{
+		logger:       s.client.logger,
+		client:       s.client.client,
+		executor:     s.client.executor,
+		now:          s.client.now,
+		blockSession: s.id,
+		onFailure:    s.client.onFailure,
+	}
+}
+
+// beginBlockSession opens the block session of the current block. Failures are
+// not fatal, the block is then executed with a session per transaction.
+func (k *Keeper) beginBlockSession(ctx sdk.Context) {
+	logger := k.Logger(ctx)
+	if k.blockSession != nil {
+		// the previous block didn't reach EndBlock, discard its session.
+		k.abortBlockSession(ctx)
+	}
+	if !k.sgxBlockSession || k.shadowExecution {
+		return
+	}
+
+	client, release, err := k.sgxExecutors.acquire(logger, false)
+	if err != nil {
+		logger.Error("failed to open SGX block session", "error", err)
+		return
+	}
+	// the block sessions of the older versions only write the state back at
+	// EndBlock, the node would run on a stale state in between.
+	if version := client.executor.protocolVersion(); version < types.EnclaveWriteThroughVersion {
+		logger.Debug("SGX executor doesn't support block sessions", "address", client.executor.addr, "version", version)
+		release()
+		return
+	}
+
+	session := &sgxBlockSession{client: client, release: release, height: ctx.BlockHeight()}
+	client.onFailure = func() { session.broken = true }
+
+	k.preparedCtx = ctx
+	session.id, err = client.BeginBlock(ctx.BlockHeader())
+	if err != nil {
+		logger.Error("failed to open SGX block session", "address", client.executor.addr, "error", err)
+		release()
+		return
+	}
+	k.blockSession = session
+}
+
+// endBlockSession closes the block session, the state of its transactions has
+// been written back to the node already. It's a no-op if no block session is
+// open.
+func (k *Keeper) endBlockSession(ctx sdk.Context) error {
+	session := k.blockSession
+	if session == nil {
+		return nil
+	}
+	k.blockSession = nil
+	defer session.release()
+
+	if session.broken {
+		return errorsmod.Wrapf(errBlockSessionBroken, "height %d", session.height)
+	}
+
+	k.preparedCtx = ctx
+	if err := session.client.EndBlock(session.id, true); err != nil {
+		return errorsmod.Wrap(err, "failed to close SGX block session")
+	}
+	return nil
+}
+
+// abortBlockSession discards the block session without committing it.
+func (k *Keeper) abortBlockSession(ctx sdk.Context) {
+	session := k.blockSession
+	k.blockSession = nil
+	defer session.release()
+
+	if session.broken {
+		return
+	}
+	if err := session.client.EndBlock(session.id, false); err != nil {
+		k.Logger(ctx).Error("failed to discard SGX block session", "height", session.height, "error", err)
+	}
+}
+
+// activeBlockSession returns the block session the message must join, nil if it
+// must be executed in a standalone session: block sessions are only joined by
+// the transactions of the block being finalized, not by their pre-executions.
+func (k *Keeper) activeBlockSession(ctx sdk.Context, cfg *EVMConfig) (*sgxBlockSession, error) {
+	session := k.blockSession
+	if session == nil || cfg.ReadOnly || cfg.DebugTrace || cfg.journal != nil ||
+		ctx.ExecMode() != sdk.ExecModeFinalize || ctx.BlockHeight() != session.height {
+		return nil, nil
+	}
+	if session.broken {
+		return nil, errorsmod.Wrapf(errBlockSessionBroken, "height %d", session.height)
+	}
+	return session, nil
+}
+
+// acquireSgxClient returns the client executing the message, joining the block
+// session when there is an active one.
+func (k *Keeper) acquireSgxClient(ctx sdk.Context, cfg *EVMConfig) (*sgxRPCClient, func(), error) {
+	session, err := k.activeBlockSession(ctx, cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+	if session == nil {
+		return k.sgxExecutors.acquire(k.Logger(ctx), cfg.ReadOnly)
+	}
+
+	client := session.txClient()
+	release := func() {
+		if err := client.EndSession(); err != nil {
+			k.Logger(ctx).Debug("failed to end SGX executor session", "address", client.executor.addr, "error", err)
+		}
+	}
+	return client, release, nil
+}
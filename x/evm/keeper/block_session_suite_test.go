@@ -0,0 +1,86 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/evmos/ethermint/testutil"
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+type BlockSessionTestSuite struct {
+	testutil.BaseTestSuiteWithAccount
+}
+
+func TestBlockSessionTestSuite(t *testing.T) {
+	suite.Run(t, new(BlockSessionTestSuite))
+}
+
+func (suite *BlockSessionTestSuite) SetupTest() {
+	suite.BaseTestSuiteWithAccount.SetupTest(suite.T())
+	suite.App.EvmKeeper.UseLocalEnclave().SetSgxBlockSession(true)
+
+	denom := suite.App.EvmKeeper.GetParams(suite.Ctx).EvmDenom
+	coins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(1_000_000_000_000_000)))
+	suite.Require().NoError(testutil.FundAccount(suite.App.BankKeeper, suite.Ctx, suite.Address.Bytes(), coins))
+}
+
+// ante applies the changes the ante handler makes on the node before the
+// transaction is executed: the nonce check and increment, and the deduction of
+// the fee.
+func (suite *BlockSessionTestSuite) ante(ctx sdk.Context, msg *types.MsgEthereumTx) {
+	acc := suite.App.AccountKeeper.GetAccount(ctx, msg.GetFrom())
+	suite.Require().Equal(acc.GetSequence(), msg.AsTransaction().Nonce(), "stale nonce")
+	suite.Require().NoError(acc.SetSequence(acc.GetSequence() + 1))
+	suite.App.AccountKeeper.SetAccount(ctx, acc)
+
+	fee := new(big.Int).Mul(msg.AsTransaction().GasPrice(), new(big.Int).SetUint64(msg.GetGas()))
+	denom := suite.App.EvmKeeper.GetParams(ctx).EvmDenom
+	fees := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(fee)))
+	suite.Require().NoError(suite.App.EvmKeeper.DeductTxCostsFromUserBalance(ctx, fees, common.BytesToAddress(msg.GetFrom())))
+}
+
+func (suite *BlockSessionTestSuite) TestMultipleTxsPerBlock() {
+	k := suite.App.EvmKeeper
+	ctx := suite.Ctx.WithExecMode(sdk.ExecModeFinalize)
+	suite.Require().NoError(k.BeginBlock(ctx))
+	suite.Require().True(k.BlockSessionOpen())
+
+	to := common.BigToAddress(big.NewInt(0xbeef))
+	gasPrice := new(big.Int).Mul(k.GetBaseFee(ctx, k.GetParams(ctx).ChainConfig.EthereumConfig(k.ChainID())), big.NewInt(2))
+	feeCollector := suite.App.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	balance := k.GetEVMDenomBalance(ctx, suite.Address)
+	collected := k.GetEVMDenomBalance(ctx, common.BytesToAddress(feeCollector))
+
+	for i := uint64(0); i < 4; i++ {
+		value := big.NewInt(int64(1000 * (i + 1)))
+		msg := types.NewTx(k.ChainID(), i, &to, value, 50_000, gasPrice, nil, nil, nil, nil)
+		msg.From = suite.Address.Bytes()
+
+		suite.ante(ctx, msg)
+		res, err := k.ApplyTransaction(ctx, msg)
+		suite.Require().NoError(err)
+		suite.Require().False(res.Failed(), res.VmError)
+
+		// the state of the transaction is on the node before the next one runs
+		cost := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(res.GasUsed))
+		balance.Sub(balance, value).Sub(balance, cost)
+		collected.Add(collected, cost)
+		suite.Require().Equal(i+1, k.GetNonce(ctx, suite.Address), "tx %d", i)
+		suite.Require().Equal(balance, k.GetEVMDenomBalance(ctx, suite.Address), "tx %d", i)
+		suite.Require().Equal(collected, k.GetEVMDenomBalance(ctx, common.BytesToAddress(feeCollector)), "tx %d", i)
+	}
+
+	// closing the block session leaves the state untouched
+	suite.Require().NoError(k.EndBlock(ctx))
+	suite.Require().False(k.BlockSessionOpen())
+	suite.Require().Equal(uint64(4), k.GetNonce(ctx, suite.Address))
+	suite.Require().Equal(balance, k.GetEVMDenomBalance(ctx, suite.Address))
+	suite.Require().Equal(big.NewInt(10_000), k.GetEVMDenomBalance(ctx, to))
+}
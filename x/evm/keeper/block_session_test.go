@@ -0,0 +1,161 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/log"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// blockSessionEnclaveConn is a fakeEnclaveConn recording the block session
+// calls it receives.
+type blockSessionEnclaveConn struct {
+	*fakeEnclaveConn
+	calls *[]string
+}
+
+func (c *blockSessionEnclaveConn) record(call string) error {
+	if c.down[c.addr] {
+		return status.Error(codes.Unavailable, "connection refused")
+	}
+	*c.calls = append(*c.calls, call)
+	return nil
+}
+
+func (c *blockSessionEnclaveConn) BeginBlock(
+	_ context.Context, req *types.EnclaveBeginBlockRequest, _ ...grpc.CallOption,
+) (*types.EnclaveBeginBlockResponse, error) {
+	if err := c.record(fmt.Sprintf("BeginBlock %d", req.Header.Height)); err != nil {
+		return nil, err
+	}
+	return &types.EnclaveBeginBlockResponse{BlockSessionID: 7}, nil
+}
+
+func (c *blockSessionEnclaveConn) Commit(
+	_ context.Context, req *types.EnclaveCommitRequest, _ ...grpc.CallOption,
+) (*types.EnclaveCommitResponse, error) {
+	if err := c.record(fmt.Sprintf("Commit %d", req.SessionID)); err != nil {
+		return nil, err
+	}
+	return &types.EnclaveCommitResponse{}, nil
+}
+
+func (c *blockSessionEnclaveConn) EndBlock(
+	_ context.Context, req *types.EnclaveEndBlockRequest, _ ...grpc.CallOption,
+) (*types.EnclaveEndBlockResponse, error) {
+	if err := c.record(fmt.Sprintf("EndBlock %d %t", req.BlockSessionID, req.Commit)); err != nil {
+		return nil, err
+	}
+	return &types.EnclaveEndBlockResponse{}, nil
+}
+
+func newBlockSessionTestKeeper(down map[string]bool, versions map[string]uint32) (*Keeper, *[]string) {
+	calls := &[]string{}
+	pool := newSgxExecutorPool([]string{"primary", "secondary"})
+	pool.dial = func(addr string) (enclaveConn, error) {
+		conn := &fakeEnclaveConn{addr: addr, down: down, versions: versions}
+		return &blockSessionEnclaveConn{fakeEnclaveConn: conn, calls: calls}, nil
+	}
+	k := &Keeper{sgxExecutors: pool, sgxContexts: newSgxContexts()}
+	return k.SetSgxBlockSession(true), calls
+}
+
+func newBlockSessionTestContext(height int64) sdk.Context {
+	return sdk.NewContext(nil, cmtproto.Header{Height: height}, false, log.NewNopLogger()).
+		WithExecMode(sdk.ExecModeFinalize)
+}
+
+func TestBlockSessionLifecycle(t *testing.T) {
+	k, calls := newBlockSessionTestKeeper(map[string]bool{}, nil)
+	ctx := newBlockSessionTestContext(5)
+	cfg := &EVMConfig{}
+
+	k.beginBlockSession(ctx)
+	require.NotNil(t, k.blockSession)
+
+	// transactions of the block join the session
+	client, release, err := k.acquireSgxClient(ctx, cfg)
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), client.blockSession)
+	require.NoError(t, client.Commit(CommitArgs{Commit: true}, &CommitReply{}))
+	release()
+
+	// queries and other execution modes use standalone sessions
+	client, release, err = k.acquireSgxClient(ctx, &EVMConfig{ReadOnly: true})
+	require.NoError(t, err)
+	require.Zero(t, client.blockSession)
+	release()
+	client, release, err = k.acquireSgxClient(ctx.WithExecMode(sdk.ExecModeCheck), cfg)
+	require.NoError(t, err)
+	require.Zero(t, client.blockSession)
+	release()
+
+	require.NoError(t, k.endBlockSession(ctx))
+	require.Nil(t, k.blockSession)
+	require.Equal(t, []string{"BeginBlock 5", "Commit 0", "EndBlock 7 true"}, *calls)
+	require.Zero(t, k.sgxExecutors.consensus[0].inflight.Load())
+}
+
+func TestBlockSessionFallback(t *testing.T) {
+	ctx := newBlockSessionTestContext(5)
+
+	// the block sessions of the executors older than the version 5 only write
+	// the state back at EndBlock, per-tx sessions are used instead
+	for _, version := range []uint32{1, types.EnclaveBlockSessionVersion, types.EnclaveWriteThroughVersion - 1} {
+		k, calls := newBlockSessionTestKeeper(map[string]bool{}, map[string]uint32{"primary": version})
+		k.beginBlockSession(ctx)
+		require.Nil(t, k.blockSession)
+		client, release, err := k.acquireSgxClient(ctx, &EVMConfig{})
+		require.NoError(t, err)
+		require.Zero(t, client.blockSession)
+		release()
+		require.NoError(t, k.endBlockSession(ctx))
+		require.Empty(t, *calls)
+	}
+
+	// block sessions are disabled in shadow execution mode
+	k, calls := newBlockSessionTestKeeper(map[string]bool{}, nil)
+	k.SetShadowExecution(true)
+	k.beginBlockSession(ctx)
+	require.Nil(t, k.blockSession)
+	require.Empty(t, *calls)
+}
+
+func TestBlockSessionBroken(t *testing.T) {
+	down := map[string]bool{}
+	k, _ := newBlockSessionTestKeeper(down, nil)
+	ctx := newBlockSessionTestContext(5)
+
+	k.beginBlockSession(ctx)
+	require.NotNil(t, k.blockSession)
+
+	// the executor went away while a transaction was committed
+	client, release, err := k.acquireSgxClient(ctx, &EVMConfig{})
+	require.NoError(t, err)
+	down["primary"] = true
+	require.ErrorIs(t, client.Commit(CommitArgs{Commit: true}, &CommitReply{}), types.ErrSgxExecutor)
+	release()
+	_, _, err = k.acquireSgxClient(ctx, &EVMConfig{})
+	require.ErrorIs(t, err, errBlockSessionBroken)
+	require.ErrorIs(t, k.endBlockSession(ctx), errBlockSessionBroken)
+	require.Nil(t, k.blockSession)
+}
+
+func TestBlockSessionAbort(t *testing.T) {
+	k, calls := newBlockSessionTestKeeper(map[string]bool{}, nil)
+
+	// a session left open by a block that didn't reach EndBlock is discarded
+	k.beginBlockSession(newBlockSessionTestContext(5))
+	k.beginBlockSession(newBlockSessionTestContext(6))
+	require.Equal(t, int64(6), k.blockSession.height)
+	require.Equal(t, []string{"BeginBlock 5", "EndBlock 7 false", "BeginBlock 6"}, *calls)
+}
@@ -19,6 +19,7 @@ import (
 	"math/big"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
@@ -38,11 +39,37 @@ type EVMConfig struct {
 	ChainConfig     *params.ChainConfig
 	CoinBase        common.Address
 	BaseFee         *big.Int
+	BlobBaseFee     *big.Int
 	TxConfig        statedb.TxConfig
 	Tracer          vm.EVMLogger
 	DebugTrace      bool
 	Overrides       *rpctypes.StateOverride
 	BlockOverrides  *rpctypes.BlockOverrides
+	// ReadOnly marks a simulation (eth_call, eth_estimateGas, traces) whose
+	// execution is dispatched to the query executors instead of the consensus
+	// one.
+	ReadOnly bool
+	// SetCodeAuthorizations are the EIP-7702 authorizations applied before the
+	// execution of the message.
+	SetCodeAuthorizations []types.SetCodeAuthorization
+	// FeePayment is the fee token paying for the gas of the transaction, nil when
+	// it's paid with the evm denom.
+	FeePayment *feemarkettypes.FeePayment
+	// InternalTxTracer records the internal transactions of the message on the
+	// in-process EVM, nil when they are not traced.
+	InternalTxTracer *types.InternalTxTracer
+
+	// journal records the StateDB accesses of the message, when it's
+	// pre-executed.
+	journal *statedb.AccessJournal
+}
+
+// GasFeePayment returns the payment the leftover gas of the transaction is refunded with.
+func (cfg EVMConfig) GasFeePayment() feemarkettypes.FeePayment {
+	if cfg.FeePayment != nil {
+		return *cfg.FeePayment
+	}
+	return feemarkettypes.NewFeePayment(cfg.Params.EvmDenom, sdkmath.LegacyOneDec())
 }
 
 // EVMConfig creates the EVMConfig based on current state
@@ -74,12 +101,18 @@ func (k *Keeper) EVMConfig(ctx sdk.Context, proposerAddress sdk.ConsAddress, cha
 		}
 	}
 
+	var blobBaseFee *big.Int
+	if ethCfg.IsCancun(big.NewInt(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())) {
+		blobBaseFee = k.feeMarketKeeper.GetBlobBaseFee(ctx)
+	}
+
 	return &EVMConfig{
 		Params:          params,
 		FeeMarketParams: feemarketParams,
 		ChainConfig:     ethCfg,
 		CoinBase:        coinbase,
 		BaseFee:         baseFee,
+		BlobBaseFee:     blobBaseFee,
 		TxConfig:        txConfig,
 	}, nil
 }
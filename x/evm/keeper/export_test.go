@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/core"
+)
+
+// UseLocalEnclave makes the keeper execute the EVM messages on an in-process
+// enclave instead of the SGX executors.
+func (k *Keeper) UseLocalEnclave() *Keeper {
+	enclave := newLocalEnclave(k)
+	k.sgxExecutors = newSgxExecutorPool(nil)
+	k.sgxExecutors.dial = func(string) (enclaveConn, error) {
+		return enclave, nil
+	}
+	return k
+}
+
+// BlockSessionOpen reports if a block session is open.
+func (k *Keeper) BlockSessionOpen() bool {
+	return k.blockSession != nil
+}
+
+// PreExecutionsReused returns the number of pre-executions applied in the
+// current block.
+func (k *Keeper) PreExecutionsReused() int {
+	if k.preExecution == nil {
+		return 0
+	}
+	return k.preExecution.reused
+}
+
+// PreExecutedTxMatches reports if a transaction pre-executed with the pre
+// config can be applied with cfg.
+func PreExecutedTxMatches(pre, cfg EVMConfig) bool {
+	return (&preExecutedTx{cfg: pre}).matches(&cfg)
+}
+
+// RecordBlockFees adds the fees paid for the gas used by the message to the fees
+// of the block.
+func (k *Keeper) RecordBlockFees(ctx sdk.Context, msg core.Message, gasUsed uint64, cfg *EVMConfig) {
+	k.recordBlockFees(ctx, msg, gasUsed, cfg)
+}
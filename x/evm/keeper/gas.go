@@ -18,6 +18,7 @@ package keeper
 import (
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/params"
 
@@ -28,6 +29,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/evmos/ethermint/x/evm/types"
+	feemarkettypes "github.com/evmos/ethermint/x/feemarket/types"
 )
 
 // GetEthIntrinsicGas returns the intrinsic gas cost for the transaction
@@ -45,6 +47,12 @@ func (k *Keeper) GetEthIntrinsicGas(ctx sdk.Context, msg core.Message, cfg *para
 // returned by the EVM execution, thus ignoring the previous intrinsic gas consumed during in the
 // AnteHandler.
 func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64, denom string) error {
+	return k.RefundGasWithFeePayment(ctx, msg, leftoverGas, feemarkettypes.NewFeePayment(denom, sdkmath.LegacyOneDec()))
+}
+
+// RefundGasWithFeePayment transfers the leftover gas to the sender of the message like RefundGas,
+// in the token that paid for the gas, converted at the price it was paid.
+func (k *Keeper) RefundGasWithFeePayment(ctx sdk.Context, msg core.Message, leftoverGas uint64, payment feemarkettypes.FeePayment) error {
 	// Return EVM tokens for remaining gas, exchanged at the original rate.
 	remaining := new(big.Int).Mul(new(big.Int).SetUint64(leftoverGas), msg.GasPrice)
 
@@ -54,7 +62,11 @@ func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64
 		return errorsmod.Wrapf(types.ErrInvalidRefund, "refunded amount value cannot be negative %d", remaining.Int64())
 	case 1:
 		// positive amount refund
-		refundedCoins := sdk.Coins{sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(remaining))}
+		refund := payment.ConvertRefund(sdkmath.NewIntFromBigInt(remaining))
+		if !refund.IsPositive() {
+			return nil
+		}
+		refundedCoins := sdk.Coins{refund}
 
 		// refund to sender from the fee collector module account, which is the escrow account in charge of collecting tx fees
 
@@ -70,6 +82,35 @@ func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64
 	return nil
 }
 
+// recordBlockFees adds the fees paid for the gas used by the message, split between the
+// base fee and the tip owed to the coinbase, to the fees of the block in the fee market,
+// and records the tip per gas for its fee history.
+//
+// The fees are the amount kept by the fee collector, the fee charged by the ante handler
+// less the refund of the leftover gas, so that their distribution leaves no dust when the
+// conversion to the fee token rounds. The tip is the remainder of the base fee part.
+func (k *Keeper) recordBlockFees(ctx sdk.Context, msg core.Message, gasUsed uint64, cfg *EVMConfig) {
+	baseFee := new(big.Int)
+	if cfg.BaseFee != nil {
+		baseFee.Set(math.BigMin(cfg.BaseFee, msg.GasPrice))
+	}
+	tip := new(big.Int).Sub(msg.GasPrice, baseFee)
+	k.feeMarketKeeper.AddTransientTxReward(ctx, cfg.TxConfig.TxHash, gasUsed, tip)
+
+	payment := cfg.GasFeePayment()
+	charged := payment.ConvertFee(sdkmath.NewIntFromBigInt(new(big.Int).Mul(new(big.Int).SetUint64(msg.GasLimit), msg.GasPrice)))
+	refunded := payment.ConvertRefund(sdkmath.NewIntFromBigInt(new(big.Int).Mul(new(big.Int).SetUint64(msg.GasLimit-gasUsed), msg.GasPrice)))
+	total := charged.Amount.Sub(refunded.Amount)
+	base := sdkmath.MinInt(payment.ConvertRefund(sdkmath.NewIntFromBigInt(baseFee.Mul(baseFee, new(big.Int).SetUint64(gasUsed)))).Amount, total)
+
+	baseFees := sdk.NewCoins(sdk.NewCoin(payment.Denom, base))
+	priorityFees := sdk.NewCoins(sdk.NewCoin(payment.Denom, total.Sub(base)))
+	if baseFees.IsZero() && priorityFees.IsZero() {
+		return
+	}
+	k.feeMarketKeeper.AddTransientBlockFees(ctx, baseFees, priorityFees, cfg.CoinBase.Bytes())
+}
+
 // ResetGasMeterAndConsumeGas reset first the gas meter consumed value to zero and set it back to the new value
 // 'gasUsed'
 func (k *Keeper) ResetGasMeterAndConsumeGas(ctx sdk.Context, gasUsed uint64) {
@@ -89,3 +130,19 @@ func GasToRefund(availableRefund, gasConsumed, refundQuotient uint64) uint64 {
 	}
 	return refund
 }
+
+// minimumGasUsed returns the gas charged to the sender of the message, which is at least the
+// minimum amount of gas defined by the fee market MinGasMultiplier if GasLimit is considerably
+// higher than GasUsed, to stay more aligned with Tendermint gas mechanics.
+// For more info https://github.com/evmos/ethermint/issues/1085
+func minimumGasUsed(msg core.Message, cfg *EVMConfig, gasUsed uint64) uint64 {
+	gasLimit := sdkmath.LegacyNewDec(int64(msg.GasLimit))
+	minGasMultiplier := cfg.FeeMarketParams.MinGasMultiplier
+	if minGasMultiplier.IsNil() {
+		// in case we are executing eth_call on a legacy block, returns a zero value.
+		minGasMultiplier = sdkmath.LegacyZeroDec()
+	}
+	minimumGasUsed := gasLimit.Mul(minGasMultiplier)
+
+	return sdkmath.LegacyMaxDec(minimumGasUsed, sdkmath.LegacyNewDec(int64(gasUsed))).TruncateInt().Uint64()
+}
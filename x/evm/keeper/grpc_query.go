@@ -259,6 +259,7 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 
 		cfg.Overrides = &overrides
 	}
+	cfg.ReadOnly = true
 
 	// ApplyMessageWithConfig expect correct nonce set in msg
 	nonce := k.GetNonce(ctx, args.GetFrom())
@@ -268,6 +269,7 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	cfg.SetCodeAuthorizations = args.GetAuthorizations()
 
 	// pass false to not commit StateDB
 	res, err := k.ApplyMessageWithConfig(ctx, msg, cfg, false)
@@ -331,6 +333,7 @@ func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*type
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to load evm config")
 	}
+	cfg.ReadOnly = true
 
 	// ApplyMessageWithConfig expect correct nonce set in msg
 	nonce := k.GetNonce(ctx, args.GetFrom())
@@ -341,6 +344,7 @@ func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*type
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+	cfg.SetCodeAuthorizations = args.GetAuthorizations()
 
 	// NOTE: the errors from the executable below should be consistent with go-ethereum,
 	// so we don't wrap them with the gRPC status code
@@ -451,6 +455,7 @@ func execTrace[T traceRequest](
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to load evm config: %s", err.Error())
 	}
+	cfg.ReadOnly = true
 
 	msg, err := msgCb(ctx, cfg)
 	if err != nil {
@@ -483,13 +488,13 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 			signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix()))
 			cfg.Tracer = types.NewNoOpTracer()
 			for i, tx := range req.Predecessors {
-				ethTx := tx.AsTransaction()
-				msg, err := core.TransactionToMessage(ethTx, signer, cfg.BaseFee)
+				msg, err := traceMessage(tx, signer, cfg.BaseFee)
 				if err != nil {
 					continue
 				}
-				cfg.TxConfig.TxHash = ethTx.Hash()
+				cfg.TxConfig.TxHash = tx.ComputeHash()
 				cfg.TxConfig.TxIndex = uint(i)
+				cfg.SetCodeAuthorizations = tx.GetSetCodeAuthorizations()
 				rsp, err := k.ApplyMessageWithConfig(ctx, *msg, cfg, true)
 				if err != nil {
 					continue
@@ -497,13 +502,13 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 				cfg.TxConfig.LogIndex += uint(len(rsp.Logs))
 			}
 
-			tx := req.Msg.AsTransaction()
-			cfg.TxConfig.TxHash = tx.Hash()
+			cfg.TxConfig.TxHash = req.Msg.ComputeHash()
+			cfg.SetCodeAuthorizations = req.Msg.GetSetCodeAuthorizations()
 			if len(req.Predecessors) > 0 {
 				cfg.TxConfig.TxIndex++
 			}
 
-			return core.TransactionToMessage(tx, signer, cfg.BaseFee)
+			return traceMessage(req.Msg, signer, cfg.BaseFee)
 		},
 	)
 	if err != nil {
@@ -547,16 +552,17 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to load evm config")
 	}
+	cfg.ReadOnly = true
 	signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix()))
 	txsLength := len(req.Txs)
 	results := make([]*types.TxTraceResult, 0, txsLength)
 
 	for i, tx := range req.Txs {
 		result := types.TxTraceResult{}
-		ethTx := tx.AsTransaction()
-		cfg.TxConfig.TxHash = ethTx.Hash()
+		cfg.TxConfig.TxHash = tx.ComputeHash()
 		cfg.TxConfig.TxIndex = uint(i)
-		msg, err := core.TransactionToMessage(ethTx, signer, cfg.BaseFee)
+		cfg.SetCodeAuthorizations = tx.GetSetCodeAuthorizations()
+		msg, err := traceMessage(tx, signer, cfg.BaseFee)
 		if err != nil {
 			result.Error = status.Error(codes.Internal, err.Error()).Error()
 		} else {
@@ -604,6 +610,7 @@ func (k Keeper) TraceCall(c context.Context, req *types.QueryTraceCallRequest) (
 			if err != nil {
 				return nil, err
 			}
+			cfg.SetCodeAuthorizations = args.GetAuthorizations()
 			return &msg, nil
 		},
 	)
@@ -749,6 +756,20 @@ func (k Keeper) BaseFee(c context.Context, _ *types.QueryBaseFeeRequest) (*types
 }
 
 // getChainID parse chainID from current context if not provided
+// traceMessage returns the core message of a traced transaction. The
+// set-code transactions can't be represented by go-ethereum, their sender is
+// the one recovered when they were decoded.
+func traceMessage(tx *types.MsgEthereumTx, signer ethtypes.Signer, baseFee *big.Int) (*core.Message, error) {
+	if tx.TxType() == types.SetCodeTxType {
+		msg, err := tx.AsMessage(baseFee)
+		if err != nil {
+			return nil, err
+		}
+		return &msg, nil
+	}
+	return core.TransactionToMessage(tx.AsTransaction(), signer, baseFee)
+}
+
 func getChainID(ctx sdk.Context, chainID int64) (*big.Int, error) {
 	if chainID == 0 {
 		return ethermint.ParseChainID(ctx.ChainID())
@@ -762,8 +783,11 @@ func getChainID(ctx sdk.Context, chainID int64) (*big.Int, error) {
 
 // QueryGetHashStateDB queries hash in statedb for sgx
 func (k Keeper) QueryGetHashStateDB(_ context.Context, req *types.GetHashRequest) (*types.GetHashResponse, error) {
-	ctx := k.preparedCtx
-	hash := k.GetHashFn(ctx)(req.Height)
+	sgxCtx, err := k.callbackContext(req.ContextID)
+	if err != nil {
+		return nil, err
+	}
+	hash := k.GetHashFn(sgxCtx.ctx)(req.Height)
 
 	res := &types.GetHashResponse{
 		Hash: hash.Hex(),
@@ -774,13 +798,19 @@ func (k Keeper) QueryGetHashStateDB(_ context.Context, req *types.GetHashRequest
 
 // PostAddBalanceStateDB add balance in statedb for sgx
 func (k Keeper) PostAddBalanceStateDB(_ context.Context, req *types.AddBalanceRequest) (*types.AddBalanceResponse, error) {
-	ctx := k.preparedCtx
+	sgxCtx, err := k.callbackContext(req.ContextID)
+	if err != nil {
+		return nil, err
+	}
 	addr, err := sdk.AccAddressFromBech32(req.Addr)
 	if err != nil {
 		return nil, err
 	}
 
-	err = k.AddBalance(ctx, addr, req.Amount)
+	if sgxCtx.journal != nil {
+		sgxCtx.journal.AddBalance(addr, req.Amount)
+	}
+	err = k.AddBalance(sgxCtx.ctx, addr, req.Amount)
 	if err != nil {
 		return nil, err
 	}
@@ -790,13 +820,19 @@ func (k Keeper) PostAddBalanceStateDB(_ context.Context, req *types.AddBalanceRe
 
 // PostSubBalanceStateDB sub balance in statedb for sgx
 func (k Keeper) PostSubBalanceStateDB(_ context.Context, req *types.SubBalanceRequest) (*types.SubBalanceResponse, error) {
-	ctx := k.preparedCtx
+	sgxCtx, err := k.callbackContext(req.ContextID)
+	if err != nil {
+		return nil, err
+	}
 	addr, err := sdk.AccAddressFromBech32(req.Addr)
 	if err != nil {
 		return nil, err
 	}
 
-	err = k.SubBalance(ctx, addr, req.Amount)
+	if sgxCtx.journal != nil {
+		sgxCtx.journal.SubBalance(addr, req.Amount)
+	}
+	err = k.SubBalance(sgxCtx.ctx, addr, req.Amount)
 	if err != nil {
 		return nil, err
 	}
@@ -806,13 +842,19 @@ func (k Keeper) PostSubBalanceStateDB(_ context.Context, req *types.SubBalanceRe
 
 // QueryGetBalanceStateDB queries balance in statedb for sgx
 func (k Keeper) QueryGetBalanceStateDB(_ context.Context, req *types.GetBalanceRequest) (*types.GetBalanceResponse, error) {
-	ctx := k.preparedCtx
+	sgxCtx, err := k.callbackContext(req.ContextID)
+	if err != nil {
+		return nil, err
+	}
 	addr, err := sdk.AccAddressFromBech32(req.Addr)
 	if err != nil {
 		return nil, err
 	}
 
-	balance := k.GetBalance(ctx, addr, req.Denom)
+	balance := k.GetBalance(sgxCtx.ctx, addr, req.Denom)
+	if sgxCtx.journal != nil {
+		sgxCtx.journal.GetBalance(addr, req.Denom, balance)
+	}
 
 	return &types.GetBalanceResponse{
 		Balance: sdkmath.NewIntFromBigInt(balance),
@@ -821,9 +863,15 @@ func (k Keeper) QueryGetBalanceStateDB(_ context.Context, req *types.GetBalanceR
 
 // QueryGetAccountStateDB queries account in statedb for sgx
 func (k Keeper) QueryGetAccountStateDB(_ context.Context, req *types.GetAccountRequest) (*types.GetAccountResponse, error) {
-	ctx := k.preparedCtx
+	sgxCtx, err := k.callbackContext(req.ContextID)
+	if err != nil {
+		return nil, err
+	}
 	addr := common.HexToAddress(req.Addr)
-	account := k.GetAccount(ctx, addr)
+	account := k.GetAccount(sgxCtx.ctx, addr)
+	if sgxCtx.journal != nil {
+		sgxCtx.journal.GetAccount(addr, account)
+	}
 	if account == nil {
 		return nil, errors.New("account doesn't exist")
 	}
@@ -840,11 +888,17 @@ func (k Keeper) QueryGetAccountStateDB(_ context.Context, req *types.GetAccountR
 
 // QueryGetStateStateDB queries state in statedb for sgx
 func (k Keeper) QueryGetStateStateDB(_ context.Context, req *types.GetStateRequest) (*types.GetStateResponse, error) {
-	ctx := k.preparedCtx
+	sgxCtx, err := k.callbackContext(req.ContextID)
+	if err != nil {
+		return nil, err
+	}
 	addr := common.HexToAddress(req.Addr)
 	key := common.HexToHash(req.Key)
 
-	hash := k.GetState(ctx, addr, key)
+	hash := k.GetState(sgxCtx.ctx, addr, key)
+	if sgxCtx.journal != nil {
+		sgxCtx.journal.GetState(addr, key, hash)
+	}
 	return &types.GetStateResponse{
 		Hash: hash.Hex(),
 	}, nil
@@ -852,10 +906,16 @@ func (k Keeper) QueryGetStateStateDB(_ context.Context, req *types.GetStateReque
 
 // QueryGetCodeStateDB queries code in statedb for sgx
 func (k Keeper) QueryGetCodeStateDB(_ context.Context, req *types.GetCodeRequest) (*types.GetCodeResponse, error) {
-	ctx := k.preparedCtx
+	sgxCtx, err := k.callbackContext(req.ContextID)
+	if err != nil {
+		return nil, err
+	}
 	codeHash := common.HexToHash(req.CodeHash)
 
-	code := k.GetCode(ctx, codeHash)
+	code := k.GetCode(sgxCtx.ctx, codeHash)
+	if sgxCtx.journal != nil {
+		sgxCtx.journal.GetCode(codeHash, code)
+	}
 	return &types.GetCodeResponse{
 		Code: code,
 	}, nil
@@ -863,16 +923,22 @@ func (k Keeper) QueryGetCodeStateDB(_ context.Context, req *types.GetCodeRequest
 
 // PostSetAccountStateDB sets account in statedb for sgx
 func (k Keeper) PostSetAccountStateDB(_ context.Context, req *types.SetAccountRequest) (*types.SetAccountResponse, error) {
-	ctx := k.preparedCtx
+	sgxCtx, err := k.callbackContext(req.ContextID)
+	if err != nil {
+		return nil, err
+	}
 	addr := common.HexToAddress(req.Addr)
 
 	var account statedb.Account
-	err := json.Unmarshal(req.Account, &account)
+	err = json.Unmarshal(req.Account, &account)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	err = k.SetAccount(ctx, addr, account)
+	if sgxCtx.journal != nil {
+		sgxCtx.journal.SetAccount(addr, account)
+	}
+	err = k.SetAccount(sgxCtx.ctx, addr, account)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
@@ -882,27 +948,45 @@ func (k Keeper) PostSetAccountStateDB(_ context.Context, req *types.SetAccountRe
 
 // PostSetStateStateDB sets state in statedb for sgx
 func (k Keeper) PostSetStateStateDB(_ context.Context, req *types.SetStateRequest) (*types.SetStateResponse, error) {
-	ctx := k.preparedCtx
+	sgxCtx, err := k.callbackContext(req.ContextID)
+	if err != nil {
+		return nil, err
+	}
 	addr := common.HexToAddress(req.Addr)
 	key := common.HexToHash(req.Key)
 
-	k.SetState(ctx, addr, key, req.Value)
+	if sgxCtx.journal != nil {
+		sgxCtx.journal.SetState(addr, key, req.Value)
+	}
+	k.SetState(sgxCtx.ctx, addr, key, req.Value)
 	return &types.SetStateResponse{}, nil
 }
 
 // PostSetCodeStateDB sets code in statedb for sgx
 func (k Keeper) PostSetCodeStateDB(_ context.Context, req *types.SetCodeRequest) (*types.SetCodeResponse, error) {
-	ctx := k.preparedCtx
+	sgxCtx, err := k.callbackContext(req.ContextID)
+	if err != nil {
+		return nil, err
+	}
 
-	k.SetCode(ctx, req.CodeHash, req.Code)
+	if sgxCtx.journal != nil {
+		sgxCtx.journal.SetCode(req.CodeHash, req.Code)
+	}
+	k.SetCode(sgxCtx.ctx, req.CodeHash, req.Code)
 	return &types.SetCodeResponse{}, nil
 }
 
 // PostDeleteAccountStateDB delete account in statedb for sgx
 func (k Keeper) PostDeleteAccountStateDB(_ context.Context, req *types.DeleteAccountRequest) (*types.DeleteAccountResponse, error) {
-	ctx := k.preparedCtx
+	sgxCtx, err := k.callbackContext(req.ContextID)
+	if err != nil {
+		return nil, err
+	}
 	addr := common.HexToAddress(req.Addr)
 
-	k.DeleteAccount(ctx, addr)
+	if sgxCtx.journal != nil {
+		sgxCtx.journal.DeleteAccount(addr)
+	}
+	k.DeleteAccount(sgxCtx.ctx, addr)
 	return &types.DeleteAccountResponse{}, nil
 }
@@ -0,0 +1,45 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// SetInternalTxTracing enables or disables the tracing of the internal transactions of the block
+// transactions: the value transfers, contract creations and self-destructs of their nested call
+// frames, which are emitted as events for the indexer and the JSON-RPC subscriptions. They are
+// recorded by executing every transaction a second time on the in-process EVM, since the enclave
+// doesn't return the call frames. When shadow execution is enabled, its run records them and no
+// other execution happens. The events are not part of the consensus, so each node can enable it
+// independently.
+func (k *Keeper) SetInternalTxTracing(enabled bool) *Keeper {
+	k.internalTxTracing = enabled
+	return k
+}
+
+// emitInternalTxs emits the events of the internal transactions recorded during the execution of
+// a successful message, if they are traced.
+func (k *Keeper) emitInternalTxs(ctx sdk.Context, cfg *EVMConfig, res *types.MsgEthereumTxResponse) {
+	if cfg.InternalTxTracer == nil || res.Failed() {
+		return
+	}
+	ctx.EventManager().EmitEvents(types.NewInternalTxEvents(
+		cfg.TxConfig.TxHash, cfg.TxConfig.TxIndex, cfg.InternalTxTracer.InternalTxs(),
+	))
+}
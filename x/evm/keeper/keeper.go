@@ -81,6 +81,29 @@ type Keeper struct {
 
 	// ctx stored at the time of prepare SGX call
 	preparedCtx sdk.Context
+	// contexts of the open enclave sessions, by context id
+	sgxContexts *sgxContexts
+
+	// SGX executors the EVM messages are dispatched to
+	sgxExecutors *sgxExecutorPool
+
+	// cross-check the enclave execution with the in-process EVM
+	shadowExecution bool
+
+	// execute the transactions of a block in a single enclave session
+	sgxBlockSession bool
+	// block session open on the primary executor, if any
+	blockSession *sgxBlockSession
+	// executor failure of the block being finalized, which aborts it at EndBlock
+	sgxFailure error
+
+	// number of workers the EVM transactions of a block are pre-executed with
+	parallelWorkers int
+	// pre-execution of the block being finalized, if any
+	preExecution *blockPreExecution
+
+	// trace the internal transactions of the block transactions
+	internalTxTracing bool
 }
 
 // NewKeeper generates new evm module keeper
@@ -121,9 +144,20 @@ func NewKeeper(
 		tracer:            tracer,
 		customContractFns: customContractFns,
 		keys:              keys,
+		sgxExecutors:      newSgxExecutorPool(nil),
+		sgxContexts:       newSgxContexts(),
 	}
 }
 
+// SetSgxExecutors sets the addresses of the SGX executors. The first address is
+// the primary executor used for block execution and the second one its
+// failover, the others only serve read-only queries (eth_call,
+// eth_estimateGas, traces).
+func (k *Keeper) SetSgxExecutors(addrs []string) *Keeper {
+	k.sgxExecutors = newSgxExecutorPool(addrs)
+	return k
+}
+
 func (k Keeper) StoreKeys() map[string]storetypes.StoreKey {
 	return k.keys
 }
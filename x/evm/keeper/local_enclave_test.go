@@ -0,0 +1,446 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"google.golang.org/grpc"
+
+	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/evmos/ethermint/x/evm/types"
+	feemarkettypes "github.com/evmos/ethermint/x/feemarket/types"
+)
+
+// localEnclave is an in-process enclave executing the messages on the
+// go-ethereum EVM. Like the SGX executors, it reads the node state and writes
+// it back at Commit through the StateDB callbacks of the keeper, with the
+// context id of the session. The block sessions follow the semantics of the
+// protocol version 5, Snapshot and RevertToSnapshot are not implemented.
+type localEnclave struct {
+	types.EnclaveClient
+
+	k *Keeper
+
+	mu       sync.Mutex
+	sessions map[uint64]*localEnclaveSession
+	nextID   uint64
+}
+
+type localEnclaveSession struct {
+	msg     core.Message
+	cfg     *EVMConfig
+	state   *callbackState
+	stateDB *statedb.StateDB
+	evm     *vm.EVM
+}
+
+func newLocalEnclave(k *Keeper) *localEnclave {
+	return &localEnclave{k: k, sessions: make(map[uint64]*localEnclaveSession)}
+}
+
+func (e *localEnclave) session(id uint64) (*localEnclaveSession, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	s, ok := e.sessions[id]
+	if !ok {
+		return nil, fmt.Errorf("unknown session %d", id)
+	}
+	return s, nil
+}
+
+func (e *localEnclave) newID() uint64 {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.nextID++
+	return e.nextID
+}
+
+// callbackState is the statedb.Keeper of the local enclave sessions, reading
+// and writing the node state through the StateDB callbacks. The StateDB changes
+// the balances natively, on a scratch branch of the node state: the balance of
+// an account is read through the callbacks before it's first used, and its
+// changes are written back at Commit.
+type callbackState struct {
+	k       *Keeper
+	id      uint64
+	denom   string
+	scratch sdk.Context
+
+	// balances are the node balances of the accounts used by the session.
+	balances map[common.Address]*big.Int
+}
+
+var _ statedb.Keeper = (*callbackState)(nil)
+
+func (s *callbackState) StoreKeys() map[string]storetypes.StoreKey {
+	return s.k.StoreKeys()
+}
+
+func (s *callbackState) GetParams(ctx sdk.Context) types.Params {
+	return s.k.GetParams(ctx)
+}
+
+// useBalance reads the node balance of the account the first time it's used.
+func (s *callbackState) useBalance(addr common.Address) error {
+	if _, ok := s.balances[addr]; ok {
+		return nil
+	}
+	res, err := s.k.QueryGetBalanceStateDB(context.Background(), &types.GetBalanceRequest{
+		Addr: sdk.AccAddress(addr.Bytes()).String(), Denom: s.denom, ContextID: s.id,
+	})
+	if err != nil {
+		return err
+	}
+	s.balances[addr] = res.Balance.BigInt()
+	return nil
+}
+
+func (s *callbackState) AddBalance(ctx sdk.Context, addr sdk.AccAddress, coins sdk.Coins) error {
+	if err := s.useBalance(common.BytesToAddress(addr)); err != nil {
+		return err
+	}
+	return s.k.AddBalance(ctx, addr, coins)
+}
+
+func (s *callbackState) SubBalance(ctx sdk.Context, addr sdk.AccAddress, coins sdk.Coins) error {
+	if err := s.useBalance(common.BytesToAddress(addr)); err != nil {
+		return err
+	}
+	return s.k.SubBalance(ctx, addr, coins)
+}
+
+func (s *callbackState) SetBalance(ctx sdk.Context, addr common.Address, amount *big.Int, denom string) error {
+	if err := s.useBalance(addr); err != nil {
+		return err
+	}
+	return s.k.SetBalance(ctx, addr, amount, denom)
+}
+
+func (s *callbackState) GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) *big.Int {
+	if err := s.useBalance(common.BytesToAddress(addr)); err != nil {
+		panic(err)
+	}
+	return s.k.GetBalance(ctx, addr, denom)
+}
+
+func (s *callbackState) GetAccount(_ sdk.Context, addr common.Address) *statedb.Account {
+	res, err := s.k.QueryGetAccountStateDB(context.Background(), &types.GetAccountRequest{Addr: addr.Hex(), ContextID: s.id})
+	if err != nil {
+		return nil
+	}
+	var account statedb.Account
+	if err := json.Unmarshal(res.Account, &account); err != nil {
+		panic(err)
+	}
+	return &account
+}
+
+func (s *callbackState) GetState(_ sdk.Context, addr common.Address, key common.Hash) common.Hash {
+	res, err := s.k.QueryGetStateStateDB(context.Background(), &types.GetStateRequest{Addr: addr.Hex(), Key: key.Hex(), ContextID: s.id})
+	if err != nil {
+		panic(err)
+	}
+	return common.HexToHash(res.Hash)
+}
+
+func (s *callbackState) GetCode(_ sdk.Context, codeHash common.Hash) []byte {
+	res, err := s.k.QueryGetCodeStateDB(context.Background(), &types.GetCodeRequest{CodeHash: codeHash.Hex(), ContextID: s.id})
+	if err != nil {
+		panic(err)
+	}
+	return res.Code
+}
+
+func (s *callbackState) ForEachStorage(ctx sdk.Context, addr common.Address, cb func(key, value common.Hash) bool) {
+	s.k.ForEachStorage(ctx, addr, cb)
+}
+
+func (s *callbackState) SetAccount(_ sdk.Context, addr common.Address, account statedb.Account) error {
+	bz, err := json.Marshal(account)
+	if err != nil {
+		return err
+	}
+	_, err = s.k.PostSetAccountStateDB(context.Background(), &types.SetAccountRequest{Addr: addr.Hex(), Account: bz, ContextID: s.id})
+	return err
+}
+
+func (s *callbackState) SetState(_ sdk.Context, addr common.Address, key common.Hash, value []byte) {
+	req := &types.SetStateRequest{Addr: addr.Hex(), Key: key.Hex(), Value: value, ContextID: s.id}
+	if _, err := s.k.PostSetStateStateDB(context.Background(), req); err != nil {
+		panic(err)
+	}
+}
+
+func (s *callbackState) SetCode(_ sdk.Context, codeHash, code []byte) {
+	req := &types.SetCodeRequest{CodeHash: codeHash, Code: code, ContextID: s.id}
+	if _, err := s.k.PostSetCodeStateDB(context.Background(), req); err != nil {
+		panic(err)
+	}
+}
+
+func (s *callbackState) DeleteAccount(_ sdk.Context, addr common.Address) error {
+	_, err := s.k.PostDeleteAccountStateDB(context.Background(), &types.DeleteAccountRequest{Addr: addr.Hex(), ContextID: s.id})
+	return err
+}
+
+// commitBalances writes the balance changes made on the scratch state back to
+// the node.
+func (s *callbackState) commitBalances() error {
+	addrs := make([]common.Address, 0, len(s.balances))
+	for addr := range s.balances {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool { return bytes.Compare(addrs[i].Bytes(), addrs[j].Bytes()) < 0 })
+
+	for _, addr := range addrs {
+		cosmosAddr := sdk.AccAddress(addr.Bytes())
+		delta := new(big.Int).Sub(s.k.GetBalance(s.scratch, cosmosAddr, s.denom), s.balances[addr])
+		coins := sdk.Coins{sdk.NewCoin(s.denom, sdkmath.NewIntFromBigInt(new(big.Int).Abs(delta)))}
+		var err error
+		switch delta.Sign() {
+		case 1:
+			_, err = s.k.PostAddBalanceStateDB(context.Background(), &types.AddBalanceRequest{Addr: cosmosAddr.String(), Amount: coins, ContextID: s.id})
+		case -1:
+			_, err = s.k.PostSubBalanceStateDB(context.Background(), &types.SubBalanceRequest{Addr: cosmosAddr.String(), Amount: coins, ContextID: s.id})
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *localEnclave) Handshake(
+	_ context.Context, _ *types.EnclaveHandshakeRequest, _ ...grpc.CallOption,
+) (*types.EnclaveHandshakeResponse, error) {
+	return &types.EnclaveHandshakeResponse{
+		ProtocolVersion:    types.EnclaveProtocolVersion,
+		MinProtocolVersion: types.EnclaveProtocolVersion,
+		EnclaveVersion:     "local",
+	}, nil
+}
+
+func (e *localEnclave) PrepareTx(
+	_ context.Context, req *types.EnclavePrepareTxRequest, _ ...grpc.CallOption,
+) (*types.EnclavePrepareTxResponse, error) {
+	sgxCtx, err := e.k.callbackContext(req.ContextID)
+	if err != nil {
+		return nil, err
+	}
+	ctx, _ := sgxCtx.ctx.CacheContext()
+	evmCfg := req.EVMConfig
+	cfg := &EVMConfig{
+		Params: types.Params{
+			EvmDenom:    evmCfg.EvmDenom,
+			ExtraEIPs:   evmCfg.ExtraEIPs,
+			ChainConfig: evmCfg.ChainConfig,
+		},
+		FeeMarketParams: feemarkettypes.Params{NoBaseFee: evmCfg.NoBaseFee},
+		ChainConfig:     evmCfg.ChainConfig.EthereumConfig(types.EnclaveIntToBig(evmCfg.ChainID)),
+		CoinBase:        common.HexToAddress(evmCfg.Coinbase),
+		BaseFee:         types.EnclaveIntToBig(evmCfg.BaseFee),
+		BlobBaseFee:     types.EnclaveIntToBig(evmCfg.BlobBaseFee),
+		TxConfig: statedb.NewTxConfig(
+			common.HexToHash(evmCfg.TxConfig.BlockHash),
+			common.HexToHash(evmCfg.TxConfig.TxHash),
+			uint(evmCfg.TxConfig.TxIndex),
+			uint(evmCfg.TxConfig.LogIndex),
+		),
+		SetCodeAuthorizations: req.Authorizations,
+	}
+	msg := req.Msg.AsMessage()
+	state := &callbackState{
+		k:        e.k,
+		id:       req.ContextID,
+		denom:    evmCfg.EvmDenom,
+		scratch:  ctx,
+		balances: make(map[common.Address]*big.Int),
+	}
+	stateDB := statedb.NewWithParams(ctx, state, cfg.TxConfig, evmCfg.EvmDenom)
+
+	id := e.newID()
+	e.mu.Lock()
+	e.sessions[id] = &localEnclaveSession{
+		msg:     msg,
+		cfg:     cfg,
+		state:   state,
+		stateDB: stateDB,
+		evm:     e.k.newLocalEVM(ctx, msg, cfg, stateDB),
+	}
+	e.mu.Unlock()
+	return &types.EnclavePrepareTxResponse{SessionID: id}, nil
+}
+
+func (e *localEnclave) StateDBPrepare(
+	_ context.Context, req *types.EnclaveStateDBPrepareRequest, _ ...grpc.CallOption,
+) (*types.EnclaveStateDBPrepareResponse, error) {
+	s, err := e.session(req.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	rules := req.Rules.ToEthereum()
+	s.stateDB.Prepare(rules, s.msg.From, s.cfg.CoinBase, s.msg.To, vm.ActivePrecompiles(rules), s.msg.AccessList)
+	if len(s.cfg.SetCodeAuthorizations) > 0 {
+		applySetCodeAuthorizations(s.stateDB, s.cfg.ChainConfig.ChainID, s.cfg.SetCodeAuthorizations)
+	}
+	return &types.EnclaveStateDBPrepareResponse{}, nil
+}
+
+func (e *localEnclave) StateDBSetNonce(
+	_ context.Context, req *types.EnclaveStateDBSetNonceRequest, _ ...grpc.CallOption,
+) (*types.EnclaveStateDBSetNonceResponse, error) {
+	s, err := e.session(req.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	s.stateDB.SetNonce(common.HexToAddress(req.Caller), req.Nonce)
+	return &types.EnclaveStateDBSetNonceResponse{}, nil
+}
+
+func (e *localEnclave) StateDBSubBalance(
+	_ context.Context, req *types.EnclaveStateDBSubBalanceRequest, _ ...grpc.CallOption,
+) (*types.EnclaveStateDBSubBalanceResponse, error) {
+	s, err := e.session(req.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	msg := req.Msg.AsMessage()
+	s.stateDB.SubBalance(common.HexToAddress(req.Caller), new(big.Int).Mul(msg.GasPrice, new(big.Int).SetUint64(msg.GasLimit)))
+	return &types.EnclaveStateDBSubBalanceResponse{}, nil
+}
+
+func (e *localEnclave) StateDBIncreaseNonce(
+	_ context.Context, req *types.EnclaveStateDBIncreaseNonceRequest, _ ...grpc.CallOption,
+) (*types.EnclaveStateDBIncreaseNonceResponse, error) {
+	s, err := e.session(req.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	caller := common.HexToAddress(req.Caller)
+	s.stateDB.SetNonce(caller, s.stateDB.GetNonce(caller)+1)
+	return &types.EnclaveStateDBIncreaseNonceResponse{}, nil
+}
+
+func (e *localEnclave) StateDBAddBalance(
+	_ context.Context, req *types.EnclaveStateDBAddBalanceRequest, _ ...grpc.CallOption,
+) (*types.EnclaveStateDBAddBalanceResponse, error) {
+	s, err := e.session(req.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	msg := req.Msg.AsMessage()
+	s.stateDB.AddBalance(common.HexToAddress(req.Caller), new(big.Int).Mul(msg.GasPrice, new(big.Int).SetUint64(req.LeftoverGas)))
+	return &types.EnclaveStateDBAddBalanceResponse{}, nil
+}
+
+func (e *localEnclave) Call(
+	_ context.Context, req *types.EnclaveCallRequest, _ ...grpc.CallOption,
+) (*types.EnclaveCallResponse, error) {
+	s, err := e.session(req.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	value := types.EnclaveIntToBig(req.Value)
+	if value == nil {
+		value = new(big.Int)
+	}
+	ret, leftOverGas, vmErr := s.evm.Call(vm.AccountRef(common.HexToAddress(req.Caller)), common.HexToAddress(req.Addr), req.Input, req.Gas, value)
+	res := &types.EnclaveCallResponse{Ret: ret, LeftOverGas: leftOverGas}
+	if vmErr != nil {
+		res.VmError = vmErr.Error()
+	}
+	return res, nil
+}
+
+func (e *localEnclave) Create(
+	_ context.Context, req *types.EnclaveCreateRequest, _ ...grpc.CallOption,
+) (*types.EnclaveCreateResponse, error) {
+	s, err := e.session(req.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	value := types.EnclaveIntToBig(req.Value)
+	if value == nil {
+		value = new(big.Int)
+	}
+	ret, addr, leftOverGas, vmErr := s.evm.Create(vm.AccountRef(common.HexToAddress(req.Caller)), req.Code, req.Gas, value)
+	res := &types.EnclaveCreateResponse{Ret: ret, ContractAddr: addr.Hex(), LeftOverGas: leftOverGas}
+	if vmErr != nil {
+		res.VmError = vmErr.Error()
+	}
+	return res, nil
+}
+
+func (e *localEnclave) StateDBGetRefund(
+	_ context.Context, req *types.EnclaveStateDBGetRefundRequest, _ ...grpc.CallOption,
+) (*types.EnclaveStateDBGetRefundResponse, error) {
+	s, err := e.session(req.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	return &types.EnclaveStateDBGetRefundResponse{Refund: s.stateDB.GetRefund()}, nil
+}
+
+func (e *localEnclave) Commit(
+	_ context.Context, req *types.EnclaveCommitRequest, _ ...grpc.CallOption,
+) (*types.EnclaveCommitResponse, error) {
+	s, err := e.session(req.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	if req.Commit {
+		if err := s.stateDB.Commit(); err != nil {
+			return nil, err
+		}
+		if err := s.state.commitBalances(); err != nil {
+			return nil, err
+		}
+	}
+	return &types.EnclaveCommitResponse{}, nil
+}
+
+func (e *localEnclave) StateDBGetLogs(
+	_ context.Context, req *types.EnclaveStateDBGetLogsRequest, _ ...grpc.CallOption,
+) (*types.EnclaveStateDBGetLogsResponse, error) {
+	s, err := e.session(req.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	return &types.EnclaveStateDBGetLogsResponse{Logs: types.NewLogsFromEth(s.stateDB.Logs())}, nil
+}
+
+func (e *localEnclave) EndSession(
+	_ context.Context, req *types.EnclaveEndSessionRequest, _ ...grpc.CallOption,
+) (*types.EnclaveEndSessionResponse, error) {
+	e.mu.Lock()
+	delete(e.sessions, req.SessionID)
+	e.mu.Unlock()
+	return &types.EnclaveEndSessionResponse{}, nil
+}
+
+func (e *localEnclave) BeginBlock(
+	_ context.Context, _ *types.EnclaveBeginBlockRequest, _ ...grpc.CallOption,
+) (*types.EnclaveBeginBlockResponse, error) {
+	return &types.EnclaveBeginBlockResponse{BlockSessionID: e.newID()}, nil
+}
+
+func (e *localEnclave) EndBlock(
+	_ context.Context, _ *types.EnclaveEndBlockRequest, _ ...grpc.CallOption,
+) (*types.EnclaveEndBlockResponse, error) {
+	// the transactions have written their state back already
+	return &types.EnclaveEndBlockResponse{}, nil
+}
+
+func (e *localEnclave) Ready() bool  { return true }
+func (e *localEnclave) Close() error { return nil }
@@ -47,7 +47,7 @@ func (k *Keeper) EthereumTx(goCtx context.Context, msg *types.MsgEthereumTx) (*t
 	txIndex := k.GetTxIndexTransient(ctx)
 
 	labels := []metrics.Label{
-		telemetry.NewLabel("tx_type", fmt.Sprintf("%d", tx.Type())),
+		telemetry.NewLabel("tx_type", fmt.Sprintf("%d", msg.TxType())),
 	}
 	if tx.To() == nil {
 		labels = append(labels, telemetry.NewLabel("execution", "create"))
@@ -122,7 +122,7 @@ func (k *Keeper) EthereumTx(goCtx context.Context, msg *types.MsgEthereumTx) (*t
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 			sdk.NewAttribute(sdk.AttributeKeySender, types.HexAddress(msg.From)),
-			sdk.NewAttribute(types.AttributeKeyTxType, strconv.Itoa(int(tx.Type()))),
+			sdk.NewAttribute(types.AttributeKeyTxType, strconv.Itoa(int(msg.TxType()))),
 		),
 	})
 
@@ -139,6 +139,12 @@ func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	// forks that are already active can't be rescheduled, and new ones must activate
+	// after the current block
+	current := k.GetParams(ctx).ChainConfig
+	if err := current.CheckCompatible(req.Params.ChainConfig, ctx.BlockHeight(), uint64(ctx.BlockTime().Unix())); err != nil {
+		return nil, err
+	}
 	if err := k.SetParams(ctx, req.Params); err != nil {
 		return nil, err
 	}
@@ -3,6 +3,9 @@ package keeper_test
 import (
 	"math/big"
 	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -96,6 +99,10 @@ func (suite *MsgServerTestSuite) TestEthereumTx() {
 }
 
 func (suite *MsgServerTestSuite) TestUpdateParams() {
+	rescheduled := types.DefaultParams()
+	cancunTime := sdkmath.NewInt(time.Now().Add(time.Hour).Unix())
+	rescheduled.ChainConfig.CancunTime = &cancunTime
+
 	testCases := []struct {
 		name      string
 		request   *types.MsgUpdateParams
@@ -114,6 +121,14 @@ func (suite *MsgServerTestSuite) TestUpdateParams() {
 			},
 			expectErr: false,
 		},
+		{
+			name: "fail - reschedule active fork",
+			request: &types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params:    rescheduled,
+			},
+			expectErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
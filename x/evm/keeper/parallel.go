@@ -0,0 +1,287 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"math/big"
+	"reflect"
+	"sync"
+	"sync/atomic"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// SetParallelExecution sets the number of workers the EVM transactions of the
+// blocks are pre-executed with, pre-execution is disabled with less than two.
+//
+// The transactions of a block are pre-executed concurrently on the consensus
+// SGX executors when the first one is applied, each on its own branch of the
+// state, recording the StateDB accesses of its enclave session. They are still
+// applied in order by ApplyTransaction, which replays the accesses of a
+// pre-executed transaction instead of executing it if all its reads return the
+// recorded values: the enclave would have made the same accesses, so the
+// results are identical to the sequential execution. The transactions
+// conflicting with the previous ones are executed again.
+//
+// Unlike Block-STM, the transactions aren't executed against multi-version
+// views of the stores: the enclave reads the state through the StateDB
+// callbacks, so the versions are checked on the accesses recorded by the
+// statedb.AccessJournal rather than on the cachekv stores.
+//
+// It requires executors speaking the enclave protocol version 6, and is
+// disabled in shadow execution mode and when the internal transactions are
+// traced, which both run the in-process EVM on the state of the execution.
+func (k *Keeper) SetParallelExecution(workers int) *Keeper {
+	k.parallelWorkers = workers
+	return k
+}
+
+// parallelExecution reports if the transactions of the blocks are pre-executed.
+func (k *Keeper) parallelExecution() bool {
+	return k.parallelWorkers >= 2 && !k.shadowExecution && !k.internalTxTracing
+}
+
+// blockPreExecution holds the EVM transactions of the block being finalized and
+// the results of their pre-execution.
+type blockPreExecution struct {
+	height int64
+	msgs   []*types.MsgEthereumTx
+	// results holds the pre-executed transactions by hash, it's set once the
+	// transactions are pre-executed.
+	results map[common.Hash]*preExecutedTx
+	// reused is the number of pre-executions applied so far.
+	reused int
+}
+
+// preExecutedTx is the outcome of the pre-execution of a transaction, its
+// config records the StateDB accesses of the enclave session.
+type preExecutedTx struct {
+	cfg EVMConfig
+	res *types.MsgEthereumTxResponse
+}
+
+// QueueBlockTxs records the EVM transactions of the block being finalized, they
+// are pre-executed when the first one is applied. It's called by the PreBlocker
+// of the app, and is a no-op when parallel execution is disabled.
+func (k *Keeper) QueueBlockTxs(ctx sdk.Context, decoder sdk.TxDecoder, txs [][]byte) {
+	k.preExecution = nil
+	if !k.parallelExecution() {
+		return
+	}
+
+	var msgs []*types.MsgEthereumTx
+	for _, bz := range txs {
+		// the invalid transactions are rejected by the ante handlers
+		tx, err := decoder(bz)
+		if err != nil {
+			continue
+		}
+		for _, msg := range tx.GetMsgs() {
+			if msgEth, ok := msg.(*types.MsgEthereumTx); ok {
+				msgs = append(msgs, msgEth)
+			}
+		}
+	}
+	if len(msgs) > 1 {
+		k.preExecution = &blockPreExecution{height: ctx.BlockHeight(), msgs: msgs}
+	}
+}
+
+// preExecuteBlock pre-executes the queued transactions of the block on top of
+// ctx, but the one being applied with cfg. It's a no-op but for the first
+// transaction of the block.
+func (k *Keeper) preExecuteBlock(ctx sdk.Context, cfg *EVMConfig) {
+	pre := k.preExecution
+	if pre == nil || pre.results != nil || pre.height != ctx.BlockHeight() || ctx.ExecMode() != sdk.ExecModeFinalize {
+		return
+	}
+	msgs := pre.msgs
+	pre.msgs = nil
+	pre.results = make(map[common.Hash]*preExecutedTx, len(msgs))
+
+	logger := k.Logger(ctx)
+	client, release, err := k.sgxExecutors.acquire(logger, false)
+	if err != nil {
+		return
+	}
+	version := client.executor.protocolVersion()
+	release()
+	if version < types.EnclaveContextVersion {
+		logger.Debug("SGX executor doesn't support pre-execution", "address", client.executor.addr, "version", version)
+		return
+	}
+
+	results := make([]*preExecutedTx, len(msgs))
+	var (
+		wg   sync.WaitGroup
+		next atomic.Int64
+	)
+	for w := 0; w < k.parallelWorkers && w < len(msgs); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := int(next.Add(1)) - 1; i < len(msgs); i = int(next.Add(1)) - 1 {
+				results[i] = k.preExecuteTx(ctx, cfg, msgs[i])
+			}
+		}()
+	}
+	wg.Wait()
+
+	for _, tx := range results {
+		if tx != nil {
+			pre.results[tx.cfg.TxConfig.TxHash] = tx
+		}
+	}
+	telemetry.IncrCounter(float32(len(pre.results)), types.ModuleName, "parallel", "pre_executions")
+}
+
+// preExecuteTx executes the transaction on a branch of ctx, after the changes
+// of the ante handler it depends on. It returns nil if the transaction is the
+// one being applied with blockCfg or if it fails, it's then executed when it's
+// applied.
+func (k *Keeper) preExecuteTx(ctx sdk.Context, blockCfg *EVMConfig, msgEth *types.MsgEthereumTx) *preExecutedTx {
+	txHash := msgEth.ComputeHash()
+	if txHash == blockCfg.TxConfig.TxHash {
+		return nil
+	}
+
+	ctx, _ = ctx.CacheContext()
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()).WithEventManager(sdk.NewEventManager())
+	logger := k.Logger(ctx)
+
+	cfg := *blockCfg
+	// the indexes of the transaction and its logs are set when it's applied
+	cfg.TxConfig = statedb.NewTxConfig(blockCfg.TxConfig.BlockHash, txHash, 0, 0)
+	cfg.SetCodeAuthorizations = msgEth.GetSetCodeAuthorizations()
+	cfg.FeePayment = nil
+	cfg.InternalTxTracer = nil
+	cfg.journal = statedb.NewAccessJournal()
+
+	if err := k.preExecutionAnte(ctx, msgEth, &cfg); err != nil {
+		logger.Debug("failed to pre-execute transaction", "hash", txHash, "error", err)
+		return nil
+	}
+	msg, err := msgEth.AsMessage(cfg.BaseFee)
+	if err != nil {
+		return nil
+	}
+	res, err := k.ApplyMessageWithConfig(ctx, msg, &cfg, true)
+	if err != nil {
+		logger.Debug("failed to pre-execute transaction", "hash", txHash, "error", err)
+		return nil
+	}
+	return &preExecutedTx{cfg: cfg, res: res}
+}
+
+// preExecutionAnte applies the changes of the ante handler the execution of the
+// transaction depends on: the increment of the sender nonce and the deduction
+// of the fee. A wrong guess, like a fee paid with another token, only causes
+// the transaction to be executed again.
+func (k *Keeper) preExecutionAnte(ctx sdk.Context, msgEth *types.MsgEthereumTx, cfg *EVMConfig) error {
+	txData, err := types.UnpackTxData(msgEth.Data)
+	if err != nil {
+		return err
+	}
+
+	acc := k.accountKeeper.GetAccount(ctx, msgEth.GetFrom())
+	if acc == nil {
+		return errorsmod.Wrapf(errortypes.ErrUnknownAddress, "account %s is nil", common.BytesToAddress(msgEth.From))
+	}
+	if err := acc.SetSequence(acc.GetSequence() + 1); err != nil {
+		return err
+	}
+	k.accountKeeper.SetAccount(ctx, acc)
+
+	height := big.NewInt(ctx.BlockHeight())
+	fees, err := VerifyFee(txData, cfg.Params.EvmDenom, cfg.BaseFee,
+		cfg.ChainConfig.IsHomestead(height),
+		cfg.ChainConfig.IsIstanbul(height),
+		cfg.ChainConfig.IsShanghai(height, uint64(ctx.BlockTime().Unix())),
+		false,
+	)
+	if err != nil {
+		return err
+	}
+	return k.DeductTxCostsFromUserBalance(ctx, fees, common.BytesToAddress(msgEth.From))
+}
+
+// applyPreExecuted applies the result of the pre-execution of the transaction,
+// replaying the StateDB accesses of its enclave session on ctx. It returns nil
+// if the transaction wasn't pre-executed or if one of the reads returns another
+// value than during the pre-execution, the transaction must then be executed.
+func (k *Keeper) applyPreExecuted(ctx sdk.Context, cfg *EVMConfig) *types.MsgEthereumTxResponse {
+	pre := k.preExecution
+	if pre == nil || pre.height != ctx.BlockHeight() || ctx.ExecMode() != sdk.ExecModeFinalize {
+		return nil
+	}
+	tx, found := pre.results[cfg.TxConfig.TxHash]
+	if !found {
+		return nil
+	}
+	delete(pre.results, cfg.TxConfig.TxHash)
+
+	if !tx.matches(cfg) {
+		telemetry.IncrCounter(1, types.ModuleName, "parallel", "reexecutions")
+		return nil
+	}
+	replayCtx, write := ctx.CacheContext()
+	valid, err := tx.cfg.journal.Replay(replayCtx, k)
+	if err != nil {
+		k.Logger(ctx).Debug("failed to replay pre-executed transaction", "hash", cfg.TxConfig.TxHash, "error", err)
+	}
+	if !valid || err != nil {
+		telemetry.IncrCounter(1, types.ModuleName, "parallel", "reexecutions")
+		return nil
+	}
+	write()
+	pre.reused++
+
+	res := tx.res
+	for i, log := range res.Logs {
+		log.TxIndex = uint64(cfg.TxConfig.TxIndex)
+		log.Index = uint64(cfg.TxConfig.LogIndex) + uint64(i)
+	}
+	return res
+}
+
+// matches reports if the transaction was pre-executed with the same
+// configuration as cfg, the module parameters may have been updated by the
+// previous transactions of the block. The transactions paying their fee with
+// another token are always executed again, the pre-execution deducting the fee
+// in the evm denom.
+func (tx *preExecutedTx) matches(cfg *EVMConfig) bool {
+	return cfg.FeePayment == nil && tx.cfg.FeePayment == nil &&
+		reflect.DeepEqual(tx.cfg.Params, cfg.Params) &&
+		tx.cfg.FeeMarketParams.NoBaseFee == cfg.FeeMarketParams.NoBaseFee &&
+		tx.cfg.CoinBase == cfg.CoinBase &&
+		equalBigInt(tx.cfg.BaseFee, cfg.BaseFee) &&
+		equalBigInt(tx.cfg.BlobBaseFee, cfg.BlobBaseFee)
+}
+
+func equalBigInt(a, b *big.Int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Cmp(b) == 0
+}
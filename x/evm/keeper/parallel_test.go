@@ -0,0 +1,261 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/evmos/ethermint/testutil"
+	"github.com/evmos/ethermint/x/evm/keeper"
+	"github.com/evmos/ethermint/x/evm/types"
+	feemarkettypes "github.com/evmos/ethermint/x/feemarket/types"
+)
+
+const parallelAccounts = 8
+
+type ParallelTestSuite struct {
+	testutil.BaseTestSuiteWithAccount
+
+	accounts []common.Address
+	token    common.Address
+	nonces   map[common.Address]uint64
+	gasPrice *big.Int
+}
+
+// txResult is the outcome of a transaction applied by applyBlock.
+type txResult struct {
+	Response *types.MsgEthereumTxResponse
+	// Err is set when the transaction couldn't be applied, its state changes
+	// are then discarded.
+	Err error
+}
+
+func TestParallelTestSuite(t *testing.T) {
+	suite.Run(t, new(ParallelTestSuite))
+}
+
+func (suite *ParallelTestSuite) SetupTest() {
+	suite.BaseTestSuiteWithAccount.SetupTest(suite.T())
+	suite.App.EvmKeeper.UseLocalEnclave().SetParallelExecution(0)
+	suite.Ctx = suite.Ctx.WithExecMode(sdk.ExecModeFinalize)
+	k := suite.App.EvmKeeper
+	suite.gasPrice = new(big.Int).Mul(k.GetBaseFee(suite.Ctx, k.GetParams(suite.Ctx).ChainConfig.EthereumConfig(k.ChainID())), big.NewInt(2))
+
+	denom := suite.App.EvmKeeper.GetParams(suite.Ctx).EvmDenom
+	coins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewIntWithDecimal(100, 18)))
+	// the fee collector pays the gas refunds
+	suite.Require().NoError(suite.App.BankKeeper.MintCoins(suite.Ctx, types.ModuleName, coins))
+	suite.Require().NoError(suite.App.BankKeeper.SendCoinsFromModuleToModule(suite.Ctx, types.ModuleName, authtypes.FeeCollectorName, coins))
+
+	suite.accounts = make([]common.Address, parallelAccounts)
+	suite.nonces = make(map[common.Address]uint64)
+	for i := range suite.accounts {
+		suite.accounts[i] = common.BigToAddress(big.NewInt(int64(0x1000 + i)))
+		suite.Require().NoError(testutil.FundAccount(suite.App.BankKeeper, suite.Ctx, suite.accounts[i].Bytes(), coins))
+	}
+
+	// deploy the token and distribute it to all the accounts
+	owner := suite.accounts[0]
+	ctorArgs, err := types.ERC20Contract.ABI.Pack("", owner, big.NewInt(1_000_000))
+	suite.Require().NoError(err)
+	deploy := types.NewTxContract(suite.App.EvmKeeper.ChainID(), suite.nonces[owner], big.NewInt(0), 2_000_000, suite.gasPrice, nil, nil,
+		append(types.ERC20Contract.Bin, ctorArgs...), nil)
+	suite.token = crypto.CreateAddress(owner, suite.nonces[owner])
+	msgs := []*types.MsgEthereumTx{suite.sign(owner, deploy)}
+	for _, to := range suite.accounts[1:] {
+		msgs = append(msgs, suite.tokenTransfer(owner, to, big.NewInt(100_000)))
+	}
+	for _, res := range suite.applyBlock(suite.Ctx, msgs) {
+		suite.Require().NoError(res.Err)
+		suite.Require().False(res.Response.Failed(), res.Response.VmError)
+	}
+}
+
+// sign sets the sender of the transaction and increments its nonce, the
+// signature isn't checked by applyBlock.
+func (suite *ParallelTestSuite) sign(from common.Address, msg *types.MsgEthereumTx) *types.MsgEthereumTx {
+	msg.From = from.Bytes()
+	suite.nonces[from]++
+	return msg
+}
+
+func (suite *ParallelTestSuite) transfer(from, to common.Address, amount *big.Int) *types.MsgEthereumTx {
+	msg := types.NewTx(suite.App.EvmKeeper.ChainID(), suite.nonces[from], &to, amount, 50_000, suite.gasPrice, nil, nil, nil, nil)
+	return suite.sign(from, msg)
+}
+
+func (suite *ParallelTestSuite) tokenTransfer(from, to common.Address, amount *big.Int) *types.MsgEthereumTx {
+	input, err := types.ERC20Contract.ABI.Pack("transfer", to, amount)
+	suite.Require().NoError(err)
+	msg := types.NewTx(suite.App.EvmKeeper.ChainID(), suite.nonces[from], &suite.token, big.NewInt(0), 100_000, suite.gasPrice, nil, nil, input, nil)
+	return suite.sign(from, msg)
+}
+
+// ante applies the changes the ante handler makes before the transaction is
+// executed: the nonce check and increment, and the deduction of the fee.
+func (suite *ParallelTestSuite) ante(ctx sdk.Context, msg *types.MsgEthereumTx) error {
+	acc := suite.App.AccountKeeper.GetAccount(ctx, msg.GetFrom())
+	if acc.GetSequence() != msg.AsTransaction().Nonce() {
+		return fmt.Errorf("invalid nonce %d, expected %d", msg.AsTransaction().Nonce(), acc.GetSequence())
+	}
+	suite.Require().NoError(acc.SetSequence(acc.GetSequence() + 1))
+	suite.App.AccountKeeper.SetAccount(ctx, acc)
+
+	fee := new(big.Int).Mul(msg.AsTransaction().GasPrice(), new(big.Int).SetUint64(msg.GetGas()))
+	denom := suite.App.EvmKeeper.GetParams(ctx).EvmDenom
+	fees := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(fee)))
+	return suite.App.EvmKeeper.DeductTxCostsFromUserBalance(ctx, fees, common.BytesToAddress(msg.GetFrom()))
+}
+
+// applyBlock applies the transactions like the block execution: they are
+// queued by the PreBlocker, then each one runs the ante handler and
+// ApplyTransaction on its own branch, which is kept if it succeeds.
+func (suite *ParallelTestSuite) applyBlock(ctx sdk.Context, msgs []*types.MsgEthereumTx) []txResult {
+	k := suite.App.EvmKeeper
+	txConfig := suite.App.TxConfig()
+	denom := k.GetParams(ctx).EvmDenom
+	txs := make([][]byte, len(msgs))
+	for i, msg := range msgs {
+		tx, err := msg.BuildTx(txConfig.NewTxBuilder(), denom)
+		suite.Require().NoError(err)
+		txs[i], err = txConfig.TxEncoder()(tx)
+		suite.Require().NoError(err)
+	}
+	k.QueueBlockTxs(ctx, txConfig.TxDecoder(), txs)
+
+	results := make([]txResult, len(msgs))
+	for i, msg := range msgs {
+		txCtx, write := ctx.CacheContext()
+		if results[i].Err = suite.ante(txCtx, msg); results[i].Err != nil {
+			continue
+		}
+		results[i].Response, results[i].Err = k.ApplyTransaction(txCtx, msg)
+		if results[i].Err == nil {
+			write()
+		}
+	}
+	return results
+}
+
+// randomWorkload returns native and token transfers between a few accounts,
+// some of them exceeding the balance of the sender.
+func (suite *ParallelTestSuite) randomWorkload(r *rand.Rand, n int) []*types.MsgEthereumTx {
+	msgs := make([]*types.MsgEthereumTx, n)
+	for i := range msgs {
+		from := suite.accounts[r.Intn(len(suite.accounts))]
+		to := suite.accounts[r.Intn(len(suite.accounts))]
+		if r.Intn(2) == 0 {
+			msgs[i] = suite.transfer(from, to, new(big.Int).Mul(big.NewInt(r.Int63n(200)), big.NewInt(1e18)))
+		} else {
+			msgs[i] = suite.tokenTransfer(from, to, big.NewInt(r.Int63n(200_000)))
+		}
+	}
+	return msgs
+}
+
+// dumpStores returns the content of all the stores the keeper has access to.
+func (suite *ParallelTestSuite) dumpStores(ctx sdk.Context) map[string]string {
+	dump := make(map[string]string)
+	for name, key := range suite.App.EvmKeeper.StoreKeys() {
+		it := ctx.MultiStore().GetKVStore(key).Iterator(nil, nil)
+		for ; it.Valid(); it.Next() {
+			dump[fmt.Sprintf("%s/%x", name, it.Key())] = fmt.Sprintf("%x", it.Value())
+		}
+		suite.Require().NoError(it.Close())
+	}
+	return dump
+}
+
+func (suite *ParallelTestSuite) TestParallelExecutionMatchesSequential() {
+	reused := 0
+	for seed := int64(0); seed < 5; seed++ {
+		r := rand.New(rand.NewSource(seed))
+		msgs := suite.randomWorkload(r, 10+r.Intn(30))
+
+		suite.App.EvmKeeper.SetParallelExecution(0)
+		seqCtx, _ := suite.Ctx.CacheContext()
+		expected := suite.applyBlock(seqCtx, msgs)
+
+		for _, workers := range []int{2, 8} {
+			suite.App.EvmKeeper.SetParallelExecution(workers)
+			ctx, _ := suite.Ctx.CacheContext()
+			results := suite.applyBlock(ctx, msgs)
+			reused += suite.App.EvmKeeper.PreExecutionsReused()
+
+			for i := range msgs {
+				suite.Require().Equal(expected[i].Err != nil, results[i].Err != nil, "seed %d, workers %d, tx %d", seed, workers, i)
+				suite.Require().Equal(expected[i].Response, results[i].Response, "seed %d, workers %d, tx %d", seed, workers, i)
+			}
+			suite.Require().Equal(suite.dumpStores(seqCtx), suite.dumpStores(ctx), "seed %d, workers %d", seed, workers)
+			suite.Require().Equal(suite.App.EvmKeeper.GetBlockBloomTransient(seqCtx), suite.App.EvmKeeper.GetBlockBloomTransient(ctx))
+			suite.Require().Equal(suite.App.EvmKeeper.GetLogSizeTransient(seqCtx), suite.App.EvmKeeper.GetLogSizeTransient(ctx))
+			suite.Require().Equal(suite.App.EvmKeeper.GetTxIndexTransient(seqCtx), suite.App.EvmKeeper.GetTxIndexTransient(ctx))
+			suite.Require().Equal(suite.App.FeeMarketKeeper.GetTransientBlockFees(seqCtx), suite.App.FeeMarketKeeper.GetTransientBlockFees(ctx))
+		}
+		suite.Require().False(suite.App.FeeMarketKeeper.GetTransientBlockFees(seqCtx).BaseFees.IsZero())
+	}
+	// the transactions not conflicting with the previous ones aren't executed again
+	suite.Require().Positive(reused)
+}
+
+func (suite *ParallelTestSuite) TestParallelExecutionContractDeployments() {
+	// contracts deployed and called within the same block
+	var msgs []*types.MsgEthereumTx
+	for _, from := range suite.accounts {
+		ctorArgs, err := types.ERC20Contract.ABI.Pack("", from, big.NewInt(1000))
+		suite.Require().NoError(err)
+		contract := crypto.CreateAddress(from, suite.nonces[from])
+		msgs = append(msgs, suite.sign(from, types.NewTxContract(suite.App.EvmKeeper.ChainID(), suite.nonces[from], big.NewInt(0), 2_000_000,
+			suite.gasPrice, nil, nil, append(types.ERC20Contract.Bin, ctorArgs...), nil)))
+
+		input, err := types.ERC20Contract.ABI.Pack("transfer", suite.accounts[0], big.NewInt(10))
+		suite.Require().NoError(err)
+		msgs = append(msgs, suite.sign(from, types.NewTx(suite.App.EvmKeeper.ChainID(), suite.nonces[from], &contract, big.NewInt(0), 100_000,
+			suite.gasPrice, nil, nil, input, nil)))
+	}
+
+	seqCtx, _ := suite.Ctx.CacheContext()
+	expected := suite.applyBlock(seqCtx, msgs)
+
+	suite.App.EvmKeeper.SetParallelExecution(4)
+	ctx, _ := suite.Ctx.CacheContext()
+	results := suite.applyBlock(ctx, msgs)
+
+	suite.Require().Equal(expected, results)
+	suite.Require().Positive(suite.App.EvmKeeper.PreExecutionsReused())
+	logs := suite.App.EvmKeeper.GetLogSizeTransient(suite.Ctx)
+	for i, res := range results {
+		suite.Require().NoError(res.Err)
+		suite.Require().False(res.Response.Failed(), res.Response.VmError)
+		for _, log := range res.Response.Logs {
+			suite.Require().Equal(logs, log.Index, "tx %d", i)
+			logs++
+		}
+	}
+	suite.Require().Equal(suite.dumpStores(seqCtx), suite.dumpStores(ctx))
+	suite.Require().Equal(logs, suite.App.EvmKeeper.GetLogSizeTransient(ctx))
+}
+
+func TestPreExecutedTxMatchesFeePayment(t *testing.T) {
+	cfg := keeper.EVMConfig{
+		Params:  types.DefaultParams(),
+		BaseFee: big.NewInt(10),
+	}
+	require.True(t, keeper.PreExecutedTxMatches(cfg, cfg))
+
+	// the pre-execution deducted the fee in the evm denom, a transaction paying
+	// with a fee token is executed again
+	feeToken := cfg
+	payment := feemarkettypes.NewFeePayment("ufee", sdkmath.LegacyNewDecWithPrec(25, 2))
+	feeToken.FeePayment = &payment
+	require.False(t, keeper.PreExecutedTxMatches(cfg, feeToken))
+}
@@ -1,10 +1,12 @@
 package keeper
 
 import (
+	"context"
 	"fmt"
 	"math/big"
-	"net/rpc"
+	"time"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/ethereum/go-ethereum/common"
@@ -13,105 +15,290 @@ import (
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/evmos/ethermint/x/evm/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// sgxRPCClient is an open session with one of the SGX executors of the
+// sgxExecutorPool.
 type sgxRPCClient struct {
-	logger log.Logger
-	cl     *rpc.Client
-}
+	logger   log.Logger
+	client   types.EnclaveClient
+	executor *sgxExecutor
+	now      func() time.Time
 
-// newSgxRPCClient creates a new RPC client to communicate with the SGX binary.
-func newSgxRPCClient(logger log.Logger) (*sgxRPCClient, error) {
-	// TODO Make ports configurable
-	cl, err := rpc.DialHTTP("tcp", "localhost"+":9092")
-	if err != nil {
-		return nil, err
-	}
-
-	return &sgxRPCClient{
-		logger: logger,
-		cl:     cl,
-	}, nil
+	// session is the id of the session opened by PrepareTx, zero until then.
+	session uint64
+	// blockSession is the id of the block session the transaction sessions
+	// join, zero for standalone sessions.
+	blockSession uint64
+	// onFailure is called on transport failures, if set.
+	onFailure func()
 }
 
-func (c *sgxRPCClient) doCall(method string, args, reply any) error {
+func (c *sgxRPCClient) doCall(method string, args any, call func(ctx context.Context) (any, error)) error {
 	c.logger.Debug(fmt.Sprintf("RPC call %s", method), "args", args)
-	err := c.cl.Call(method, args, reply)
+	reply, err := call(context.Background())
 	c.logger.Debug(fmt.Sprintf("RPC call %s", method), "reply", reply)
+
+	// errors returned by the executor itself don't affect its health, only
+	// transport failures do. EVM errors are part of the replies.
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		c.executor.markFailure(c.now())
+		c.executor.disconnect()
+		if c.onFailure != nil {
+			c.onFailure()
+		}
+		// the message may have been executed by the executors of the other
+		// nodes, it must not be mistaken for a failed message.
+		return errorsmod.Wrapf(types.ErrSgxExecutor, "%s on %s: %s", method, c.executor.addr, err)
+	default:
+		c.executor.markSuccess()
+	}
 	return err
 }
 
 func (c *sgxRPCClient) PrepareTx(args PrepareTxArgs, reply *PrepareTxReply) error {
-	return c.doCall("SgxRpcServer.PrepareTx", args, reply)
+	req := &types.EnclavePrepareTxRequest{
+		TxHash:         args.TxHash,
+		Header:         args.Header,
+		Msg:            types.NewEnclaveMessage(args.Msg),
+		BlockSessionID: c.blockSession,
+		Authorizations: args.Authorizations,
+		ContextID:      args.ContextID,
+		EVMConfig: types.EnclaveEVMConfig{
+			ChainConfig: args.EvmConfig.ChainConfig,
+			ChainID:     types.NewEnclaveInt(args.EvmConfig.ChainID),
+			Coinbase:    args.EvmConfig.CoinBase.Hex(),
+			BaseFee:     types.NewEnclaveInt(args.EvmConfig.BaseFee),
+			BlobBaseFee: types.NewEnclaveInt(args.EvmConfig.BlobBaseFee),
+			TxConfig: types.EnclaveTxConfig{
+				BlockHash: args.EvmConfig.TxConfig.BlockHash.Hex(),
+				TxHash:    args.EvmConfig.TxConfig.TxHash.Hex(),
+				TxIndex:   uint64(args.EvmConfig.TxConfig.TxIndex),
+				LogIndex:  uint64(args.EvmConfig.TxConfig.LogIndex),
+			},
+			DebugTrace: args.EvmConfig.DebugTrace,
+			NoBaseFee:  args.EvmConfig.NoBaseFee,
+			EvmDenom:   args.EvmConfig.EvmDenom,
+			ExtraEIPs:  args.EvmConfig.ExtraEips,
+			Overrides:  args.EvmConfig.Overrides,
+		},
+	}
+	var res *types.EnclavePrepareTxResponse
+	err := c.doCall("PrepareTx", req, func(ctx context.Context) (reply any, err error) {
+		res, err = c.client.PrepareTx(ctx, req)
+		return res, err
+	})
+	if err != nil {
+		return err
+	}
+	c.session = res.SessionID
+	return nil
 }
 
 func (c *sgxRPCClient) Call(args CallArgs, reply *CallReply) error {
-	return c.doCall("SgxRpcServer.Call", args, reply)
+	req := &types.EnclaveCallRequest{
+		SessionID: c.session,
+		Caller:    args.Caller.Address().Hex(),
+		Addr:      args.Addr.Hex(),
+		Input:     args.Input,
+		Gas:       args.Gas,
+		Value:     types.NewEnclaveInt(args.Value),
+	}
+	var res *types.EnclaveCallResponse
+	err := c.doCall("Call", req, func(ctx context.Context) (reply any, err error) {
+		res, err = c.client.Call(ctx, req)
+		return res, err
+	})
+	if err != nil {
+		return err
+	}
+	reply.Ret = res.Ret
+	reply.LeftOverGas = res.LeftOverGas
+	reply.VmErr = types.NewEnclaveVMError(res.VmError)
+	return nil
 }
 
 func (c *sgxRPCClient) Create(args CreateArgs, reply *CreateReply) error {
-	return c.doCall("SgxRpcServer.Create", args, reply)
+	req := &types.EnclaveCreateRequest{
+		SessionID: c.session,
+		Caller:    args.Caller.Address().Hex(),
+		Code:      args.Code,
+		Gas:       args.Gas,
+		Value:     types.NewEnclaveInt(args.Value),
+	}
+	var res *types.EnclaveCreateResponse
+	err := c.doCall("Create", req, func(ctx context.Context) (reply any, err error) {
+		res, err = c.client.Create(ctx, req)
+		return res, err
+	})
+	if err != nil {
+		return err
+	}
+	reply.Ret = res.Ret
+	reply.ContractAddr = common.HexToAddress(res.ContractAddr)
+	reply.LeftOverGas = res.LeftOverGas
+	reply.VmErr = types.NewEnclaveVMError(res.VmError)
+	return nil
 }
 
 func (c *sgxRPCClient) Commit(args CommitArgs, reply *CommitReply) error {
-	return c.doCall("SgxRpcServer.Commit", args, reply)
+	req := &types.EnclaveCommitRequest{SessionID: c.session, Commit: args.Commit}
+	return c.doCall("Commit", req, func(ctx context.Context) (any, error) {
+		return c.client.Commit(ctx, req)
+	})
 }
 
 func (c *sgxRPCClient) StateDBAddBalance(args StateDBAddBalanceArgs, reply *StateDBAddBalanceReply) error {
-	return c.doCall("SgxRpcServer.StateDBAddBalance", args, reply)
+	req := &types.EnclaveStateDBAddBalanceRequest{
+		SessionID:   c.session,
+		Caller:      args.Caller.Address().Hex(),
+		Msg:         types.NewEnclaveMessage(args.Msg),
+		LeftoverGas: args.LeftoverGas,
+	}
+	return c.doCall("StateDBAddBalance", req, func(ctx context.Context) (any, error) {
+		return c.client.StateDBAddBalance(ctx, req)
+	})
 }
 
 func (c *sgxRPCClient) StateDBSubBalance(args StateDBSubBalanceArgs, reply *StateDBSubBalanceReply) error {
-	return c.doCall("SgxRpcServer.StateDBSubBalance", args, reply)
+	req := &types.EnclaveStateDBSubBalanceRequest{
+		SessionID: c.session,
+		Caller:    args.Caller.Address().Hex(),
+		Msg:       types.NewEnclaveMessage(args.Msg),
+	}
+	return c.doCall("StateDBSubBalance", req, func(ctx context.Context) (any, error) {
+		return c.client.StateDBSubBalance(ctx, req)
+	})
 }
 
 func (c *sgxRPCClient) StateDBSetNonce(args StateDBSetNonceArgs, reply *StateDBSetNonceReply) error {
-	return c.doCall("SgxRpcServer.StateDBSetNonce", args, reply)
+	req := &types.EnclaveStateDBSetNonceRequest{
+		SessionID: c.session,
+		Caller:    args.Caller.Address().Hex(),
+		Nonce:     args.Nonce,
+	}
+	return c.doCall("StateDBSetNonce", req, func(ctx context.Context) (any, error) {
+		return c.client.StateDBSetNonce(ctx, req)
+	})
 }
 
 func (c *sgxRPCClient) StateDBIncreaseNonce(args StateDBIncreaseNonceArgs, reply *StateDBIncreaseNonceReply) error {
-	return c.doCall("SgxRpcServer.StateDBIncreaseNonce", args, reply)
+	req := &types.EnclaveStateDBIncreaseNonceRequest{
+		SessionID: c.session,
+		Caller:    args.Caller.Address().Hex(),
+		Msg:       types.NewEnclaveMessage(args.Msg),
+	}
+	return c.doCall("StateDBIncreaseNonce", req, func(ctx context.Context) (any, error) {
+		return c.client.StateDBIncreaseNonce(ctx, req)
+	})
 }
 
 func (c *sgxRPCClient) StateDBPrepare(args StateDBPrepareArgs, reply *StateDBPrepareReply) error {
-	return c.doCall("SgxRpcServer.StateDBPrepare", args, reply)
+	req := &types.EnclaveStateDBPrepareRequest{
+		SessionID: c.session,
+		Msg:       types.NewEnclaveMessage(args.Msg),
+		Rules:     types.NewEnclaveRules(args.Rules),
+	}
+	return c.doCall("StateDBPrepare", req, func(ctx context.Context) (any, error) {
+		return c.client.StateDBPrepare(ctx, req)
+	})
 }
 
 func (c *sgxRPCClient) StateDBGetRefund(args StateDBGetRefundArgs, reply *StateDBGetRefundReply) error {
-	return c.doCall("SgxRpcServer.StateDBGetRefund", args, reply)
+	req := &types.EnclaveStateDBGetRefundRequest{SessionID: c.session}
+	var res *types.EnclaveStateDBGetRefundResponse
+	err := c.doCall("StateDBGetRefund", req, func(ctx context.Context) (reply any, err error) {
+		res, err = c.client.StateDBGetRefund(ctx, req)
+		return res, err
+	})
+	if err != nil {
+		return err
+	}
+	reply.Refund = res.Refund
+	return nil
 }
 
 func (c *sgxRPCClient) StateDBGetLogs(args StateDBGetLogsArgs, reply *StateDBGetLogsReply) error {
-	return c.doCall("SgxRpcServer.StateDBGetLogs", args, reply)
+	req := &types.EnclaveStateDBGetLogsRequest{SessionID: c.session}
+	var res *types.EnclaveStateDBGetLogsResponse
+	err := c.doCall("StateDBGetLogs", req, func(ctx context.Context) (reply any, err error) {
+		res, err = c.client.StateDBGetLogs(ctx, req)
+		return res, err
+	})
+	if err != nil {
+		return err
+	}
+	reply.Logs = types.LogsToEthereum(res.Logs)
+	return nil
+}
+
+// EndSession releases the session on the executor, it's a no-op if no session
+// was opened.
+func (c *sgxRPCClient) EndSession() error {
+	if c.session == 0 {
+		return nil
+	}
+	req := &types.EnclaveEndSessionRequest{SessionID: c.session}
+	err := c.doCall("EndSession", req, func(ctx context.Context) (any, error) {
+		return c.client.EndSession(ctx, req)
+	})
+	c.session = 0
+	return err
+}
+
+// BeginBlock opens a block session on the executor and returns its id.
+func (c *sgxRPCClient) BeginBlock(header cmtproto.Header) (uint64, error) {
+	req := &types.EnclaveBeginBlockRequest{Header: header}
+	var res *types.EnclaveBeginBlockResponse
+	err := c.doCall("BeginBlock", req, func(ctx context.Context) (reply any, err error) {
+		res, err = c.client.BeginBlock(ctx, req)
+		return res, err
+	})
+	if err != nil {
+		return 0, err
+	}
+	return res.BlockSessionID, nil
+}
+
+// EndBlock closes the block session.
+func (c *sgxRPCClient) EndBlock(blockSession uint64, commit bool) error {
+	req := &types.EnclaveEndBlockRequest{BlockSessionID: blockSession, Commit: commit}
+	return c.doCall("EndBlock", req, func(ctx context.Context) (any, error) {
+		return c.client.EndBlock(ctx, req)
+	})
 }
 
 // PrepareTxEVMConfig only contains the fields from EVMConfig that are needed
 // to create a new EVM instance. This is used to pass the EVM configuration
-// over RPC to the SGX binary.
+// to the SGX binary.
 type PrepareTxEVMConfig struct {
-	// ChainConfig is the EVM chain configuration in JSON format. Since the
-	// underlying params.ChainConfig struct contains pointer fields, they are
-	// not serializable over RPC with gob. Instead, the JSON representation is
-	// used.
-	ChainConfigJson []byte
+	// ChainConfig is the EVM chain configuration from the module parameters,
+	// the enclave derives the params.ChainConfig from it and ChainID.
+	ChainConfig types.ChainConfig
+	ChainID     *big.Int
 
 	// Fields from EVMConfig
-	CoinBase   common.Address
-	BaseFee    *big.Int
-	TxConfig   statedb.TxConfig
-	DebugTrace bool
+	CoinBase    common.Address
+	BaseFee     *big.Int
+	BlobBaseFee *big.Int
+	TxConfig    statedb.TxConfig
+	DebugTrace  bool
 
 	// Fields from EVMConfig.FeeMarketParams struct
 	NoBaseFee bool
 
 	// Fields from EVMConfig.Params struct
 	EvmDenom  string
-	ExtraEips []int
+	ExtraEips []int64
 	// *rpctypes.StateOverride : original type
 	Overrides []byte
 }
 
-// PrepareTxArgs is the argument struct for the SgxRpcServer.PrepareTx RPC method.
+// PrepareTxArgs is the argument struct for the Enclave.PrepareTx RPC method.
 type PrepareTxArgs struct {
 	TxHash []byte
 	// Header is the Tendermint header of the block in which the transaction
@@ -121,13 +308,18 @@ type PrepareTxArgs struct {
 	Msg core.Message
 	// EvmConfig is the EVM configuration to set.
 	EvmConfig PrepareTxEVMConfig
+	// Authorizations are the EIP-7702 authorizations of the transaction.
+	Authorizations []types.SetCodeAuthorization
+	// ContextID is the id of the node context of the session, passed back in
+	// the StateDB callbacks.
+	ContextID uint64
 }
 
-// PrepareTxArgs is the reply struct for the SgxRpcServer.PrepareTx RPC method.
+// PrepareTxArgs is the reply struct for the Enclave.PrepareTx RPC method.
 type PrepareTxReply struct {
 }
 
-// CallArgs is the argument struct for the SgxRpcServer.Call RPC method.
+// CallArgs is the argument struct for the Enclave.Call RPC method.
 type CallArgs struct {
 	Caller vm.AccountRef
 	Addr   common.Address
@@ -136,13 +328,15 @@ type CallArgs struct {
 	Value  *big.Int
 }
 
-// CallReply is the reply struct for the SgxRpcServer.Call RPC method.
+// CallReply is the reply struct for the Enclave.Call RPC method.
 type CallReply struct {
 	Ret         []byte
 	LeftOverGas uint64
+	// VmErr is the EVM error of the call, if any.
+	VmErr error
 }
 
-// CreateArgs is the argument struct for the SgxRpcServer.Create RPC method.
+// CreateArgs is the argument struct for the Enclave.Create RPC method.
 type CreateArgs struct {
 	Caller vm.AccountRef
 	Code   []byte
@@ -150,50 +344,52 @@ type CreateArgs struct {
 	Value  *big.Int
 }
 
-// CreateReply is the reply struct for the SgxRpcServer.Create RPC method.
+// CreateReply is the reply struct for the Enclave.Create RPC method.
 type CreateReply struct {
 	Ret          []byte
 	ContractAddr common.Address
 	LeftOverGas  uint64
+	// VmErr is the EVM error of the contract creation, if any.
+	VmErr error
 }
 
-// CommitArgs is the argument struct for the SgxRpcServer.Commit RPC method.
+// CommitArgs is the argument struct for the Enclave.Commit RPC method.
 type CommitArgs struct {
 	Commit bool
 }
 
-// CommitReply is the reply struct for the SgxRpcServer.Commit RPC method.
+// CommitReply is the reply struct for the Enclave.Commit RPC method.
 type CommitReply struct {
 }
 
-// CommitArgs is the argument struct for the SgxRpcServer.StateDBSubBalance RPC method.
+// CommitArgs is the argument struct for the Enclave.StateDBSubBalance RPC method.
 type StateDBSubBalanceArgs struct {
 	Caller vm.AccountRef
 	Msg    core.Message
 }
 
-// CommitReply is the reply struct for the SgxRpcServer.StateDBSubBalance RPC method.
+// CommitReply is the reply struct for the Enclave.StateDBSubBalance RPC method.
 type StateDBSubBalanceReply struct {
 }
 
-// CommitArgs is the argument struct for the SgxRpcServer.StateDSetNonce RPC method.
+// CommitArgs is the argument struct for the Enclave.StateDSetNonce RPC method.
 type StateDBSetNonceArgs struct {
 	Caller vm.AccountRef
 	Nonce  uint64
 }
 
-// CommitReply is the reply struct for the SgxRpcServer.StateDSetNonce RPC method.
+// CommitReply is the reply struct for the Enclave.StateDSetNonce RPC method.
 type StateDBSetNonceReply struct {
 }
 
-// StateDBAddBalanceArgs is the argument struct for the SgxRpcServer.StateDBAddBalance RPC method.
+// StateDBAddBalanceArgs is the argument struct for the Enclave.StateDBAddBalance RPC method.
 type StateDBAddBalanceArgs struct {
 	Caller      vm.AccountRef
 	Msg         core.Message
 	LeftoverGas uint64
 }
 
-// StateDBAddBalanceReply is the reply struct for the SgxRpcServer.StateDBAddBalance RPC method.
+// StateDBAddBalanceReply is the reply struct for the Enclave.StateDBAddBalance RPC method.
 type StateDBAddBalanceReply struct {
 }
 
@@ -205,13 +401,13 @@ type StateDBPrepareArgs struct {
 type StateDBPrepareReply struct {
 }
 
-// StateDBIncreaseNonceArgs is the argument struct for the SgxRpcServer.StateDBIncreaseNonce RPC method.
+// StateDBIncreaseNonceArgs is the argument struct for the Enclave.StateDBIncreaseNonce RPC method.
 type StateDBIncreaseNonceArgs struct {
 	Caller vm.AccountRef
 	Msg    core.Message
 }
 
-// StateDBIncreaseNonceReply is the reply struct for the SgxRpcServer.StateDBIncreaseNonce RPC method.
+// StateDBIncreaseNonceReply is the reply struct for the Enclave.StateDBIncreaseNonce RPC method.
 type StateDBIncreaseNonceReply struct {
 }
 
@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"cosmossdk.io/log"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// callEnclaveConn is a fakeEnclaveConn whose calls fail with vmError, or with a
+// transport error when the executor is down.
+type callEnclaveConn struct {
+	*fakeEnclaveConn
+	vmError string
+}
+
+func (c *callEnclaveConn) Call(
+	_ context.Context, _ *types.EnclaveCallRequest, _ ...grpc.CallOption,
+) (*types.EnclaveCallResponse, error) {
+	if c.down[c.addr] {
+		return nil, status.Error(codes.Unavailable, "connection reset")
+	}
+	return &types.EnclaveCallResponse{LeftOverGas: 100, VmError: c.vmError}, nil
+}
+
+func TestSgxRPCClientCallErrors(t *testing.T) {
+	down := map[string]bool{}
+	conn := &callEnclaveConn{fakeEnclaveConn: &fakeEnclaveConn{addr: "primary", down: down}}
+	pool := newSgxExecutorPool([]string{"primary"})
+	pool.dial = func(string) (enclaveConn, error) { return conn, nil }
+
+	client, release, err := pool.acquire(log.NewNopLogger(), false)
+	require.NoError(t, err)
+	defer release()
+	args := CallArgs{Caller: vm.AccountRef(common.HexToAddress("0x01")), Addr: common.HexToAddress("0x02")}
+
+	// EVM errors are part of the reply, with their go-ethereum identity
+	conn.vmError = vm.ErrExecutionReverted.Error()
+	var reply CallReply
+	require.NoError(t, client.Call(args, &reply))
+	require.ErrorIs(t, reply.VmErr, vm.ErrExecutionReverted)
+	require.Equal(t, uint64(100), reply.LeftOverGas)
+
+	conn.vmError = ""
+	reply = CallReply{}
+	require.NoError(t, client.Call(args, &reply))
+	require.NoError(t, reply.VmErr)
+
+	// transport failures are executor failures, not EVM errors
+	down["primary"] = true
+	reply = CallReply{}
+	err = client.Call(args, &reply)
+	require.ErrorIs(t, err, types.ErrSgxExecutor)
+	require.NoError(t, reply.VmErr)
+}
+
+func TestRecordSgxFailure(t *testing.T) {
+	ctx := sdk.NewContext(nil, cmtproto.Header{Height: 3}, false, log.NewNopLogger())
+	failure := errors.Join(errors.New("failed to apply ethereum core message"), errNoSgxExecutor)
+
+	testCases := []struct {
+		name     string
+		mode     sdk.ExecMode
+		err      error
+		recorded bool
+	}{
+		{"executor failure in FinalizeBlock", sdk.ExecModeFinalize, failure, true},
+		{"executor failure in a query", sdk.ExecModeSimulate, failure, false},
+		{"message failure in FinalizeBlock", sdk.ExecModeFinalize, types.ErrInvalidGasLimit, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			k := &Keeper{}
+			k.recordSgxFailure(ctx.WithExecMode(tc.mode), tc.err)
+			if !tc.recorded {
+				require.NoError(t, k.sgxFailure)
+				return
+			}
+			require.ErrorIs(t, k.sgxFailure, types.ErrSgxExecutor)
+
+			// only the first failure of the block is kept
+			k.recordSgxFailure(ctx.WithExecMode(tc.mode), errBlockSessionBroken)
+			require.ErrorIs(t, k.sgxFailure, errNoSgxExecutor)
+		})
+	}
+}
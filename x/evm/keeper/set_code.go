@@ -0,0 +1,81 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// applySetCodeAuthorizations applies the EIP-7702 authorizations of a set-code
+// transaction, it must be called once the access list is prepared. The invalid
+// authorizations are skipped, they don't invalidate the transaction.
+func applySetCodeAuthorizations(stateDB vm.StateDB, chainID *big.Int, auths []types.SetCodeAuthorization) {
+	for _, auth := range auths {
+		if authChainID := auth.GetChainID(); authChainID.Sign() != 0 && authChainID.Cmp(chainID) != 0 {
+			continue
+		}
+		authority, err := auth.Authority()
+		if err != nil {
+			continue
+		}
+		stateDB.AddAddressToAccessList(authority)
+
+		// only the EOAs and the already delegated accounts can delegate
+		if code := stateDB.GetCode(authority); len(code) > 0 {
+			if _, ok := types.ParseDelegation(code); !ok {
+				continue
+			}
+		}
+		if stateDB.GetNonce(authority) != auth.Nonce {
+			continue
+		}
+
+		if stateDB.Exist(authority) {
+			stateDB.AddRefund(types.SetCodeAuthorizationGas - types.SetCodeAuthorizationBaseGas)
+		}
+		if address := auth.GetAddress(); address == (common.Address{}) {
+			// delegating to the zero address clears the delegation
+			stateDB.SetCode(authority, nil)
+		} else {
+			stateDB.SetCode(authority, types.AddressToDelegation(address))
+		}
+		stateDB.SetNonce(authority, auth.Nonce+1)
+	}
+}
+
+// delegationStateDB resolves the EIP-7702 delegation designators, the calls to
+// a delegated account execute the code of its delegate. EXTCODESIZE and
+// EXTCODEHASH return the designator, EXTCODECOPY copies the delegate code and
+// the access of the delegate isn't charged, go-ethereum doesn't allow to hook
+// these opcodes.
+type delegationStateDB struct {
+	vm.StateDB
+}
+
+// GetCode returns the code of the delegate of a delegated account, and the
+// code of the account otherwise.
+func (s delegationStateDB) GetCode(addr common.Address) []byte {
+	code := s.StateDB.GetCode(addr)
+	if target, ok := types.ParseDelegation(code); ok {
+		return s.StateDB.GetCode(target)
+	}
+	return code
+}
@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+func (suite *ParallelTestSuite) enablePrague() {
+	params := suite.App.EvmKeeper.GetParams(suite.Ctx)
+	pragueTime := sdkmath.ZeroInt()
+	params.ChainConfig.PragueTime = &pragueTime
+	suite.Require().NoError(suite.App.EvmKeeper.SetParams(suite.Ctx, params))
+}
+
+func (suite *ParallelTestSuite) setCodeTx(from, to common.Address, input []byte, auths ...types.SetCodeAuthorization) *types.MsgEthereumTx {
+	msg := types.NewSetCodeTx(suite.App.EvmKeeper.ChainID(), suite.nonces[from], to, big.NewInt(0), 200_000, suite.gasPrice, suite.gasPrice, input, nil, auths)
+	return suite.sign(from, msg)
+}
+
+func (suite *ParallelTestSuite) TestApplyTransactionSetCode() {
+	suite.enablePrague()
+
+	deployer := suite.accounts[0]
+	storage := crypto.CreateAddress(deployer, suite.nonces[deployer])
+	deploy := types.NewTxContract(suite.App.EvmKeeper.ChainID(), suite.nonces[deployer], big.NewInt(0), 1_000_000, suite.gasPrice, nil, nil,
+		types.SimpleStorageContract.Bin, nil)
+	store, err := types.SimpleStorageContract.ABI.Pack("store", big.NewInt(42))
+	suite.Require().NoError(err)
+	get, err := types.SimpleStorageContract.ABI.Pack("get")
+	suite.Require().NoError(err)
+
+	authority, priv := tests.NewAddrKey()
+	key, err := priv.(*ethsecp256k1.PrivKey).ToECDSA()
+	suite.Require().NoError(err)
+	signAuth := func(delegate common.Address, nonce uint64) types.SetCodeAuthorization {
+		auth := types.NewSetCodeAuthorization(suite.App.EvmKeeper.ChainID(), delegate, nonce)
+		suite.Require().NoError(auth.Sign(key))
+		return auth
+	}
+
+	sponsor := suite.accounts[1]
+	results := suite.applyBlock(suite.Ctx, []*types.MsgEthereumTx{
+		suite.sign(deployer, deploy),
+		// the authority delegates to the storage contract and is called in the same transaction
+		suite.setCodeTx(sponsor, authority, store, signAuth(storage, 0)),
+		// the stale authorization is skipped
+		suite.setCodeTx(sponsor, authority, get, signAuth(common.Address{}, 0)),
+		// the authorization of another chain is skipped
+		suite.setCodeTx(sponsor, authority, get, types.SetCodeAuthorization{}),
+	})
+	for i, res := range results {
+		suite.Require().NoError(res.Err, "tx %d", i)
+		suite.Require().False(res.Response.Failed(), "tx %d: %s", i, res.Response.VmError)
+	}
+	suite.Require().Equal(common.BigToHash(big.NewInt(42)).Bytes(), results[2].Response.Ret)
+	suite.Require().Equal(common.BigToHash(big.NewInt(42)).Bytes(), results[3].Response.Ret)
+
+	acct := suite.App.EvmKeeper.GetAccount(suite.Ctx, authority)
+	suite.Require().NotNil(acct)
+	suite.Require().Equal(uint64(1), acct.Nonce)
+	suite.Require().Equal(types.AddressToDelegation(storage), suite.App.EvmKeeper.GetCode(suite.Ctx, common.BytesToHash(acct.CodeHash)))
+	// the storage is the one of the authority
+	suite.Require().Equal(common.BigToHash(big.NewInt(42)), suite.App.EvmKeeper.GetState(suite.Ctx, authority, common.Hash{}))
+	suite.Require().Equal(common.Hash{}, suite.App.EvmKeeper.GetState(suite.Ctx, storage, common.Hash{}))
+
+	// delegating to the zero address clears the delegation
+	results = suite.applyBlock(suite.Ctx, []*types.MsgEthereumTx{
+		suite.setCodeTx(sponsor, authority, nil, signAuth(common.Address{}, 1)),
+	})
+	suite.Require().NoError(results[0].Err)
+	acct = suite.App.EvmKeeper.GetAccount(suite.Ctx, authority)
+	suite.Require().Equal(uint64(2), acct.Nonce)
+	suite.Require().False(acct.IsContract())
+}
+
+func (suite *ParallelTestSuite) TestApplyTransactionSetCodeBeforePrague() {
+	authority, priv := tests.NewAddrKey()
+	key, err := priv.(*ethsecp256k1.PrivKey).ToECDSA()
+	suite.Require().NoError(err)
+	auth := types.NewSetCodeAuthorization(suite.App.EvmKeeper.ChainID(), suite.token, 0)
+	suite.Require().NoError(auth.Sign(key))
+
+	results := suite.applyBlock(suite.Ctx, []*types.MsgEthereumTx{
+		suite.setCodeTx(suite.accounts[1], authority, nil, auth),
+	})
+	suite.Require().ErrorIs(results[0].Err, types.ErrTxTypeNotSupported)
+	suite.Require().Nil(suite.App.EvmKeeper.GetAccount(suite.Ctx, authority))
+}
+
+func (suite *ParallelTestSuite) TestParallelExecutionSetCode() {
+	suite.enablePrague()
+
+	authority, priv := tests.NewAddrKey()
+	key, err := priv.(*ethsecp256k1.PrivKey).ToECDSA()
+	suite.Require().NoError(err)
+	auth := types.NewSetCodeAuthorization(suite.App.EvmKeeper.ChainID(), suite.token, 0)
+	suite.Require().NoError(auth.Sign(key))
+	balanceOf, err := types.ERC20Contract.ABI.Pack("balanceOf", suite.accounts[1])
+	suite.Require().NoError(err)
+
+	msgs := []*types.MsgEthereumTx{
+		// the authority delegates to the token, the later calls depend on it
+		suite.setCodeTx(suite.accounts[1], authority, balanceOf, auth),
+		suite.setCodeTx(suite.accounts[2], authority, balanceOf),
+	}
+	// independent transactions
+	for _, from := range suite.accounts[3:] {
+		msgs = append(msgs, suite.setCodeTx(from, suite.token, balanceOf))
+	}
+
+	seqCtx, _ := suite.Ctx.CacheContext()
+	expected := suite.applyBlock(seqCtx, msgs)
+
+	suite.App.EvmKeeper.SetParallelExecution(4)
+	ctx, _ := suite.Ctx.CacheContext()
+	results := suite.applyBlock(ctx, msgs)
+
+	suite.Require().Equal(expected, results)
+	for i, res := range results {
+		suite.Require().NoError(res.Err, "tx %d", i)
+		suite.Require().False(res.Response.Failed(), "tx %d: %s", i, res.Response.VmError)
+	}
+	suite.Require().Positive(suite.App.EvmKeeper.PreExecutionsReused())
+	suite.Require().Equal(suite.dumpStores(seqCtx), suite.dumpStores(ctx))
+	acct := suite.App.EvmKeeper.GetAccount(ctx, authority)
+	suite.Require().Equal(types.AddressToDelegation(suite.token), suite.App.EvmKeeper.GetCode(ctx, common.BytesToHash(acct.CodeHash)))
+}
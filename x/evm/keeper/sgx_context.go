@@ -0,0 +1,78 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/evmos/ethermint/x/evm/statedb"
+)
+
+// sgxContext is the node context an enclave session reads and writes through
+// the StateDB callbacks.
+type sgxContext struct {
+	ctx sdk.Context
+	// journal records the accesses of the session, if set.
+	journal *statedb.AccessJournal
+}
+
+// sgxContexts holds the contexts of the open enclave sessions by id, so that
+// the sessions can run concurrently. It's shared by the copies of the keeper
+// serving the callbacks.
+type sgxContexts struct {
+	mu     sync.Mutex
+	nextID uint64
+	byID   map[uint64]sgxContext
+}
+
+func newSgxContexts() *sgxContexts {
+	return &sgxContexts{byID: make(map[uint64]sgxContext)}
+}
+
+// register makes the context available to the callbacks of a session, until
+// the returned function is called.
+func (c *sgxContexts) register(ctx sgxContext) (uint64, func()) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.nextID++
+	id := c.nextID
+	c.byID[id] = ctx
+	return id, func() {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		delete(c.byID, id)
+	}
+}
+
+// callbackContext returns the context of the session a StateDB callback is made
+// for. The id is zero for the executors older than EnclaveContextVersion, which
+// operate on the context of the last prepared session.
+func (k Keeper) callbackContext(id uint64) (sgxContext, error) {
+	if id == 0 {
+		return sgxContext{ctx: k.preparedCtx}, nil
+	}
+	k.sgxContexts.mu.Lock()
+	defer k.sgxContexts.mu.Unlock()
+	ctx, ok := k.sgxContexts.byID[id]
+	if !ok {
+		return sgxContext{}, status.Errorf(codes.NotFound, "unknown context %d", id)
+	}
+	return ctx, nil
+}
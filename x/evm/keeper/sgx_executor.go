@@ -0,0 +1,302 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/evmos/ethermint/server/config"
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+const (
+	// sgxExecutorBaseBackoff is the time an executor is excluded from the
+	// rotation after its first consecutive failure. The backoff doubles with
+	// every further failure, up to sgxExecutorMaxBackoff.
+	sgxExecutorBaseBackoff = 500 * time.Millisecond
+	sgxExecutorMaxBackoff  = 30 * time.Second
+
+	// sgxHandshakeTimeout bounds the protocol version handshake made when
+	// connecting to an executor.
+	sgxHandshakeTimeout = 5 * time.Second
+)
+
+// errNoSgxExecutor is returned when none of the configured executors could be
+// reached.
+var errNoSgxExecutor = errorsmod.Wrap(types.ErrSgxExecutor, "no healthy SGX executor available")
+
+// enclaveConn is a connection to an SGX executor.
+type enclaveConn interface {
+	types.EnclaveClient
+	io.Closer
+
+	// Ready reports whether the underlying transport is usable.
+	Ready() bool
+}
+
+// grpcEnclaveConn is an enclaveConn over a gRPC client connection.
+type grpcEnclaveConn struct {
+	types.EnclaveClient
+	conn *grpc.ClientConn
+}
+
+func dialEnclave(addr string) (enclaveConn, error) {
+	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	return &grpcEnclaveConn{EnclaveClient: types.NewEnclaveClient(conn), conn: conn}, nil
+}
+
+func (c *grpcEnclaveConn) Ready() bool {
+	state := c.conn.GetState()
+	return state != connectivity.TransientFailure && state != connectivity.Shutdown
+}
+
+func (c *grpcEnclaveConn) Close() error {
+	return c.conn.Close()
+}
+
+// sgxExecutor tracks the connection to and the health of a single SGX executor
+// endpoint.
+type sgxExecutor struct {
+	addr string
+
+	// inflight is the number of sessions currently open against the executor.
+	inflight atomic.Int64
+
+	mu             sync.Mutex
+	failures       int
+	unhealthyUntil time.Time
+	// conn is shared by all the sessions opened against the executor, it's
+	// established lazily and dropped on transport failures.
+	conn enclaveConn
+	// version is the protocol version negotiated on conn.
+	version uint32
+}
+
+// healthy reports whether the executor is outside of its failure backoff window.
+func (e *sgxExecutor) healthy(now time.Time) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return !now.Before(e.unhealthyUntil)
+}
+
+// markFailure records a transport failure and excludes the executor from the
+// rotation for an exponentially growing backoff period.
+func (e *sgxExecutor) markFailure(now time.Time) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	backoff := sgxExecutorBaseBackoff << e.failures
+	if backoff <= 0 || backoff > sgxExecutorMaxBackoff {
+		backoff = sgxExecutorMaxBackoff
+	} else {
+		e.failures++
+	}
+	e.unhealthyUntil = now.Add(backoff)
+}
+
+// markSuccess resets the failure counter of the executor.
+func (e *sgxExecutor) markSuccess() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.failures = 0
+	e.unhealthyUntil = time.Time{}
+}
+
+// connect returns the connection to the executor, dialing it and checking the
+// protocol version if there isn't a usable one yet.
+func (e *sgxExecutor) connect(dial func(addr string) (enclaveConn, error)) (enclaveConn, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.conn != nil {
+		if e.conn.Ready() {
+			return e.conn, nil
+		}
+		// the executor may have been restarted with another version, redo the
+		// handshake on a fresh connection.
+		_ = e.conn.Close()
+		e.conn = nil
+	}
+
+	conn, err := dial(e.addr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), sgxHandshakeTimeout)
+	defer cancel()
+	res, err := conn.Handshake(ctx, types.NewEnclaveHandshakeRequest())
+	if err == nil {
+		err = res.Validate()
+	}
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+
+	e.conn = conn
+	e.version = res.NegotiatedVersion()
+	return conn, nil
+}
+
+// protocolVersion returns the protocol version negotiated with the executor,
+// zero if it isn't connected.
+func (e *sgxExecutor) protocolVersion() uint32 {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.conn == nil {
+		return 0
+	}
+	return e.version
+}
+
+// disconnect drops the connection to the executor after a transport failure.
+func (e *sgxExecutor) disconnect() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.conn != nil {
+		_ = e.conn.Close()
+		e.conn = nil
+	}
+}
+
+// sgxExecutorPool dispatches EVM sessions to a set of SGX executors.
+//
+// The first endpoint is the primary executor and the second one its failover
+// secondary; both are reserved for consensus execution. Read-only simulations
+// (eth_call, eth_estimateGas and traces) are balanced across the remaining
+// endpoints, picking the healthy executor with the fewest open sessions. When
+// no query executor is available, the secondary and then the primary serve
+// queries too.
+//
+// The failover happens when a session is acquired: a session is bound to the
+// executor it was opened on, and isn't retried on another executor if its
+// transport fails mid-way, as the executor may have written part of the state
+// back to the node already. The message then fails with ErrSgxExecutor, which
+// aborts the block being finalized at EndBlock, and the executor is put in
+// backoff so that the next sessions are opened on the secondary.
+type sgxExecutorPool struct {
+	consensus []*sgxExecutor
+	query     []*sgxExecutor
+
+	// next is used to break ties between equally loaded query executors.
+	next atomic.Uint64
+
+	// dial opens a connection to an executor, it's replaced in tests.
+	dial func(addr string) (enclaveConn, error)
+	now  func() time.Time
+}
+
+// newSgxExecutorPool creates a pool over the given executor addresses, falling
+// back to config.DefaultSgxExecutorAddress if none is provided.
+func newSgxExecutorPool(addrs []string) *sgxExecutorPool {
+	if len(addrs) == 0 {
+		addrs = []string{config.DefaultSgxExecutorAddress}
+	}
+
+	executors := make([]*sgxExecutor, len(addrs))
+	for i, addr := range addrs {
+		executors[i] = &sgxExecutor{addr: addr}
+	}
+
+	split := 2
+	if len(executors) < split {
+		split = len(executors)
+	}
+
+	return &sgxExecutorPool{
+		consensus: executors[:split],
+		query:     executors[split:],
+		dial:      dialEnclave,
+		now:       time.Now,
+	}
+}
+
+// acquire opens a session with an SGX executor. Consensus sessions are pinned
+// to the primary executor and fail over to the secondary, read-only sessions
+// are balanced over the query executors. Executors speaking an incompatible
+// protocol version are rejected. The returned release function must be called
+// once the session is over.
+func (p *sgxExecutorPool) acquire(logger log.Logger, readOnly bool) (*sgxRPCClient, func(), error) {
+	var candidates []*sgxExecutor
+	if readOnly {
+		// fall back to the consensus executors, secondary first, to keep
+		// queries away from the primary as much as possible.
+		candidates = p.orderByLoad(p.query)
+		for i := len(p.consensus) - 1; i >= 0; i-- {
+			candidates = append(candidates, p.consensus[i])
+		}
+	} else {
+		candidates = p.consensus
+	}
+
+	now := p.now()
+	var errs []error
+	// healthy executors are tried first, the ones in backoff are only used as
+	// a last resort so that a fully degraded pool still makes progress.
+	for _, wantHealthy := range []bool{true, false} {
+		for _, e := range candidates {
+			if e.healthy(now) != wantHealthy {
+				continue
+			}
+			conn, err := e.connect(p.dial)
+			if err != nil {
+				e.markFailure(p.now())
+				logger.Error("failed to connect to SGX executor", "address", e.addr, "error", err)
+				errs = append(errs, fmt.Errorf("%s: %w", e.addr, err))
+				continue
+			}
+
+			e.inflight.Add(1)
+			client := &sgxRPCClient{
+				logger:   logger.With("executor", e.addr),
+				client:   conn,
+				executor: e,
+				now:      p.now,
+			}
+			release := func() {
+				defer e.inflight.Add(-1)
+				if err := client.EndSession(); err != nil {
+					logger.Debug("failed to end SGX executor session", "address", e.addr, "error", err)
+				}
+			}
+			return client, release, nil
+		}
+	}
+
+	return nil, nil, errors.Join(append([]error{errNoSgxExecutor}, errs...)...)
+}
+
+// orderByLoad returns the executors sorted by the number of open sessions,
+// starting the scan at a rotating offset so that ties are spread evenly.
+func (p *sgxExecutorPool) orderByLoad(executors []*sgxExecutor) []*sgxExecutor {
+	n := len(executors)
+	if n == 0 {
+		return nil
+	}
+
+	offset := int(p.next.Add(1) % uint64(n))
+	ordered := make([]*sgxExecutor, 0, n)
+	for i := 0; i < n; i++ {
+		ordered = append(ordered, executors[(offset+i)%n])
+	}
+
+	// insertion sort, the number of executors is small
+	for i := 1; i < n; i++ {
+		for j := i; j > 0 && ordered[j].inflight.Load() < ordered[j-1].inflight.Load(); j-- {
+			ordered[j], ordered[j-1] = ordered[j-1], ordered[j]
+		}
+	}
+	return ordered
+}
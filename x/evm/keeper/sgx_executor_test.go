@@ -0,0 +1,186 @@
+package keeper
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/evmos/ethermint/server/config"
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// fakeEnclaveConn is an in-memory enclaveConn, the executors listed in down
+// are unreachable.
+type fakeEnclaveConn struct {
+	types.EnclaveClient
+
+	addr     string
+	down     map[string]bool
+	versions map[string]uint32
+}
+
+func (c *fakeEnclaveConn) Handshake(
+	_ context.Context, _ *types.EnclaveHandshakeRequest, _ ...grpc.CallOption,
+) (*types.EnclaveHandshakeResponse, error) {
+	if c.down[c.addr] {
+		return nil, status.Error(codes.Unavailable, "connection refused")
+	}
+	version, ok := c.versions[c.addr]
+	if !ok {
+		version = types.EnclaveProtocolVersion
+	}
+	return &types.EnclaveHandshakeResponse{ProtocolVersion: version, MinProtocolVersion: version}, nil
+}
+
+func (c *fakeEnclaveConn) EndSession(
+	_ context.Context, _ *types.EnclaveEndSessionRequest, _ ...grpc.CallOption,
+) (*types.EnclaveEndSessionResponse, error) {
+	return &types.EnclaveEndSessionResponse{}, nil
+}
+
+func (c *fakeEnclaveConn) Ready() bool  { return !c.down[c.addr] }
+func (c *fakeEnclaveConn) Close() error { return nil }
+
+// newTestSgxExecutorPool returns a pool whose dialer connects to in-memory
+// executors, the addresses listed in down are unreachable and versions
+// overrides the protocol version spoken by an executor.
+func newTestSgxExecutorPool(addrs []string, down map[string]bool, versions map[string]uint32) *sgxExecutorPool {
+	pool := newSgxExecutorPool(addrs)
+	pool.dial = func(addr string) (enclaveConn, error) {
+		return &fakeEnclaveConn{addr: addr, down: down, versions: versions}, nil
+	}
+	return pool
+}
+
+func TestSgxExecutorPoolDefault(t *testing.T) {
+	pool := newSgxExecutorPool(nil)
+	require.Len(t, pool.consensus, 1)
+	require.Empty(t, pool.query)
+	require.Equal(t, config.DefaultSgxExecutorAddress, pool.consensus[0].addr)
+}
+
+func TestSgxExecutorPoolConsensusFailover(t *testing.T) {
+	down := map[string]bool{}
+	pool := newTestSgxExecutorPool([]string{"primary", "secondary", "query"}, down, nil)
+
+	cl, release, err := pool.acquire(log.NewNopLogger(), false)
+	require.NoError(t, err)
+	require.Equal(t, "primary", cl.executor.addr)
+	release()
+
+	down["primary"] = true
+	cl, release, err = pool.acquire(log.NewNopLogger(), false)
+	require.NoError(t, err)
+	require.Equal(t, "secondary", cl.executor.addr)
+	release()
+	require.False(t, pool.consensus[0].healthy(time.Now()))
+
+	// consensus execution never uses the query executors
+	down["secondary"] = true
+	_, _, err = pool.acquire(log.NewNopLogger(), false)
+	require.ErrorIs(t, err, errNoSgxExecutor)
+
+	// the primary is used again once it's back
+	down["primary"] = false
+	cl, release, err = pool.acquire(log.NewNopLogger(), false)
+	require.NoError(t, err)
+	require.Equal(t, "primary", cl.executor.addr)
+	release()
+}
+
+func TestSgxExecutorPoolQueryBalancing(t *testing.T) {
+	down := map[string]bool{}
+	pool := newTestSgxExecutorPool([]string{"primary", "secondary", "q1", "q2"}, down, nil)
+
+	// open sessions are spread over the query executors
+	cl1, release1, err := pool.acquire(log.NewNopLogger(), true)
+	require.NoError(t, err)
+	cl2, release2, err := pool.acquire(log.NewNopLogger(), true)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"q1", "q2"}, []string{cl1.executor.addr, cl2.executor.addr})
+	release1()
+	release2()
+
+	// an unhealthy query executor is skipped
+	down["q1"] = true
+	for i := 0; i < 4; i++ {
+		cl, release, err := pool.acquire(log.NewNopLogger(), true)
+		require.NoError(t, err)
+		require.Equal(t, "q2", cl.executor.addr)
+		release()
+	}
+
+	// queries fall back to the secondary before the primary
+	down["q2"] = true
+	cl, release, err := pool.acquire(log.NewNopLogger(), true)
+	require.NoError(t, err)
+	require.Equal(t, "secondary", cl.executor.addr)
+	release()
+}
+
+func TestSgxExecutorPoolIncompatibleVersion(t *testing.T) {
+	versions := map[string]uint32{"primary": types.EnclaveProtocolVersion + 1}
+	pool := newTestSgxExecutorPool([]string{"primary", "secondary"}, map[string]bool{}, versions)
+
+	cl, release, err := pool.acquire(log.NewNopLogger(), false)
+	require.NoError(t, err)
+	require.Equal(t, "secondary", cl.executor.addr)
+	release()
+	require.False(t, pool.consensus[0].healthy(time.Now()))
+
+	versions["secondary"] = types.EnclaveProtocolVersion + 1
+	pool = newTestSgxExecutorPool([]string{"primary", "secondary"}, map[string]bool{}, versions)
+	_, _, err = pool.acquire(log.NewNopLogger(), false)
+	require.ErrorIs(t, err, errNoSgxExecutor)
+	require.ErrorIs(t, err, types.ErrIncompatibleEnclave)
+}
+
+func TestSgxExecutorPoolReconnect(t *testing.T) {
+	down := map[string]bool{}
+	pool := newTestSgxExecutorPool([]string{"primary"}, down, nil)
+
+	cl, release, err := pool.acquire(log.NewNopLogger(), false)
+	require.NoError(t, err)
+	conn := cl.client
+	release()
+
+	// the connection is shared by the sessions
+	cl, release, err = pool.acquire(log.NewNopLogger(), false)
+	require.NoError(t, err)
+	require.Same(t, conn, cl.client)
+	release()
+
+	// a broken connection is replaced once the executor is back
+	down["primary"] = true
+	_, _, err = pool.acquire(log.NewNopLogger(), false)
+	require.Error(t, err)
+	down["primary"] = false
+	cl, release, err = pool.acquire(log.NewNopLogger(), false)
+	require.NoError(t, err)
+	require.NotSame(t, conn, cl.client)
+	release()
+}
+
+func TestSgxExecutorBackoff(t *testing.T) {
+	e := &sgxExecutor{addr: "primary"}
+	now := time.Now()
+
+	e.markFailure(now)
+	require.False(t, e.healthy(now))
+	require.True(t, e.healthy(now.Add(sgxExecutorBaseBackoff)))
+
+	for i := 0; i < 100; i++ {
+		e.markFailure(now)
+	}
+	require.False(t, e.healthy(now.Add(sgxExecutorMaxBackoff-time.Nanosecond)))
+	require.True(t, e.healthy(now.Add(sgxExecutorMaxBackoff)))
+
+	e.markSuccess()
+	require.True(t, e.healthy(now))
+}
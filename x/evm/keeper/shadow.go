@@ -0,0 +1,353 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/hashicorp/go-metrics"
+
+	ethermint "github.com/evmos/ethermint/types"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// SetShadowExecution enables or disables the shadow execution mode, in which every message
+// executed by the SGX enclave is also executed by the in-process EVM, and any divergence between
+// both results is reported. The enclave result is always the one used by consensus.
+func (k *Keeper) SetShadowExecution(enabled bool) *Keeper {
+	k.shadowExecution = enabled
+	return k
+}
+
+// shadowResult is the outcome of a message executed by the in-process EVM.
+type shadowResult struct {
+	res *types.MsgEthereumTxResponse
+	err error
+	// ctx is the discarded cache context holding the local state writes
+	ctx      sdk.Context
+	dirties  []common.Address
+	keys     map[common.Address][]common.Hash
+	evmDenom string
+}
+
+// ShadowDivergence describes a single difference between the enclave and the
+// in-process EVM execution of a message.
+type ShadowDivergence struct {
+	// Field is the diverging part of the result: error, ret, vm_error,
+	// gas_used, logs, nonce, code_hash, balance or storage.
+	Field   string          `json:"field"`
+	Address *common.Address `json:"address,omitempty"`
+	Key     *common.Hash    `json:"key,omitempty"`
+	Enclave string          `json:"enclave"`
+	Local   string          `json:"local"`
+}
+
+// ShadowReport is the structured report emitted when the enclave and the
+// in-process EVM executions of a message diverge.
+type ShadowReport struct {
+	TxHash      common.Hash        `json:"tx_hash"`
+	Height      int64              `json:"height"`
+	Divergences []ShadowDivergence `json:"divergences"`
+}
+
+// shadowApplyMessage executes the message on the in-process EVM against a cache context that is
+// never written back, so it doesn't affect the state nor the gas meters of the caller. It must run
+// before the enclave touches the state.
+func (k *Keeper) shadowApplyMessage(ctx sdk.Context, msg core.Message, cfg *EVMConfig) *shadowResult {
+	cacheCtx, _ := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()).CacheContext()
+
+	// copy the config to leave the tracer and overrides of the caller untouched
+	localCfg := *cfg
+	localCfg.Tracer = types.NewNoOpTracer()
+	if cfg.InternalTxTracer != nil {
+		localCfg.Tracer = cfg.InternalTxTracer
+	}
+
+	res, stateDB, err := k.applyMessageLocal(cacheCtx, msg, &localCfg)
+	result := &shadowResult{
+		res:      res,
+		err:      err,
+		ctx:      cacheCtx,
+		evmDenom: cfg.Params.EvmDenom,
+	}
+	if stateDB != nil {
+		result.dirties, result.keys = stateDB.Dirties()
+	}
+	return result
+}
+
+// applyMessageLocal applies the message on the in-process go-ethereum EVM and commits the
+// resulting state to the given context.
+func (k *Keeper) applyMessageLocal(
+	ctx sdk.Context,
+	msg core.Message,
+	cfg *EVMConfig,
+) (res *types.MsgEthereumTxResponse, stateDB *statedb.StateDB, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			res, stateDB, err = nil, nil, fmt.Errorf("in-process EVM panicked: %v", r)
+		}
+	}()
+
+	stateDB = statedb.NewWithParams(ctx, k, cfg.TxConfig, cfg.Params.EvmDenom)
+	if err := cfg.Overrides.Apply(stateDB); err != nil {
+		return nil, nil, errorsmod.Wrap(err, "failed to apply state override")
+	}
+	evm := k.newLocalEVM(ctx, msg, cfg, stateDB)
+
+	leftoverGas := msg.GasLimit
+	sender := vm.AccountRef(msg.From)
+	contractCreation := msg.To == nil
+	intrinsicGas, err := k.GetEthIntrinsicGas(ctx, msg, cfg.ChainConfig, contractCreation)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "intrinsic gas failed")
+	}
+	intrinsicGas += types.SetCodeIntrinsicGas(cfg.SetCodeAuthorizations)
+	if leftoverGas < intrinsicGas {
+		return nil, nil, errorsmod.Wrap(core.ErrIntrinsicGas, "apply message")
+	}
+	leftoverGas -= intrinsicGas
+
+	rules := cfg.ChainConfig.Rules(evm.Context.BlockNumber, cfg.ChainConfig.MergeNetsplitBlock != nil, evm.Context.Time)
+	if rules.IsShanghai && contractCreation && len(msg.Data) > params.MaxInitCodeSize {
+		return nil, nil, fmt.Errorf("%w: code size %v limit %v", core.ErrMaxInitCodeSizeExceeded, len(msg.Data), params.MaxInitCodeSize)
+	}
+	stateDB.Prepare(rules, msg.From, cfg.CoinBase, msg.To, vm.ActivePrecompiles(rules), msg.AccessList)
+	if len(cfg.SetCodeAuthorizations) > 0 {
+		if !rules.IsPrague {
+			return nil, nil, errorsmod.Wrap(types.ErrTxTypeNotSupported, "set-code transactions are enabled by Prague")
+		}
+		applySetCodeAuthorizations(stateDB, cfg.ChainConfig.ChainID, cfg.SetCodeAuthorizations)
+	}
+
+	var (
+		ret   []byte
+		vmErr error
+	)
+	if contractCreation {
+		// take over the nonce management from evm, like the enclave does
+		stateDB.SetNonce(sender.Address(), msg.Nonce)
+		ret, _, leftoverGas, vmErr = evm.Create(sender, msg.Data, leftoverGas, msg.Value)
+		stateDB.SetNonce(sender.Address(), msg.Nonce+1)
+	} else {
+		ret, leftoverGas, vmErr = evm.Call(sender, *msg.To, msg.Data, leftoverGas, msg.Value)
+	}
+
+	refundQuotient := params.RefundQuotient
+	if rules.IsLondon {
+		refundQuotient = params.RefundQuotientEIP3529
+	}
+	if msg.GasLimit < leftoverGas {
+		return nil, nil, errorsmod.Wrap(types.ErrGasOverflow, "apply message")
+	}
+	temporaryGasUsed := msg.GasLimit - leftoverGas
+	leftoverGas += GasToRefund(stateDB.GetRefund(), temporaryGasUsed, refundQuotient)
+	if msg.GasLimit < leftoverGas {
+		return nil, nil, errorsmod.Wrapf(types.ErrGasOverflow, "message gas limit < leftover gas (%d < %d)", msg.GasLimit, leftoverGas)
+	}
+
+	var vmError string
+	if vmErr != nil {
+		vmError = vmErr.Error()
+	}
+
+	if err := stateDB.Commit(); err != nil {
+		return nil, nil, errorsmod.Wrap(err, "failed to commit stateDB")
+	}
+
+	return &types.MsgEthereumTxResponse{
+		GasUsed: minimumGasUsed(msg, cfg, temporaryGasUsed),
+		VmError: vmError,
+		Ret:     ret,
+		Logs:    types.NewLogsFromEth(stateDB.Logs()),
+		Hash:    cfg.TxConfig.TxHash.Hex(),
+	}, stateDB, nil
+}
+
+// newLocalEVM creates a go-ethereum EVM instance with the block context of the given sdk context,
+// mirroring the environment the enclave sets up for a message.
+func (k *Keeper) newLocalEVM(ctx sdk.Context, msg core.Message, cfg *EVMConfig, stateDB vm.StateDB) *vm.EVM {
+	blockCtx := vm.BlockContext{
+		CanTransfer: core.CanTransfer,
+		Transfer:    core.Transfer,
+		GetHash:     k.GetHashFn(ctx),
+		Coinbase:    cfg.CoinBase,
+		GasLimit:    ethermint.BlockGasLimit(ctx),
+		BlockNumber: big.NewInt(ctx.BlockHeight()),
+		Time:        uint64(ctx.BlockHeader().Time.Unix()),
+		Difficulty:  big.NewInt(0), // unused. Only required in PoW context
+		BaseFee:     cfg.BaseFee,
+		BlobBaseFee: cfg.BlobBaseFee,
+		Random:      &common.MaxHash, // need to be different than nil to signal it is after the merge and pick up the right opcodes
+	}
+	cfg.BlockOverrides.Apply(&blockCtx)
+
+	if cfg.ChainConfig.IsPrague(blockCtx.BlockNumber, blockCtx.Time) {
+		stateDB = delegationStateDB{stateDB}
+	}
+
+	txCtx := core.NewEVMTxContext(&msg)
+	return vm.NewEVM(blockCtx, txCtx, stateDB, cfg.ChainConfig, k.VMConfig(ctx, msg, cfg))
+}
+
+// compareShadowResult compares the enclave result of a message, or the error it failed with, with
+// the in-process one and emits a ShadowReport, as an error log and a telemetry counter per
+// diverging field, if they differ. The state writes are only compared when the enclave result has
+// been committed to ctx.
+func (k *Keeper) compareShadowResult(
+	ctx sdk.Context,
+	cfg *EVMConfig,
+	res *types.MsgEthereumTxResponse,
+	enclaveErr error,
+	shadow *shadowResult,
+	committed bool,
+) *ShadowReport {
+	report := &ShadowReport{
+		TxHash: cfg.TxConfig.TxHash,
+		Height: ctx.BlockHeight(),
+	}
+	diverge := func(field string, addr *common.Address, key *common.Hash, enclave, local string) {
+		report.Divergences = append(report.Divergences, ShadowDivergence{
+			Field:   field,
+			Address: addr,
+			Key:     key,
+			Enclave: enclave,
+			Local:   local,
+		})
+	}
+
+	telemetry.IncrCounter(1, types.ModuleName, "shadow", "total")
+
+	switch {
+	case enclaveErr != nil && shadow.err != nil:
+		// both executions rejected the message
+	case enclaveErr != nil:
+		diverge("error", nil, nil, enclaveErr.Error(), "")
+	case shadow.err != nil:
+		diverge("error", nil, nil, "", shadow.err.Error())
+	default:
+		local := shadow.res
+		if !bytes.Equal(res.Ret, local.Ret) {
+			diverge("ret", nil, nil, hexutil.Encode(res.Ret), hexutil.Encode(local.Ret))
+		}
+		if res.VmError != local.VmError {
+			diverge("vm_error", nil, nil, res.VmError, local.VmError)
+		}
+		if res.GasUsed != local.GasUsed {
+			diverge("gas_used", nil, nil, fmt.Sprint(res.GasUsed), fmt.Sprint(local.GasUsed))
+		}
+		if enclaveLogs, localLogs := logsDigest(res.Logs), logsDigest(local.Logs); enclaveLogs != localLogs {
+			diverge("logs", nil, nil, enclaveLogs, localLogs)
+		}
+		if committed {
+			k.compareShadowState(ctx, shadow, diverge)
+		}
+	}
+
+	if len(report.Divergences) == 0 {
+		return nil
+	}
+
+	for _, d := range report.Divergences {
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, "shadow", "divergence"},
+			1,
+			[]metrics.Label{telemetry.NewLabel("field", d.Field)},
+		)
+	}
+
+	bz, err := json.Marshal(report)
+	if err != nil {
+		k.Logger(ctx).Error("failed to marshal shadow execution report", "error", err)
+	}
+	k.Logger(ctx).Error(
+		"enclave and in-process EVM executions diverged",
+		"tx", report.TxHash.Hex(), "height", report.Height, "report", string(bz),
+	)
+	return report
+}
+
+// compareShadowState compares the accounts and storage slots written by the in-process EVM with
+// the state committed by the enclave.
+func (k *Keeper) compareShadowState(
+	ctx sdk.Context,
+	shadow *shadowResult,
+	diverge func(field string, addr *common.Address, key *common.Hash, enclave, local string),
+) {
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	for _, addr := range shadow.dirties {
+		addr := addr
+		enclaveAcct, localAcct := k.GetAccount(ctx, addr), k.GetAccount(shadow.ctx, addr)
+		switch {
+		case enclaveAcct == nil || localAcct == nil:
+			if enclaveAcct != localAcct {
+				diverge("account", &addr, nil, fmt.Sprint(enclaveAcct != nil), fmt.Sprint(localAcct != nil))
+			}
+		default:
+			if enclaveAcct.Nonce != localAcct.Nonce {
+				diverge("nonce", &addr, nil, fmt.Sprint(enclaveAcct.Nonce), fmt.Sprint(localAcct.Nonce))
+			}
+			if !bytes.Equal(enclaveAcct.CodeHash, localAcct.CodeHash) {
+				diverge("code_hash", &addr, nil, hexutil.Encode(enclaveAcct.CodeHash), hexutil.Encode(localAcct.CodeHash))
+			}
+		}
+
+		cosmosAddr := sdk.AccAddress(addr.Bytes())
+		enclaveBalance := k.GetBalance(ctx, cosmosAddr, shadow.evmDenom)
+		localBalance := k.GetBalance(shadow.ctx, cosmosAddr, shadow.evmDenom)
+		if enclaveBalance.Cmp(localBalance) != 0 {
+			diverge("balance", &addr, nil, enclaveBalance.String(), localBalance.String())
+		}
+
+		for _, key := range shadow.keys[addr] {
+			key := key
+			enclaveValue, localValue := k.GetState(ctx, addr, key), k.GetState(shadow.ctx, addr, key)
+			if enclaveValue != localValue {
+				diverge("storage", &addr, &key, enclaveValue.Hex(), localValue.Hex())
+			}
+		}
+	}
+}
+
+// logsDigest returns a hash of the consensus relevant fields of the logs: the emitter address, the
+// topics and the data.
+func logsDigest(logs []*types.Log) string {
+	var buf bytes.Buffer
+	for _, log := range types.LogsToEthereum(logs) {
+		buf.Write(log.Address.Bytes())
+		buf.Write(sdk.Uint64ToBigEndian(uint64(len(log.Topics))))
+		for _, topic := range log.Topics {
+			buf.Write(topic.Bytes())
+		}
+		buf.Write(sdk.Uint64ToBigEndian(uint64(len(log.Data))))
+		buf.Write(log.Data)
+	}
+	return fmt.Sprintf("%d logs, digest %s", len(logs), crypto.Keccak256Hash(buf.Bytes()).Hex())
+}
@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"errors"
+	"testing"
+
+	"cosmossdk.io/log"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+func TestCompareShadowResult(t *testing.T) {
+	k := &Keeper{}
+	ctx := sdk.Context{}.WithLogger(log.NewNopLogger()).WithBlockHeight(10)
+	cfg := &EVMConfig{}
+	cfg.TxConfig.TxHash = common.HexToHash("0x01")
+
+	logs := []*types.Log{{
+		Address: common.HexToAddress("0x02").Hex(),
+		Topics:  []string{common.HexToHash("0x03").Hex()},
+		Data:    []byte{1, 2, 3},
+	}}
+	enclave := &types.MsgEthereumTxResponse{GasUsed: 21000, Ret: []byte{1}, Logs: logs}
+
+	testCases := []struct {
+		name       string
+		enclaveErr error
+		shadow     *shadowResult
+		fields     []string
+	}{
+		{
+			"identical results",
+			nil,
+			&shadowResult{res: &types.MsgEthereumTxResponse{GasUsed: 21000, Ret: []byte{1}, Logs: logs}},
+			nil,
+		},
+		{
+			"log index and tx hash are ignored",
+			nil,
+			&shadowResult{res: &types.MsgEthereumTxResponse{GasUsed: 21000, Ret: []byte{1}, Logs: []*types.Log{{
+				Address: logs[0].Address,
+				Topics:  logs[0].Topics,
+				Data:    logs[0].Data,
+				Index:   7,
+				TxHash:  common.HexToHash("0x04").Hex(),
+			}}}},
+			nil,
+		},
+		{
+			"diverging ret, gas and logs",
+			nil,
+			&shadowResult{res: &types.MsgEthereumTxResponse{GasUsed: 21001, Ret: []byte{2}, VmError: "execution reverted"}},
+			[]string{"ret", "vm_error", "gas_used", "logs"},
+		},
+		{
+			"in-process EVM failure",
+			nil,
+			&shadowResult{err: errors.New("in-process EVM panicked")},
+			[]string{"error"},
+		},
+		{
+			"enclave failure",
+			errors.New("SGX executor unavailable"),
+			&shadowResult{res: &types.MsgEthereumTxResponse{GasUsed: 21000, Ret: []byte{1}, Logs: logs}},
+			[]string{"error"},
+		},
+		{
+			"both executions failed",
+			errors.New("intrinsic gas too low"),
+			&shadowResult{err: errors.New("intrinsic gas too low")},
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := enclave
+			if tc.enclaveErr != nil {
+				res = nil
+			}
+			report := k.compareShadowResult(ctx, cfg, res, tc.enclaveErr, tc.shadow, false)
+			if tc.fields == nil {
+				require.Nil(t, report)
+				return
+			}
+
+			require.NotNil(t, report)
+			require.Equal(t, cfg.TxConfig.TxHash, report.TxHash)
+			require.Equal(t, int64(10), report.Height)
+			fields := make([]string, len(report.Divergences))
+			for i, d := range report.Divergences {
+				fields[i] = d.Field
+			}
+			require.Equal(t, tc.fields, fields)
+		})
+	}
+}
@@ -23,7 +23,6 @@ import (
 	cmttypes "github.com/cometbft/cometbft/types"
 
 	errorsmod "cosmossdk.io/errors"
-	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	ethermint "github.com/evmos/ethermint/types"
 	"github.com/evmos/ethermint/x/evm/types"
@@ -115,11 +114,18 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, msgEth *types.MsgEthereumTx)
 		bloomReceipt ethtypes.Bloom
 	)
 
-	ethTx := msgEth.AsTransaction()
-	cfg, err := k.EVMConfig(ctx, sdk.ConsAddress(ctx.BlockHeader().ProposerAddress), k.eip155ChainID, ethTx.Hash())
+	cfg, err := k.EVMConfig(ctx, sdk.ConsAddress(ctx.BlockHeader().ProposerAddress), k.eip155ChainID, msgEth.ComputeHash())
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to load evm config")
 	}
+	cfg.SetCodeAuthorizations = msgEth.GetSetCodeAuthorizations()
+	if payment, found := k.feeMarketKeeper.GetTransientFeePayment(ctx, cfg.TxConfig.TxHash); found {
+		cfg.FeePayment = &payment
+	}
+
+	if k.internalTxTracing {
+		cfg.InternalTxTracer = types.NewInternalTxTracer()
+	}
 
 	msg, err := msgEth.AsMessage(cfg.BaseFee)
 	if err != nil {
@@ -137,10 +143,15 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, msgEth *types.MsgEthereumTx)
 		tmpCtx, commit = ctx.CacheContext()
 	}
 
-	// pass true to commit the StateDB
-	res, err := k.ApplyMessageWithConfig(tmpCtx, msg, cfg, true)
-	if err != nil {
-		return nil, errorsmod.Wrap(err, "failed to apply ethereum core message")
+	// the transaction is only executed if its pre-execution can't be reused
+	k.preExecuteBlock(ctx, cfg)
+	res := k.applyPreExecuted(tmpCtx, cfg)
+	if res == nil {
+		// pass true to commit the StateDB
+		res, err = k.ApplyMessageWithConfig(tmpCtx, msg, cfg, true)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "failed to apply ethereum core message")
+		}
 	}
 
 	logs := types.LogsToEthereum(res.Logs)
@@ -167,7 +178,7 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, msgEth *types.MsgEthereumTx)
 	}
 
 	receipt := &ethtypes.Receipt{
-		Type:              ethTx.Type(),
+		Type:              msgEth.TxType(),
 		PostState:         nil, // TODO: intermediate state root
 		CumulativeGasUsed: cumulativeGasUsed,
 		Bloom:             bloomReceipt,
@@ -197,11 +208,13 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, msgEth *types.MsgEthereumTx)
 			res.Logs = types.NewLogsFromEth(receipt.Logs)
 		}
 	}
+	k.emitInternalTxs(ctx, cfg, res)
 
 	// refund gas in order to match the Ethereum gas consumption instead of the default SDK one.
-	if err = k.RefundGas(ctx, msg, msg.GasLimit-res.GasUsed, cfg.Params.EvmDenom); err != nil {
+	if err = k.RefundGasWithFeePayment(ctx, msg, msg.GasLimit-res.GasUsed, cfg.GasFeePayment()); err != nil {
 		return nil, errorsmod.Wrapf(err, "failed to refund gas leftover gas to sender %s", msg.From)
 	}
+	k.recordBlockFees(ctx, msg, res.GasUsed, cfg)
 
 	if len(receipt.Logs) > 0 {
 		// Update transient block bloom filter
@@ -284,7 +297,7 @@ func (k *Keeper) ApplyMessageWithConfig(
 	msg core.Message,
 	cfg *EVMConfig,
 	commit bool,
-) (*types.MsgEthereumTxResponse, error) {
+) (res *types.MsgEthereumTxResponse, err error) {
 	var (
 		ret   []byte // return bytes from evm execution
 		vmErr error  // vm errors do not effect consensus and are therefore not assigned to err
@@ -296,16 +309,48 @@ func (k *Keeper) ApplyMessageWithConfig(
 	} else if !cfg.Params.EnableCall && msg.To != nil {
 		return nil, errorsmod.Wrap(types.ErrCallDisabled, "failed to call contract")
 	}
+	if len(cfg.SetCodeAuthorizations) > 0 && !cfg.ChainConfig.IsPrague(big.NewInt(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())) {
+		return nil, errorsmod.Wrap(types.ErrTxTypeNotSupported, "set-code transactions are enabled by Prague")
+	}
 
-	sgxRPCClient, err := newSgxRPCClient(k.Logger(ctx))
+	// the in-process execution must happen before the enclave modifies the state, traces are
+	// not cross-checked to keep the tracer output untouched. The internal transactions are
+	// recorded by the same run, so the message is executed a single extra time when both
+	// the shadow execution and the internal transaction tracing are enabled.
+	var shadow *shadowResult
+	if (k.shadowExecution || cfg.InternalTxTracer != nil) && !cfg.DebugTrace {
+		shadow = k.shadowApplyMessage(ctx, msg, cfg)
+	}
+	if shadow != nil && k.shadowExecution {
+		// the enclave failures are reported too, the in-process EVM may have executed the message
+		defer func() {
+			if err != nil {
+				k.compareShadowResult(ctx, cfg, nil, err, shadow, false)
+			}
+		}()
+	}
+	defer func() {
+		// the failed pre-executions are executed again
+		if err != nil && cfg.journal == nil {
+			k.recordSgxFailure(ctx, err)
+		}
+	}()
+
+	sgxRPCClient, release, err := k.acquireSgxClient(ctx, cfg)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to create new SGX rpc client")
 	}
 
-	err = k.prepareTxForSgx(ctx, msg, cfg, sgxRPCClient)
+	releaseCtx, err := k.prepareTxForSgx(ctx, msg, cfg, sgxRPCClient)
 	if err != nil {
+		release()
 		return nil, errorsmod.Wrap(err, "failed to create new RPC server")
 	}
+	// the context is released once the session is over
+	defer func() {
+		release()
+		releaseCtx()
+	}()
 
 	leftoverGas := msg.GasLimit
 	sender := vm.AccountRef(msg.From)
@@ -363,6 +408,7 @@ func (k *Keeper) ApplyMessageWithConfig(
 		// should have already been checked on Ante Handler
 		return nil, errorsmod.Wrap(err, "intrinsic gas failed")
 	}
+	intrinsicGas += types.SetCodeIntrinsicGas(cfg.SetCodeAuthorizations)
 
 	// Should check again even if it is checked on Ante Handler, because eth_call don't go through Ante Handler.
 	if leftoverGas < intrinsicGas {
@@ -414,34 +460,41 @@ func (k *Keeper) ApplyMessageWithConfig(
 		// Ethermint original code:
 		// ret, _, leftoverGas, vmErr = evm.Create(sender, msg.Data, leftoverGas, msg.Value)
 		var reply CreateReply
-		vmErr = sgxRPCClient.Create(CreateArgs{
+		err = sgxRPCClient.Create(CreateArgs{
 			Caller: sender,
 			Code:   msg.Data,
 			Gas:    leftoverGas,
 			Value:  msg.Value,
 		}, &reply)
-		ret = reply.Ret
-		leftoverGas = reply.LeftOverGas
+		if err != nil {
+			return nil, err
+		}
+		ret, leftoverGas, vmErr = reply.Ret, reply.LeftOverGas, reply.VmErr
 
 		// Ethermint original code:
 		// stateDB.SetNonce(sender.Address(), msg.Nonce+1)
-		sgxRPCClient.StateDBSetNonce(StateDBSetNonceArgs{
+		err = sgxRPCClient.StateDBSetNonce(StateDBSetNonceArgs{
 			Caller: sender,
 			Nonce:  msg.Nonce + 1,
 		}, &replyNonce)
+		if err != nil {
+			return nil, err
+		}
 	} else {
 		// Ethermint original code:
 		// ret, leftoverGas, vmErr = evm.Call(sender, *msg.To, msg.Data, leftoverGas, msg.Value)
 		var reply CallReply
-		vmErr = sgxRPCClient.Call(CallArgs{
+		err = sgxRPCClient.Call(CallArgs{
 			Caller: sender,
 			Addr:   *msg.To,
 			Input:  msg.Data,
 			Gas:    leftoverGas,
 			Value:  msg.Value,
 		}, &reply)
-		ret = reply.Ret
-		leftoverGas = reply.LeftOverGas
+		if err != nil {
+			return nil, err
+		}
+		ret, leftoverGas, vmErr = reply.Ret, reply.LeftOverGas, reply.VmErr
 	}
 
 	refundQuotient := params.RefundQuotient
@@ -491,22 +544,11 @@ func (k *Keeper) ApplyMessageWithConfig(
 		}
 	}
 
-	// calculate a minimum amount of gas to be charged to sender if GasLimit
-	// is considerably higher than GasUsed to stay more aligned with Tendermint gas mechanics
-	// for more info https://github.com/evmos/ethermint/issues/1085
-	gasLimit := sdkmath.LegacyNewDec(int64(msg.GasLimit))
-	minGasMultiplier := cfg.FeeMarketParams.MinGasMultiplier
-	if minGasMultiplier.IsNil() {
-		// in case we are executing eth_call on a legacy block, returns a zero value.
-		minGasMultiplier = sdkmath.LegacyZeroDec()
-	}
-	minimumGasUsed := gasLimit.Mul(minGasMultiplier)
-
 	if msg.GasLimit < leftoverGas {
 		return nil, errorsmod.Wrapf(types.ErrGasOverflow, "message gas limit < leftover gas (%d < %d)", msg.GasLimit, leftoverGas)
 	}
 
-	gasUsed := sdkmath.LegacyMaxDec(minimumGasUsed, sdkmath.LegacyNewDec(int64(temporaryGasUsed))).TruncateInt().Uint64()
+	gasUsed := minimumGasUsed(msg, cfg, temporaryGasUsed)
 	// reset leftoverGas, to be used by the tracer
 	leftoverGas = msg.GasLimit - gasUsed
 
@@ -518,54 +560,88 @@ func (k *Keeper) ApplyMessageWithConfig(
 		return nil, err
 	}
 
-	return &types.MsgEthereumTxResponse{
+	res = &types.MsgEthereumTxResponse{
 		GasUsed:   gasUsed,
 		VmError:   vmError,
 		Ret:       ret,
 		Logs:      types.NewLogsFromEth(replyLog.Logs),
 		Hash:      cfg.TxConfig.TxHash.Hex(),
 		BlockHash: ctx.HeaderHash(),
-	}, nil
+	}
+
+	if shadow != nil && k.shadowExecution {
+		// the report never affects the result, which is always the enclave one
+		k.compareShadowResult(ctx, cfg, res, nil, shadow, commit)
+	}
+
+	return res, nil
 }
 
-// prepareTxForSgx prepares the transaction for the SGX enclave. It:
-//   - creates an RPC server around the keeper to receive requests sent by the
-//     SGX
-//   - sends a "PrepareTx" request to the SGX enclave with the relevant tx and
-//     block info
-func (k *Keeper) prepareTxForSgx(ctx sdk.Context, msg core.Message, cfg *EVMConfig, sgxRPCClient *sgxRPCClient) error {
-	// Step 1. Send a "PrepareTx" request to the SGX enclave.
-	ChainConfigJson, err := json.Marshal(cfg.ChainConfig)
-	if err != nil {
-		return err
+// prepareTxForSgx prepares the transaction for the SGX enclave. It sends a
+// "PrepareTx" request to the SGX enclave with the relevant tx and block info,
+// opening the session the following requests operate on. The returned function
+// releases the node context of the session once it's over.
+func (k *Keeper) prepareTxForSgx(ctx sdk.Context, msg core.Message, cfg *EVMConfig, sgxRPCClient *sgxRPCClient) (func(), error) {
+	version := sgxRPCClient.executor.protocolVersion()
+	if len(cfg.SetCodeAuthorizations) > 0 && version < types.EnclaveSetCodeVersion {
+		return nil, errorsmod.Wrapf(
+			types.ErrTxTypeNotSupported,
+			"SGX executor %s doesn't support set-code transactions", sgxRPCClient.executor.addr,
+		)
 	}
 
-	var overrides []byte
+	if len(msg.BlobHashes) > 0 && version < types.EnclaveBlobVersion {
+		return nil, errorsmod.Wrapf(
+			types.ErrTxTypeNotSupported,
+			"SGX executor %s doesn't support blob transactions", sgxRPCClient.executor.addr,
+		)
+	}
+
+	var (
+		overrides []byte
+		err       error
+	)
 	if cfg.Overrides != nil {
 		overrides, err = json.Marshal(cfg.Overrides)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
-	ctx.HeaderHash()
 	args := PrepareTxArgs{
+		TxHash: cfg.TxConfig.TxHash.Bytes(),
 		Header: ctx.BlockHeader(),
 		Msg:    msg,
 		EvmConfig: PrepareTxEVMConfig{
-			ChainConfigJson: ChainConfigJson,
-			CoinBase:        cfg.CoinBase,
-			BaseFee:         cfg.BaseFee,
-			TxConfig:        cfg.TxConfig,
-			DebugTrace:      cfg.DebugTrace,
-			NoBaseFee:       cfg.FeeMarketParams.NoBaseFee,
-			EvmDenom:        cfg.Params.EvmDenom,
-			Overrides:       overrides,
+			ChainConfig: cfg.Params.ChainConfig,
+			ChainID:     cfg.ChainConfig.ChainID,
+			CoinBase:    cfg.CoinBase,
+			BaseFee:     cfg.BaseFee,
+			BlobBaseFee: cfg.BlobBaseFee,
+			TxConfig:    cfg.TxConfig,
+			DebugTrace:  cfg.DebugTrace,
+			NoBaseFee:   cfg.FeeMarketParams.NoBaseFee,
+			EvmDenom:    cfg.Params.EvmDenom,
+			ExtraEips:   cfg.Params.ExtraEIPs,
+			Overrides:   overrides,
 		},
+		Authorizations: cfg.SetCodeAuthorizations,
 	}
 
-	// Snapshot the ctx
-	k.preparedCtx = ctx
+	// the older executors make their callbacks on the last prepared context
+	release := func() {}
+	if version >= types.EnclaveContextVersion {
+		args.ContextID, release = k.sgxContexts.register(sgxContext{ctx: ctx, journal: cfg.journal})
+	} else {
+		if cfg.journal != nil {
+			return nil, fmt.Errorf("SGX executor %s doesn't support concurrent sessions", sgxRPCClient.executor.addr)
+		}
+		k.preparedCtx = ctx
+	}
 
-	return sgxRPCClient.PrepareTx(args, &PrepareTxReply{})
+	if err := sgxRPCClient.PrepareTx(args, &PrepareTxReply{}); err != nil {
+		release()
+		return nil, err
+	}
+	return release, nil
 }
@@ -518,6 +518,56 @@ func (suite *StateTransitionTestSuite) TestRefundGas() {
 	suite.mintFeeCollector = false
 }
 
+func (suite *StateTransitionTestSuite) TestRefundGasWithFeePayment() {
+	suite.SetupTest()
+	coins := sdk.NewCoins(sdk.NewCoin("ibc/usdc", sdkmath.NewInt(1000)))
+	suite.Require().NoError(suite.App.BankKeeper.MintCoins(suite.Ctx, types.ModuleName, coins))
+	suite.Require().NoError(suite.App.BankKeeper.SendCoinsFromModuleToModule(suite.Ctx, types.ModuleName, authtypes.FeeCollectorName, coins))
+
+	from := tests.GenerateAddress()
+	msg := core.Message{From: from, GasPrice: big.NewInt(10)}
+	payment := feemarkettypes.NewFeePayment("ibc/usdc", sdkmath.LegacyNewDecWithPrec(5, 1))
+
+	// 100 leftover gas at 10 per gas, converted at 0.5
+	suite.Require().NoError(suite.App.EvmKeeper.RefundGasWithFeePayment(suite.Ctx, msg, 100, payment))
+	suite.Require().Equal(big.NewInt(500), suite.App.EvmKeeper.GetBalance(suite.Ctx, from.Bytes(), "ibc/usdc"))
+	suite.Require().Zero(suite.App.EvmKeeper.GetBalance(suite.Ctx, from.Bytes(), types.DefaultEVMDenom).Sign())
+
+	// the refund is capped by the fee collector balance
+	suite.Require().Error(suite.App.EvmKeeper.RefundGasWithFeePayment(suite.Ctx, msg, 101, payment))
+}
+
+func (suite *StateTransitionTestSuite) TestRecordBlockFeesWithFeePayment() {
+	suite.SetupTest()
+	// a price of a third of the evm denom rounds the conversions
+	payment := feemarkettypes.NewFeePayment("ibc/usdc", sdkmath.LegacyOneDec().QuoInt64(3))
+	msg := core.Message{From: tests.GenerateAddress(), GasLimit: 100, GasPrice: big.NewInt(7)}
+	gasUsed := uint64(61)
+	collector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+
+	// the fee charged by the ante handler, less the refund of the leftover gas
+	charged := sdk.NewCoins(payment.ConvertFee(sdkmath.NewInt(700)))
+	suite.Require().NoError(suite.App.BankKeeper.MintCoins(suite.Ctx, types.ModuleName, charged))
+	suite.Require().NoError(suite.App.BankKeeper.SendCoinsFromModuleToModule(suite.Ctx, types.ModuleName, authtypes.FeeCollectorName, charged))
+	suite.Require().NoError(suite.App.EvmKeeper.RefundGasWithFeePayment(suite.Ctx, msg, msg.GasLimit-gasUsed, payment))
+
+	cfg := &keeper.EVMConfig{
+		Params:     types.DefaultParams(),
+		BaseFee:    big.NewInt(5),
+		CoinBase:   tests.GenerateAddress(),
+		FeePayment: &payment,
+	}
+	suite.App.EvmKeeper.RecordBlockFees(suite.Ctx, msg, gasUsed, cfg)
+
+	// the block fees are the fees kept by the fee collector, the tip taking the rounding
+	fees := suite.App.FeeMarketKeeper.GetTransientBlockFees(suite.Ctx)
+	suite.Require().Equal(sdkmath.NewInt(101), fees.BaseFees.AmountOf("ibc/usdc"))
+	suite.Require().Equal(
+		suite.App.BankKeeper.GetBalance(suite.Ctx, collector, "ibc/usdc").Amount,
+		fees.BaseFees.Add(fees.PriorityFees...).AmountOf("ibc/usdc"),
+	)
+}
+
 func (suite *StateTransitionTestSuite) TestResetGasMeterAndConsumeGas() {
 	testCases := []struct {
 		name        string
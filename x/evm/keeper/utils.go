@@ -111,6 +111,7 @@ func VerifyFee(
 			isContractCreation, homestead, istanbul, shanghai,
 		)
 	}
+	intrinsicGas += types.SetCodeIntrinsicGas(types.GetSetCodeAuthorizations(txData))
 
 	// intrinsic gas verification during CheckTx
 	if isCheckTx && gasLimit < intrinsicGas {
@@ -0,0 +1,175 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package statedb
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// errStaleRead is returned by the replay of a read returning another value than
+// the recorded one.
+var errStaleRead = errors.New("stale read")
+
+// accessEntry replays a single access of a session on the keeper.
+type accessEntry func(ctx sdk.Context, keeper Keeper) error
+
+// AccessJournal records, in order, the reads and writes of a StateDB session
+// made through the Keeper: accounts, balances, storage slots and code. It's the
+// read and write set of the session, at the granularity of the StateDB.
+//
+// Replaying the journal on another state reproduces the writes of the session
+// as long as every read returns the recorded value: the session depends on the
+// state only through its reads, it would have made the same accesses. The block
+// hashes are not recorded, they don't change within a block.
+//
+// It's safe for concurrent use.
+type AccessJournal struct {
+	mu      sync.Mutex
+	entries []accessEntry
+}
+
+// NewAccessJournal returns an empty journal.
+func NewAccessJournal() *AccessJournal {
+	return &AccessJournal{}
+}
+
+func (j *AccessJournal) append(entry accessEntry) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.entries = append(j.entries, entry)
+}
+
+// GetAccount records the read of an account, nil if it doesn't exist.
+func (j *AccessJournal) GetAccount(addr common.Address, account *Account) {
+	var read *Account
+	if account != nil {
+		read = &Account{Nonce: account.Nonce, CodeHash: common.CopyBytes(account.CodeHash)}
+	}
+	j.append(func(ctx sdk.Context, keeper Keeper) error {
+		current := keeper.GetAccount(ctx, addr)
+		if (current == nil) != (read == nil) {
+			return errStaleRead
+		}
+		if current != nil && (current.Nonce != read.Nonce || !bytes.Equal(current.CodeHash, read.CodeHash)) {
+			return errStaleRead
+		}
+		return nil
+	})
+}
+
+// GetBalance records the read of the balance of an account.
+func (j *AccessJournal) GetBalance(addr sdk.AccAddress, denom string, balance *big.Int) {
+	read := new(big.Int).Set(balance)
+	j.append(func(ctx sdk.Context, keeper Keeper) error {
+		if keeper.GetBalance(ctx, addr, denom).Cmp(read) != 0 {
+			return errStaleRead
+		}
+		return nil
+	})
+}
+
+// GetState records the read of a storage slot.
+func (j *AccessJournal) GetState(addr common.Address, key, value common.Hash) {
+	j.append(func(ctx sdk.Context, keeper Keeper) error {
+		if keeper.GetState(ctx, addr, key) != value {
+			return errStaleRead
+		}
+		return nil
+	})
+}
+
+// GetCode records the read of a code.
+func (j *AccessJournal) GetCode(codeHash common.Hash, code []byte) {
+	read := common.CopyBytes(code)
+	j.append(func(ctx sdk.Context, keeper Keeper) error {
+		if !bytes.Equal(keeper.GetCode(ctx, codeHash), read) {
+			return errStaleRead
+		}
+		return nil
+	})
+}
+
+// AddBalance records an increase of the balance of an account.
+func (j *AccessJournal) AddBalance(addr sdk.AccAddress, coins sdk.Coins) {
+	coins = append(sdk.Coins(nil), coins...)
+	j.append(func(ctx sdk.Context, keeper Keeper) error {
+		return keeper.AddBalance(ctx, addr, coins)
+	})
+}
+
+// SubBalance records a decrease of the balance of an account.
+func (j *AccessJournal) SubBalance(addr sdk.AccAddress, coins sdk.Coins) {
+	coins = append(sdk.Coins(nil), coins...)
+	j.append(func(ctx sdk.Context, keeper Keeper) error {
+		return keeper.SubBalance(ctx, addr, coins)
+	})
+}
+
+// SetAccount records the write of an account.
+func (j *AccessJournal) SetAccount(addr common.Address, account Account) {
+	account.CodeHash = common.CopyBytes(account.CodeHash)
+	j.append(func(ctx sdk.Context, keeper Keeper) error {
+		return keeper.SetAccount(ctx, addr, account)
+	})
+}
+
+// SetState records the write of a storage slot.
+func (j *AccessJournal) SetState(addr common.Address, key common.Hash, value []byte) {
+	value = common.CopyBytes(value)
+	j.append(func(ctx sdk.Context, keeper Keeper) error {
+		keeper.SetState(ctx, addr, key, value)
+		return nil
+	})
+}
+
+// SetCode records the write of a code.
+func (j *AccessJournal) SetCode(codeHash, code []byte) {
+	codeHash, code = common.CopyBytes(codeHash), common.CopyBytes(code)
+	j.append(func(ctx sdk.Context, keeper Keeper) error {
+		keeper.SetCode(ctx, codeHash, code)
+		return nil
+	})
+}
+
+// DeleteAccount records the deletion of an account.
+func (j *AccessJournal) DeleteAccount(addr common.Address) {
+	j.append(func(ctx sdk.Context, keeper Keeper) error {
+		return keeper.DeleteAccount(ctx, addr)
+	})
+}
+
+// Replay replays the accesses in order on ctx. It returns false if a read
+// returns another value than the recorded one, the writes already replayed on
+// ctx must then be discarded.
+func (j *AccessJournal) Replay(ctx sdk.Context, keeper Keeper) (bool, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	for _, entry := range j.entries {
+		if err := entry(ctx, keeper); err != nil {
+			if errors.Is(err, errStaleRead) {
+				return false, nil
+			}
+			return false, err
+		}
+	}
+	return true, nil
+}
@@ -647,6 +647,19 @@ func (s *StateDB) Commit() error {
 	return nil
 }
 
+// Dirties returns the accounts modified by the state transition, sorted by
+// address, along with the sorted storage keys written for each of them.
+func (s *StateDB) Dirties() ([]common.Address, map[common.Address][]common.Hash) {
+	addrs := s.journal.sortedDirties()
+	keys := make(map[common.Address][]common.Hash, len(addrs))
+	for _, addr := range addrs {
+		if obj := s.stateObjects[addr]; obj != nil {
+			keys[addr] = obj.dirtyStorage.SortedKeys()
+		}
+	}
+	return addrs, keys
+}
+
 func (s *StateDB) emitNativeEvents(contract common.Address, converter EventConverter, events []sdk.Event) {
 	if converter == nil {
 		return
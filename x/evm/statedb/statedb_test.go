@@ -750,6 +750,36 @@ func (suite *StateDBTestSuite) TestSetStorage() {
 	suite.Require().Equal(common.Hash{}, stateDB.GetState(contract, common.BigToHash(big.NewInt(2))))
 }
 
+func (suite *StateDBTestSuite) TestDirties() {
+	key1 := common.BigToHash(big.NewInt(1))
+	key2 := common.BigToHash(big.NewInt(2))
+	value := common.BigToHash(big.NewInt(3))
+
+	_, ctx, keeper := setupTestEnv(suite.T())
+	db := statedb.New(ctx, keeper, emptyTxConfig)
+
+	addrs, keys := db.Dirties()
+	suite.Require().Empty(addrs)
+	suite.Require().Empty(keys)
+
+	db.SetState(address2, key2, value)
+	db.SetState(address2, key1, value)
+	db.SetNonce(address, 1)
+
+	addrs, keys = db.Dirties()
+	suite.Require().Equal([]common.Address{address, address2}, addrs)
+	suite.Require().Empty(keys[address])
+	suite.Require().Equal([]common.Hash{key1, key2}, keys[address2])
+
+	// reverted changes are not reported
+	db = statedb.New(ctx, keeper, emptyTxConfig)
+	rev := db.Snapshot()
+	db.SetState(address, key1, value)
+	db.RevertToSnapshot(rev)
+	addrs, _ = db.Dirties()
+	suite.Require().Empty(addrs)
+}
+
 type StateDBWithForEachStorage interface {
 	ForEachStorage(common.Address, func(common.Hash, common.Hash) bool) error
 }
@@ -0,0 +1,488 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
+
+	"github.com/evmos/ethermint/types"
+)
+
+// MaxBlobsPerTx is the maximum number of blobs a single transaction can carry,
+// bounded by the blob gas available in a block.
+const MaxBlobsPerTx = params.MaxBlobGasPerBlock / params.BlobTxBlobGasPerBlob
+
+func newBlobTx(tx *ethtypes.Transaction) (*BlobTx, error) {
+	txData := &BlobTx{
+		Nonce:    tx.Nonce(),
+		Data:     tx.Data(),
+		GasLimit: tx.Gas(),
+	}
+
+	v, r, s := tx.RawSignatureValues()
+	if to := tx.To(); to != nil {
+		txData.To = to.Hex()
+	}
+
+	if tx.Value() != nil {
+		amountInt, err := types.SafeNewIntFromBigInt(tx.Value())
+		if err != nil {
+			return nil, err
+		}
+		txData.Amount = &amountInt
+	}
+
+	if tx.GasFeeCap() != nil {
+		gasFeeCapInt, err := types.SafeNewIntFromBigInt(tx.GasFeeCap())
+		if err != nil {
+			return nil, err
+		}
+		txData.GasFeeCap = &gasFeeCapInt
+	}
+
+	if tx.GasTipCap() != nil {
+		gasTipCapInt, err := types.SafeNewIntFromBigInt(tx.GasTipCap())
+		if err != nil {
+			return nil, err
+		}
+		txData.GasTipCap = &gasTipCapInt
+	}
+
+	if tx.BlobGasFeeCap() != nil {
+		blobFeeCapInt, err := types.SafeNewIntFromBigInt(tx.BlobGasFeeCap())
+		if err != nil {
+			return nil, err
+		}
+		txData.BlobFeeCap = &blobFeeCapInt
+	}
+
+	if tx.AccessList() != nil {
+		al := tx.AccessList()
+		txData.Accesses = NewAccessList(&al)
+	}
+
+	for _, hash := range tx.BlobHashes() {
+		txData.BlobHashes = append(txData.BlobHashes, hash.Hex())
+	}
+
+	txData.Sidecar = NewBlobTxSidecar(tx.BlobTxSidecar())
+	txData.SetSignatureValues(tx.ChainId(), v, r, s)
+	return txData, nil
+}
+
+// NewBlobTxSidecar returns the proto representation of an ethereum blob sidecar.
+func NewBlobTxSidecar(sidecar *ethtypes.BlobTxSidecar) *BlobTxSidecar {
+	if sidecar == nil {
+		return nil
+	}
+
+	sc := &BlobTxSidecar{
+		Blobs:       make([][]byte, len(sidecar.Blobs)),
+		Commitments: make([][]byte, len(sidecar.Commitments)),
+		Proofs:      make([][]byte, len(sidecar.Proofs)),
+	}
+	for i := range sidecar.Blobs {
+		sc.Blobs[i] = common.CopyBytes(sidecar.Blobs[i][:])
+	}
+	for i := range sidecar.Commitments {
+		sc.Commitments[i] = common.CopyBytes(sidecar.Commitments[i][:])
+	}
+	for i := range sidecar.Proofs {
+		sc.Proofs[i] = common.CopyBytes(sidecar.Proofs[i][:])
+	}
+	return sc
+}
+
+// ToEthSidecar converts the sidecar to its ethereum representation. Elements
+// with a wrong length are rejected.
+func (sc *BlobTxSidecar) ToEthSidecar() (*ethtypes.BlobTxSidecar, error) {
+	if sc == nil {
+		return nil, nil
+	}
+
+	sidecar := &ethtypes.BlobTxSidecar{
+		Blobs:       make([]kzg4844.Blob, len(sc.Blobs)),
+		Commitments: make([]kzg4844.Commitment, len(sc.Commitments)),
+		Proofs:      make([]kzg4844.Proof, len(sc.Proofs)),
+	}
+	for i, blob := range sc.Blobs {
+		if len(blob) != len(kzg4844.Blob{}) {
+			return nil, errorsmod.Wrapf(ErrInvalidBlob, "blob %d has invalid length %d", i, len(blob))
+		}
+		copy(sidecar.Blobs[i][:], blob)
+	}
+	for i, commitment := range sc.Commitments {
+		if len(commitment) != len(kzg4844.Commitment{}) {
+			return nil, errorsmod.Wrapf(ErrInvalidBlob, "commitment %d has invalid length %d", i, len(commitment))
+		}
+		copy(sidecar.Commitments[i][:], commitment)
+	}
+	for i, proof := range sc.Proofs {
+		if len(proof) != len(kzg4844.Proof{}) {
+			return nil, errorsmod.Wrapf(ErrInvalidBlob, "proof %d has invalid length %d", i, len(proof))
+		}
+		copy(sidecar.Proofs[i][:], proof)
+	}
+	return sidecar, nil
+}
+
+// TxType returns the tx type
+func (tx *BlobTx) TxType() uint8 {
+	return ethtypes.BlobTxType
+}
+
+// Copy returns an instance with the same field values
+func (tx *BlobTx) Copy() TxData {
+	return &BlobTx{
+		ChainID:    tx.ChainID,
+		Nonce:      tx.Nonce,
+		GasTipCap:  tx.GasTipCap,
+		GasFeeCap:  tx.GasFeeCap,
+		GasLimit:   tx.GasLimit,
+		To:         tx.To,
+		Amount:     tx.Amount,
+		Data:       common.CopyBytes(tx.Data),
+		Accesses:   tx.Accesses,
+		BlobFeeCap: tx.BlobFeeCap,
+		BlobHashes: append([]string(nil), tx.BlobHashes...),
+		Sidecar:    tx.Sidecar,
+		V:          common.CopyBytes(tx.V),
+		R:          common.CopyBytes(tx.R),
+		S:          common.CopyBytes(tx.S),
+	}
+}
+
+// GetChainID returns the chain id field from the BlobTx
+func (tx *BlobTx) GetChainID() *big.Int {
+	if tx.ChainID == nil {
+		return nil
+	}
+
+	return tx.ChainID.BigInt()
+}
+
+// GetAccessList returns the AccessList field.
+func (tx *BlobTx) GetAccessList() ethtypes.AccessList {
+	if tx.Accesses == nil {
+		return nil
+	}
+	return *tx.Accesses.ToEthAccessList()
+}
+
+// GetData returns the a copy of the input data bytes.
+func (tx *BlobTx) GetData() []byte {
+	return common.CopyBytes(tx.Data)
+}
+
+// GetGas returns the gas limit.
+func (tx *BlobTx) GetGas() uint64 {
+	return tx.GasLimit
+}
+
+// GetGasPrice returns the gas fee cap field.
+func (tx *BlobTx) GetGasPrice() *big.Int {
+	return tx.GetGasFeeCap()
+}
+
+// GetGasTipCap returns the gas tip cap field.
+func (tx *BlobTx) GetGasTipCap() *big.Int {
+	if tx.GasTipCap == nil {
+		return nil
+	}
+	return tx.GasTipCap.BigInt()
+}
+
+// GetGasFeeCap returns the gas fee cap field.
+func (tx *BlobTx) GetGasFeeCap() *big.Int {
+	if tx.GasFeeCap == nil {
+		return nil
+	}
+	return tx.GasFeeCap.BigInt()
+}
+
+// GetBlobFeeCap returns the blob gas fee cap field.
+func (tx *BlobTx) GetBlobFeeCap() *big.Int {
+	if tx.BlobFeeCap == nil {
+		return nil
+	}
+	return tx.BlobFeeCap.BigInt()
+}
+
+// GetBlobVersionedHashes returns the versioned hashes of the blobs.
+func (tx *BlobTx) GetBlobVersionedHashes() []common.Hash {
+	hashes := make([]common.Hash, len(tx.BlobHashes))
+	for i, hash := range tx.BlobHashes {
+		hashes[i] = common.HexToHash(hash)
+	}
+	return hashes
+}
+
+// BlobGas returns the blob gas consumed by the transaction.
+func (tx *BlobTx) BlobGas() uint64 {
+	return params.BlobTxBlobGasPerBlob * uint64(len(tx.BlobHashes))
+}
+
+// GetValue returns the tx amount.
+func (tx *BlobTx) GetValue() *big.Int {
+	if tx.Amount == nil {
+		return nil
+	}
+
+	return tx.Amount.BigInt()
+}
+
+// GetNonce returns the account sequence for the transaction.
+func (tx *BlobTx) GetNonce() uint64 { return tx.Nonce }
+
+// GetTo returns the pointer to the recipient address.
+func (tx *BlobTx) GetTo() *common.Address {
+	if tx.To == "" {
+		return nil
+	}
+	to := common.HexToAddress(tx.To)
+	return &to
+}
+
+// AsEthereumData returns an BlobTx transaction tx from the proto-formatted
+// TxData defined on the Cosmos EVM. The sidecar is attached when it can be
+// converted, it doesn't affect the transaction hash nor its signature.
+func (tx *BlobTx) AsEthereumData() ethtypes.TxData {
+	v, r, s := tx.GetRawSignatureValues()
+	sidecar, _ := tx.Sidecar.ToEthSidecar()
+	return &ethtypes.BlobTx{
+		ChainID:    uint256MustFromBig(tx.GetChainID()),
+		Nonce:      tx.GetNonce(),
+		GasTipCap:  uint256MustFromBig(tx.GetGasTipCap()),
+		GasFeeCap:  uint256MustFromBig(tx.GetGasFeeCap()),
+		Gas:        tx.GetGas(),
+		To:         common.HexToAddress(tx.To),
+		Value:      uint256MustFromBig(tx.GetValue()),
+		Data:       tx.GetData(),
+		AccessList: tx.GetAccessList(),
+		BlobFeeCap: uint256MustFromBig(tx.GetBlobFeeCap()),
+		BlobHashes: tx.GetBlobVersionedHashes(),
+		Sidecar:    sidecar,
+		V:          uint256MustFromBig(v),
+		R:          uint256MustFromBig(r),
+		S:          uint256MustFromBig(s),
+	}
+}
+
+// GetRawSignatureValues returns the V, R, S signature values of the transaction.
+// The return values should not be modified by the caller.
+func (tx *BlobTx) GetRawSignatureValues() (v, r, s *big.Int) {
+	return rawSignatureValues(tx.V, tx.R, tx.S)
+}
+
+// SetSignatureValues sets the signature values to the transaction.
+func (tx *BlobTx) SetSignatureValues(chainID, v, r, s *big.Int) {
+	if v != nil {
+		tx.V = v.Bytes()
+	}
+	if r != nil {
+		tx.R = r.Bytes()
+	}
+	if s != nil {
+		tx.S = s.Bytes()
+	}
+	if chainID != nil {
+		chainIDInt := sdkmath.NewIntFromBigInt(chainID)
+		tx.ChainID = &chainIDInt
+	}
+}
+
+// Validate performs a stateless validation of the tx fields. The KZG proofs of
+// the sidecar are checked separately by VerifySidecar as they are expensive.
+func (tx BlobTx) Validate() error {
+	if tx.GasTipCap == nil {
+		return errorsmod.Wrap(ErrInvalidGasCap, "gas tip cap cannot nil")
+	}
+
+	if tx.GasFeeCap == nil {
+		return errorsmod.Wrap(ErrInvalidGasCap, "gas fee cap cannot nil")
+	}
+
+	if tx.BlobFeeCap == nil {
+		return errorsmod.Wrap(ErrInvalidGasCap, "blob fee cap cannot nil")
+	}
+
+	if tx.GasTipCap.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidGasCap, "gas tip cap cannot be negative %s", tx.GasTipCap)
+	}
+
+	if tx.GasFeeCap.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidGasCap, "gas fee cap cannot be negative %s", tx.GasFeeCap)
+	}
+
+	if tx.BlobFeeCap.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidGasCap, "blob fee cap cannot be negative %s", tx.BlobFeeCap)
+	}
+
+	if !types.IsValidInt256(tx.GetGasTipCap()) {
+		return errorsmod.Wrap(ErrInvalidGasCap, "out of bound")
+	}
+
+	if !types.IsValidInt256(tx.GetGasFeeCap()) {
+		return errorsmod.Wrap(ErrInvalidGasCap, "out of bound")
+	}
+
+	if !types.IsValidInt256(tx.GetBlobFeeCap()) {
+		return errorsmod.Wrap(ErrInvalidGasCap, "out of bound")
+	}
+
+	if tx.GasFeeCap.LT(*tx.GasTipCap) {
+		return errorsmod.Wrapf(
+			ErrInvalidGasCap, "max priority fee per gas higher than max fee per gas (%s > %s)",
+			tx.GasTipCap, tx.GasFeeCap,
+		)
+	}
+
+	if !types.IsValidInt256(tx.Fee()) {
+		return errorsmod.Wrap(ErrInvalidGasFee, "out of bound")
+	}
+
+	amount := tx.GetValue()
+	// Amount can be 0
+	if amount != nil && amount.Sign() == -1 {
+		return errorsmod.Wrapf(ErrInvalidAmount, "amount cannot be negative %s", amount)
+	}
+	if !types.IsValidInt256(amount) {
+		return errorsmod.Wrap(ErrInvalidAmount, "out of bound")
+	}
+
+	// blob transactions can't create contracts
+	if err := types.ValidateAddress(tx.To); err != nil {
+		return errorsmod.Wrap(err, "invalid to address")
+	}
+
+	if len(tx.BlobHashes) == 0 {
+		return errorsmod.Wrap(ErrInvalidBlob, "blob transaction without blobs")
+	}
+	if len(tx.BlobHashes) > MaxBlobsPerTx {
+		return errorsmod.Wrapf(ErrInvalidBlob, "too many blobs (%d > %d)", len(tx.BlobHashes), MaxBlobsPerTx)
+	}
+	for i, hash := range tx.BlobHashes {
+		bz := common.FromHex(hash)
+		if len(bz) != common.HashLength {
+			return errorsmod.Wrapf(ErrInvalidBlob, "blob hash %d has invalid length %d", i, len(bz))
+		}
+		if bz[0] != params.BlobTxHashVersion {
+			return errorsmod.Wrapf(ErrInvalidBlob, "blob hash %d has invalid version %d", i, bz[0])
+		}
+	}
+
+	// the sidecar is stripped from the transactions included in the blocks
+	if tx.Sidecar == nil {
+		return tx.validateChainID()
+	}
+	sidecar, err := tx.Sidecar.ToEthSidecar()
+	if err != nil {
+		return err
+	}
+	if len(sidecar.Blobs) != len(tx.BlobHashes) ||
+		len(sidecar.Commitments) != len(tx.BlobHashes) ||
+		len(sidecar.Proofs) != len(tx.BlobHashes) {
+		return errorsmod.Wrapf(
+			ErrInvalidBlob, "sidecar doesn't match blob hashes (%d blobs, %d commitments, %d proofs, %d hashes)",
+			len(sidecar.Blobs), len(sidecar.Commitments), len(sidecar.Proofs), len(tx.BlobHashes),
+		)
+	}
+	for i, hash := range sidecar.BlobHashes() {
+		if hash != common.HexToHash(tx.BlobHashes[i]) {
+			return errorsmod.Wrapf(ErrInvalidBlob, "commitment %d doesn't match blob hash %s", i, tx.BlobHashes[i])
+		}
+	}
+
+	return tx.validateChainID()
+}
+
+func (tx BlobTx) validateChainID() error {
+	if tx.GetChainID() == nil {
+		return errorsmod.Wrap(
+			errortypes.ErrInvalidChainID,
+			"chain ID must be present on Blob txs",
+		)
+	}
+	return nil
+}
+
+// VerifySidecar verifies the KZG proofs of the blobs against their commitments.
+// It's only done in CheckTx, the sidecars are stripped from the transactions
+// included in the blocks.
+func (tx *BlobTx) VerifySidecar() error {
+	sidecar, err := tx.Sidecar.ToEthSidecar()
+	if err != nil {
+		return err
+	}
+	if sidecar == nil {
+		return errorsmod.Wrap(ErrInvalidBlob, "missing blob sidecar")
+	}
+	if len(sidecar.Commitments) != len(sidecar.Blobs) || len(sidecar.Proofs) != len(sidecar.Blobs) {
+		return errorsmod.Wrap(ErrInvalidBlob, "sidecar elements count mismatch")
+	}
+	for i := range sidecar.Blobs {
+		if err := kzg4844.VerifyBlobProof(sidecar.Blobs[i], sidecar.Commitments[i], sidecar.Proofs[i]); err != nil {
+			return errorsmod.Wrapf(ErrInvalidBlob, "invalid proof for blob %d: %s", i, err)
+		}
+	}
+	return nil
+}
+
+// Fee returns gasprice * gaslimit + blob_gasprice * blob_gas.
+func (tx BlobTx) Fee() *big.Int {
+	return new(big.Int).Add(fee(tx.GetGasFeeCap(), tx.GasLimit), fee(tx.GetBlobFeeCap(), tx.BlobGas()))
+}
+
+// Cost returns amount + gasprice * gaslimit + blob_gasprice * blob_gas.
+func (tx BlobTx) Cost() *big.Int {
+	return cost(tx.Fee(), tx.GetValue())
+}
+
+// EffectiveGasPrice returns the effective gas price
+func (tx *BlobTx) EffectiveGasPrice(baseFee *big.Int) *big.Int {
+	return EffectiveGasPrice(baseFee, tx.GasFeeCap.BigInt(), tx.GasTipCap.BigInt())
+}
+
+// EffectiveFee returns effective_gasprice * gaslimit. The blob gas is charged
+// separately at the blob base fee of the block.
+func (tx BlobTx) EffectiveFee(baseFee *big.Int) *big.Int {
+	return fee(tx.EffectiveGasPrice(baseFee), tx.GasLimit)
+}
+
+// EffectiveCost returns amount + effective_gasprice * gaslimit.
+func (tx BlobTx) EffectiveCost(baseFee *big.Int) *big.Int {
+	return cost(tx.EffectiveFee(baseFee), tx.GetValue())
+}
+
+// uint256MustFromBig converts a big integer which was validated to fit in 256
+// bits, nil is converted to zero.
+func uint256MustFromBig(i *big.Int) *uint256.Int {
+	if i == nil {
+		return new(uint256.Int)
+	}
+	u, _ := uint256.FromBig(i)
+	return u
+}
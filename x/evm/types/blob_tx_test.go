@@ -0,0 +1,173 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+	"github.com/evmos/ethermint/tests"
+)
+
+// newBlobSidecar returns a sidecar of a single blob whose first field element
+// is set to the given value.
+func newBlobSidecar(t *testing.T, value byte) *ethtypes.BlobTxSidecar {
+	var blob kzg4844.Blob
+	blob[31] = value
+	commitment, err := kzg4844.BlobToCommitment(blob)
+	require.NoError(t, err)
+	proof, err := kzg4844.ComputeBlobProof(blob, commitment)
+	require.NoError(t, err)
+	return &ethtypes.BlobTxSidecar{
+		Blobs:       []kzg4844.Blob{blob},
+		Commitments: []kzg4844.Commitment{commitment},
+		Proofs:      []kzg4844.Proof{proof},
+	}
+}
+
+func newSignedBlobTx(t *testing.T, chainID *big.Int, sidecar *ethtypes.BlobTxSidecar) (*ethtypes.Transaction, common.Address) {
+	addr, priv := tests.NewAddrKey()
+	key, err := priv.(*ethsecp256k1.PrivKey).ToECDSA()
+	require.NoError(t, err)
+
+	tx, err := ethtypes.SignNewTx(key, ethtypes.LatestSignerForChainID(chainID), &ethtypes.BlobTx{
+		ChainID:    uint256.MustFromBig(chainID),
+		Nonce:      1,
+		GasTipCap:  uint256.NewInt(1),
+		GasFeeCap:  uint256.NewInt(100),
+		Gas:        21000,
+		To:         tests.GenerateAddress(),
+		Value:      uint256.NewInt(10),
+		BlobFeeCap: uint256.NewInt(5),
+		BlobHashes: sidecar.BlobHashes(),
+		Sidecar:    sidecar,
+	})
+	require.NoError(t, err)
+	return tx, addr
+}
+
+func TestBlobTxRoundTrip(t *testing.T) {
+	chainID := big.NewInt(9000)
+	tx, from := newSignedBlobTx(t, chainID, newBlobSidecar(t, 1))
+
+	// the network encoding carries the sidecar
+	bz, err := tx.MarshalBinary()
+	require.NoError(t, err)
+
+	var msg MsgEthereumTx
+	require.NoError(t, msg.UnmarshalBinary(bz, chainID))
+	require.Equal(t, tx.Hash().Hex(), msg.Hash)
+	require.Equal(t, uint8(ethtypes.BlobTxType), msg.TxType())
+	require.Equal(t, from.Bytes(), msg.From)
+
+	txData, err := UnpackTxData(msg.Data)
+	require.NoError(t, err)
+	blobTx, ok := txData.(*BlobTx)
+	require.True(t, ok)
+	require.NoError(t, blobTx.Validate())
+	require.NoError(t, blobTx.VerifySidecar())
+	require.Equal(t, uint64(params.BlobTxBlobGasPerBlob), blobTx.BlobGas())
+	require.Equal(t, tx.BlobHashes(), blobTx.GetBlobVersionedHashes())
+
+	// the fee covers the blob gas at the blob fee cap
+	expFee := new(big.Int).SetUint64(100*21000 + 5*params.BlobTxBlobGasPerBlob)
+	require.Equal(t, expFee, blobTx.Fee())
+	require.Equal(t, new(big.Int).Add(expFee, big.NewInt(10)), blobTx.Cost())
+	require.Equal(t, big.NewInt(51*21000), blobTx.EffectiveFee(big.NewInt(50)))
+
+	// the sidecar is excluded from the hash
+	ethTx := msg.AsTransaction()
+	require.Equal(t, tx.Hash(), ethTx.Hash())
+	require.NotNil(t, ethTx.BlobTxSidecar())
+
+	ethMsg, err := msg.AsMessage(big.NewInt(50))
+	require.NoError(t, err)
+	require.Equal(t, tx.BlobHashes(), ethMsg.BlobHashes)
+	require.Equal(t, big.NewInt(5), ethMsg.BlobGasFeeCap)
+}
+
+func TestBlobTxValidate(t *testing.T) {
+	chainID := big.NewInt(9000)
+	tx, _ := newSignedBlobTx(t, chainID, newBlobSidecar(t, 1))
+	otherSidecar := NewBlobTxSidecar(newBlobSidecar(t, 2))
+
+	testCases := []struct {
+		name     string
+		malleate func(*BlobTx)
+		expError bool
+	}{
+		{"valid", func(*BlobTx) {}, false},
+		{"no blobs", func(tx *BlobTx) { tx.BlobHashes = nil }, true},
+		{"invalid hash version", func(tx *BlobTx) { tx.BlobHashes = []string{common.Hash{0x02}.Hex()} }, true},
+		{"stripped sidecar", func(tx *BlobTx) { tx.Sidecar = nil }, false},
+		{"sidecar count mismatch", func(tx *BlobTx) { tx.Sidecar.Proofs = nil }, true},
+		{"commitment mismatch", func(tx *BlobTx) { tx.Sidecar = otherSidecar }, true},
+		{"invalid blob length", func(tx *BlobTx) { tx.Sidecar.Blobs[0] = []byte{1} }, true},
+		{"contract creation", func(tx *BlobTx) { tx.To = "" }, true},
+		{"nil blob fee cap", func(tx *BlobTx) { tx.BlobFeeCap = nil }, true},
+	}
+
+	for _, tc := range testCases {
+		blobTx, err := newBlobTx(tx)
+		require.NoError(t, err)
+		tc.malleate(blobTx)
+		err = blobTx.Validate()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestBlobTxVerifySidecar(t *testing.T) {
+	tx, _ := newSignedBlobTx(t, big.NewInt(9000), newBlobSidecar(t, 1))
+	blobTx, err := newBlobTx(tx)
+	require.NoError(t, err)
+	require.NoError(t, blobTx.VerifySidecar())
+
+	// the proof of another blob doesn't verify
+	blobTx.Sidecar.Proofs[0] = NewBlobTxSidecar(newBlobSidecar(t, 2)).Proofs[0]
+	require.ErrorIs(t, blobTx.VerifySidecar(), ErrInvalidBlob)
+
+	blobTx.Sidecar = nil
+	require.ErrorIs(t, blobTx.VerifySidecar(), ErrInvalidBlob)
+}
+
+func TestStripBlobSidecar(t *testing.T) {
+	chainID := big.NewInt(9000)
+	tx, from := newSignedBlobTx(t, chainID, newBlobSidecar(t, 1))
+	var msg MsgEthereumTx
+	require.NoError(t, msg.FromSignedEthereumTx(tx, chainID))
+	require.Equal(t, from.Bytes(), msg.From)
+
+	sidecar, err := msg.StripBlobSidecar()
+	require.NoError(t, err)
+	require.Equal(t, NewBlobTxSidecar(tx.BlobTxSidecar()), sidecar)
+
+	// the versioned hashes, the hash and the sender are kept
+	txData, err := UnpackTxData(msg.Data)
+	require.NoError(t, err)
+	blobTx := txData.(*BlobTx)
+	require.Nil(t, blobTx.Sidecar)
+	require.NoError(t, blobTx.Validate())
+	require.Equal(t, tx.BlobHashes(), blobTx.GetBlobVersionedHashes())
+	require.Equal(t, tx.Hash(), msg.ComputeHash())
+	require.NoError(t, msg.VerifySender(chainID))
+
+	sidecar, err = msg.StripBlobSidecar()
+	require.NoError(t, err)
+	require.Nil(t, sidecar)
+
+	// the other transactions have no sidecar
+	legacy := NewTx(chainID, 0, &common.Address{}, big.NewInt(1), 21000, big.NewInt(1), nil, nil, nil, nil)
+	sidecar, err = legacy.StripBlobSidecar()
+	require.NoError(t, err)
+	require.Nil(t, sidecar)
+}
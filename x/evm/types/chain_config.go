@@ -179,6 +179,89 @@ func (cc ChainConfig) Validate() error {
 	if err := cc.EthereumConfig(nil).CheckConfigForkOrder(); err != nil {
 		return errorsmod.Wrap(err, "invalid config fork order")
 	}
+	// go-ethereum treats cancun as optional, but its opcodes and transaction types are
+	// required by prague.
+	if cc.CancunTime == nil && cc.PragueTime != nil {
+		return errorsmod.Wrapf(ErrInvalidChainConfig, "unsupported fork ordering: cancunTime not enabled, but pragueTime enabled at timestamp %s", cc.PragueTime)
+	}
+	return nil
+}
+
+// CheckCompatible returns an error if replacing the ChainConfig by newcfg at the given
+// height and block time would rewrite a fork that is already active, or schedule one
+// in the past.
+func (cc ChainConfig) CheckCompatible(newcfg ChainConfig, height int64, blockTime uint64) error {
+	if height < 0 {
+		return errorsmod.Wrapf(ErrInvalidChainConfig, "negative height %d", height)
+	}
+	if err := cc.EthereumConfig(nil).CheckCompatible(newcfg.EthereumConfig(nil), uint64(height), blockTime); err != nil {
+		return errorsmod.Wrap(ErrInvalidChainConfig, err.Error())
+	}
+	return nil
+}
+
+// Fork describes a hard fork of the ChainConfig, activated either by block number or,
+// for the forks after the merge, by block time.
+type Fork struct {
+	Name  string
+	Block *big.Int
+	Time  *uint64
+}
+
+// IsActive returns true if the fork is active at the given height and block time.
+func (f Fork) IsActive(height *big.Int, blockTime uint64) bool {
+	if f.Block != nil {
+		return f.Block.Cmp(height) <= 0
+	}
+	return f.Time != nil && *f.Time <= blockTime
+}
+
+// IsScheduled returns true if the fork has an activation block or time.
+func (f Fork) IsScheduled() bool {
+	return f.Block != nil || f.Time != nil
+}
+
+// TimeForks is the list of the forks activated by block time, which are the ones that
+// can be scheduled on a live chain.
+var TimeForks = []string{"shanghai", "cancun", "prague"}
+
+// Forks returns the hard forks of the ChainConfig in activation order.
+func (cc ChainConfig) Forks() []Fork {
+	return []Fork{
+		{Name: "homestead", Block: getBlockValue(cc.HomesteadBlock)},
+		{Name: "daoFork", Block: getBlockValue(cc.DAOForkBlock)},
+		{Name: "eip150", Block: getBlockValue(cc.EIP150Block)},
+		{Name: "eip155", Block: getBlockValue(cc.EIP155Block)},
+		{Name: "eip158", Block: getBlockValue(cc.EIP158Block)},
+		{Name: "byzantium", Block: getBlockValue(cc.ByzantiumBlock)},
+		{Name: "constantinople", Block: getBlockValue(cc.ConstantinopleBlock)},
+		{Name: "petersburg", Block: getBlockValue(cc.PetersburgBlock)},
+		{Name: "istanbul", Block: getBlockValue(cc.IstanbulBlock)},
+		{Name: "muirGlacier", Block: getBlockValue(cc.MuirGlacierBlock)},
+		{Name: "berlin", Block: getBlockValue(cc.BerlinBlock)},
+		{Name: "london", Block: getBlockValue(cc.LondonBlock)},
+		{Name: "arrowGlacier", Block: getBlockValue(cc.ArrowGlacierBlock)},
+		{Name: "grayGlacier", Block: getBlockValue(cc.GrayGlacierBlock)},
+		{Name: "mergeNetsplit", Block: getBlockValue(cc.MergeNetsplitBlock)},
+		{Name: "shanghai", Time: getTimeValue(cc.ShanghaiTime)},
+		{Name: "cancun", Time: getTimeValue(cc.CancunTime)},
+		{Name: "prague", Time: getTimeValue(cc.PragueTime)},
+	}
+}
+
+// ScheduleFork sets the activation time of the time based fork with the given name.
+func (cc *ChainConfig) ScheduleFork(name string, time uint64) error {
+	t := sdkmath.NewIntFromUint64(time)
+	switch strings.ToLower(name) {
+	case "shanghai":
+		cc.ShanghaiTime = &t
+	case "cancun":
+		cc.CancunTime = &t
+	case "prague":
+		cc.PragueTime = &t
+	default:
+		return errorsmod.Wrapf(ErrInvalidChainConfig, "unknown time based fork %s, expected one of %s", name, strings.Join(TimeForks, ", "))
+	}
 	return nil
 }
 
@@ -0,0 +1,95 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+)
+
+func newInt(i int64) *sdkmath.Int {
+	v := sdkmath.NewInt(i)
+	return &v
+}
+
+func TestChainConfigValidate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		malleate  func(cc *ChainConfig)
+		expectErr bool
+	}{
+		{"default", func(*ChainConfig) {}, false},
+		{"prague after cancun", func(cc *ChainConfig) { cc.CancunTime, cc.PragueTime = newInt(10), newInt(20) }, false},
+		{"negative block", func(cc *ChainConfig) { cc.LondonBlock = newInt(-1) }, true},
+		{"negative time", func(cc *ChainConfig) { cc.PragueTime = newInt(-1) }, true},
+		{"block forks out of order", func(cc *ChainConfig) { cc.BerlinBlock = newInt(10) }, true},
+		{"time forks out of order", func(cc *ChainConfig) { cc.CancunTime, cc.PragueTime = newInt(20), newInt(10) }, true},
+		{"time fork without london", func(cc *ChainConfig) {
+			cc.LondonBlock, cc.ArrowGlacierBlock, cc.GrayGlacierBlock, cc.MergeNetsplitBlock = nil, nil, nil, nil
+		}, true},
+		{"cancun without shanghai", func(cc *ChainConfig) { cc.ShanghaiTime = nil }, true},
+		{"prague without cancun", func(cc *ChainConfig) { cc.CancunTime, cc.PragueTime = nil, newInt(10) }, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cc := DefaultChainConfig()
+			tc.malleate(&cc)
+			err := cc.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestChainConfigCheckCompatible(t *testing.T) {
+	current := DefaultChainConfig()
+	current.PragueTime = newInt(200)
+
+	testCases := []struct {
+		name      string
+		malleate  func(cc *ChainConfig)
+		expectErr bool
+	}{
+		{"unchanged", func(*ChainConfig) {}, false},
+		{"reschedule pending fork", func(cc *ChainConfig) { cc.PragueTime = newInt(300) }, false},
+		{"unschedule pending fork", func(cc *ChainConfig) { cc.PragueTime = nil }, false},
+		{"reschedule active fork", func(cc *ChainConfig) { cc.CancunTime = newInt(150) }, true},
+		{"schedule fork in the past", func(cc *ChainConfig) { cc.PragueTime = newInt(50) }, true},
+		{"schedule fork in the current block", func(cc *ChainConfig) { cc.PragueTime = newInt(100) }, true},
+		{"reschedule active block fork", func(cc *ChainConfig) { cc.MergeNetsplitBlock = newInt(20) }, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			newcfg := current
+			tc.malleate(&newcfg)
+			err := current.CheckCompatible(newcfg, 10, 100)
+			if tc.expectErr {
+				require.ErrorIs(t, err, ErrInvalidChainConfig)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestChainConfigForks(t *testing.T) {
+	cc := DefaultChainConfig()
+	require.NoError(t, cc.ScheduleFork("Prague", 200))
+	require.Error(t, cc.ScheduleFork("osaka", 300))
+	require.Equal(t, newInt(200), cc.PragueTime)
+
+	forks := cc.Forks()
+	require.Equal(t, "homestead", forks[0].Name)
+	prague := forks[len(forks)-1]
+	require.Equal(t, "prague", prague.Name)
+	require.True(t, prague.IsScheduled())
+	require.False(t, prague.IsActive(big.NewInt(10), 199))
+	require.True(t, prague.IsActive(big.NewInt(10), 200))
+	require.True(t, forks[0].IsActive(big.NewInt(0), 0))
+}
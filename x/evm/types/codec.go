@@ -67,6 +67,8 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*TxData)(nil),
 		&DynamicFeeTx{},
 		&AccessListTx{},
+		&SetCodeTx{},
+		&BlobTx{},
 		&LegacyTx{},
 	)
 
@@ -0,0 +1,243 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"errors"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+const (
+	// EnclaveProtocolVersion is the version of the enclave protocol implemented
+	// by the node. It must be bumped on every breaking change of enclave.proto.
+	EnclaveProtocolVersion uint32 = 6
+	// MinEnclaveProtocolVersion is the oldest enclave protocol version the node
+	// is still able to speak.
+	MinEnclaveProtocolVersion uint32 = 1
+
+	// EnclaveBlockSessionVersion is the first protocol version supporting
+	// block sessions, they are only used since EnclaveWriteThroughVersion.
+	EnclaveBlockSessionVersion uint32 = 2
+	// EnclaveSetCodeVersion is the first protocol version supporting EIP-7702
+	// set-code transactions.
+	EnclaveSetCodeVersion uint32 = 3
+	// EnclaveBlobVersion is the first protocol version supporting EIP-4844
+	// blob transactions.
+	EnclaveBlobVersion uint32 = 4
+	// EnclaveWriteThroughVersion is the first protocol version whose block
+	// sessions write the state of every transaction back to the node.
+	EnclaveWriteThroughVersion uint32 = 5
+	// EnclaveContextVersion is the first protocol version whose sessions pass
+	// their context id in the StateDB callbacks.
+	EnclaveContextVersion uint32 = 6
+)
+
+// NewEnclaveHandshakeRequest returns the handshake advertising the protocol
+// versions supported by the node.
+func NewEnclaveHandshakeRequest() *EnclaveHandshakeRequest {
+	return &EnclaveHandshakeRequest{
+		ProtocolVersion:    EnclaveProtocolVersion,
+		MinProtocolVersion: MinEnclaveProtocolVersion,
+	}
+}
+
+// Validate checks that the enclave speaks a protocol version compatible with
+// the node, that is both version ranges overlap.
+func (res EnclaveHandshakeResponse) Validate() error {
+	if res.MinProtocolVersion > res.ProtocolVersion {
+		return errorsmod.Wrapf(
+			ErrIncompatibleEnclave,
+			"invalid enclave protocol range [%d, %d]", res.MinProtocolVersion, res.ProtocolVersion,
+		)
+	}
+	if res.MinProtocolVersion > EnclaveProtocolVersion || res.ProtocolVersion < MinEnclaveProtocolVersion {
+		return errorsmod.Wrapf(
+			ErrIncompatibleEnclave,
+			"enclave %q speaks protocol [%d, %d], node speaks [%d, %d]",
+			res.EnclaveVersion, res.MinProtocolVersion, res.ProtocolVersion,
+			MinEnclaveProtocolVersion, EnclaveProtocolVersion,
+		)
+	}
+	return nil
+}
+
+// NegotiatedVersion returns the protocol version used with the enclave, that is
+// the highest version both sides support. The response must be valid.
+func (res EnclaveHandshakeResponse) NegotiatedVersion() uint32 {
+	if res.ProtocolVersion < EnclaveProtocolVersion {
+		return res.ProtocolVersion
+	}
+	return EnclaveProtocolVersion
+}
+
+// enclaveVMErrors are the go-ethereum EVM errors, indexed by the message the
+// enclave reports them with.
+var enclaveVMErrors = func() map[string]error {
+	errs := make(map[string]error)
+	for _, err := range []error{
+		vm.ErrOutOfGas,
+		vm.ErrCodeStoreOutOfGas,
+		vm.ErrDepth,
+		vm.ErrInsufficientBalance,
+		vm.ErrContractAddressCollision,
+		vm.ErrExecutionReverted,
+		vm.ErrMaxCodeSizeExceeded,
+		vm.ErrMaxInitCodeSizeExceeded,
+		vm.ErrInvalidJump,
+		vm.ErrWriteProtection,
+		vm.ErrReturnDataOutOfBounds,
+		vm.ErrGasUintOverflow,
+		vm.ErrInvalidCode,
+		vm.ErrNonceUintOverflow,
+	} {
+		errs[err.Error()] = err
+	}
+	return errs
+}()
+
+// NewEnclaveVMError converts the EVM error reported by the enclave back to the
+// go-ethereum error, so that it can be matched with errors.Is. It returns nil if
+// the message is empty.
+func NewEnclaveVMError(msg string) error {
+	if msg == "" {
+		return nil
+	}
+	if err, ok := enclaveVMErrors[msg]; ok {
+		return err
+	}
+	return errors.New(msg)
+}
+
+// NewEnclaveMessage converts a go-ethereum message to its protobuf representation.
+func NewEnclaveMessage(msg core.Message) EnclaveMessage {
+	m := EnclaveMessage{
+		From:              msg.From.Hex(),
+		Nonce:             msg.Nonce,
+		Value:             NewEnclaveInt(msg.Value),
+		GasLimit:          msg.GasLimit,
+		GasPrice:          NewEnclaveInt(msg.GasPrice),
+		GasFeeCap:         NewEnclaveInt(msg.GasFeeCap),
+		GasTipCap:         NewEnclaveInt(msg.GasTipCap),
+		Data:              msg.Data,
+		AccessList:        NewAccessList(&msg.AccessList),
+		BlobGasFeeCap:     NewEnclaveInt(msg.BlobGasFeeCap),
+		SkipAccountChecks: msg.SkipAccountChecks,
+	}
+	if msg.To != nil {
+		m.To = msg.To.Hex()
+	}
+	for _, h := range msg.BlobHashes {
+		m.BlobHashes = append(m.BlobHashes, h.Hex())
+	}
+	return m
+}
+
+// AsMessage converts the protobuf message back to a go-ethereum message.
+func (m EnclaveMessage) AsMessage() core.Message {
+	msg := core.Message{
+		From:              common.HexToAddress(m.From),
+		Nonce:             m.Nonce,
+		Value:             EnclaveIntToBig(m.Value),
+		GasLimit:          m.GasLimit,
+		GasPrice:          EnclaveIntToBig(m.GasPrice),
+		GasFeeCap:         EnclaveIntToBig(m.GasFeeCap),
+		GasTipCap:         EnclaveIntToBig(m.GasTipCap),
+		Data:              m.Data,
+		BlobGasFeeCap:     EnclaveIntToBig(m.BlobGasFeeCap),
+		SkipAccountChecks: m.SkipAccountChecks,
+	}
+	if m.To != "" {
+		to := common.HexToAddress(m.To)
+		msg.To = &to
+	}
+	if len(m.AccessList) > 0 {
+		msg.AccessList = *AccessList(m.AccessList).ToEthAccessList()
+	}
+	for _, h := range m.BlobHashes {
+		msg.BlobHashes = append(msg.BlobHashes, common.HexToHash(h))
+	}
+	return msg
+}
+
+// NewEnclaveRules converts the go-ethereum fork rules to their protobuf representation.
+func NewEnclaveRules(rules params.Rules) EnclaveRules {
+	return EnclaveRules{
+		ChainID:          NewEnclaveInt(rules.ChainID),
+		IsHomestead:      rules.IsHomestead,
+		IsEIP150:         rules.IsEIP150,
+		IsEIP155:         rules.IsEIP155,
+		IsEIP158:         rules.IsEIP158,
+		IsByzantium:      rules.IsByzantium,
+		IsConstantinople: rules.IsConstantinople,
+		IsPetersburg:     rules.IsPetersburg,
+		IsIstanbul:       rules.IsIstanbul,
+		IsBerlin:         rules.IsBerlin,
+		IsLondon:         rules.IsLondon,
+		IsMerge:          rules.IsMerge,
+		IsShanghai:       rules.IsShanghai,
+		IsCancun:         rules.IsCancun,
+		IsPrague:         rules.IsPrague,
+		IsVerkle:         rules.IsVerkle,
+	}
+}
+
+// ToEthereum converts the protobuf fork rules back to the go-ethereum ones.
+func (r EnclaveRules) ToEthereum() params.Rules {
+	return params.Rules{
+		ChainID:          EnclaveIntToBig(r.ChainID),
+		IsHomestead:      r.IsHomestead,
+		IsEIP150:         r.IsEIP150,
+		IsEIP155:         r.IsEIP155,
+		IsEIP158:         r.IsEIP158,
+		IsByzantium:      r.IsByzantium,
+		IsConstantinople: r.IsConstantinople,
+		IsPetersburg:     r.IsPetersburg,
+		IsIstanbul:       r.IsIstanbul,
+		IsBerlin:         r.IsBerlin,
+		IsLondon:         r.IsLondon,
+		IsMerge:          r.IsMerge,
+		IsShanghai:       r.IsShanghai,
+		IsCancun:         r.IsCancun,
+		IsPrague:         r.IsPrague,
+		IsVerkle:         r.IsVerkle,
+	}
+}
+
+// NewEnclaveInt converts an optional big integer to the protobuf representation
+// used by the enclave protocol.
+func NewEnclaveInt(i *big.Int) *sdkmath.Int {
+	if i == nil {
+		return nil
+	}
+	v := sdkmath.NewIntFromBigInt(i)
+	return &v
+}
+
+// EnclaveIntToBig converts an optional protobuf integer of the enclave protocol
+// back to a big integer.
+func EnclaveIntToBig(i *sdkmath.Int) *big.Int {
+	if i == nil || i.IsNil() {
+		return nil
+	}
+	return i.BigInt()
+}
@@ -0,0 +1,10398 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ethermint/evm/v1/enclave.proto
+
+package types
+
+import (
+	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	types "github.com/cometbft/cometbft/proto/tendermint/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EnclaveHandshakeRequest is the request type for the Enclave/Handshake RPC method.
+type EnclaveHandshakeRequest struct {
+	// protocol_version is the protocol version implemented by the node
+	ProtocolVersion uint32 `protobuf:"varint,1,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	// min_protocol_version is the oldest protocol version the node supports
+	MinProtocolVersion uint32 `protobuf:"varint,2,opt,name=min_protocol_version,json=minProtocolVersion,proto3" json:"min_protocol_version,omitempty"`
+}
+
+func (m *EnclaveHandshakeRequest) Reset()         { *m = EnclaveHandshakeRequest{} }
+func (m *EnclaveHandshakeRequest) String() string { return proto.CompactTextString(m) }
+func (*EnclaveHandshakeRequest) ProtoMessage()    {}
+func (*EnclaveHandshakeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cbc5b5ae02f8afca, []int{0}
+}
+func (m *EnclaveHandshakeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnclaveHandshakeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnclaveHandshakeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EnclaveHandshakeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnclaveHandshakeRequest.Merge(m, src)
+}
+func (m *EnclaveHandshakeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnclaveHandshakeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnclaveHandshakeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnclaveHandshakeRequest proto.InternalMessageInfo
+
+func (m *EnclaveHandshakeRequest) GetProtocolVersion() uint32 {
+	if m != nil {
+		return m.ProtocolVersion
+	}
+	return 0
+}
+
+func (m *EnclaveHandshakeRequest) GetMinProtocolVersion() uint32 {
+	if m != nil {
+		return m.MinProtocolVersion
+	}
+	return 0
+}
+
+// EnclaveHandshakeResponse is the response type for the Enclave/Handshake RPC method.
+type EnclaveHandshakeResponse struct {
+	// protocol_version is the protocol version implemented by the enclave
+	ProtocolVersion uint32 `protobuf:"varint,1,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	// min_protocol_version is the oldest protocol version the enclave supports
+	MinProtocolVersion uint32 `protobuf:"varint,2,opt,name=min_protocol_version,json=minProtocolVersion,proto3" json:"min_protocol_version,omitempty"`
+	// enclave_version is the software version of the enclave, informative only
+	EnclaveVersion string `protobuf:"bytes,3,opt,name=enclave_version,json=enclaveVersion,proto3" json:"enclave_version,omitempty"`
+}
+
+func (m *EnclaveHandshakeResponse) Reset()         { *m = EnclaveHandshakeResponse{} }
+func (m *EnclaveHandshakeResponse) String() string { return proto.CompactTextString(m) }
+func (*EnclaveHandshakeResponse) ProtoMessage()    {}
+func (*EnclaveHandshakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cbc5b5ae02f8afca, []int{1}
+}
+func (m *EnclaveHandshakeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnclaveHandshakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnclaveHandshakeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EnclaveHandshakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnclaveHandshakeResponse.Merge(m, src)
+}
+func (m *EnclaveHandshakeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnclaveHandshakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnclaveHandshakeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnclaveHandshakeResponse proto.InternalMessageInfo
+
+func (m *EnclaveHandshakeResponse) GetProtocolVersion() uint32 {
+	if m != nil {
+		return m.ProtocolVersion
+	}
+	return 0
+}
+
+func (m *EnclaveHandshakeResponse) GetMinProtocolVersion() uint32 {
+	if m != nil {
+		return m.MinProtocolVersion
+	}
+	return 0
+}
+
+func (m *EnclaveHandshakeResponse) GetEnclaveVersion() string {
+	if m != nil {
+		return m.EnclaveVersion
+	}
+	return ""
+}
+
+// EnclaveMessage is the EVM message executed in a session, it mirrors
+// go-ethereum's core.Message.
+type EnclaveMessage struct {
+	// from is the hex formatted address of the sender
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// to is the hex formatted address of the recipient, empty for contract creations
+	To string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// nonce of the message
+	Nonce uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// value is the amount transferred to the recipient
+	Value *cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=value,proto3,customtype=cosmossdk.io/math.Int" json:"value,omitempty"`
+	// gas_limit of the message
+	GasLimit uint64 `protobuf:"varint,5,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// gas_price is the effective gas price
+	GasPrice *cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=gas_price,json=gasPrice,proto3,customtype=cosmossdk.io/math.Int" json:"gas_price,omitempty"`
+	// gas_fee_cap is the maximum fee per gas
+	GasFeeCap *cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=gas_fee_cap,json=gasFeeCap,proto3,customtype=cosmossdk.io/math.Int" json:"gas_fee_cap,omitempty"`
+	// gas_tip_cap is the maximum priority fee per gas
+	GasTipCap *cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=gas_tip_cap,json=gasTipCap,proto3,customtype=cosmossdk.io/math.Int" json:"gas_tip_cap,omitempty"`
+	// data is the input of the message call or the contract init code
+	Data []byte `protobuf:"bytes,9,opt,name=data,proto3" json:"data,omitempty"`
+	// access_list is the EIP-2930 access list of the message
+	AccessList []AccessTuple `protobuf:"bytes,10,rep,name=access_list,json=accessList,proto3" json:"access_list"`
+	// blob_gas_fee_cap is the maximum fee per blob gas
+	BlobGasFeeCap *cosmossdk_io_math.Int `protobuf:"bytes,11,opt,name=blob_gas_fee_cap,json=blobGasFeeCap,proto3,customtype=cosmossdk.io/math.Int" json:"blob_gas_fee_cap,omitempty"`
+	// blob_hashes are the hex formatted versioned hashes of the blobs
+	BlobHashes []string `protobuf:"bytes,12,rep,name=blob_hashes,json=blobHashes,proto3" json:"blob_hashes,omitempty"`
+	// skip_account_checks disables the nonce and EOA checks of the sender
+	SkipAccountChecks bool `protobuf:"varint,13,opt,name=skip_account_checks,json=skipAccountChecks,proto3" json:"skip_account_checks,omitempty"`
+}
+
+func (m *EnclaveMessage) Reset()         { *m = EnclaveMessage{} }
+func (m *EnclaveMessage) String() string { return proto.CompactTextString(m) }
+func (*EnclaveMessage) ProtoMessage()    {}
+func (*EnclaveMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cbc5b5ae02f8afca, []int{2}
+}
+func (m *EnclaveMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnclaveMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnclaveMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EnclaveMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnclaveMessage.Merge(m, src)
+}
+func (m *EnclaveMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnclaveMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnclaveMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnclaveMessage proto.InternalMessageInfo
+
+// EnclaveTxConfig mirrors statedb.TxConfig.
+type EnclaveTxConfig struct {
+	// block_hash is the hex formatted hash of the current block
+	BlockHash string `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// tx_hash is the hex formatted hash of the current transaction
+	TxHash string `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// tx_index is the index of the current transaction in the block
+	TxIndex uint64 `protobuf:"varint,3,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+	// log_index is the index of the next log within the block
+	LogIndex uint64 `protobuf:"varint,4,opt,name=log_index,json=logIndex,proto3" json:"log_index,omitempty"`
+}
+
+func (m *EnclaveTxConfig) Reset()         { *m = EnclaveTxConfig{} }
+func (m *EnclaveTxConfig) String() string { return proto.CompactTextString(m) }
+func (*EnclaveTxConfig) ProtoMessage()    {}
+func (*EnclaveTxConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cbc5b5ae02f8afca, []int{3}
+}
+func (m *EnclaveTxConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnclaveTxConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnclaveTxConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EnclaveTxConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnclaveTxConfig.Merge(m, src)
+}
+func (m *EnclaveTxConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnclaveTxConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnclaveTxConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnclaveTxConfig proto.InternalMessageInfo
+
+func (m *EnclaveTxConfig) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *EnclaveTxConfig) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *EnclaveTxConfig) GetTxIndex() uint64 {
+	if m != nil {
+		return m.TxIndex
+	}
+	return 0
+}
+
+func (m *EnclaveTxConfig) GetLogIndex() uint64 {
+	if m != nil {
+		return m.LogIndex
+	}
+	return 0
+}
+
+// EnclaveEVMConfig contains the fields of the node's EVMConfig that are needed
+// to create a new EVM instance.
+type EnclaveEVMConfig struct {
+	// chain_config is the EVM chain configuration from the module parameters
+	ChainConfig ChainConfig `protobuf:"bytes,1,opt,name=chain_config,json=chainConfig,proto3" json:"chain_config"`
+	// chain_id is the EIP-155 chain id
+	ChainID *cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3,customtype=cosmossdk.io/math.Int" json:"chain_id,omitempty"`
+	// coinbase is the hex formatted address of the block proposer
+	Coinbase string `protobuf:"bytes,3,opt,name=coinbase,proto3" json:"coinbase,omitempty"`
+	// base_fee is the base fee of the block, empty before London
+	BaseFee *cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=base_fee,json=baseFee,proto3,customtype=cosmossdk.io/math.Int" json:"base_fee,omitempty"`
+	// tx_config is the transaction and log index information
+	TxConfig EnclaveTxConfig `protobuf:"bytes,5,opt,name=tx_config,json=txConfig,proto3" json:"tx_config"`
+	// debug_trace is set when the message is traced
+	DebugTrace bool `protobuf:"varint,6,opt,name=debug_trace,json=debugTrace,proto3" json:"debug_trace,omitempty"`
+	// no_base_fee is the no_base_fee parameter of the fee market module
+	NoBaseFee bool `protobuf:"varint,7,opt,name=no_base_fee,json=noBaseFee,proto3" json:"no_base_fee,omitempty"`
+	// evm_denom is the denomination of the EVM native token
+	EvmDenom string `protobuf:"bytes,8,opt,name=evm_denom,json=evmDenom,proto3" json:"evm_denom,omitempty"`
+	// extra_eips are the additional EIPs activated on the EVM
+	ExtraEIPs []int64 `protobuf:"varint,9,rep,packed,name=extra_eips,json=extraEips,proto3" json:"extra_eips,omitempty"`
+	// overrides is the state override set, in the same json format as the json
+	// rpc api
+	Overrides []byte `protobuf:"bytes,10,opt,name=overrides,proto3" json:"overrides,omitempty"`
+	// blob_base_fee is the EIP-4844 blob base fee of the block, empty before
+	// Cancun
+	BlobBaseFee *cosmossdk_io_math.Int `protobuf:"bytes,11,opt,name=blob_base_fee,json=blobBaseFee,proto3,customtype=cosmossdk.io/math.Int" json:"blob_base_fee,omitempty"`
+}
+
+func (m *EnclaveEVMConfig) Reset()         { *m = EnclaveEVMConfig{} }
+func (m *EnclaveEVMConfig) String() string { return proto.CompactTextString(m) }
+func (*EnclaveEVMConfig) ProtoMessage()    {}
+func (*EnclaveEVMConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cbc5b5ae02f8afca, []int{4}
+}
+func (m *EnclaveEVMConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnclaveEVMConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnclaveEVMConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EnclaveEVMConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnclaveEVMConfig.Merge(m, src)
+}
+func (m *EnclaveEVMConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnclaveEVMConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnclaveEVMConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnclaveEVMConfig proto.InternalMessageInfo
+
+func (m *EnclaveEVMConfig) GetChainConfig() ChainConfig {
+	if m != nil {
+		return m.ChainConfig
+	}
+	return ChainConfig{}
+}
+
+func (m *EnclaveEVMConfig) GetCoinbase() string {
+	if m != nil {
+		return m.Coinbase
+	}
+	return ""
+}
+
+func (m *EnclaveEVMConfig) GetTxConfig() EnclaveTxConfig {
+	if m != nil {
+		return m.TxConfig
+	}
+	return EnclaveTxConfig{}
+}
+
+func (m *EnclaveEVMConfig) GetDebugTrace() bool {
+	if m != nil {
+		return m.DebugTrace
+	}
+	return false
+}
+
+func (m *EnclaveEVMConfig) GetNoBaseFee() bool {
+	if m != nil {
+		return m.NoBaseFee
+	}
+	return false
+}
+
+func (m *EnclaveEVMConfig) GetEvmDenom() string {
+	if m != nil {
+		return m.EvmDenom
+	}
+	return ""
+}
+
+func (m *EnclaveEVMConfig) GetExtraEIPs() []int64 {
+	if m != nil {
+		return m.ExtraEIPs
+	}
+	return nil
+}
+
+func (m *EnclaveEVMConfig) GetOverrides() []byte {
+	if m != nil {
+		return m.Overrides
+	}
+	return nil
+}
+
+// EnclaveRules mirrors go-ethereum's params.Rules.
+type EnclaveRules struct {
+	// chain_id is the EIP-155 chain id
+	ChainID *cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3,customtype=cosmossdk.io/math.Int" json:"chain_id,omitempty"`
+	// is_homestead is true if the Homestead fork is active
+	IsHomestead bool `protobuf:"varint,2,opt,name=is_homestead,json=isHomestead,proto3" json:"is_homestead,omitempty"`
+	// is_eip150 is true if EIP-150 is active
+	IsEIP150 bool `protobuf:"varint,3,opt,name=is_eip150,json=isEip150,proto3" json:"is_eip150,omitempty"`
+	// is_eip155 is true if EIP-155 is active
+	IsEIP155 bool `protobuf:"varint,4,opt,name=is_eip155,json=isEip155,proto3" json:"is_eip155,omitempty"`
+	// is_eip158 is true if EIP-158 is active
+	IsEIP158 bool `protobuf:"varint,5,opt,name=is_eip158,json=isEip158,proto3" json:"is_eip158,omitempty"`
+	// is_byzantium is true if the Byzantium fork is active
+	IsByzantium bool `protobuf:"varint,6,opt,name=is_byzantium,json=isByzantium,proto3" json:"is_byzantium,omitempty"`
+	// is_constantinople is true if the Constantinople fork is active
+	IsConstantinople bool `protobuf:"varint,7,opt,name=is_constantinople,json=isConstantinople,proto3" json:"is_constantinople,omitempty"`
+	// is_petersburg is true if the Petersburg fork is active
+	IsPetersburg bool `protobuf:"varint,8,opt,name=is_petersburg,json=isPetersburg,proto3" json:"is_petersburg,omitempty"`
+	// is_istanbul is true if the Istanbul fork is active
+	IsIstanbul bool `protobuf:"varint,9,opt,name=is_istanbul,json=isIstanbul,proto3" json:"is_istanbul,omitempty"`
+	// is_berlin is true if the Berlin fork is active
+	IsBerlin bool `protobuf:"varint,10,opt,name=is_berlin,json=isBerlin,proto3" json:"is_berlin,omitempty"`
+	// is_london is true if the London fork is active
+	IsLondon bool `protobuf:"varint,11,opt,name=is_london,json=isLondon,proto3" json:"is_london,omitempty"`
+	// is_merge is true if the Merge fork is active
+	IsMerge bool `protobuf:"varint,12,opt,name=is_merge,json=isMerge,proto3" json:"is_merge,omitempty"`
+	// is_shanghai is true if the Shanghai fork is active
+	IsShanghai bool `protobuf:"varint,13,opt,name=is_shanghai,json=isShanghai,proto3" json:"is_shanghai,omitempty"`
+	// is_cancun is true if the Cancun fork is active
+	IsCancun bool `protobuf:"varint,14,opt,name=is_cancun,json=isCancun,proto3" json:"is_cancun,omitempty"`
+	// is_prague is true if the Prague fork is active
+	IsPrague bool `protobuf:"varint,15,opt,name=is_prague,json=isPrague,proto3" json:"is_prague,omitempty"`
+	// is_verkle is true if the Verkle fork is active
+	IsVerkle bool `protobuf:"varint,16,opt,name=is_verkle,json=isVerkle,proto3" json:"is_verkle,omitempty"`
+}
+
+func (m *EnclaveRules) Reset()         { *m = EnclaveRules{} }
+func (m *EnclaveRules) String() string { return proto.CompactTextString(m) }
+func (*EnclaveRules) ProtoMessage()    {}
+func (*EnclaveRules) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cbc5b5ae02f8afca, []int{5}
+}
+func (m *EnclaveRules) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnclaveRules) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnclaveRules.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EnclaveRules) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnclaveRules.Merge(m, src)
+}
+func (m *EnclaveRules) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnclaveRules) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnclaveRules.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnclaveRules proto.InternalMessageInfo
+
+func (m *EnclaveRules) GetIsHomestead() bool {
+	if m != nil {
+		return m.IsHomestead
+	}
+	return false
+}
+
+func (m *EnclaveRules) GetIsEIP150() bool {
+	if m != nil {
+		return m.IsEIP150
+	}
+	return false
+}
+
+func (m *EnclaveRules) GetIsEIP155() bool {
+	if m != nil {
+		return m.IsEIP155
+	}
+	return false
+}
+
+func (m *EnclaveRules) GetIsEIP158() bool {
+	if m != nil {
+		return m.IsEIP158
+	}
+	return false
+}
+
+func (m *EnclaveRules) GetIsByzantium() bool {
+	if m != nil {
+		return m.IsByzantium
+	}
+	return false
+}
+
+func (m *EnclaveRules) GetIsConstantinople() bool {
+	if m != nil {
+		return m.IsConstantinople
+	}
+	return false
+}
+
+func (m *EnclaveRules) GetIsPetersburg() bool {
+	if m != nil {
+		return m.IsPetersburg
+	}
+	return false
+}
+
+func (m *EnclaveRules) GetIsIstanbul() bool {
+	if m != nil {
+		return m.IsIstanbul
+	}
+	return false
+}
+
+func (m *EnclaveRules) GetIsBerlin() bool {
+	if m != nil {
+		return m.IsBerlin
+	}
+	return false
+}
+
+func (m *EnclaveRules) GetIsLondon() bool {
+	if m != nil {
+		return m.IsLondon
+	}
+	return false
+}
+
+func (m *EnclaveRules) GetIsMerge() bool {
+	if m != nil {
+		return m.IsMerge
+	}
+	return false
+}
+
+func (m *EnclaveRules) GetIsShanghai() bool {
+	if m != nil {
+		return m.IsShanghai
+	}
+	return false
+}
+
+func (m *EnclaveRules) GetIsCancun() bool {
+	if m != nil {
+		return m.IsCancun
+	}
+	return false
+}
+
+func (m *EnclaveRules) GetIsPrague() bool {
+	if m != nil {
+		return m.IsPrague
+	}
+	return false
+}
+
+func (m *EnclaveRules) GetIsVerkle() bool {
+	if m != nil {
+		return m.IsVerkle
+	}
+	return false
+}
+
+// EnclavePrepareTxRequest is the request type for the Enclave/PrepareTx RPC method.
+type EnclavePrepareTxRequest struct {
+	// tx_hash is the hash of the transaction being executed
+	TxHash []byte `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// header is the header of the block in which the transaction is executed
+	Header types.Header `protobuf:"bytes,2,opt,name=header,proto3" json:"header"`
+	// msg is the EVM message to execute
+	Msg EnclaveMessage `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg"`
+	// evm_config is the configuration of the EVM
+	EVMConfig EnclaveEVMConfig `protobuf:"bytes,4,opt,name=evm_config,json=evmConfig,proto3" json:"evm_config"`
+	// block_session_id is the block session the transaction joins, zero for a
+	// standalone session. A joining session reads from and commits into the
+	// block session state, and is reverted if it ends without a commit.
+	BlockSessionID uint64 `protobuf:"varint,5,opt,name=block_session_id,json=blockSessionId,proto3" json:"block_session_id,omitempty"`
+	// authorizations are the EIP-7702 authorizations of a set-code transaction,
+	// applied by StateDBPrepare once the access list is set up. Since protocol
+	// version 3.
+	Authorizations []SetCodeAuthorization `protobuf:"bytes,6,rep,name=authorizations,proto3" json:"authorizations"`
+	// context_id identifies the node context the session operates on, it must be
+	// set in the StateDB callbacks made for the session. Zero selects the context
+	// of the last prepared session. Since protocol version 6.
+	ContextID uint64 `protobuf:"varint,7,opt,name=context_id,json=contextId,proto3" json:"context_id,omitempty"`
+}
+
+func (m *EnclavePrepareTxRequest) Reset()         { *m = EnclavePrepareTxRequest{} }
+func (m *EnclavePrepareTxRequest) String() string { return proto.CompactTextString(m) }
+func (*EnclavePrepareTxRequest) ProtoMessage()    {}
+func (*EnclavePrepareTxRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cbc5b5ae02f8afca, []int{6}
+}
+func (m *EnclavePrepareTxRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnclavePrepareTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnclavePrepareTxRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EnclavePrepareTxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnclavePrepareTxRequest.Merge(m, src)
+}
+func (m *EnclavePrepareTxRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnclavePrepareTxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnclavePrepareTxRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnclavePrepareTxRequest proto.InternalMessageInfo
+
+func (m *EnclavePrepareTxRequest) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *EnclavePrepareTxRequest) GetHeader() types.Header {
+	if m != nil {
+		return m.Header
+	}
+	return types.Header{}
+}
+
+func (m *EnclavePrepareTxRequest) GetMsg() EnclaveMessage {
+	if m != nil {
+		return m.Msg
+	}
+	return EnclaveMessage{}
+}
+
+func (m *EnclavePrepareTxRequest) GetEVMConfig() EnclaveEVMConfig {
+	if m != nil {
+		return m.EVMConfig
+	}
+	return EnclaveEVMConfig{}
+}
+
+func (m *EnclavePrepareTxRequest) GetBlockSessionID() uint64 {
+	if m != nil {
+		return m.BlockSessionID
+	}
+	return 0
+}
+
+func (m *EnclavePrepareTxRequest) GetAuthorizations() []SetCodeAuthorization {
+	if m != nil {
+		return m.Authorizations
+	}
+	return nil
+}
+
+func (m *EnclavePrepareTxRequest) GetContextID() uint64 {
+	if m != nil {
+		return m.ContextID
+	}
+	return 0
+}
+
+// EnclavePrepareTxResponse is the response type for the Enclave/PrepareTx RPC method.
+type EnclavePrepareTxResponse struct {
+	// session_id identifies the session in the subsequent calls
+	SessionID uint64 `protobuf:"varint,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+}
+
+func (m *EnclavePrepareTxResponse) Reset()         { *m = EnclavePrepareTxResponse{} }
+func (m *EnclavePrepareTxResponse) String() string { return proto.CompactTextString(m) }
+func (*EnclavePrepareTxResponse) ProtoMessage()    {}
+func (*EnclavePrepareTxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cbc5b5ae02f8afca, []int{7}
+}
+func (m *EnclavePrepareTxResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnclavePrepareTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnclavePrepareTxResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EnclavePrepareTxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnclavePrepareTxResponse.Merge(m, src)
+}
+func (m *EnclavePrepareTxResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnclavePrepareTxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnclavePrepareTxResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnclavePrepareTxResponse proto.InternalMessageInfo
+
+func (m *EnclavePrepareTxResponse) GetSessionID() uint64 {
+	if m != nil {
+		return m.SessionID
+	}
+	return 0
+}
+
+// EnclaveCallRequest is the request type for the Enclave/Call RPC method.
+type EnclaveCallRequest struct {
+	// session_id is the id returned by PrepareTx
+	SessionID uint64 `protobuf:"varint,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// caller is the hex formatted address of the caller
+	Caller string `protobuf:"bytes,2,opt,name=caller,proto3" json:"caller,omitempty"`
+	// addr is the hex formatted address of the called contract
+	Addr string `protobuf:"bytes,3,opt,name=addr,proto3" json:"addr,omitempty"`
+	// input is the call data
+	Input []byte `protobuf:"bytes,4,opt,name=input,proto3" json:"input,omitempty"`
+	// gas is the gas available for the call
+	Gas uint64 `protobuf:"varint,5,opt,name=gas,proto3" json:"gas,omitempty"`
+	// value is the amount transferred to the contract
+	Value *cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=value,proto3,customtype=cosmossdk.io/math.Int" json:"value,omitempty"`
+}
+
+func (m *EnclaveCallRequest) Reset()         { *m = EnclaveCallRequest{} }
+func (m *EnclaveCallRequest) String() string { return proto.CompactTextString(m) }
+func (*EnclaveCallRequest) ProtoMessage()    {}
+func (*EnclaveCallRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cbc5b5ae02f8afca, []int{8}
+}
+func (m *EnclaveCallRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnclaveCallRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnclaveCallRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EnclaveCallRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnclaveCallRequest.Merge(m, src)
+}
+func (m *EnclaveCallRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnclaveCallRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnclaveCallRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnclaveCallRequest proto.InternalMessageInfo
+
+func (m *EnclaveCallRequest) GetSessionID() uint64 {
+	if m != nil {
+		return m.SessionID
+	}
+	return 0
+}
+
+func (m *EnclaveCallRequest) GetCaller() string {
+	if m != nil {
+		return m.Caller
+	}
+	return ""
+}
+
+func (m *EnclaveCallRequest) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *EnclaveCallRequest) GetInput() []byte {
+	if m != nil {
+		return m.Input
+	}
+	return nil
+}
+
+func (m *EnclaveCallRequest) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+// EnclaveCallResponse is the response type for the Enclave/Call RPC method.
+type EnclaveCallResponse struct {
+	// ret is the data returned by the call
+	Ret []byte `protobuf:"bytes,1,opt,name=ret,proto3" json:"ret,omitempty"`
+	// left_over_gas is the gas left after the call
+	LeftOverGas uint64 `protobuf:"varint,2,opt,name=left_over_gas,json=leftOverGas,proto3" json:"left_over_gas,omitempty"`
+	// vm_error is the EVM error, if any
+	VmError string `protobuf:"bytes,3,opt,name=vm_error,json=vmError,proto3" json:"vm_error,omitempty"`
+}
+
+func (m *EnclaveCallResponse) Reset()         { *m = EnclaveCallResponse{} }
+func (m *EnclaveCallResponse) String() string { return proto.CompactTextString(m) }
+func (*EnclaveCallResponse) ProtoMessage()    {}
+func (*EnclaveCallResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cbc5b5ae02f8afca, []int{9}
+}
+func (m *EnclaveCallResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnclaveCallResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnclaveCallResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EnclaveCallResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnclaveCallResponse.Merge(m, src)
+}
+func (m *EnclaveCallResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnclaveCallResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnclaveCallResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnclaveCallResponse proto.InternalMessageInfo
+
+func (m *EnclaveCallResponse) GetRet() []byte {
+	if m != nil {
+		return m.Ret
+	}
+	return nil
+}
+
+func (m *EnclaveCallResponse) GetLeftOverGas() uint64 {
+	if m != nil {
+		return m.LeftOverGas
+	}
+	return 0
+}
+
+func (m *EnclaveCallResponse) GetVmError() string {
+	if m != nil {
+		return m.VmError
+	}
+	return ""
+}
+
+// EnclaveCreateRequest is the request type for the Enclave/Create RPC method.
+type EnclaveCreateRequest struct {
+	// session_id is the id returned by PrepareTx
+	SessionID uint64 `protobuf:"varint,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// caller is the hex formatted address of the creator
+	Caller string `protobuf:"bytes,2,opt,name=caller,proto3" json:"caller,omitempty"`
+	// code is the contract init code
+	Code []byte `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	// gas is the gas available for the creation
+	Gas uint64 `protobuf:"varint,4,opt,name=gas,proto3" json:"gas,omitempty"`
+	// value is the amount transferred to the contract
+	Value *cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=value,proto3,customtype=cosmossdk.io/math.Int" json:"value,omitempty"`
+}
+
+func (m *EnclaveCreateRequest) Reset()         { *m = EnclaveCreateRequest{} }
+func (m *EnclaveCreateRequest) String() string { return proto.CompactTextString(m) }
+func (*EnclaveCreateRequest) ProtoMessage()    {}
+func (*EnclaveCreateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cbc5b5ae02f8afca, []int{10}
+}
+func (m *EnclaveCreateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnclaveCreateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnclaveCreateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EnclaveCreateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnclaveCreateRequest.Merge(m, src)
+}
+func (m *EnclaveCreateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnclaveCreateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnclaveCreateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnclaveCreateRequest proto.InternalMessageInfo
+
+func (m *EnclaveCreateRequest) GetSessionID() uint64 {
+	if m != nil {
+		return m.SessionID
+	}
+	return 0
+}
+
+func (m *EnclaveCreateRequest) GetCaller() string {
+	if m != nil {
+		return m.Caller
+	}
+	return ""
+}
+
+func (m *EnclaveCreateRequest) GetCode() []byte {
+	if m != nil {
+		return m.Code
+	}
+	return nil
+}
+
+func (m *EnclaveCreateRequest) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+// EnclaveCreateResponse is the response type for the Enclave/Create RPC method.
+type EnclaveCreateResponse struct {
+	// ret is the data returned by the init code
+	Ret []byte `protobuf:"bytes,1,opt,name=ret,proto3" json:"ret,omitempty"`
+	// contract_addr is the hex formatted address of the created contract
+	ContractAddr string `protobuf:"bytes,2,opt,name=contract_addr,json=contractAddr,proto3" json:"contract_addr,omitempty"`
+	// left_over_gas is the gas left after the creation
+	LeftOverGas uint64 `protobuf:"varint,3,opt,name=left_over_gas,json=leftOverGas,proto3" json:"left_over_gas,omitempty"`
+	// vm_error is the EVM error, if any
+	VmError string `protobuf:"bytes,4,opt,name=vm_error,json=vmError,proto3" json:"vm_error,omitempty"`
+}
+
+func (m *EnclaveCreateResponse) Reset()         { *m = EnclaveCreateResponse{} }
+func (m *EnclaveCreateResponse) String() string { return proto.CompactTextString(m) }
+func (*EnclaveCreateResponse) ProtoMessage()    {}
+func (*EnclaveCreateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cbc5b5ae02f8afca, []int{11}
+}
+func (m *EnclaveCreateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnclaveCreateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnclaveCreateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EnclaveCreateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnclaveCreateResponse.Merge(m, src)
+}
+func (m *EnclaveCreateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnclaveCreateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnclaveCreateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnclaveCreateResponse proto.InternalMessageInfo
+
+func (m *EnclaveCreateResponse) GetRet() []byte {
+	if m != nil {
+		return m.Ret
+	}
+	return nil
+}
+
+func (m *EnclaveCreateResponse) GetContractAddr() string {
+	if m != nil {
+		return m.ContractAddr
+	}
+	return ""
+}
+
+func (m *EnclaveCreateResponse) GetLeftOverGas() uint64 {
+	if m != nil {
+		return m.LeftOverGas
+	}
+	return 0
+}
+
+func (m *EnclaveCreateResponse) GetVmError() string {
+	if m != nil {
+		return m.VmError
+	}
+	return ""
+}
+
+// EnclaveCommitRequest is the request type for the Enclave/Commit RPC method.
+type EnclaveCommitRequest struct {
+	// session_id is the id returned by PrepareTx
+	SessionID uint64 `protobuf:"varint,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// commit writes the dirty states back to the node when true
+	Commit bool `protobuf:"varint,2,opt,name=commit,proto3" json:"commit,omitempty"`
+}
+
+func (m *EnclaveCommitRequest) Reset()         { *m = EnclaveCommitRequest{} }
+func (m *EnclaveCommitRequest) String() string { return proto.CompactTextString(m) }
+func (*EnclaveCommitRequest) ProtoMessage()    {}
+func (*EnclaveCommitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cbc5b5ae02f8afca, []int{12}
+}
+func (m *EnclaveCommitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnclaveCommitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnclaveCommitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EnclaveCommitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnclaveCommitRequest.Merge(m, src)
+}
+func (m *EnclaveCommitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnclaveCommitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnclaveCommitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnclaveCommitRequest proto.InternalMessageInfo
+
+func (m *EnclaveCommitRequest) GetSessionID() uint64 {
+	if m != nil {
+		return m.SessionID
+	}
+	return 0
+}
+
+func (m *EnclaveCommitRequest) GetCommit() bool {
+	if m != nil {
+		return m.Commit
+	}
+	return false
+}
+
+// EnclaveCommitResponse is the response type for the Enclave/Commit RPC method.
+type EnclaveCommitResponse struct {
+}
+
+func (m *EnclaveCommitResponse) Reset()         { *m = EnclaveCommitResponse{} }
+func (m *EnclaveCommitResponse) String() string { return proto.CompactTextString(m) }
+func (*EnclaveCommitResponse) ProtoMessage()    {}
+func (*EnclaveCommitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cbc5b5ae02f8afca, []int{13}
+}
+func (m *EnclaveCommitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnclaveCommitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnclaveCommitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EnclaveCommitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnclaveCommitResponse.Merge(m, src)
+}
+func (m *EnclaveCommitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnclaveCommitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnclaveCommitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnclaveCommitResponse proto.InternalMessageInfo
+
+// EnclaveStateDBAddBalanceRequest is the request type for the Enclave/StateDBAddBalance RPC method.
+type EnclaveStateDBAddBalanceRequest struct {
+	// session_id is the id returned by PrepareTx
+	SessionID uint64 `protobuf:"varint,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// caller is the hex formatted address of the account to credit
+	Caller string `protobuf:"bytes,2,opt,name=caller,proto3" json:"caller,omitempty"`
+	// msg is the EVM message being executed
+	Msg EnclaveMessage `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg"`
+	// leftover_gas is the gas left after the execution
+	LeftoverGas uint64 `protobuf:"varint,4,opt,name=leftover_gas,json=leftoverGas,proto3" json:"leftover_gas,omitempty"`
+}
+
+func (m *EnclaveStateDBAddBalanceRequest) Reset()         { *m = EnclaveStateDBAddBalanceRequest{} }
+func (m *EnclaveStateDBAddBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*EnclaveStateDBAddBalanceRequest) ProtoMessage()    {}
+func (*EnclaveStateDBAddBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cbc5b5ae02f8afca, []int{14}
+}
+func (m *EnclaveStateDBAddBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnclaveStateDBAddBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnclaveStateDBAddBalanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EnclaveStateDBAddBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnclaveStateDBAddBalanceRequest.Merge(m, src)
+}
+func (m *EnclaveStateDBAddBalanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnclaveStateDBAddBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnclaveStateDBAddBalanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnclaveStateDBAddBalanceRequest proto.InternalMessageInfo
+
+func (m *EnclaveStateDBAddBalanceRequest) GetSessionID() uint64 {
+	if m != nil {
+		return m.SessionID
+	}
+	return 0
+}
+
+func (m *EnclaveStateDBAddBalanceRequest) GetCaller() string {
+	if m != nil {
+		return m.Caller
+	}
+	return ""
+}
+
+func (m *EnclaveStateDBAddBalanceRequest) GetMsg() EnclaveMessage {
+	if m != nil {
+		return m.Msg
+	}
+	return EnclaveMessage{}
+}
+
+func (m *EnclaveStateDBAddBalanceRequest) GetLeftoverGas() uint64 {
+	if m != nil {
+		return m.LeftoverGas
+	}
+	return 0
+}
+
+// EnclaveStateDBAddBalanceResponse is the response type for the Enclave/StateDBAddBalance RPC method.
+type EnclaveStateDBAddBalanceResponse struct {
+}
+
+func (m *EnclaveStateDBAddBalanceResponse) Reset()         { *m = EnclaveStateDBAddBalanceResponse{} }
+func (m *EnclaveStateDBAddBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*EnclaveStateDBAddBalanceResponse) ProtoMessage()    {}
+func (*EnclaveStateDBAddBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cbc5b5ae02f8afca, []int{15}
+}
+func (m *EnclaveStateDBAddBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnclaveStateDBAddBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnclaveStateDBAddBalanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EnclaveStateDBAddBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnclaveStateDBAddBalanceResponse.Merge(m, src)
+}
+func (m *EnclaveStateDBAddBalanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnclaveStateDBAddBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnclaveStateDBAddBalanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnclaveStateDBAddBalanceResponse proto.InternalMessageInfo
+
+// EnclaveStateDBSubBalanceRequest is the request type for the Enclave/StateDBSubBalance RPC method.
+type EnclaveStateDBSubBalanceRequest struct {
+	// session_id is the id returned by PrepareTx
+	SessionID uint64 `protobuf:"varint,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// caller is the hex formatted address of the account to debit
+	Caller string `protobuf:"bytes,2,opt,name=caller,proto3" json:"caller,omitempty"`
+	// msg is the EVM message being executed
+	Msg EnclaveMessage `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg"`
+}
+
+func (m *EnclaveStateDBSubBalanceRequest) Reset()         { *m = EnclaveStateDBSubBalanceRequest{} }
+func (m *EnclaveStateDBSubBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*EnclaveStateDBSubBalanceRequest) ProtoMessage()    {}
+func (*EnclaveStateDBSubBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cbc5b5ae02f8afca, []int{16}
+}
+func (m *EnclaveStateDBSubBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnclaveStateDBSubBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnclaveStateDBSubBalanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EnclaveStateDBSubBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnclaveStateDBSubBalanceRequest.Merge(m, src)
+}
+func (m *EnclaveStateDBSubBalanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnclaveStateDBSubBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnclaveStateDBSubBalanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnclaveStateDBSubBalanceRequest proto.InternalMessageInfo
+
+func (m *EnclaveStateDBSubBalanceRequest) GetSessionID() uint64 {
+	if m != nil {
+		return m.SessionID
+	}
+	return 0
+}
+
+func (m *EnclaveStateDBSubBalanceRequest) GetCaller() string {
+	if m != nil {
+		return m.Caller
+	}
+	return ""
+}
+
+func (m *EnclaveStateDBSubBalanceRequest) GetMsg() EnclaveMessage {
+	if m != nil {
+		return m.Msg
+	}
+	return EnclaveMessage{}
+}
+
+// EnclaveStateDBSubBalanceResponse is the response type for the Enclave/StateDBSubBalance RPC method.
+type EnclaveStateDBSubBalanceResponse struct {
+}
+
+func (m *EnclaveStateDBSubBalanceResponse) Reset()         { *m = EnclaveStateDBSubBalanceResponse{} }
+func (m *EnclaveStateDBSubBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*EnclaveStateDBSubBalanceResponse) ProtoMessage()    {}
+func (*EnclaveStateDBSubBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cbc5b5ae02f8afca, []int{17}
+}
+func (m *EnclaveStateDBSubBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnclaveStateDBSubBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnclaveStateDBSubBalanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EnclaveStateDBSubBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnclaveStateDBSubBalanceResponse.Merge(m, src)
+}
+func (m *EnclaveStateDBSubBalanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnclaveStateDBSubBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnclaveStateDBSubBalanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnclaveStateDBSubBalanceResponse proto.InternalMessageInfo
+
+// EnclaveStateDBSetNonceRequest is the request type for the Enclave/StateDBSetNonce RPC method.
+type EnclaveStateDBSetNonceRequest struct {
+	// session_id is the id returned by PrepareTx
+	SessionID uint64 `protobuf:"varint,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// caller is the hex formatted address of the account
+	Caller string `protobuf:"bytes,2,opt,name=caller,proto3" json:"caller,omitempty"`
+	// nonce is the new nonce of the account
+	Nonce uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *EnclaveStateDBSetNonceRequest) Reset()         { *m = EnclaveStateDBSetNonceRequest{} }
+func (m *EnclaveStateDBSetNonceRequest) String() string { return proto.CompactTextString(m) }
+func (*EnclaveStateDBSetNonceRequest) ProtoMessage()    {}
+func (*EnclaveStateDBSetNonceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cbc5b5ae02f8afca, []int{18}
+}
+func (m *EnclaveStateDBSetNonceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnclaveStateDBSetNonceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnclaveStateDBSetNonceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EnclaveStateDBSetNonceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnclaveStateDBSetNonceRequest.Merge(m, src)
+}
+func (m *EnclaveStateDBSetNonceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnclaveStateDBSetNonceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnclaveStateDBSetNonceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnclaveStateDBSetNonceRequest proto.InternalMessageInfo
+
+func (m *EnclaveStateDBSetNonceRequest) GetSessionID() uint64 {
+	if m != nil {
+		return m.SessionID
+	}
+	return 0
+}
+
+func (m *EnclaveStateDBSetNonceRequest) GetCaller() string {
+	if m != nil {
+		return m.Caller
+	}
+	return ""
+}
+
+func (m *EnclaveStateDBSetNonceRequest) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+// EnclaveStateDBSetNonceResponse is the response type for the Enclave/StateDBSetNonce RPC method.
+type EnclaveStateDBSetNonceResponse struct {
+}
+
+func (m *EnclaveStateDBSetNonceResponse) Reset()         { *m = EnclaveStateDBSetNonceResponse{} }
+func (m *EnclaveStateDBSetNonceResponse) String() string { return proto.CompactTextString(m) }
+func (*EnclaveStateDBSetNonceResponse) ProtoMessage()    {}
+func (*EnclaveStateDBSetNonceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cbc5b5ae02f8afca, []int{19}
+}
+func (m *EnclaveStateDBSetNonceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnclaveStateDBSetNonceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnclaveStateDBSetNonceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EnclaveStateDBSetNonceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnclaveStateDBSetNonceResponse.Merge(m, src)
+}
+func (m *EnclaveStateDBSetNonceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnclaveStateDBSetNonceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnclaveStateDBSetNonceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnclaveStateDBSetNonceResponse proto.InternalMessageInfo
+
+// EnclaveStateDBIncreaseNonceRequest is the request type for the Enclave/StateDBIncreaseNonce RPC method.
+type EnclaveStateDBIncreaseNonceRequest struct {
+	// session_id is the id returned by PrepareTx
+	SessionID uint64 `protobuf:"varint,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// caller is the hex formatted address of the account
+	Caller string `protobuf:"bytes,2,opt,name=caller,proto3" json:"caller,omitempty"`
+	// msg is the EVM message being executed
+	Msg EnclaveMessage `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg"`
+}
+
+func (m *EnclaveStateDBIncreaseNonceRequest) Reset()         { *m = EnclaveStateDBIncreaseNonceRequest{} }
+func (m *EnclaveStateDBIncreaseNonceRequest) String() string { return proto.CompactTextString(m) }
+func (*EnclaveStateDBIncreaseNonceRequest) ProtoMessage()    {}
+func (*EnclaveStateDBIncreaseNonceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cbc5b5ae02f8afca, []int{20}
+}
+func (m *EnclaveStateDBIncreaseNonceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnclaveStateDBIncreaseNonceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnclaveStateDBIncreaseNonceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EnclaveStateDBIncreaseNonceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnclaveStateDBIncreaseNonceRequest.Merge(m, src)
+}
+func (m *EnclaveStateDBIncreaseNonceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnclaveStateDBIncreaseNonceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnclaveStateDBIncreaseNonceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnclaveStateDBIncreaseNonceRequest proto.InternalMessageInfo
+
+func (m *EnclaveStateDBIncreaseNonceRequest) GetSessionID() uint64 {
+	if m != nil {
+		return m.SessionID
+	}
+	return 0
+}
+
+func (m *EnclaveStateDBIncreaseNonceRequest) GetCaller() string {
+	if m != nil {
+		return m.Caller
+	}
+	return ""
+}
+
+func (m *EnclaveStateDBIncreaseNonceRequest) GetMsg() EnclaveMessage {
+	if m != nil {
+		return m.Msg
+	}
+	return EnclaveMessage{}
+}
+
+// EnclaveStateDBIncreaseNonceResponse is the response type for the Enclave/StateDBIncreaseNonce RPC method.
+type EnclaveStateDBIncreaseNonceResponse struct {
+}
+
+func (m *EnclaveStateDBIncreaseNonceResponse) Reset()         { *m = EnclaveStateDBIncreaseNonceResponse{} }
+func (m *EnclaveStateDBIncreaseNonceResponse) String() string { return proto.CompactTextString(m) }
+func (*EnclaveStateDBIncreaseNonceResponse) ProtoMessage()    {}
+func (*EnclaveStateDBIncreaseNonceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cbc5b5ae02f8afca, []int{21}
+}
+func (m *EnclaveStateDBIncreaseNonceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnclaveStateDBIncreaseNonceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnclaveStateDBIncreaseNonceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EnclaveStateDBIncreaseNonceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnclaveStateDBIncreaseNonceResponse.Merge(m, src)
+}
+func (m *EnclaveStateDBIncreaseNonceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnclaveStateDBIncreaseNonceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnclaveStateDBIncreaseNonceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnclaveStateDBIncreaseNonceResponse proto.InternalMessageInfo
+
+// EnclaveStateDBPrepareRequest is the request type for the Enclave/StateDBPrepare RPC method.
+type EnclaveStateDBPrepareRequest struct {
+	// session_id is the id returned by PrepareTx
+	SessionID uint64 `protobuf:"varint,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// msg is the EVM message being executed
+	Msg EnclaveMessage `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg"`
+	// rules are the fork rules active for the block
+	Rules EnclaveRules `protobuf:"bytes,3,opt,name=rules,proto3" json:"rules"`
+}
+
+func (m *EnclaveStateDBPrepareRequest) Reset()         { *m = EnclaveStateDBPrepareRequest{} }
+func (m *EnclaveStateDBPrepareRequest) String() string { return proto.CompactTextString(m) }
+func (*EnclaveStateDBPrepareRequest) ProtoMessage()    {}
+func (*EnclaveStateDBPrepareRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cbc5b5ae02f8afca, []int{22}
+}
+func (m *EnclaveStateDBPrepareRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnclaveStateDBPrepareRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnclaveStateDBPrepareRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EnclaveStateDBPrepareRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnclaveStateDBPrepareRequest.Merge(m, src)
+}
+func (m *EnclaveStateDBPrepareRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnclaveStateDBPrepareRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnclaveStateDBPrepareRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnclaveStateDBPrepareRequest proto.InternalMessageInfo
+
+func (m *EnclaveStateDBPrepareRequest) GetSessionID() uint64 {
+	if m != nil {
+		return m.SessionID
+	}
+	return 0
+}
+
+func (m *EnclaveStateDBPrepareRequest) GetMsg() EnclaveMessage {
+	if m != nil {
+		return m.Msg
+	}
+	return EnclaveMessage{}
+}
+
+func (m *EnclaveStateDBPrepareRequest) GetRules() EnclaveRules {
+	if m != nil {
+		return m.Rules
+	}
+	return EnclaveRules{}
+}
+
+// EnclaveStateDBPrepareResponse is the response type for the Enclave/StateDBPrepare RPC method.
+type EnclaveStateDBPrepareResponse struct {
+}
+
+func (m *EnclaveStateDBPrepareResponse) Reset()         { *m = EnclaveStateDBPrepareResponse{} }
+func (m *EnclaveStateDBPrepareResponse) String() string { return proto.CompactTextString(m) }
+func (*EnclaveStateDBPrepareResponse) ProtoMessage()    {}
+func (*EnclaveStateDBPrepareResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cbc5b5ae02f8afca, []int{23}
+}
+func (m *EnclaveStateDBPrepareResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnclaveStateDBPrepareResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnclaveStateDBPrepareResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EnclaveStateDBPrepareResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnclaveStateDBPrepareResponse.Merge(m, src)
+}
+func (m *EnclaveStateDBPrepareResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnclaveStateDBPrepareResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnclaveStateDBPrepareResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnclaveStateDBPrepareResponse proto.InternalMessageInfo
+
+// EnclaveStateDBGetRefundRequest is the request type for the Enclave/StateDBGetRefund RPC method.
+type EnclaveStateDBGetRefundRequest struct {
+	// session_id is the id returned by PrepareTx
+	SessionID uint64 `protobuf:"varint,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+}
+
+func (m *EnclaveStateDBGetRefundRequest) Reset()         { *m = EnclaveStateDBGetRefundRequest{} }
+func (m *EnclaveStateDBGetRefundRequest) String() string { return proto.CompactTextString(m) }
+func (*EnclaveStateDBGetRefundRequest) ProtoMessage()    {}
+func (*EnclaveStateDBGetRefundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cbc5b5ae02f8afca, []int{24}
+}
+func (m *EnclaveStateDBGetRefundRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnclaveStateDBGetRefundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnclaveStateDBGetRefundRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EnclaveStateDBGetRefundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnclaveStateDBGetRefundRequest.Merge(m, src)
+}
+func (m *EnclaveStateDBGetRefundRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnclaveStateDBGetRefundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnclaveStateDBGetRefundRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnclaveStateDBGetRefundRequest proto.InternalMessageInfo
+
+func (m *EnclaveStateDBGetRefundRequest) GetSessionID() uint64 {
+	if m != nil {
+		return m.SessionID
+	}
+	return 0
+}
+
+// EnclaveStateDBGetRefundResponse is the response type for the Enclave/StateDBGetRefund RPC method.
+type EnclaveStateDBGetRefundResponse struct {
+	// refund is the gas refund counter
+	Refund uint64 `protobuf:"varint,1,opt,name=refund,proto3" json:"refund,omitempty"`
+}
+
+func (m *EnclaveStateDBGetRefundResponse) Reset()         { *m = EnclaveStateDBGetRefundResponse{} }
+func (m *EnclaveStateDBGetRefundResponse) String() string { return proto.CompactTextString(m) }
+func (*EnclaveStateDBGetRefundResponse) ProtoMessage()    {}
+func (*EnclaveStateDBGetRefundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cbc5b5ae02f8afca, []int{25}
+}
+func (m *EnclaveStateDBGetRefundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnclaveStateDBGetRefundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnclaveStateDBGetRefundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EnclaveStateDBGetRefundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnclaveStateDBGetRefundResponse.Merge(m, src)
+}
+func (m *EnclaveStateDBGetRefundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnclaveStateDBGetRefundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnclaveStateDBGetRefundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnclaveStateDBGetRefundResponse proto.InternalMessageInfo
+
+func (m *EnclaveStateDBGetRefundResponse) GetRefund() uint64 {
+	if m != nil {
+		return m.Refund
+	}
+	return 0
+}
+
+// EnclaveStateDBGetLogsRequest is the request type for the Enclave/StateDBGetLogs RPC method.
+type EnclaveStateDBGetLogsRequest struct {
+	// session_id is the id returned by PrepareTx
+	SessionID uint64 `protobuf:"varint,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+}
+
+func (m *EnclaveStateDBGetLogsRequest) Reset()         { *m = EnclaveStateDBGetLogsRequest{} }
+func (m *EnclaveStateDBGetLogsRequest) String() string { return proto.CompactTextString(m) }
+func (*EnclaveStateDBGetLogsRequest) ProtoMessage()    {}
+func (*EnclaveStateDBGetLogsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cbc5b5ae02f8afca, []int{26}
+}
+func (m *EnclaveStateDBGetLogsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnclaveStateDBGetLogsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnclaveStateDBGetLogsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EnclaveStateDBGetLogsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnclaveStateDBGetLogsRequest.Merge(m, src)
+}
+func (m *EnclaveStateDBGetLogsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnclaveStateDBGetLogsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnclaveStateDBGetLogsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnclaveStateDBGetLogsRequest proto.InternalMessageInfo
+
+func (m *EnclaveStateDBGetLogsRequest) GetSessionID() uint64 {
+	if m != nil {
+		return m.SessionID
+	}
+	return 0
+}
+
+// EnclaveStateDBGetLogsResponse is the response type for the Enclave/StateDBGetLogs RPC method.
+type EnclaveStateDBGetLogsResponse struct {
+	// logs are the logs emitted during the session
+	Logs []*Log `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+}
+
+func (m *EnclaveStateDBGetLogsResponse) Reset()         { *m = EnclaveStateDBGetLogsResponse{} }
+func (m *EnclaveStateDBGetLogsResponse) String() string { return proto.CompactTextString(m) }
+func (*EnclaveStateDBGetLogsResponse) ProtoMessage()    {}
+func (*EnclaveStateDBGetLogsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cbc5b5ae02f8afca, []int{27}
+}
+func (m *EnclaveStateDBGetLogsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnclaveStateDBGetLogsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnclaveStateDBGetLogsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EnclaveStateDBGetLogsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnclaveStateDBGetLogsResponse.Merge(m, src)
+}
+func (m *EnclaveStateDBGetLogsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnclaveStateDBGetLogsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnclaveStateDBGetLogsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnclaveStateDBGetLogsResponse proto.InternalMessageInfo
+
+func (m *EnclaveStateDBGetLogsResponse) GetLogs() []*Log {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
+// EnclaveEndSessionRequest is the request type for the Enclave/EndSession RPC method.
+type EnclaveEndSessionRequest struct {
+	// session_id is the id returned by PrepareTx
+	SessionID uint64 `protobuf:"varint,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+}
+
+func (m *EnclaveEndSessionRequest) Reset()         { *m = EnclaveEndSessionRequest{} }
+func (m *EnclaveEndSessionRequest) String() string { return proto.CompactTextString(m) }
+func (*EnclaveEndSessionRequest) ProtoMessage()    {}
+func (*EnclaveEndSessionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cbc5b5ae02f8afca, []int{28}
+}
+func (m *EnclaveEndSessionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnclaveEndSessionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnclaveEndSessionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EnclaveEndSessionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnclaveEndSessionRequest.Merge(m, src)
+}
+func (m *EnclaveEndSessionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnclaveEndSessionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnclaveEndSessionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnclaveEndSessionRequest proto.InternalMessageInfo
+
+func (m *EnclaveEndSessionRequest) GetSessionID() uint64 {
+	if m != nil {
+		return m.SessionID
+	}
+	return 0
+}
+
+// EnclaveEndSessionResponse is the response type for the Enclave/EndSession RPC method.
+type EnclaveEndSessionResponse struct {
+}
+
+func (m *EnclaveEndSessionResponse) Reset()         { *m = EnclaveEndSessionResponse{} }
+func (m *EnclaveEndSessionResponse) String() string { return proto.CompactTextString(m) }
+func (*EnclaveEndSessionResponse) ProtoMessage()    {}
+func (*EnclaveEndSessionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cbc5b5ae02f8afca, []int{29}
+}
+func (m *EnclaveEndSessionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnclaveEndSessionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnclaveEndSessionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EnclaveEndSessionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnclaveEndSessionResponse.Merge(m, src)
+}
+func (m *EnclaveEndSessionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnclaveEndSessionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnclaveEndSessionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnclaveEndSessionResponse proto.InternalMessageInfo
+
+// EnclaveBeginBlockRequest is the request type for the Enclave/BeginBlock RPC method.
+type EnclaveBeginBlockRequest struct {
+	// header is the header of the block being executed
+	Header types.Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header"`
+}
+
+func (m *EnclaveBeginBlockRequest) Reset()         { *m = EnclaveBeginBlockRequest{} }
+func (m *EnclaveBeginBlockRequest) String() string { return proto.CompactTextString(m) }
+func (*EnclaveBeginBlockRequest) ProtoMessage()    {}
+func (*EnclaveBeginBlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cbc5b5ae02f8afca, []int{30}
+}
+func (m *EnclaveBeginBlockRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnclaveBeginBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnclaveBeginBlockRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EnclaveBeginBlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnclaveBeginBlockRequest.Merge(m, src)
+}
+func (m *EnclaveBeginBlockRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnclaveBeginBlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnclaveBeginBlockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnclaveBeginBlockRequest proto.InternalMessageInfo
+
+func (m *EnclaveBeginBlockRequest) GetHeader() types.Header {
+	if m != nil {
+		return m.Header
+	}
+	return types.Header{}
+}
+
+// EnclaveBeginBlockResponse is the response type for the Enclave/BeginBlock RPC method.
+type EnclaveBeginBlockResponse struct {
+	// block_session_id identifies the block session in the subsequent calls
+	BlockSessionID uint64 `protobuf:"varint,1,opt,name=block_session_id,json=blockSessionId,proto3" json:"block_session_id,omitempty"`
+}
+
+func (m *EnclaveBeginBlockResponse) Reset()         { *m = EnclaveBeginBlockResponse{} }
+func (m *EnclaveBeginBlockResponse) String() string { return proto.CompactTextString(m) }
+func (*EnclaveBeginBlockResponse) ProtoMessage()    {}
+func (*EnclaveBeginBlockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cbc5b5ae02f8afca, []int{31}
+}
+func (m *EnclaveBeginBlockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnclaveBeginBlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnclaveBeginBlockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EnclaveBeginBlockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnclaveBeginBlockResponse.Merge(m, src)
+}
+func (m *EnclaveBeginBlockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnclaveBeginBlockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnclaveBeginBlockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnclaveBeginBlockResponse proto.InternalMessageInfo
+
+func (m *EnclaveBeginBlockResponse) GetBlockSessionID() uint64 {
+	if m != nil {
+		return m.BlockSessionID
+	}
+	return 0
+}
+
+// EnclaveSnapshotRequest is the request type for the Enclave/Snapshot RPC method.
+type EnclaveSnapshotRequest struct {
+	// block_session_id is the id returned by BeginBlock
+	BlockSessionID uint64 `protobuf:"varint,1,opt,name=block_session_id,json=blockSessionId,proto3" json:"block_session_id,omitempty"`
+}
+
+func (m *EnclaveSnapshotRequest) Reset()         { *m = EnclaveSnapshotRequest{} }
+func (m *EnclaveSnapshotRequest) String() string { return proto.CompactTextString(m) }
+func (*EnclaveSnapshotRequest) ProtoMessage()    {}
+func (*EnclaveSnapshotRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cbc5b5ae02f8afca, []int{32}
+}
+func (m *EnclaveSnapshotRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnclaveSnapshotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnclaveSnapshotRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EnclaveSnapshotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnclaveSnapshotRequest.Merge(m, src)
+}
+func (m *EnclaveSnapshotRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnclaveSnapshotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnclaveSnapshotRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnclaveSnapshotRequest proto.InternalMessageInfo
+
+func (m *EnclaveSnapshotRequest) GetBlockSessionID() uint64 {
+	if m != nil {
+		return m.BlockSessionID
+	}
+	return 0
+}
+
+// EnclaveSnapshotResponse is the response type for the Enclave/Snapshot RPC method.
+type EnclaveSnapshotResponse struct {
+	// snapshot_id identifies the snapshot in RevertToSnapshot
+	SnapshotID uint64 `protobuf:"varint,1,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
+}
+
+func (m *EnclaveSnapshotResponse) Reset()         { *m = EnclaveSnapshotResponse{} }
+func (m *EnclaveSnapshotResponse) String() string { return proto.CompactTextString(m) }
+func (*EnclaveSnapshotResponse) ProtoMessage()    {}
+func (*EnclaveSnapshotResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cbc5b5ae02f8afca, []int{33}
+}
+func (m *EnclaveSnapshotResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnclaveSnapshotResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnclaveSnapshotResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EnclaveSnapshotResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnclaveSnapshotResponse.Merge(m, src)
+}
+func (m *EnclaveSnapshotResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnclaveSnapshotResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnclaveSnapshotResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnclaveSnapshotResponse proto.InternalMessageInfo
+
+func (m *EnclaveSnapshotResponse) GetSnapshotID() uint64 {
+	if m != nil {
+		return m.SnapshotID
+	}
+	return 0
+}
+
+// EnclaveRevertToSnapshotRequest is the request type for the Enclave/RevertToSnapshot RPC method.
+type EnclaveRevertToSnapshotRequest struct {
+	// block_session_id is the id returned by BeginBlock
+	BlockSessionID uint64 `protobuf:"varint,1,opt,name=block_session_id,json=blockSessionId,proto3" json:"block_session_id,omitempty"`
+	// snapshot_id is the id returned by Snapshot
+	SnapshotID uint64 `protobuf:"varint,2,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
+}
+
+func (m *EnclaveRevertToSnapshotRequest) Reset()         { *m = EnclaveRevertToSnapshotRequest{} }
+func (m *EnclaveRevertToSnapshotRequest) String() string { return proto.CompactTextString(m) }
+func (*EnclaveRevertToSnapshotRequest) ProtoMessage()    {}
+func (*EnclaveRevertToSnapshotRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cbc5b5ae02f8afca, []int{34}
+}
+func (m *EnclaveRevertToSnapshotRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnclaveRevertToSnapshotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnclaveRevertToSnapshotRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EnclaveRevertToSnapshotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnclaveRevertToSnapshotRequest.Merge(m, src)
+}
+func (m *EnclaveRevertToSnapshotRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnclaveRevertToSnapshotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnclaveRevertToSnapshotRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnclaveRevertToSnapshotRequest proto.InternalMessageInfo
+
+func (m *EnclaveRevertToSnapshotRequest) GetBlockSessionID() uint64 {
+	if m != nil {
+		return m.BlockSessionID
+	}
+	return 0
+}
+
+func (m *EnclaveRevertToSnapshotRequest) GetSnapshotID() uint64 {
+	if m != nil {
+		return m.SnapshotID
+	}
+	return 0
+}
+
+// EnclaveRevertToSnapshotResponse is the response type for the Enclave/RevertToSnapshot RPC method.
+type EnclaveRevertToSnapshotResponse struct {
+}
+
+func (m *EnclaveRevertToSnapshotResponse) Reset()         { *m = EnclaveRevertToSnapshotResponse{} }
+func (m *EnclaveRevertToSnapshotResponse) String() string { return proto.CompactTextString(m) }
+func (*EnclaveRevertToSnapshotResponse) ProtoMessage()    {}
+func (*EnclaveRevertToSnapshotResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cbc5b5ae02f8afca, []int{35}
+}
+func (m *EnclaveRevertToSnapshotResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnclaveRevertToSnapshotResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnclaveRevertToSnapshotResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EnclaveRevertToSnapshotResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnclaveRevertToSnapshotResponse.Merge(m, src)
+}
+func (m *EnclaveRevertToSnapshotResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnclaveRevertToSnapshotResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnclaveRevertToSnapshotResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnclaveRevertToSnapshotResponse proto.InternalMessageInfo
+
+// EnclaveEndBlockRequest is the request type for the Enclave/EndBlock RPC method.
+type EnclaveEndBlockRequest struct {
+	// block_session_id is the id returned by BeginBlock
+	BlockSessionID uint64 `protobuf:"varint,1,opt,name=block_session_id,json=blockSessionId,proto3" json:"block_session_id,omitempty"`
+	// commit writes the block session state back to the node when true, it's
+	// discarded otherwise
+	Commit bool `protobuf:"varint,2,opt,name=commit,proto3" json:"commit,omitempty"`
+}
+
+func (m *EnclaveEndBlockRequest) Reset()         { *m = EnclaveEndBlockRequest{} }
+func (m *EnclaveEndBlockRequest) String() string { return proto.CompactTextString(m) }
+func (*EnclaveEndBlockRequest) ProtoMessage()    {}
+func (*EnclaveEndBlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cbc5b5ae02f8afca, []int{36}
+}
+func (m *EnclaveEndBlockRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnclaveEndBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnclaveEndBlockRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EnclaveEndBlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnclaveEndBlockRequest.Merge(m, src)
+}
+func (m *EnclaveEndBlockRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnclaveEndBlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnclaveEndBlockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnclaveEndBlockRequest proto.InternalMessageInfo
+
+func (m *EnclaveEndBlockRequest) GetBlockSessionID() uint64 {
+	if m != nil {
+		return m.BlockSessionID
+	}
+	return 0
+}
+
+func (m *EnclaveEndBlockRequest) GetCommit() bool {
+	if m != nil {
+		return m.Commit
+	}
+	return false
+}
+
+// EnclaveEndBlockResponse is the response type for the Enclave/EndBlock RPC method.
+type EnclaveEndBlockResponse struct {
+}
+
+func (m *EnclaveEndBlockResponse) Reset()         { *m = EnclaveEndBlockResponse{} }
+func (m *EnclaveEndBlockResponse) String() string { return proto.CompactTextString(m) }
+func (*EnclaveEndBlockResponse) ProtoMessage()    {}
+func (*EnclaveEndBlockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cbc5b5ae02f8afca, []int{37}
+}
+func (m *EnclaveEndBlockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnclaveEndBlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnclaveEndBlockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EnclaveEndBlockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnclaveEndBlockResponse.Merge(m, src)
+}
+func (m *EnclaveEndBlockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnclaveEndBlockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnclaveEndBlockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnclaveEndBlockResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*EnclaveHandshakeRequest)(nil), "ethermint.evm.v1.EnclaveHandshakeRequest")
+	proto.RegisterType((*EnclaveHandshakeResponse)(nil), "ethermint.evm.v1.EnclaveHandshakeResponse")
+	proto.RegisterType((*EnclaveMessage)(nil), "ethermint.evm.v1.EnclaveMessage")
+	proto.RegisterType((*EnclaveTxConfig)(nil), "ethermint.evm.v1.EnclaveTxConfig")
+	proto.RegisterType((*EnclaveEVMConfig)(nil), "ethermint.evm.v1.EnclaveEVMConfig")
+	proto.RegisterType((*EnclaveRules)(nil), "ethermint.evm.v1.EnclaveRules")
+	proto.RegisterType((*EnclavePrepareTxRequest)(nil), "ethermint.evm.v1.EnclavePrepareTxRequest")
+	proto.RegisterType((*EnclavePrepareTxResponse)(nil), "ethermint.evm.v1.EnclavePrepareTxResponse")
+	proto.RegisterType((*EnclaveCallRequest)(nil), "ethermint.evm.v1.EnclaveCallRequest")
+	proto.RegisterType((*EnclaveCallResponse)(nil), "ethermint.evm.v1.EnclaveCallResponse")
+	proto.RegisterType((*EnclaveCreateRequest)(nil), "ethermint.evm.v1.EnclaveCreateRequest")
+	proto.RegisterType((*EnclaveCreateResponse)(nil), "ethermint.evm.v1.EnclaveCreateResponse")
+	proto.RegisterType((*EnclaveCommitRequest)(nil), "ethermint.evm.v1.EnclaveCommitRequest")
+	proto.RegisterType((*EnclaveCommitResponse)(nil), "ethermint.evm.v1.EnclaveCommitResponse")
+	proto.RegisterType((*EnclaveStateDBAddBalanceRequest)(nil), "ethermint.evm.v1.EnclaveStateDBAddBalanceRequest")
+	proto.RegisterType((*EnclaveStateDBAddBalanceResponse)(nil), "ethermint.evm.v1.EnclaveStateDBAddBalanceResponse")
+	proto.RegisterType((*EnclaveStateDBSubBalanceRequest)(nil), "ethermint.evm.v1.EnclaveStateDBSubBalanceRequest")
+	proto.RegisterType((*EnclaveStateDBSubBalanceResponse)(nil), "ethermint.evm.v1.EnclaveStateDBSubBalanceResponse")
+	proto.RegisterType((*EnclaveStateDBSetNonceRequest)(nil), "ethermint.evm.v1.EnclaveStateDBSetNonceRequest")
+	proto.RegisterType((*EnclaveStateDBSetNonceResponse)(nil), "ethermint.evm.v1.EnclaveStateDBSetNonceResponse")
+	proto.RegisterType((*EnclaveStateDBIncreaseNonceRequest)(nil), "ethermint.evm.v1.EnclaveStateDBIncreaseNonceRequest")
+	proto.RegisterType((*EnclaveStateDBIncreaseNonceResponse)(nil), "ethermint.evm.v1.EnclaveStateDBIncreaseNonceResponse")
+	proto.RegisterType((*EnclaveStateDBPrepareRequest)(nil), "ethermint.evm.v1.EnclaveStateDBPrepareRequest")
+	proto.RegisterType((*EnclaveStateDBPrepareResponse)(nil), "ethermint.evm.v1.EnclaveStateDBPrepareResponse")
+	proto.RegisterType((*EnclaveStateDBGetRefundRequest)(nil), "ethermint.evm.v1.EnclaveStateDBGetRefundRequest")
+	proto.RegisterType((*EnclaveStateDBGetRefundResponse)(nil), "ethermint.evm.v1.EnclaveStateDBGetRefundResponse")
+	proto.RegisterType((*EnclaveStateDBGetLogsRequest)(nil), "ethermint.evm.v1.EnclaveStateDBGetLogsRequest")
+	proto.RegisterType((*EnclaveStateDBGetLogsResponse)(nil), "ethermint.evm.v1.EnclaveStateDBGetLogsResponse")
+	proto.RegisterType((*EnclaveEndSessionRequest)(nil), "ethermint.evm.v1.EnclaveEndSessionRequest")
+	proto.RegisterType((*EnclaveEndSessionResponse)(nil), "ethermint.evm.v1.EnclaveEndSessionResponse")
+	proto.RegisterType((*EnclaveBeginBlockRequest)(nil), "ethermint.evm.v1.EnclaveBeginBlockRequest")
+	proto.RegisterType((*EnclaveBeginBlockResponse)(nil), "ethermint.evm.v1.EnclaveBeginBlockResponse")
+	proto.RegisterType((*EnclaveSnapshotRequest)(nil), "ethermint.evm.v1.EnclaveSnapshotRequest")
+	proto.RegisterType((*EnclaveSnapshotResponse)(nil), "ethermint.evm.v1.EnclaveSnapshotResponse")
+	proto.RegisterType((*EnclaveRevertToSnapshotRequest)(nil), "ethermint.evm.v1.EnclaveRevertToSnapshotRequest")
+	proto.RegisterType((*EnclaveRevertToSnapshotResponse)(nil), "ethermint.evm.v1.EnclaveRevertToSnapshotResponse")
+	proto.RegisterType((*EnclaveEndBlockRequest)(nil), "ethermint.evm.v1.EnclaveEndBlockRequest")
+	proto.RegisterType((*EnclaveEndBlockResponse)(nil), "ethermint.evm.v1.EnclaveEndBlockResponse")
+}
+
+func init() { proto.RegisterFile("ethermint/evm/v1/enclave.proto", fileDescriptor_cbc5b5ae02f8afca) }
+
+var fileDescriptor_cbc5b5ae02f8afca = []byte{
+	// 2211 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x4f, 0x6f, 0x1b, 0xc7,
+	0x15, 0xf7, 0x5a, 0x94, 0x44, 0x3e, 0x52, 0xb2, 0x3c, 0xf1, 0x9f, 0x15, 0x6d, 0x8b, 0xf2, 0x3a,
+	0xb1, 0xe5, 0x38, 0x20, 0x2d, 0x35, 0x0a, 0x9c, 0xa0, 0x45, 0x6b, 0x4a, 0xb2, 0xcd, 0x40, 0x76,
+	0x85, 0x95, 0x60, 0x20, 0x45, 0x81, 0xc5, 0x70, 0x77, 0x44, 0x0e, 0xb4, 0xbb, 0xc3, 0xec, 0x0c,
+	0x59, 0xc6, 0x3d, 0x15, 0xbd, 0xf4, 0x52, 0xb4, 0x1f, 0xa0, 0x87, 0x14, 0xe8, 0x37, 0xe8, 0xa5,
+	0x40, 0x7b, 0x2a, 0x0a, 0x34, 0xc7, 0x9c, 0x8a, 0xa2, 0x07, 0xa1, 0x90, 0xbf, 0x48, 0x31, 0xb3,
+	0xb3, 0xfc, 0xb7, 0xa2, 0x48, 0x29, 0x2e, 0x90, 0x4b, 0xb2, 0xf3, 0xde, 0xef, 0xfd, 0x9d, 0x37,
+	0x33, 0x3f, 0x8b, 0xb0, 0x42, 0x44, 0x93, 0x44, 0x01, 0x0d, 0x45, 0x85, 0x74, 0x82, 0x4a, 0x67,
+	0xbd, 0x42, 0x42, 0xd7, 0xc7, 0x1d, 0x52, 0x6e, 0x45, 0x4c, 0x30, 0xb4, 0xd4, 0xd3, 0x97, 0x49,
+	0x27, 0x28, 0x77, 0xd6, 0x8b, 0xf7, 0x52, 0x16, 0xd8, 0x75, 0x09, 0xe7, 0x8e, 0x68, 0xb7, 0x7c,
+	0x6d, 0x76, 0x0a, 0xc8, 0x6d, 0x62, 0x1a, 0x3a, 0x2e, 0x0b, 0x0f, 0x69, 0x43, 0x83, 0x8a, 0x29,
+	0x90, 0xcf, 0x12, 0xdd, 0x72, 0x4a, 0x27, 0xba, 0x5a, 0x75, 0xad, 0xc1, 0x1a, 0x4c, 0x7d, 0x56,
+	0xe4, 0x97, 0x96, 0xde, 0x16, 0x24, 0xf4, 0xb4, 0x85, 0xf8, 0xaa, 0x45, 0x78, 0xfc, 0xdf, 0x58,
+	0x6b, 0x75, 0xe0, 0xe6, 0x4e, 0x5c, 0xd7, 0x0b, 0x1c, 0x7a, 0xbc, 0x89, 0x8f, 0x88, 0x4d, 0xbe,
+	0x6c, 0x13, 0x2e, 0xd0, 0x43, 0x58, 0x52, 0x18, 0x97, 0xf9, 0x4e, 0x87, 0x44, 0x9c, 0xb2, 0xd0,
+	0x34, 0x56, 0x8d, 0xb5, 0x05, 0xfb, 0x4a, 0x22, 0x7f, 0x1d, 0x8b, 0xd1, 0x63, 0xb8, 0x16, 0xd0,
+	0xd0, 0x49, 0xc1, 0x2f, 0x2b, 0x38, 0x0a, 0x68, 0xb8, 0x37, 0x6c, 0x61, 0x7d, 0x6d, 0x80, 0x99,
+	0x0e, 0xcc, 0x5b, 0x2c, 0xe4, 0xe4, 0xff, 0x1a, 0x19, 0x3d, 0x80, 0x2b, 0x7a, 0x27, 0x7b, 0xe0,
+	0x99, 0x55, 0x63, 0x2d, 0x67, 0x2f, 0x6a, 0x71, 0x92, 0xe2, 0x5f, 0x33, 0xb0, 0xa8, 0x53, 0x7c,
+	0x49, 0x38, 0xc7, 0x0d, 0x82, 0x10, 0x64, 0x0e, 0x23, 0x16, 0xa8, 0x64, 0x72, 0xb6, 0xfa, 0x46,
+	0x8b, 0x70, 0x59, 0x30, 0x15, 0x2f, 0x67, 0x5f, 0x16, 0x0c, 0x5d, 0x83, 0xd9, 0x90, 0x85, 0x2e,
+	0x51, 0x5e, 0x33, 0x76, 0xbc, 0x40, 0x15, 0x98, 0xed, 0x60, 0xbf, 0x4d, 0xcc, 0x8c, 0x04, 0x56,
+	0x97, 0xff, 0x73, 0x5c, 0xba, 0xee, 0x32, 0x1e, 0x30, 0xce, 0xbd, 0xa3, 0x32, 0x65, 0x95, 0x00,
+	0x8b, 0x66, 0xb9, 0x16, 0x0a, 0x3b, 0xc6, 0xa1, 0x5b, 0x90, 0x6b, 0x60, 0xee, 0xf8, 0x34, 0xa0,
+	0xc2, 0x9c, 0x55, 0xae, 0xb2, 0x0d, 0xcc, 0x77, 0xe5, 0x1a, 0x7d, 0x12, 0x2b, 0x5b, 0x11, 0x75,
+	0x89, 0x39, 0x37, 0xc9, 0xa3, 0xb4, 0xdb, 0x93, 0x50, 0xf4, 0x29, 0xe4, 0xa5, 0xdd, 0x21, 0x21,
+	0x8e, 0x8b, 0x5b, 0xe6, 0xfc, 0x24, 0x4b, 0x19, 0xe5, 0x19, 0x21, 0x5b, 0xb8, 0x95, 0x98, 0x0a,
+	0xda, 0x52, 0xa6, 0xd9, 0x69, 0x4c, 0x0f, 0x68, 0x4b, 0x9a, 0x22, 0xc8, 0x78, 0x58, 0x60, 0x33,
+	0xb7, 0x6a, 0xac, 0x15, 0x6c, 0xf5, 0x8d, 0xb6, 0x21, 0xaf, 0x4f, 0x87, 0x4f, 0xb9, 0x30, 0x61,
+	0x75, 0x66, 0x2d, 0xbf, 0x71, 0xa7, 0x3c, 0x7a, 0xa8, 0xca, 0x4f, 0x15, 0xe8, 0x40, 0x9e, 0xa0,
+	0x6a, 0xe6, 0x9b, 0xe3, 0xd2, 0x25, 0x1b, 0x62, 0xbb, 0x5d, 0xca, 0x05, 0xaa, 0xc2, 0x52, 0xdd,
+	0x67, 0x75, 0x67, 0xb0, 0xa8, 0xfc, 0xa4, 0xcc, 0x16, 0xa4, 0xc9, 0xf3, 0x5e, 0x61, 0x25, 0xc8,
+	0x2b, 0x1f, 0x4d, 0xcc, 0x9b, 0x84, 0x9b, 0x85, 0xd5, 0x99, 0xb5, 0x9c, 0x0d, 0x52, 0xf4, 0x42,
+	0x49, 0x50, 0x19, 0xde, 0xe3, 0x47, 0xb4, 0xe5, 0x60, 0xd7, 0x65, 0xed, 0x50, 0x38, 0x6e, 0x93,
+	0xb8, 0x47, 0xdc, 0x5c, 0x58, 0x35, 0xd6, 0xb2, 0xf6, 0x55, 0xa9, 0x7a, 0x1a, 0x6b, 0xb6, 0x94,
+	0xe2, 0xb3, 0xcc, 0x6f, 0xbe, 0x2e, 0x5d, 0xb2, 0x7e, 0x65, 0xc0, 0x15, 0x3d, 0x3d, 0x07, 0xdd,
+	0x2d, 0x75, 0xba, 0xd1, 0x1d, 0x90, 0x7e, 0xdd, 0x23, 0x15, 0x4b, 0x0f, 0x51, 0x4e, 0x49, 0x64,
+	0x28, 0x74, 0x13, 0xe6, 0x45, 0x37, 0xd6, 0xc5, 0xe3, 0x34, 0x27, 0xba, 0x4a, 0xb1, 0x0c, 0x59,
+	0xd1, 0x75, 0x68, 0xe8, 0x91, 0xae, 0x9e, 0xaa, 0x79, 0xd1, 0xad, 0xc9, 0xa5, 0x1c, 0x13, 0x9f,
+	0x35, 0xb4, 0x2e, 0x13, 0x8f, 0x89, 0xcf, 0x1a, 0x4a, 0x69, 0xfd, 0x21, 0x03, 0x4b, 0x3a, 0x87,
+	0x9d, 0xd7, 0x2f, 0x75, 0x12, 0xcf, 0xa0, 0x30, 0x78, 0xe5, 0xa8, 0x34, 0x4e, 0x6d, 0xfd, 0x96,
+	0x44, 0xc5, 0x46, 0xba, 0xf5, 0x79, 0xb7, 0x2f, 0x42, 0x3f, 0x86, 0x6c, 0xec, 0x87, 0x7a, 0x71,
+	0xba, 0xd5, 0xf7, 0xc7, 0xf6, 0xfc, 0xe4, 0xb8, 0x34, 0xaf, 0xdc, 0xd5, 0xb6, 0xed, 0x79, 0x65,
+	0x55, 0xf3, 0x50, 0x11, 0xb2, 0x2e, 0xa3, 0x61, 0x1d, 0x73, 0xa2, 0x4f, 0x60, 0x6f, 0x8d, 0x3e,
+	0x86, 0xac, 0xfc, 0xbf, 0xdc, 0xd4, 0xc9, 0x27, 0x66, 0x5e, 0x42, 0x9f, 0x11, 0x82, 0xb6, 0x21,
+	0x27, 0xba, 0x49, 0x5d, 0xb3, 0xaa, 0xae, 0xbb, 0xe9, 0xba, 0x46, 0x76, 0x45, 0xd7, 0x96, 0x15,
+	0xc9, 0x2e, 0x95, 0x20, 0xef, 0x91, 0x7a, 0xbb, 0xe1, 0x88, 0x08, 0xeb, 0xe3, 0x95, 0xb5, 0x41,
+	0x89, 0x0e, 0xa4, 0x04, 0xad, 0x40, 0x3e, 0x64, 0x4e, 0x2f, 0xbf, 0x79, 0x05, 0xc8, 0x85, 0xac,
+	0xaa, 0xd3, 0xb8, 0x05, 0x39, 0xd2, 0x09, 0x1c, 0x8f, 0x84, 0x2c, 0x88, 0x0f, 0x8a, 0x9d, 0x25,
+	0x9d, 0x60, 0x5b, 0xae, 0xd1, 0x47, 0x00, 0xa4, 0x2b, 0x22, 0xec, 0x10, 0xda, 0xe2, 0x66, 0x6e,
+	0x75, 0x66, 0x6d, 0xa6, 0xba, 0x70, 0x72, 0x5c, 0xca, 0xed, 0x48, 0xe9, 0x4e, 0x6d, 0x8f, 0xdb,
+	0x39, 0x05, 0xd8, 0xa1, 0x2d, 0x8e, 0x6e, 0x43, 0x8e, 0x75, 0x48, 0x14, 0x51, 0x8f, 0x70, 0x13,
+	0xd4, 0xf9, 0xe9, 0x0b, 0xd0, 0x8f, 0x40, 0xcd, 0x72, 0x3f, 0x95, 0x89, 0xb3, 0xaf, 0x46, 0x5d,
+	0xe7, 0x69, 0xfd, 0x2b, 0x03, 0x05, 0xdd, 0x0c, 0xbb, 0xed, 0x13, 0x3e, 0xb4, 0xa5, 0xc6, 0x45,
+	0xb6, 0xf4, 0x2e, 0x14, 0x28, 0x77, 0x9a, 0x2c, 0x20, 0x5c, 0x10, 0x1c, 0xcf, 0x45, 0xd6, 0xce,
+	0x53, 0xfe, 0x22, 0x11, 0xa1, 0x87, 0x90, 0xa3, 0x5c, 0x16, 0xbf, 0xbe, 0xf9, 0x58, 0x6d, 0x7b,
+	0xb6, 0x5a, 0x38, 0x39, 0x2e, 0x65, 0x6b, 0x7c, 0xa7, 0xb6, 0xb7, 0xbe, 0xf9, 0xd8, 0xce, 0x52,
+	0xbe, 0xa3, 0xb4, 0x83, 0xd0, 0x4d, 0x33, 0x93, 0x82, 0x6e, 0xf6, 0xa0, 0x9b, 0x83, 0xd0, 0x27,
+	0xe6, 0x6c, 0x0a, 0xfa, 0xa4, 0x07, 0x7d, 0xa2, 0x73, 0xac, 0x7f, 0xf5, 0x06, 0x87, 0x82, 0xb6,
+	0x03, 0xbd, 0xbf, 0x79, 0xca, 0xab, 0x89, 0x08, 0x3d, 0x82, 0xab, 0x94, 0xcb, 0x39, 0xe2, 0x42,
+	0x4a, 0x42, 0xd6, 0xf2, 0x93, 0x6d, 0x5e, 0xa2, 0x7c, 0x6b, 0x48, 0x8e, 0xee, 0xc1, 0x02, 0xe5,
+	0x4e, 0x8b, 0x08, 0x12, 0xf1, 0x7a, 0x3b, 0x6a, 0xa8, 0x1d, 0xcf, 0xda, 0x05, 0xca, 0xf7, 0x7a,
+	0x32, 0x39, 0x53, 0x94, 0x3b, 0x54, 0xda, 0xd5, 0xdb, 0xbe, 0xba, 0x09, 0xb3, 0x36, 0x50, 0x5e,
+	0xd3, 0x12, 0x39, 0x33, 0x32, 0x2b, 0x12, 0xf9, 0x34, 0x54, 0x1b, 0x9d, 0x95, 0x29, 0x57, 0xd5,
+	0x5a, 0x2b, 0x7d, 0x16, 0x7a, 0x2c, 0x34, 0xf3, 0x89, 0x72, 0x57, 0xad, 0xe5, 0xe5, 0x40, 0xb9,
+	0x13, 0x90, 0xa8, 0x41, 0xcc, 0x82, 0xd2, 0xcd, 0x53, 0xfe, 0x52, 0x2e, 0x75, 0x54, 0xde, 0xc4,
+	0x61, 0xa3, 0x89, 0xa9, 0xbe, 0xb1, 0x80, 0xf2, 0x7d, 0x2d, 0xd1, 0x8e, 0x5d, 0x1c, 0xba, 0xed,
+	0xd0, 0x5c, 0x4c, 0x1c, 0x6f, 0xa9, 0xb5, 0x56, 0xb6, 0x22, 0xdc, 0x68, 0x13, 0xf3, 0x4a, 0xa2,
+	0xdc, 0x53, 0x6b, 0xad, 0xec, 0x90, 0xe8, 0xc8, 0x27, 0xe6, 0x52, 0xa2, 0x7c, 0xad, 0xd6, 0xd6,
+	0xdf, 0x66, 0x7a, 0xac, 0x62, 0x2f, 0x22, 0x2d, 0x1c, 0x91, 0x83, 0x6e, 0xc2, 0x2a, 0x06, 0x2e,
+	0x39, 0x43, 0xcd, 0x73, 0x72, 0xc9, 0x7d, 0x02, 0x73, 0x4d, 0x82, 0x3d, 0x12, 0xa9, 0xa9, 0xc9,
+	0x6f, 0x98, 0xe5, 0x3e, 0x71, 0x29, 0xc7, 0x94, 0xe5, 0x85, 0xd2, 0xeb, 0x03, 0xab, 0xd1, 0xe8,
+	0x09, 0xcc, 0x04, 0xbc, 0xa1, 0x46, 0x29, 0xbf, 0xb1, 0x3a, 0xf6, 0xb8, 0xeb, 0x27, 0x5c, 0x1b,
+	0x4b, 0x13, 0x74, 0x00, 0x20, 0xcf, 0xa9, 0xbe, 0x2f, 0x32, 0xca, 0x81, 0x35, 0xd6, 0x41, 0xef,
+	0x06, 0xad, 0x5e, 0x95, 0x2e, 0xd4, 0x91, 0x4d, 0x44, 0xb6, 0x3c, 0xf0, 0xf1, 0x27, 0xfa, 0xa1,
+	0x7a, 0x93, 0xdc, 0x23, 0x87, 0x13, 0x2e, 0x79, 0x84, 0x3c, 0x4c, 0xea, 0xfd, 0xae, 0xa2, 0x93,
+	0xe3, 0xd2, 0x62, 0x55, 0xea, 0xf6, 0x63, 0x55, 0x6d, 0xdb, 0x5e, 0xac, 0x0f, 0xae, 0x3d, 0x74,
+	0x00, 0x8b, 0xb8, 0x2d, 0x9a, 0x2c, 0xa2, 0x6f, 0xb0, 0xa0, 0x2c, 0xe4, 0xe6, 0x9c, 0x7a, 0x1a,
+	0xef, 0xa7, 0xf3, 0xda, 0x27, 0x62, 0x8b, 0x79, 0xe4, 0xe9, 0x20, 0x5c, 0x97, 0x37, 0xe2, 0x43,
+	0x5e, 0x3a, 0x2e, 0x0b, 0x05, 0xe9, 0x0a, 0x99, 0xcd, 0xbc, 0xca, 0x46, 0x5d, 0x3a, 0x5b, 0xb1,
+	0xb4, 0xb6, 0x6d, 0xe7, 0x34, 0xa0, 0xe6, 0x59, 0x2f, 0xc0, 0x4c, 0xef, 0x9e, 0xa6, 0x66, 0x1f,
+	0x01, 0x0c, 0xd4, 0x65, 0xf4, 0x3d, 0xf5, 0x4b, 0xca, 0xf1, 0xa4, 0x1a, 0xeb, 0x9f, 0x06, 0x20,
+	0xed, 0x6a, 0x0b, 0xfb, 0x7e, 0x32, 0x03, 0xe7, 0x72, 0x82, 0x6e, 0xc0, 0x9c, 0x8b, 0x7d, 0x5f,
+	0x0f, 0x46, 0xce, 0xd6, 0x2b, 0x49, 0x2b, 0xb0, 0xe7, 0x45, 0xfa, 0xed, 0x50, 0xdf, 0x92, 0x7c,
+	0xd1, 0xb0, 0xd5, 0x16, 0x6a, 0x37, 0x0b, 0x76, 0xbc, 0x40, 0x4b, 0x30, 0xd3, 0xc0, 0x5c, 0xb3,
+	0x28, 0xf9, 0xd9, 0xa7, 0x63, 0x73, 0xd3, 0xd1, 0x31, 0xeb, 0x10, 0xde, 0x1b, 0x2a, 0x44, 0xb7,
+	0x63, 0x09, 0x66, 0x22, 0x22, 0xf4, 0x24, 0xcb, 0x4f, 0x64, 0xc1, 0x82, 0x4f, 0x0e, 0x85, 0x23,
+	0x6f, 0x69, 0xc9, 0x4b, 0x54, 0xd2, 0x19, 0x3b, 0x2f, 0x85, 0x3f, 0xed, 0x90, 0xe8, 0x39, 0xe6,
+	0xf2, 0xc8, 0x76, 0x02, 0x87, 0x44, 0x11, 0x4b, 0xb2, 0x9f, 0xef, 0x04, 0x3b, 0x72, 0x69, 0xfd,
+	0xd9, 0x80, 0x6b, 0x49, 0xa0, 0x88, 0x60, 0x41, 0xde, 0x79, 0xcf, 0x5c, 0xe6, 0xc5, 0xef, 0x6d,
+	0xc1, 0x56, 0xdf, 0x49, 0x77, 0x32, 0xa7, 0x74, 0x67, 0x76, 0xca, 0xee, 0xfc, 0xd6, 0x80, 0xeb,
+	0x23, 0x59, 0x8f, 0x6d, 0xd0, 0x3d, 0x58, 0x90, 0xa3, 0x16, 0x61, 0x57, 0x38, 0x6a, 0xff, 0xe2,
+	0x0c, 0x0b, 0x89, 0xf0, 0xa9, 0xdc, 0xc7, 0x54, 0x17, 0x67, 0xce, 0xee, 0x62, 0x66, 0xb8, 0x8b,
+	0x3f, 0xef, 0x37, 0x91, 0x05, 0x01, 0x15, 0x17, 0x6f, 0xa2, 0x32, 0xd7, 0xef, 0x98, 0x5e, 0x59,
+	0x37, 0xfb, 0xc5, 0x6a, 0xef, 0x71, 0xb1, 0xd6, 0x3f, 0x0c, 0x28, 0x69, 0xcd, 0xbe, 0xc0, 0x82,
+	0x6c, 0x57, 0x9f, 0x7a, 0x5e, 0x15, 0xfb, 0x38, 0x74, 0xdf, 0xf1, 0x3e, 0x5e, 0xfc, 0xd2, 0xbb,
+	0x0b, 0x05, 0xd9, 0xc4, 0x5e, 0x63, 0x33, 0xfd, 0xc6, 0xb2, 0xb8, 0xb1, 0x96, 0x05, 0xab, 0xe3,
+	0xab, 0xd0, 0xa5, 0xfe, 0x31, 0x55, 0xea, 0x7e, 0xbb, 0xfe, 0xfd, 0x2a, 0x35, 0x5d, 0xc7, 0x60,
+	0x8a, 0xba, 0x8e, 0x5f, 0xc2, 0x9d, 0x11, 0x0c, 0x11, 0xaf, 0xd8, 0x3b, 0x2f, 0xe2, 0xd4, 0x7f,
+	0x14, 0x5a, 0xab, 0xb0, 0x32, 0x2e, 0xb8, 0x4e, 0xef, 0x4f, 0x06, 0x58, 0xc3, 0x90, 0x5a, 0xe8,
+	0x46, 0x04, 0x73, 0xf2, 0x8a, 0x7d, 0x8f, 0x3a, 0xfd, 0x01, 0xdc, 0x3b, 0x33, 0x4b, 0x5d, 0xcd,
+	0xdf, 0x0d, 0xb8, 0x3d, 0x8c, 0xd3, 0x0f, 0xcc, 0xc5, 0xea, 0xd0, 0xf9, 0x5e, 0x3e, 0xff, 0x21,
+	0xf8, 0x0c, 0x66, 0x23, 0xc9, 0x78, 0x75, 0xad, 0x2b, 0x63, 0x6d, 0x15, 0x2f, 0xd6, 0x96, 0xb1,
+	0x89, 0x55, 0x82, 0x3b, 0x63, 0x6a, 0xd0, 0x55, 0xbe, 0x1a, 0xdd, 0xd5, 0xe7, 0x44, 0xd8, 0xe4,
+	0xb0, 0x1d, 0x7a, 0x17, 0x2a, 0xd3, 0xfa, 0x14, 0x4a, 0x63, 0xfd, 0xe9, 0x5b, 0xf6, 0x06, 0xcc,
+	0x45, 0x4a, 0x12, 0x3b, 0xb3, 0xf5, 0xca, 0xda, 0x1d, 0xed, 0xf7, 0x73, 0x22, 0x76, 0x59, 0x83,
+	0x5f, 0x2c, 0x91, 0xcf, 0xe1, 0xce, 0x18, 0x6f, 0xbd, 0xbf, 0xdb, 0x64, 0x7c, 0xd6, 0xe0, 0xa6,
+	0xa1, 0x28, 0xcb, 0xf5, 0x74, 0x57, 0x77, 0x59, 0xc3, 0x56, 0x90, 0x01, 0x8e, 0xb1, 0x13, 0x7a,
+	0x3a, 0xda, 0xc5, 0xb2, 0xba, 0x05, 0xcb, 0xa7, 0x78, 0xd2, 0x7b, 0x61, 0xf7, 0xc2, 0x54, 0x49,
+	0x83, 0x86, 0x8a, 0x7c, 0x25, 0x61, 0xfa, 0x84, 0xd3, 0x38, 0x0f, 0xe1, 0xb4, 0xbe, 0x80, 0xe5,
+	0x53, 0x7c, 0xea, 0x16, 0x9c, 0xc6, 0xfe, 0x8c, 0x69, 0xd9, 0x9f, 0xf5, 0x1a, 0x6e, 0x24, 0x1d,
+	0x0e, 0x71, 0x8b, 0x37, 0x59, 0xef, 0xe5, 0xfa, 0x6e, 0x7e, 0x3f, 0x87, 0x9b, 0x29, 0xbf, 0x3a,
+	0xe1, 0x0a, 0xe4, 0xb9, 0x96, 0xf5, 0x7d, 0x2e, 0x9e, 0x1c, 0x97, 0x20, 0x81, 0xd6, 0xb6, 0x6d,
+	0x48, 0x20, 0x35, 0xcf, 0xfa, 0x9d, 0xd1, 0x9b, 0x6f, 0x9b, 0x74, 0x48, 0x24, 0x0e, 0xd8, 0x3b,
+	0x4d, 0x76, 0x34, 0xa3, 0xcb, 0x13, 0x33, 0xba, 0x0b, 0xa5, 0xb1, 0x09, 0xe9, 0x39, 0x08, 0x7b,
+	0x8d, 0xdd, 0x09, 0xbd, 0xa1, 0x29, 0xf8, 0x6e, 0xb9, 0x8e, 0xa3, 0x08, 0xcb, 0x70, 0x33, 0x15,
+	0x2f, 0x4e, 0x65, 0xe3, 0x2f, 0x8b, 0x30, 0xaf, 0x75, 0xa8, 0x0e, 0xb9, 0xde, 0x5f, 0x3f, 0xd1,
+	0xc3, 0xb1, 0xb7, 0xd0, 0xe8, 0x9f, 0x66, 0x8b, 0x1f, 0x4e, 0x03, 0xd5, 0x1b, 0x5c, 0x87, 0x5c,
+	0x8f, 0xc6, 0x9f, 0x11, 0x63, 0xf4, 0x1f, 0x6a, 0xc5, 0x0f, 0xa7, 0x81, 0xea, 0x18, 0xfb, 0x90,
+	0x91, 0xb4, 0x18, 0xbd, 0x3f, 0xd6, 0x66, 0x80, 0xfe, 0x17, 0x3f, 0x98, 0x80, 0xd2, 0x4e, 0xbf,
+	0x80, 0xb9, 0x98, 0x4c, 0xa2, 0xfb, 0xe3, 0x0d, 0x06, 0x39, 0x72, 0xf1, 0xc1, 0x44, 0xdc, 0x80,
+	0x6b, 0xb5, 0x51, 0x67, 0xb9, 0x1e, 0x64, 0x8e, 0xc5, 0x07, 0x13, 0x71, 0xda, 0xf5, 0x1b, 0xb8,
+	0x9a, 0x62, 0x4d, 0x68, 0x7d, 0xac, 0xf5, 0x38, 0x9e, 0x58, 0xdc, 0x38, 0x8f, 0x49, 0x2a, 0x76,
+	0x9f, 0xe9, 0x4c, 0x8e, 0x9d, 0x22, 0x6e, 0xc5, 0x8d, 0xf3, 0x98, 0xe8, 0xd8, 0x02, 0xae, 0x8c,
+	0x90, 0x18, 0x54, 0x99, 0xe8, 0x66, 0x98, 0x6b, 0x15, 0x1f, 0x4f, 0x6f, 0xa0, 0xa3, 0xfe, 0xda,
+	0x80, 0x6b, 0xa7, 0x51, 0x0e, 0xf4, 0xf1, 0x24, 0x57, 0xa7, 0xf1, 0xa8, 0xe2, 0xe6, 0x39, 0xad,
+	0x74, 0x16, 0x5f, 0xc2, 0xe2, 0x30, 0x17, 0x40, 0xe5, 0x49, 0x8e, 0x86, 0x89, 0x4f, 0xb1, 0x32,
+	0x35, 0x5e, 0x87, 0xfc, 0x05, 0x2c, 0x8d, 0xb2, 0x01, 0x34, 0xb1, 0x7d, 0xa3, 0x44, 0xa4, 0xb8,
+	0x7e, 0x0e, 0x8b, 0x54, 0xad, 0xfa, 0xf5, 0x9f, 0x5c, 0xeb, 0x30, 0xe9, 0x28, 0x56, 0xa6, 0xc6,
+	0xeb, 0x90, 0x04, 0xa0, 0xff, 0xb4, 0xa3, 0xf1, 0xf7, 0x52, 0x8a, 0x49, 0x14, 0x1f, 0x4d, 0x85,
+	0xed, 0x87, 0xe9, 0x3f, 0xe8, 0x67, 0x84, 0x49, 0x31, 0x89, 0xe2, 0xa3, 0xa9, 0xb0, 0x3a, 0x8c,
+	0x03, 0xd9, 0xe4, 0x79, 0x42, 0x6b, 0xe3, 0x5b, 0x31, 0xfc, 0xa4, 0x16, 0x1f, 0x4e, 0x81, 0xec,
+	0x8f, 0xc6, 0xe8, 0x3b, 0x78, 0xc6, 0x68, 0x8c, 0x79, 0xc3, 0x8b, 0xeb, 0xe7, 0xb0, 0xe8, 0x57,
+	0x96, 0xbc, 0x76, 0x67, 0x54, 0x36, 0xf2, 0x00, 0x17, 0x1f, 0x4e, 0x81, 0x8c, 0x03, 0x54, 0x7f,
+	0xf2, 0xcd, 0xc9, 0x8a, 0xf1, 0xed, 0xc9, 0x8a, 0xf1, 0xdf, 0x93, 0x15, 0xe3, 0xf7, 0x6f, 0x57,
+	0x2e, 0x7d, 0xfb, 0x76, 0xe5, 0xd2, 0xbf, 0xdf, 0xae, 0x5c, 0xfa, 0xd9, 0xfd, 0x06, 0x15, 0xcd,
+	0x76, 0xbd, 0xec, 0xb2, 0x40, 0xfe, 0x2c, 0xca, 0x78, 0xa5, 0xff, 0x33, 0x69, 0x57, 0x4a, 0xe2,
+	0x1f, 0x3d, 0xeb, 0x73, 0xea, 0x87, 0xc2, 0x1f, 0xfc, 0x6f, 0x00, 0x45, 0x1a, 0x7c, 0x8b, 0xde,
+	0x1d, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// EnclaveClient is the client API for Enclave service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type EnclaveClient interface {
+	// Handshake negotiates the protocol version. It's the first call made on a
+	// new connection, the node disconnects from enclaves it isn't compatible with.
+	Handshake(ctx context.Context, in *EnclaveHandshakeRequest, opts ...grpc.CallOption) (*EnclaveHandshakeResponse, error)
+	// PrepareTx opens a session to execute a single EVM message.
+	PrepareTx(ctx context.Context, in *EnclavePrepareTxRequest, opts ...grpc.CallOption) (*EnclavePrepareTxResponse, error)
+	// Call executes a message call on the session EVM.
+	Call(ctx context.Context, in *EnclaveCallRequest, opts ...grpc.CallOption) (*EnclaveCallResponse, error)
+	// Create executes a contract creation on the session EVM.
+	Create(ctx context.Context, in *EnclaveCreateRequest, opts ...grpc.CallOption) (*EnclaveCreateResponse, error)
+	// Commit writes the dirty states of the session StateDB back to the node.
+	Commit(ctx context.Context, in *EnclaveCommitRequest, opts ...grpc.CallOption) (*EnclaveCommitResponse, error)
+	// StateDBAddBalance refunds the leftover gas to the message sender.
+	StateDBAddBalance(ctx context.Context, in *EnclaveStateDBAddBalanceRequest, opts ...grpc.CallOption) (*EnclaveStateDBAddBalanceResponse, error)
+	// StateDBSubBalance deducts the gas fee from the message sender.
+	StateDBSubBalance(ctx context.Context, in *EnclaveStateDBSubBalanceRequest, opts ...grpc.CallOption) (*EnclaveStateDBSubBalanceResponse, error)
+	// StateDBSetNonce sets the nonce of an account.
+	StateDBSetNonce(ctx context.Context, in *EnclaveStateDBSetNonceRequest, opts ...grpc.CallOption) (*EnclaveStateDBSetNonceResponse, error)
+	// StateDBIncreaseNonce increases the nonce of the message sender by one.
+	StateDBIncreaseNonce(ctx context.Context, in *EnclaveStateDBIncreaseNonceRequest, opts ...grpc.CallOption) (*EnclaveStateDBIncreaseNonceResponse, error)
+	// StateDBPrepare prepares the access list and resets the transient storage.
+	StateDBPrepare(ctx context.Context, in *EnclaveStateDBPrepareRequest, opts ...grpc.CallOption) (*EnclaveStateDBPrepareResponse, error)
+	// StateDBGetRefund returns the gas refund counter of the session.
+	StateDBGetRefund(ctx context.Context, in *EnclaveStateDBGetRefundRequest, opts ...grpc.CallOption) (*EnclaveStateDBGetRefundResponse, error)
+	// StateDBGetLogs returns the logs emitted during the session.
+	StateDBGetLogs(ctx context.Context, in *EnclaveStateDBGetLogsRequest, opts ...grpc.CallOption) (*EnclaveStateDBGetLogsResponse, error)
+	// EndSession closes a session and releases its resources.
+	EndSession(ctx context.Context, in *EnclaveEndSessionRequest, opts ...grpc.CallOption) (*EnclaveEndSessionResponse, error)
+	// BeginBlock opens a block session, available since protocol version 2.
+	BeginBlock(ctx context.Context, in *EnclaveBeginBlockRequest, opts ...grpc.CallOption) (*EnclaveBeginBlockResponse, error)
+	// Snapshot returns an identifier for the current state of a block session.
+	Snapshot(ctx context.Context, in *EnclaveSnapshotRequest, opts ...grpc.CallOption) (*EnclaveSnapshotResponse, error)
+	// RevertToSnapshot reverts the state of a block session to a snapshot.
+	RevertToSnapshot(ctx context.Context, in *EnclaveRevertToSnapshotRequest, opts ...grpc.CallOption) (*EnclaveRevertToSnapshotResponse, error)
+	// EndBlock writes the state of a block session back to the node and closes it,
+	// there is no state left to write since protocol version 5.
+	EndBlock(ctx context.Context, in *EnclaveEndBlockRequest, opts ...grpc.CallOption) (*EnclaveEndBlockResponse, error)
+}
+
+type enclaveClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewEnclaveClient(cc grpc1.ClientConn) EnclaveClient {
+	return &enclaveClient{cc}
+}
+
+func (c *enclaveClient) Handshake(ctx context.Context, in *EnclaveHandshakeRequest, opts ...grpc.CallOption) (*EnclaveHandshakeResponse, error) {
+	out := new(EnclaveHandshakeResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Enclave/Handshake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enclaveClient) PrepareTx(ctx context.Context, in *EnclavePrepareTxRequest, opts ...grpc.CallOption) (*EnclavePrepareTxResponse, error) {
+	out := new(EnclavePrepareTxResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Enclave/PrepareTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enclaveClient) Call(ctx context.Context, in *EnclaveCallRequest, opts ...grpc.CallOption) (*EnclaveCallResponse, error) {
+	out := new(EnclaveCallResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Enclave/Call", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enclaveClient) Create(ctx context.Context, in *EnclaveCreateRequest, opts ...grpc.CallOption) (*EnclaveCreateResponse, error) {
+	out := new(EnclaveCreateResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Enclave/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enclaveClient) Commit(ctx context.Context, in *EnclaveCommitRequest, opts ...grpc.CallOption) (*EnclaveCommitResponse, error) {
+	out := new(EnclaveCommitResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Enclave/Commit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enclaveClient) StateDBAddBalance(ctx context.Context, in *EnclaveStateDBAddBalanceRequest, opts ...grpc.CallOption) (*EnclaveStateDBAddBalanceResponse, error) {
+	out := new(EnclaveStateDBAddBalanceResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Enclave/StateDBAddBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enclaveClient) StateDBSubBalance(ctx context.Context, in *EnclaveStateDBSubBalanceRequest, opts ...grpc.CallOption) (*EnclaveStateDBSubBalanceResponse, error) {
+	out := new(EnclaveStateDBSubBalanceResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Enclave/StateDBSubBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enclaveClient) StateDBSetNonce(ctx context.Context, in *EnclaveStateDBSetNonceRequest, opts ...grpc.CallOption) (*EnclaveStateDBSetNonceResponse, error) {
+	out := new(EnclaveStateDBSetNonceResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Enclave/StateDBSetNonce", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enclaveClient) StateDBIncreaseNonce(ctx context.Context, in *EnclaveStateDBIncreaseNonceRequest, opts ...grpc.CallOption) (*EnclaveStateDBIncreaseNonceResponse, error) {
+	out := new(EnclaveStateDBIncreaseNonceResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Enclave/StateDBIncreaseNonce", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enclaveClient) StateDBPrepare(ctx context.Context, in *EnclaveStateDBPrepareRequest, opts ...grpc.CallOption) (*EnclaveStateDBPrepareResponse, error) {
+	out := new(EnclaveStateDBPrepareResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Enclave/StateDBPrepare", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enclaveClient) StateDBGetRefund(ctx context.Context, in *EnclaveStateDBGetRefundRequest, opts ...grpc.CallOption) (*EnclaveStateDBGetRefundResponse, error) {
+	out := new(EnclaveStateDBGetRefundResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Enclave/StateDBGetRefund", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enclaveClient) StateDBGetLogs(ctx context.Context, in *EnclaveStateDBGetLogsRequest, opts ...grpc.CallOption) (*EnclaveStateDBGetLogsResponse, error) {
+	out := new(EnclaveStateDBGetLogsResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Enclave/StateDBGetLogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enclaveClient) EndSession(ctx context.Context, in *EnclaveEndSessionRequest, opts ...grpc.CallOption) (*EnclaveEndSessionResponse, error) {
+	out := new(EnclaveEndSessionResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Enclave/EndSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enclaveClient) BeginBlock(ctx context.Context, in *EnclaveBeginBlockRequest, opts ...grpc.CallOption) (*EnclaveBeginBlockResponse, error) {
+	out := new(EnclaveBeginBlockResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Enclave/BeginBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enclaveClient) Snapshot(ctx context.Context, in *EnclaveSnapshotRequest, opts ...grpc.CallOption) (*EnclaveSnapshotResponse, error) {
+	out := new(EnclaveSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Enclave/Snapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enclaveClient) RevertToSnapshot(ctx context.Context, in *EnclaveRevertToSnapshotRequest, opts ...grpc.CallOption) (*EnclaveRevertToSnapshotResponse, error) {
+	out := new(EnclaveRevertToSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Enclave/RevertToSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enclaveClient) EndBlock(ctx context.Context, in *EnclaveEndBlockRequest, opts ...grpc.CallOption) (*EnclaveEndBlockResponse, error) {
+	out := new(EnclaveEndBlockResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Enclave/EndBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EnclaveServer is the server API for Enclave service.
+type EnclaveServer interface {
+	// Handshake negotiates the protocol version. It's the first call made on a
+	// new connection, the node disconnects from enclaves it isn't compatible with.
+	Handshake(context.Context, *EnclaveHandshakeRequest) (*EnclaveHandshakeResponse, error)
+	// PrepareTx opens a session to execute a single EVM message.
+	PrepareTx(context.Context, *EnclavePrepareTxRequest) (*EnclavePrepareTxResponse, error)
+	// Call executes a message call on the session EVM.
+	Call(context.Context, *EnclaveCallRequest) (*EnclaveCallResponse, error)
+	// Create executes a contract creation on the session EVM.
+	Create(context.Context, *EnclaveCreateRequest) (*EnclaveCreateResponse, error)
+	// Commit writes the dirty states of the session StateDB back to the node.
+	Commit(context.Context, *EnclaveCommitRequest) (*EnclaveCommitResponse, error)
+	// StateDBAddBalance refunds the leftover gas to the message sender.
+	StateDBAddBalance(context.Context, *EnclaveStateDBAddBalanceRequest) (*EnclaveStateDBAddBalanceResponse, error)
+	// StateDBSubBalance deducts the gas fee from the message sender.
+	StateDBSubBalance(context.Context, *EnclaveStateDBSubBalanceRequest) (*EnclaveStateDBSubBalanceResponse, error)
+	// StateDBSetNonce sets the nonce of an account.
+	StateDBSetNonce(context.Context, *EnclaveStateDBSetNonceRequest) (*EnclaveStateDBSetNonceResponse, error)
+	// StateDBIncreaseNonce increases the nonce of the message sender by one.
+	StateDBIncreaseNonce(context.Context, *EnclaveStateDBIncreaseNonceRequest) (*EnclaveStateDBIncreaseNonceResponse, error)
+	// StateDBPrepare prepares the access list and resets the transient storage.
+	StateDBPrepare(context.Context, *EnclaveStateDBPrepareRequest) (*EnclaveStateDBPrepareResponse, error)
+	// StateDBGetRefund returns the gas refund counter of the session.
+	StateDBGetRefund(context.Context, *EnclaveStateDBGetRefundRequest) (*EnclaveStateDBGetRefundResponse, error)
+	// StateDBGetLogs returns the logs emitted during the session.
+	StateDBGetLogs(context.Context, *EnclaveStateDBGetLogsRequest) (*EnclaveStateDBGetLogsResponse, error)
+	// EndSession closes a session and releases its resources.
+	EndSession(context.Context, *EnclaveEndSessionRequest) (*EnclaveEndSessionResponse, error)
+	// BeginBlock opens a block session, available since protocol version 2.
+	BeginBlock(context.Context, *EnclaveBeginBlockRequest) (*EnclaveBeginBlockResponse, error)
+	// Snapshot returns an identifier for the current state of a block session.
+	Snapshot(context.Context, *EnclaveSnapshotRequest) (*EnclaveSnapshotResponse, error)
+	// RevertToSnapshot reverts the state of a block session to a snapshot.
+	RevertToSnapshot(context.Context, *EnclaveRevertToSnapshotRequest) (*EnclaveRevertToSnapshotResponse, error)
+	// EndBlock writes the state of a block session back to the node and closes it,
+	// there is no state left to write since protocol version 5.
+	EndBlock(context.Context, *EnclaveEndBlockRequest) (*EnclaveEndBlockResponse, error)
+}
+
+// UnimplementedEnclaveServer can be embedded to have forward compatible implementations.
+type UnimplementedEnclaveServer struct {
+}
+
+func (*UnimplementedEnclaveServer) Handshake(ctx context.Context, req *EnclaveHandshakeRequest) (*EnclaveHandshakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Handshake not implemented")
+}
+func (*UnimplementedEnclaveServer) PrepareTx(ctx context.Context, req *EnclavePrepareTxRequest) (*EnclavePrepareTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrepareTx not implemented")
+}
+func (*UnimplementedEnclaveServer) Call(ctx context.Context, req *EnclaveCallRequest) (*EnclaveCallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Call not implemented")
+}
+func (*UnimplementedEnclaveServer) Create(ctx context.Context, req *EnclaveCreateRequest) (*EnclaveCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (*UnimplementedEnclaveServer) Commit(ctx context.Context, req *EnclaveCommitRequest) (*EnclaveCommitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Commit not implemented")
+}
+func (*UnimplementedEnclaveServer) StateDBAddBalance(ctx context.Context, req *EnclaveStateDBAddBalanceRequest) (*EnclaveStateDBAddBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StateDBAddBalance not implemented")
+}
+func (*UnimplementedEnclaveServer) StateDBSubBalance(ctx context.Context, req *EnclaveStateDBSubBalanceRequest) (*EnclaveStateDBSubBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StateDBSubBalance not implemented")
+}
+func (*UnimplementedEnclaveServer) StateDBSetNonce(ctx context.Context, req *EnclaveStateDBSetNonceRequest) (*EnclaveStateDBSetNonceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StateDBSetNonce not implemented")
+}
+func (*UnimplementedEnclaveServer) StateDBIncreaseNonce(ctx context.Context, req *EnclaveStateDBIncreaseNonceRequest) (*EnclaveStateDBIncreaseNonceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StateDBIncreaseNonce not implemented")
+}
+func (*UnimplementedEnclaveServer) StateDBPrepare(ctx context.Context, req *EnclaveStateDBPrepareRequest) (*EnclaveStateDBPrepareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StateDBPrepare not implemented")
+}
+func (*UnimplementedEnclaveServer) StateDBGetRefund(ctx context.Context, req *EnclaveStateDBGetRefundRequest) (*EnclaveStateDBGetRefundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StateDBGetRefund not implemented")
+}
+func (*UnimplementedEnclaveServer) StateDBGetLogs(ctx context.Context, req *EnclaveStateDBGetLogsRequest) (*EnclaveStateDBGetLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StateDBGetLogs not implemented")
+}
+func (*UnimplementedEnclaveServer) EndSession(ctx context.Context, req *EnclaveEndSessionRequest) (*EnclaveEndSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EndSession not implemented")
+}
+func (*UnimplementedEnclaveServer) BeginBlock(ctx context.Context, req *EnclaveBeginBlockRequest) (*EnclaveBeginBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginBlock not implemented")
+}
+func (*UnimplementedEnclaveServer) Snapshot(ctx context.Context, req *EnclaveSnapshotRequest) (*EnclaveSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Snapshot not implemented")
+}
+func (*UnimplementedEnclaveServer) RevertToSnapshot(ctx context.Context, req *EnclaveRevertToSnapshotRequest) (*EnclaveRevertToSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertToSnapshot not implemented")
+}
+func (*UnimplementedEnclaveServer) EndBlock(ctx context.Context, req *EnclaveEndBlockRequest) (*EnclaveEndBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EndBlock not implemented")
+}
+
+func RegisterEnclaveServer(s grpc1.Server, srv EnclaveServer) {
+	s.RegisterService(&_Enclave_serviceDesc, srv)
+}
+
+func _Enclave_Handshake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnclaveHandshakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveServer).Handshake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Enclave/Handshake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveServer).Handshake(ctx, req.(*EnclaveHandshakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Enclave_PrepareTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnclavePrepareTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveServer).PrepareTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Enclave/PrepareTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveServer).PrepareTx(ctx, req.(*EnclavePrepareTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Enclave_Call_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnclaveCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveServer).Call(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Enclave/Call",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveServer).Call(ctx, req.(*EnclaveCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Enclave_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnclaveCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Enclave/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveServer).Create(ctx, req.(*EnclaveCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Enclave_Commit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnclaveCommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveServer).Commit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Enclave/Commit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveServer).Commit(ctx, req.(*EnclaveCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Enclave_StateDBAddBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnclaveStateDBAddBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveServer).StateDBAddBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Enclave/StateDBAddBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveServer).StateDBAddBalance(ctx, req.(*EnclaveStateDBAddBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Enclave_StateDBSubBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnclaveStateDBSubBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveServer).StateDBSubBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Enclave/StateDBSubBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveServer).StateDBSubBalance(ctx, req.(*EnclaveStateDBSubBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Enclave_StateDBSetNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnclaveStateDBSetNonceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveServer).StateDBSetNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Enclave/StateDBSetNonce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveServer).StateDBSetNonce(ctx, req.(*EnclaveStateDBSetNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Enclave_StateDBIncreaseNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnclaveStateDBIncreaseNonceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveServer).StateDBIncreaseNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Enclave/StateDBIncreaseNonce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveServer).StateDBIncreaseNonce(ctx, req.(*EnclaveStateDBIncreaseNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Enclave_StateDBPrepare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnclaveStateDBPrepareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveServer).StateDBPrepare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Enclave/StateDBPrepare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveServer).StateDBPrepare(ctx, req.(*EnclaveStateDBPrepareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Enclave_StateDBGetRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnclaveStateDBGetRefundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveServer).StateDBGetRefund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Enclave/StateDBGetRefund",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveServer).StateDBGetRefund(ctx, req.(*EnclaveStateDBGetRefundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Enclave_StateDBGetLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnclaveStateDBGetLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveServer).StateDBGetLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Enclave/StateDBGetLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveServer).StateDBGetLogs(ctx, req.(*EnclaveStateDBGetLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Enclave_EndSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnclaveEndSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveServer).EndSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Enclave/EndSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveServer).EndSession(ctx, req.(*EnclaveEndSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Enclave_BeginBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnclaveBeginBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveServer).BeginBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Enclave/BeginBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveServer).BeginBlock(ctx, req.(*EnclaveBeginBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Enclave_Snapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnclaveSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveServer).Snapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Enclave/Snapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveServer).Snapshot(ctx, req.(*EnclaveSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Enclave_RevertToSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnclaveRevertToSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveServer).RevertToSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Enclave/RevertToSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveServer).RevertToSnapshot(ctx, req.(*EnclaveRevertToSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Enclave_EndBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnclaveEndBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveServer).EndBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Enclave/EndBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveServer).EndBlock(ctx, req.(*EnclaveEndBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Enclave_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethermint.evm.v1.Enclave",
+	HandlerType: (*EnclaveServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Handshake",
+			Handler:    _Enclave_Handshake_Handler,
+		},
+		{
+			MethodName: "PrepareTx",
+			Handler:    _Enclave_PrepareTx_Handler,
+		},
+		{
+			MethodName: "Call",
+			Handler:    _Enclave_Call_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _Enclave_Create_Handler,
+		},
+		{
+			MethodName: "Commit",
+			Handler:    _Enclave_Commit_Handler,
+		},
+		{
+			MethodName: "StateDBAddBalance",
+			Handler:    _Enclave_StateDBAddBalance_Handler,
+		},
+		{
+			MethodName: "StateDBSubBalance",
+			Handler:    _Enclave_StateDBSubBalance_Handler,
+		},
+		{
+			MethodName: "StateDBSetNonce",
+			Handler:    _Enclave_StateDBSetNonce_Handler,
+		},
+		{
+			MethodName: "StateDBIncreaseNonce",
+			Handler:    _Enclave_StateDBIncreaseNonce_Handler,
+		},
+		{
+			MethodName: "StateDBPrepare",
+			Handler:    _Enclave_StateDBPrepare_Handler,
+		},
+		{
+			MethodName: "StateDBGetRefund",
+			Handler:    _Enclave_StateDBGetRefund_Handler,
+		},
+		{
+			MethodName: "StateDBGetLogs",
+			Handler:    _Enclave_StateDBGetLogs_Handler,
+		},
+		{
+			MethodName: "EndSession",
+			Handler:    _Enclave_EndSession_Handler,
+		},
+		{
+			MethodName: "BeginBlock",
+			Handler:    _Enclave_BeginBlock_Handler,
+		},
+		{
+			MethodName: "Snapshot",
+			Handler:    _Enclave_Snapshot_Handler,
+		},
+		{
+			MethodName: "RevertToSnapshot",
+			Handler:    _Enclave_RevertToSnapshot_Handler,
+		},
+		{
+			MethodName: "EndBlock",
+			Handler:    _Enclave_EndBlock_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ethermint/evm/v1/enclave.proto",
+}
+
+func (m *EnclaveHandshakeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnclaveHandshakeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnclaveHandshakeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinProtocolVersion != 0 {
+		i = encodeVarintEnclave(dAtA, i, uint64(m.MinProtocolVersion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ProtocolVersion != 0 {
+		i = encodeVarintEnclave(dAtA, i, uint64(m.ProtocolVersion))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EnclaveHandshakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnclaveHandshakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnclaveHandshakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EnclaveVersion) > 0 {
+		i -= len(m.EnclaveVersion)
+		copy(dAtA[i:], m.EnclaveVersion)
+		i = encodeVarintEnclave(dAtA, i, uint64(len(m.EnclaveVersion)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MinProtocolVersion != 0 {
+		i = encodeVarintEnclave(dAtA, i, uint64(m.MinProtocolVersion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ProtocolVersion != 0 {
+		i = encodeVarintEnclave(dAtA, i, uint64(m.ProtocolVersion))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EnclaveMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnclaveMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnclaveMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SkipAccountChecks {
+		i--
+		if m.SkipAccountChecks {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.BlobHashes) > 0 {
+		for iNdEx := len(m.BlobHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BlobHashes[iNdEx])
+			copy(dAtA[i:], m.BlobHashes[iNdEx])
+			i = encodeVarintEnclave(dAtA, i, uint64(len(m.BlobHashes[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.BlobGasFeeCap != nil {
+		{
+			size := m.BlobGasFeeCap.Size()
+			i -= size
+			if _, err := m.BlobGasFeeCap.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEnclave(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.AccessList) > 0 {
+		for iNdEx := len(m.AccessList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccessList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEnclave(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintEnclave(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.GasTipCap != nil {
+		{
+			size := m.GasTipCap.Size()
+			i -= size
+			if _, err := m.GasTipCap.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEnclave(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.GasFeeCap != nil {
+		{
+			size := m.GasFeeCap.Size()
+			i -= size
+			if _, err := m.GasFeeCap.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEnclave(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.GasPrice != nil {
+		{
+			size := m.GasPrice.Size()
+			i -= size
+			if _, err := m.GasPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEnclave(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintEnclave(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Value != nil {
+		{
+			size := m.Value.Size()
+			i -= size
+			if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEnclave(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintEnclave(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintEnclave(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintEnclave(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EnclaveTxConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnclaveTxConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnclaveTxConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LogIndex != 0 {
+		i = encodeVarintEnclave(dAtA, i, uint64(m.LogIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TxIndex != 0 {
+		i = encodeVarintEnclave(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintEnclave(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintEnclave(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EnclaveEVMConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnclaveEVMConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnclaveEVMConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlobBaseFee != nil {
+		{
+			size := m.BlobBaseFee.Size()
+			i -= size
+			if _, err := m.BlobBaseFee.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEnclave(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Overrides) > 0 {
+		i -= len(m.Overrides)
+		copy(dAtA[i:], m.Overrides)
+		i = encodeVarintEnclave(dAtA, i, uint64(len(m.Overrides)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.ExtraEIPs) > 0 {
+		dAtA2 := make([]byte, len(m.ExtraEIPs)*10)
+		var j1 int
+		for _, num1 := range m.ExtraEIPs {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintEnclave(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.EvmDenom) > 0 {
+		i -= len(m.EvmDenom)
+		copy(dAtA[i:], m.EvmDenom)
+		i = encodeVarintEnclave(dAtA, i, uint64(len(m.EvmDenom)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.NoBaseFee {
+		i--
+		if m.NoBaseFee {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.DebugTrace {
+		i--
+		if m.DebugTrace {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.TxConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEnclave(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.BaseFee != nil {
+		{
+			size := m.BaseFee.Size()
+			i -= size
+			if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEnclave(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Coinbase) > 0 {
+		i -= len(m.Coinbase)
+		copy(dAtA[i:], m.Coinbase)
+		i = encodeVarintEnclave(dAtA, i, uint64(len(m.Coinbase)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ChainID != nil {
+		{
+			size := m.ChainID.Size()
+			i -= size
+			if _, err := m.ChainID.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEnclave(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ChainConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEnclave(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EnclaveRules) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnclaveRules) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnclaveRules) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsVerkle {
+		i--
+		if m.IsVerkle {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.IsPrague {
+		i--
+		if m.IsPrague {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.IsCancun {
+		i--
+		if m.IsCancun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.IsShanghai {
+		i--
+		if m.IsShanghai {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.IsMerge {
+		i--
+		if m.IsMerge {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.IsLondon {
+		i--
+		if m.IsLondon {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.IsBerlin {
+		i--
+		if m.IsBerlin {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.IsIstanbul {
+		i--
+		if m.IsIstanbul {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.IsPetersburg {
+		i--
+		if m.IsPetersburg {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.IsConstantinople {
+		i--
+		if m.IsConstantinople {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.IsByzantium {
+		i--
+		if m.IsByzantium {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.IsEIP158 {
+		i--
+		if m.IsEIP158 {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.IsEIP155 {
+		i--
+		if m.IsEIP155 {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.IsEIP150 {
+		i--
+		if m.IsEIP150 {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.IsHomestead {
+		i--
+		if m.IsHomestead {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChainID != nil {
+		{
+			size := m.ChainID.Size()
+			i -= size
+			if _, err := m.ChainID.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEnclave(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EnclavePrepareTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnclavePrepareTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnclavePrepareTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContextID != 0 {
+		i = encodeVarintEnclave(dAtA, i, uint64(m.ContextID))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Authorizations) > 0 {
+		for iNdEx := len(m.Authorizations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Authorizations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEnclave(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.BlockSessionID != 0 {
+		i = encodeVarintEnclave(dAtA, i, uint64(m.BlockSessionID))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.EVMConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEnclave(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEnclave(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEnclave(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintEnclave(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EnclavePrepareTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnclavePrepareTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnclavePrepareTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SessionID != 0 {
+		i = encodeVarintEnclave(dAtA, i, uint64(m.SessionID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EnclaveCallRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnclaveCallRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnclaveCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != nil {
+		{
+			size := m.Value.Size()
+			i -= size
+			if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEnclave(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Gas != 0 {
+		i = encodeVarintEnclave(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Input) > 0 {
+		i -= len(m.Input)
+		copy(dAtA[i:], m.Input)
+		i = encodeVarintEnclave(dAtA, i, uint64(len(m.Input)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintEnclave(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Caller) > 0 {
+		i -= len(m.Caller)
+		copy(dAtA[i:], m.Caller)
+		i = encodeVarintEnclave(dAtA, i, uint64(len(m.Caller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SessionID != 0 {
+		i = encodeVarintEnclave(dAtA, i, uint64(m.SessionID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EnclaveCallResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnclaveCallResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnclaveCallResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VmError) > 0 {
+		i -= len(m.VmError)
+		copy(dAtA[i:], m.VmError)
+		i = encodeVarintEnclave(dAtA, i, uint64(len(m.VmError)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.LeftOverGas != 0 {
+		i = encodeVarintEnclave(dAtA, i, uint64(m.LeftOverGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Ret) > 0 {
+		i -= len(m.Ret)
+		copy(dAtA[i:], m.Ret)
+		i = encodeVarintEnclave(dAtA, i, uint64(len(m.Ret)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EnclaveCreateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnclaveCreateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnclaveCreateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != nil {
+		{
+			size := m.Value.Size()
+			i -= size
+			if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEnclave(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Gas != 0 {
+		i = encodeVarintEnclave(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintEnclave(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Caller) > 0 {
+		i -= len(m.Caller)
+		copy(dAtA[i:], m.Caller)
+		i = encodeVarintEnclave(dAtA, i, uint64(len(m.Caller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SessionID != 0 {
+		i = encodeVarintEnclave(dAtA, i, uint64(m.SessionID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EnclaveCreateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnclaveCreateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnclaveCreateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VmError) > 0 {
+		i -= len(m.VmError)
+		copy(dAtA[i:], m.VmError)
+		i = encodeVarintEnclave(dAtA, i, uint64(len(m.VmError)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.LeftOverGas != 0 {
+		i = encodeVarintEnclave(dAtA, i, uint64(m.LeftOverGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ContractAddr) > 0 {
+		i -= len(m.ContractAddr)
+		copy(dAtA[i:], m.ContractAddr)
+		i = encodeVarintEnclave(dAtA, i, uint64(len(m.ContractAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Ret) > 0 {
+		i -= len(m.Ret)
+		copy(dAtA[i:], m.Ret)
+		i = encodeVarintEnclave(dAtA, i, uint64(len(m.Ret)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EnclaveCommitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnclaveCommitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnclaveCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Commit {
+		i--
+		if m.Commit {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SessionID != 0 {
+		i = encodeVarintEnclave(dAtA, i, uint64(m.SessionID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EnclaveCommitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnclaveCommitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnclaveCommitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *EnclaveStateDBAddBalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnclaveStateDBAddBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnclaveStateDBAddBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LeftoverGas != 0 {
+		i = encodeVarintEnclave(dAtA, i, uint64(m.LeftoverGas))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEnclave(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Caller) > 0 {
+		i -= len(m.Caller)
+		copy(dAtA[i:], m.Caller)
+		i = encodeVarintEnclave(dAtA, i, uint64(len(m.Caller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SessionID != 0 {
+		i = encodeVarintEnclave(dAtA, i, uint64(m.SessionID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EnclaveStateDBAddBalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnclaveStateDBAddBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnclaveStateDBAddBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *EnclaveStateDBSubBalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnclaveStateDBSubBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnclaveStateDBSubBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEnclave(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Caller) > 0 {
+		i -= len(m.Caller)
+		copy(dAtA[i:], m.Caller)
+		i = encodeVarintEnclave(dAtA, i, uint64(len(m.Caller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SessionID != 0 {
+		i = encodeVarintEnclave(dAtA, i, uint64(m.SessionID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EnclaveStateDBSubBalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnclaveStateDBSubBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnclaveStateDBSubBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *EnclaveStateDBSetNonceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnclaveStateDBSetNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnclaveStateDBSetNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintEnclave(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Caller) > 0 {
+		i -= len(m.Caller)
+		copy(dAtA[i:], m.Caller)
+		i = encodeVarintEnclave(dAtA, i, uint64(len(m.Caller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SessionID != 0 {
+		i = encodeVarintEnclave(dAtA, i, uint64(m.SessionID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EnclaveStateDBSetNonceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnclaveStateDBSetNonceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnclaveStateDBSetNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *EnclaveStateDBIncreaseNonceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnclaveStateDBIncreaseNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnclaveStateDBIncreaseNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEnclave(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Caller) > 0 {
+		i -= len(m.Caller)
+		copy(dAtA[i:], m.Caller)
+		i = encodeVarintEnclave(dAtA, i, uint64(len(m.Caller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SessionID != 0 {
+		i = encodeVarintEnclave(dAtA, i, uint64(m.SessionID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EnclaveStateDBIncreaseNonceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnclaveStateDBIncreaseNonceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnclaveStateDBIncreaseNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *EnclaveStateDBPrepareRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnclaveStateDBPrepareRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnclaveStateDBPrepareRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Rules.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEnclave(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEnclave(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.SessionID != 0 {
+		i = encodeVarintEnclave(dAtA, i, uint64(m.SessionID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EnclaveStateDBPrepareResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnclaveStateDBPrepareResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnclaveStateDBPrepareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *EnclaveStateDBGetRefundRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnclaveStateDBGetRefundRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnclaveStateDBGetRefundRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SessionID != 0 {
+		i = encodeVarintEnclave(dAtA, i, uint64(m.SessionID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EnclaveStateDBGetRefundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnclaveStateDBGetRefundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnclaveStateDBGetRefundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Refund != 0 {
+		i = encodeVarintEnclave(dAtA, i, uint64(m.Refund))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EnclaveStateDBGetLogsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnclaveStateDBGetLogsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnclaveStateDBGetLogsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SessionID != 0 {
+		i = encodeVarintEnclave(dAtA, i, uint64(m.SessionID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EnclaveStateDBGetLogsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnclaveStateDBGetLogsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnclaveStateDBGetLogsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Logs) > 0 {
+		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEnclave(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EnclaveEndSessionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnclaveEndSessionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnclaveEndSessionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SessionID != 0 {
+		i = encodeVarintEnclave(dAtA, i, uint64(m.SessionID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EnclaveEndSessionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnclaveEndSessionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnclaveEndSessionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *EnclaveBeginBlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnclaveBeginBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnclaveBeginBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEnclave(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EnclaveBeginBlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnclaveBeginBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnclaveBeginBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockSessionID != 0 {
+		i = encodeVarintEnclave(dAtA, i, uint64(m.BlockSessionID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EnclaveSnapshotRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnclaveSnapshotRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnclaveSnapshotRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockSessionID != 0 {
+		i = encodeVarintEnclave(dAtA, i, uint64(m.BlockSessionID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EnclaveSnapshotResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnclaveSnapshotResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnclaveSnapshotResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SnapshotID != 0 {
+		i = encodeVarintEnclave(dAtA, i, uint64(m.SnapshotID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EnclaveRevertToSnapshotRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnclaveRevertToSnapshotRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnclaveRevertToSnapshotRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SnapshotID != 0 {
+		i = encodeVarintEnclave(dAtA, i, uint64(m.SnapshotID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockSessionID != 0 {
+		i = encodeVarintEnclave(dAtA, i, uint64(m.BlockSessionID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EnclaveRevertToSnapshotResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnclaveRevertToSnapshotResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnclaveRevertToSnapshotResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *EnclaveEndBlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnclaveEndBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnclaveEndBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Commit {
+		i--
+		if m.Commit {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockSessionID != 0 {
+		i = encodeVarintEnclave(dAtA, i, uint64(m.BlockSessionID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EnclaveEndBlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnclaveEndBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnclaveEndBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEnclave(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEnclave(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EnclaveHandshakeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProtocolVersion != 0 {
+		n += 1 + sovEnclave(uint64(m.ProtocolVersion))
+	}
+	if m.MinProtocolVersion != 0 {
+		n += 1 + sovEnclave(uint64(m.MinProtocolVersion))
+	}
+	return n
+}
+
+func (m *EnclaveHandshakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProtocolVersion != 0 {
+		n += 1 + sovEnclave(uint64(m.ProtocolVersion))
+	}
+	if m.MinProtocolVersion != 0 {
+		n += 1 + sovEnclave(uint64(m.MinProtocolVersion))
+	}
+	l = len(m.EnclaveVersion)
+	if l > 0 {
+		n += 1 + l + sovEnclave(uint64(l))
+	}
+	return n
+}
+
+func (m *EnclaveMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovEnclave(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovEnclave(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovEnclave(uint64(m.Nonce))
+	}
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovEnclave(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovEnclave(uint64(m.GasLimit))
+	}
+	if m.GasPrice != nil {
+		l = m.GasPrice.Size()
+		n += 1 + l + sovEnclave(uint64(l))
+	}
+	if m.GasFeeCap != nil {
+		l = m.GasFeeCap.Size()
+		n += 1 + l + sovEnclave(uint64(l))
+	}
+	if m.GasTipCap != nil {
+		l = m.GasTipCap.Size()
+		n += 1 + l + sovEnclave(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovEnclave(uint64(l))
+	}
+	if len(m.AccessList) > 0 {
+		for _, e := range m.AccessList {
+			l = e.Size()
+			n += 1 + l + sovEnclave(uint64(l))
+		}
+	}
+	if m.BlobGasFeeCap != nil {
+		l = m.BlobGasFeeCap.Size()
+		n += 1 + l + sovEnclave(uint64(l))
+	}
+	if len(m.BlobHashes) > 0 {
+		for _, s := range m.BlobHashes {
+			l = len(s)
+			n += 1 + l + sovEnclave(uint64(l))
+		}
+	}
+	if m.SkipAccountChecks {
+		n += 2
+	}
+	return n
+}
+
+func (m *EnclaveTxConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovEnclave(uint64(l))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovEnclave(uint64(l))
+	}
+	if m.TxIndex != 0 {
+		n += 1 + sovEnclave(uint64(m.TxIndex))
+	}
+	if m.LogIndex != 0 {
+		n += 1 + sovEnclave(uint64(m.LogIndex))
+	}
+	return n
+}
+
+func (m *EnclaveEVMConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ChainConfig.Size()
+	n += 1 + l + sovEnclave(uint64(l))
+	if m.ChainID != nil {
+		l = m.ChainID.Size()
+		n += 1 + l + sovEnclave(uint64(l))
+	}
+	l = len(m.Coinbase)
+	if l > 0 {
+		n += 1 + l + sovEnclave(uint64(l))
+	}
+	if m.BaseFee != nil {
+		l = m.BaseFee.Size()
+		n += 1 + l + sovEnclave(uint64(l))
+	}
+	l = m.TxConfig.Size()
+	n += 1 + l + sovEnclave(uint64(l))
+	if m.DebugTrace {
+		n += 2
+	}
+	if m.NoBaseFee {
+		n += 2
+	}
+	l = len(m.EvmDenom)
+	if l > 0 {
+		n += 1 + l + sovEnclave(uint64(l))
+	}
+	if len(m.ExtraEIPs) > 0 {
+		l = 0
+		for _, e := range m.ExtraEIPs {
+			l += sovEnclave(uint64(e))
+		}
+		n += 1 + sovEnclave(uint64(l)) + l
+	}
+	l = len(m.Overrides)
+	if l > 0 {
+		n += 1 + l + sovEnclave(uint64(l))
+	}
+	if m.BlobBaseFee != nil {
+		l = m.BlobBaseFee.Size()
+		n += 1 + l + sovEnclave(uint64(l))
+	}
+	return n
+}
+
+func (m *EnclaveRules) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainID != nil {
+		l = m.ChainID.Size()
+		n += 1 + l + sovEnclave(uint64(l))
+	}
+	if m.IsHomestead {
+		n += 2
+	}
+	if m.IsEIP150 {
+		n += 2
+	}
+	if m.IsEIP155 {
+		n += 2
+	}
+	if m.IsEIP158 {
+		n += 2
+	}
+	if m.IsByzantium {
+		n += 2
+	}
+	if m.IsConstantinople {
+		n += 2
+	}
+	if m.IsPetersburg {
+		n += 2
+	}
+	if m.IsIstanbul {
+		n += 2
+	}
+	if m.IsBerlin {
+		n += 2
+	}
+	if m.IsLondon {
+		n += 2
+	}
+	if m.IsMerge {
+		n += 2
+	}
+	if m.IsShanghai {
+		n += 2
+	}
+	if m.IsCancun {
+		n += 2
+	}
+	if m.IsPrague {
+		n += 2
+	}
+	if m.IsVerkle {
+		n += 3
+	}
+	return n
+}
+
+func (m *EnclavePrepareTxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovEnclave(uint64(l))
+	}
+	l = m.Header.Size()
+	n += 1 + l + sovEnclave(uint64(l))
+	l = m.Msg.Size()
+	n += 1 + l + sovEnclave(uint64(l))
+	l = m.EVMConfig.Size()
+	n += 1 + l + sovEnclave(uint64(l))
+	if m.BlockSessionID != 0 {
+		n += 1 + sovEnclave(uint64(m.BlockSessionID))
+	}
+	if len(m.Authorizations) > 0 {
+		for _, e := range m.Authorizations {
+			l = e.Size()
+			n += 1 + l + sovEnclave(uint64(l))
+		}
+	}
+	if m.ContextID != 0 {
+		n += 1 + sovEnclave(uint64(m.ContextID))
+	}
+	return n
+}
+
+func (m *EnclavePrepareTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SessionID != 0 {
+		n += 1 + sovEnclave(uint64(m.SessionID))
+	}
+	return n
+}
+
+func (m *EnclaveCallRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SessionID != 0 {
+		n += 1 + sovEnclave(uint64(m.SessionID))
+	}
+	l = len(m.Caller)
+	if l > 0 {
+		n += 1 + l + sovEnclave(uint64(l))
+	}
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovEnclave(uint64(l))
+	}
+	l = len(m.Input)
+	if l > 0 {
+		n += 1 + l + sovEnclave(uint64(l))
+	}
+	if m.Gas != 0 {
+		n += 1 + sovEnclave(uint64(m.Gas))
+	}
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovEnclave(uint64(l))
+	}
+	return n
+}
+
+func (m *EnclaveCallResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Ret)
+	if l > 0 {
+		n += 1 + l + sovEnclave(uint64(l))
+	}
+	if m.LeftOverGas != 0 {
+		n += 1 + sovEnclave(uint64(m.LeftOverGas))
+	}
+	l = len(m.VmError)
+	if l > 0 {
+		n += 1 + l + sovEnclave(uint64(l))
+	}
+	return n
+}
+
+func (m *EnclaveCreateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SessionID != 0 {
+		n += 1 + sovEnclave(uint64(m.SessionID))
+	}
+	l = len(m.Caller)
+	if l > 0 {
+		n += 1 + l + sovEnclave(uint64(l))
+	}
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovEnclave(uint64(l))
+	}
+	if m.Gas != 0 {
+		n += 1 + sovEnclave(uint64(m.Gas))
+	}
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovEnclave(uint64(l))
+	}
+	return n
+}
+
+func (m *EnclaveCreateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Ret)
+	if l > 0 {
+		n += 1 + l + sovEnclave(uint64(l))
+	}
+	l = len(m.ContractAddr)
+	if l > 0 {
+		n += 1 + l + sovEnclave(uint64(l))
+	}
+	if m.LeftOverGas != 0 {
+		n += 1 + sovEnclave(uint64(m.LeftOverGas))
+	}
+	l = len(m.VmError)
+	if l > 0 {
+		n += 1 + l + sovEnclave(uint64(l))
+	}
+	return n
+}
+
+func (m *EnclaveCommitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SessionID != 0 {
+		n += 1 + sovEnclave(uint64(m.SessionID))
+	}
+	if m.Commit {
+		n += 2
+	}
+	return n
+}
+
+func (m *EnclaveCommitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *EnclaveStateDBAddBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SessionID != 0 {
+		n += 1 + sovEnclave(uint64(m.SessionID))
+	}
+	l = len(m.Caller)
+	if l > 0 {
+		n += 1 + l + sovEnclave(uint64(l))
+	}
+	l = m.Msg.Size()
+	n += 1 + l + sovEnclave(uint64(l))
+	if m.LeftoverGas != 0 {
+		n += 1 + sovEnclave(uint64(m.LeftoverGas))
+	}
+	return n
+}
+
+func (m *EnclaveStateDBAddBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *EnclaveStateDBSubBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SessionID != 0 {
+		n += 1 + sovEnclave(uint64(m.SessionID))
+	}
+	l = len(m.Caller)
+	if l > 0 {
+		n += 1 + l + sovEnclave(uint64(l))
+	}
+	l = m.Msg.Size()
+	n += 1 + l + sovEnclave(uint64(l))
+	return n
+}
+
+func (m *EnclaveStateDBSubBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *EnclaveStateDBSetNonceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SessionID != 0 {
+		n += 1 + sovEnclave(uint64(m.SessionID))
+	}
+	l = len(m.Caller)
+	if l > 0 {
+		n += 1 + l + sovEnclave(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovEnclave(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *EnclaveStateDBSetNonceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *EnclaveStateDBIncreaseNonceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SessionID != 0 {
+		n += 1 + sovEnclave(uint64(m.SessionID))
+	}
+	l = len(m.Caller)
+	if l > 0 {
+		n += 1 + l + sovEnclave(uint64(l))
+	}
+	l = m.Msg.Size()
+	n += 1 + l + sovEnclave(uint64(l))
+	return n
+}
+
+func (m *EnclaveStateDBIncreaseNonceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *EnclaveStateDBPrepareRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SessionID != 0 {
+		n += 1 + sovEnclave(uint64(m.SessionID))
+	}
+	l = m.Msg.Size()
+	n += 1 + l + sovEnclave(uint64(l))
+	l = m.Rules.Size()
+	n += 1 + l + sovEnclave(uint64(l))
+	return n
+}
+
+func (m *EnclaveStateDBPrepareResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *EnclaveStateDBGetRefundRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SessionID != 0 {
+		n += 1 + sovEnclave(uint64(m.SessionID))
+	}
+	return n
+}
+
+func (m *EnclaveStateDBGetRefundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Refund != 0 {
+		n += 1 + sovEnclave(uint64(m.Refund))
+	}
+	return n
+}
+
+func (m *EnclaveStateDBGetLogsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SessionID != 0 {
+		n += 1 + sovEnclave(uint64(m.SessionID))
+	}
+	return n
+}
+
+func (m *EnclaveStateDBGetLogsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Logs) > 0 {
+		for _, e := range m.Logs {
+			l = e.Size()
+			n += 1 + l + sovEnclave(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EnclaveEndSessionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SessionID != 0 {
+		n += 1 + sovEnclave(uint64(m.SessionID))
+	}
+	return n
+}
+
+func (m *EnclaveEndSessionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *EnclaveBeginBlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Header.Size()
+	n += 1 + l + sovEnclave(uint64(l))
+	return n
+}
+
+func (m *EnclaveBeginBlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockSessionID != 0 {
+		n += 1 + sovEnclave(uint64(m.BlockSessionID))
+	}
+	return n
+}
+
+func (m *EnclaveSnapshotRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockSessionID != 0 {
+		n += 1 + sovEnclave(uint64(m.BlockSessionID))
+	}
+	return n
+}
+
+func (m *EnclaveSnapshotResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SnapshotID != 0 {
+		n += 1 + sovEnclave(uint64(m.SnapshotID))
+	}
+	return n
+}
+
+func (m *EnclaveRevertToSnapshotRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockSessionID != 0 {
+		n += 1 + sovEnclave(uint64(m.BlockSessionID))
+	}
+	if m.SnapshotID != 0 {
+		n += 1 + sovEnclave(uint64(m.SnapshotID))
+	}
+	return n
+}
+
+func (m *EnclaveRevertToSnapshotResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *EnclaveEndBlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockSessionID != 0 {
+		n += 1 + sovEnclave(uint64(m.BlockSessionID))
+	}
+	if m.Commit {
+		n += 2
+	}
+	return n
+}
+
+func (m *EnclaveEndBlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovEnclave(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEnclave(x uint64) (n int) {
+	return sovEnclave(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EnclaveHandshakeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEnclave
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnclaveHandshakeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnclaveHandshakeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolVersion", wireType)
+			}
+			m.ProtocolVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProtocolVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinProtocolVersion", wireType)
+			}
+			m.MinProtocolVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinProtocolVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEnclave(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EnclaveHandshakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEnclave
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnclaveHandshakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnclaveHandshakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolVersion", wireType)
+			}
+			m.ProtocolVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProtocolVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinProtocolVersion", wireType)
+			}
+			m.MinProtocolVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinProtocolVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnclaveVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnclaveVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEnclave(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EnclaveMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEnclave
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnclaveMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnclaveMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.Value = &v
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.GasPrice = &v
+			if err := m.GasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasFeeCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.GasFeeCap = &v
+			if err := m.GasFeeCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasTipCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.GasTipCap = &v
+			if err := m.GasTipCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccessList = append(m.AccessList, AccessTuple{})
+			if err := m.AccessList[len(m.AccessList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobGasFeeCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.BlobGasFeeCap = &v
+			if err := m.BlobGasFeeCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobHashes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlobHashes = append(m.BlobHashes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipAccountChecks", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SkipAccountChecks = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEnclave(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EnclaveTxConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEnclave
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnclaveTxConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnclaveTxConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+			}
+			m.TxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogIndex", wireType)
+			}
+			m.LogIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEnclave(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EnclaveEVMConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEnclave
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnclaveEVMConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnclaveEVMConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ChainConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.ChainID = &v
+			if err := m.ChainID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coinbase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coinbase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.BaseFee = &v
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TxConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DebugTrace", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DebugTrace = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoBaseFee", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NoBaseFee = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEnclave
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ExtraEIPs = append(m.ExtraEIPs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEnclave
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEnclave
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEnclave
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ExtraEIPs) == 0 {
+					m.ExtraEIPs = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEnclave
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ExtraEIPs = append(m.ExtraEIPs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraEIPs", wireType)
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Overrides = append(m.Overrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.Overrides == nil {
+				m.Overrides = []byte{}
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.BlobBaseFee = &v
+			if err := m.BlobBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEnclave(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EnclaveRules) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEnclave
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnclaveRules: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnclaveRules: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.ChainID = &v
+			if err := m.ChainID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsHomestead", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsHomestead = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsEIP150", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsEIP150 = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsEIP155", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsEIP155 = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsEIP158", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsEIP158 = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsByzantium", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsByzantium = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsConstantinople", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsConstantinople = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsPetersburg", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsPetersburg = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsIstanbul", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsIstanbul = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsBerlin", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsBerlin = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsLondon", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsLondon = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsMerge", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsMerge = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsShanghai", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsShanghai = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsCancun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsCancun = bool(v != 0)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsPrague", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsPrague = bool(v != 0)
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsVerkle", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsVerkle = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEnclave(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EnclavePrepareTxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEnclave
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnclavePrepareTxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnclavePrepareTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EVMConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EVMConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockSessionID", wireType)
+			}
+			m.BlockSessionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockSessionID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorizations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authorizations = append(m.Authorizations, SetCodeAuthorization{})
+			if err := m.Authorizations[len(m.Authorizations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContextID", wireType)
+			}
+			m.ContextID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContextID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEnclave(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EnclavePrepareTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEnclave
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnclavePrepareTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnclavePrepareTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionID", wireType)
+			}
+			m.SessionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEnclave(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EnclaveCallRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEnclave
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnclaveCallRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnclaveCallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionID", wireType)
+			}
+			m.SessionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Caller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Input = append(m.Input[:0], dAtA[iNdEx:postIndex]...)
+			if m.Input == nil {
+				m.Input = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.Value = &v
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEnclave(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EnclaveCallResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEnclave
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnclaveCallResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnclaveCallResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ret", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ret = append(m.Ret[:0], dAtA[iNdEx:postIndex]...)
+			if m.Ret == nil {
+				m.Ret = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeftOverGas", wireType)
+			}
+			m.LeftOverGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeftOverGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VmError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEnclave(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EnclaveCreateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEnclave
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnclaveCreateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnclaveCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionID", wireType)
+			}
+			m.SessionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Caller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
+			if m.Code == nil {
+				m.Code = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.Value = &v
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEnclave(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EnclaveCreateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEnclave
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnclaveCreateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnclaveCreateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ret", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ret = append(m.Ret[:0], dAtA[iNdEx:postIndex]...)
+			if m.Ret == nil {
+				m.Ret = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeftOverGas", wireType)
+			}
+			m.LeftOverGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeftOverGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VmError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEnclave(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EnclaveCommitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEnclave
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnclaveCommitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnclaveCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionID", wireType)
+			}
+			m.SessionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Commit = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEnclave(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EnclaveCommitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEnclave
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnclaveCommitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnclaveCommitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEnclave(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EnclaveStateDBAddBalanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEnclave
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnclaveStateDBAddBalanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnclaveStateDBAddBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionID", wireType)
+			}
+			m.SessionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Caller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeftoverGas", wireType)
+			}
+			m.LeftoverGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeftoverGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEnclave(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EnclaveStateDBAddBalanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEnclave
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnclaveStateDBAddBalanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnclaveStateDBAddBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEnclave(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EnclaveStateDBSubBalanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEnclave
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnclaveStateDBSubBalanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnclaveStateDBSubBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionID", wireType)
+			}
+			m.SessionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Caller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEnclave(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EnclaveStateDBSubBalanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEnclave
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnclaveStateDBSubBalanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnclaveStateDBSubBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEnclave(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EnclaveStateDBSetNonceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEnclave
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnclaveStateDBSetNonceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnclaveStateDBSetNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionID", wireType)
+			}
+			m.SessionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Caller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEnclave(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EnclaveStateDBSetNonceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEnclave
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnclaveStateDBSetNonceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnclaveStateDBSetNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEnclave(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EnclaveStateDBIncreaseNonceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEnclave
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnclaveStateDBIncreaseNonceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnclaveStateDBIncreaseNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionID", wireType)
+			}
+			m.SessionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Caller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEnclave(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EnclaveStateDBIncreaseNonceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEnclave
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnclaveStateDBIncreaseNonceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnclaveStateDBIncreaseNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEnclave(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EnclaveStateDBPrepareRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEnclave
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnclaveStateDBPrepareRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnclaveStateDBPrepareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionID", wireType)
+			}
+			m.SessionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rules.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEnclave(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EnclaveStateDBPrepareResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEnclave
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnclaveStateDBPrepareResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnclaveStateDBPrepareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEnclave(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EnclaveStateDBGetRefundRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEnclave
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnclaveStateDBGetRefundRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnclaveStateDBGetRefundRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionID", wireType)
+			}
+			m.SessionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEnclave(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EnclaveStateDBGetRefundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEnclave
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnclaveStateDBGetRefundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnclaveStateDBGetRefundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refund", wireType)
+			}
+			m.Refund = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Refund |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEnclave(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EnclaveStateDBGetLogsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEnclave
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnclaveStateDBGetLogsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnclaveStateDBGetLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionID", wireType)
+			}
+			m.SessionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEnclave(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EnclaveStateDBGetLogsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEnclave
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnclaveStateDBGetLogsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnclaveStateDBGetLogsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logs = append(m.Logs, &Log{})
+			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEnclave(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EnclaveEndSessionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEnclave
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnclaveEndSessionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnclaveEndSessionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionID", wireType)
+			}
+			m.SessionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEnclave(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EnclaveEndSessionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEnclave
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnclaveEndSessionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnclaveEndSessionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEnclave(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EnclaveBeginBlockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEnclave
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnclaveBeginBlockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnclaveBeginBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEnclave(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EnclaveBeginBlockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEnclave
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnclaveBeginBlockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnclaveBeginBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockSessionID", wireType)
+			}
+			m.BlockSessionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockSessionID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEnclave(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EnclaveSnapshotRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEnclave
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnclaveSnapshotRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnclaveSnapshotRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockSessionID", wireType)
+			}
+			m.BlockSessionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockSessionID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEnclave(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EnclaveSnapshotResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEnclave
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnclaveSnapshotResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnclaveSnapshotResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotID", wireType)
+			}
+			m.SnapshotID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEnclave(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EnclaveRevertToSnapshotRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEnclave
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnclaveRevertToSnapshotRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnclaveRevertToSnapshotRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockSessionID", wireType)
+			}
+			m.BlockSessionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockSessionID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotID", wireType)
+			}
+			m.SnapshotID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEnclave(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EnclaveRevertToSnapshotResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEnclave
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnclaveRevertToSnapshotResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnclaveRevertToSnapshotResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEnclave(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EnclaveEndBlockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEnclave
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnclaveEndBlockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnclaveEndBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockSessionID", wireType)
+			}
+			m.BlockSessionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockSessionID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Commit = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEnclave(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EnclaveEndBlockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEnclave
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnclaveEndBlockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnclaveEndBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEnclave(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEnclave
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEnclave(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEnclave
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEnclave
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEnclave
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEnclave
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEnclave
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEnclave        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEnclave          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEnclave = fmt.Errorf("proto: unexpected end of group")
+)
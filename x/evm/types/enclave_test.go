@@ -0,0 +1,101 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnclaveHandshakeResponseValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		res      EnclaveHandshakeResponse
+		expError bool
+	}{
+		{"same version", EnclaveHandshakeResponse{ProtocolVersion: EnclaveProtocolVersion, MinProtocolVersion: EnclaveProtocolVersion}, false},
+		{"newer enclave, still compatible", EnclaveHandshakeResponse{ProtocolVersion: EnclaveProtocolVersion + 1, MinProtocolVersion: EnclaveProtocolVersion}, false},
+		{"newer enclave, incompatible", EnclaveHandshakeResponse{ProtocolVersion: EnclaveProtocolVersion + 2, MinProtocolVersion: EnclaveProtocolVersion + 1}, true},
+		{"older enclave", EnclaveHandshakeResponse{ProtocolVersion: MinEnclaveProtocolVersion - 1, MinProtocolVersion: 0}, true},
+		{"invalid range", EnclaveHandshakeResponse{ProtocolVersion: EnclaveProtocolVersion, MinProtocolVersion: EnclaveProtocolVersion + 1}, true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.res.Validate()
+		if tc.expError {
+			require.ErrorIs(t, err, ErrIncompatibleEnclave, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestEnclaveMessage(t *testing.T) {
+	to := common.HexToAddress("0x2")
+	testCases := []struct {
+		name string
+		msg  core.Message
+	}{
+		{
+			"contract creation",
+			core.Message{
+				From:     common.HexToAddress("0x1"),
+				Nonce:    1,
+				Value:    big.NewInt(0),
+				GasLimit: 21000,
+				GasPrice: big.NewInt(1),
+				Data:     []byte{0x60, 0x60},
+			},
+		},
+		{
+			"dynamic fee call with access list and blobs",
+			core.Message{
+				To:        &to,
+				From:      common.HexToAddress("0x1"),
+				Nonce:     2,
+				Value:     big.NewInt(100),
+				GasLimit:  50000,
+				GasPrice:  big.NewInt(10),
+				GasFeeCap: big.NewInt(20),
+				GasTipCap: big.NewInt(2),
+				AccessList: ethtypes.AccessList{
+					{Address: to, StorageKeys: []common.Hash{common.HexToHash("0x3")}},
+				},
+				BlobGasFeeCap:     big.NewInt(5),
+				BlobHashes:        []common.Hash{common.HexToHash("0x4")},
+				SkipAccountChecks: true,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		m := NewEnclaveMessage(tc.msg)
+		bz, err := m.Marshal()
+		require.NoError(t, err, tc.name)
+
+		var decoded EnclaveMessage
+		require.NoError(t, decoded.Unmarshal(bz), tc.name)
+		require.Equal(t, tc.msg, decoded.AsMessage(), tc.name)
+	}
+}
+
+func TestEnclaveRules(t *testing.T) {
+	rules := DefaultChainConfig().EthereumConfig(big.NewInt(9000)).Rules(big.NewInt(1), true, 0)
+	require.Equal(t, rules, NewEnclaveRules(rules).ToEthereum())
+	require.Equal(t, params.Rules{}, NewEnclaveRules(params.Rules{}).ToEthereum())
+}
+
+func TestNewEnclaveVMError(t *testing.T) {
+	require.NoError(t, NewEnclaveVMError(""))
+	require.ErrorIs(t, NewEnclaveVMError(vm.ErrExecutionReverted.Error()), vm.ErrExecutionReverted)
+	require.ErrorIs(t, NewEnclaveVMError(vm.ErrOutOfGas.Error()), vm.ErrOutOfGas)
+
+	err := NewEnclaveVMError("invalid opcode: opcode 0xfe not defined")
+	require.EqualError(t, err, "invalid opcode: opcode 0xfe not defined")
+	require.NotErrorIs(t, err, vm.ErrExecutionReverted)
+}
@@ -49,6 +49,11 @@ const (
 	codeErrGasOverflow
 	codeErrInvalidAccount
 	codeErrInvalidGasLimit
+	codeErrIncompatibleEnclave
+	codeErrInvalidAuthorization
+	codeErrTxTypeNotSupported
+	codeErrInvalidBlob
+	codeErrSgxExecutor
 )
 
 var ErrPostTxProcessing = errors.New("failed to execute post processing")
@@ -116,6 +121,22 @@ var (
 
 	// ErrInvalidGasLimit returns an error if gas limit value is invalid
 	ErrInvalidGasLimit = errorsmod.Register(ModuleName, codeErrInvalidGasLimit, "invalid gas limit")
+
+	// ErrIncompatibleEnclave returns an error if an SGX enclave speaks an unsupported protocol version
+	ErrIncompatibleEnclave = errorsmod.Register(ModuleName, codeErrIncompatibleEnclave, "incompatible enclave protocol version")
+
+	// ErrInvalidAuthorization returns an error if an EIP-7702 authorization is malformed
+	ErrInvalidAuthorization = errorsmod.Register(ModuleName, codeErrInvalidAuthorization, "invalid set-code authorization")
+
+	// ErrTxTypeNotSupported returns an error if the transaction type isn't enabled yet
+	ErrTxTypeNotSupported = errorsmod.Register(ModuleName, codeErrTxTypeNotSupported, "transaction type not supported")
+
+	// ErrInvalidBlob returns an error if an EIP-4844 blob or its sidecar is invalid
+	ErrInvalidBlob = errorsmod.Register(ModuleName, codeErrInvalidBlob, "invalid blob")
+
+	// ErrSgxExecutor returns an error if an SGX executor failed to serve a request, as opposed to
+	// the EVM errors of the messages it executes
+	ErrSgxExecutor = errorsmod.Register(ModuleName, codeErrSgxExecutor, "SGX executor failure")
 )
 
 // NewExecErrorWithReason unpacks the revert return bytes and returns a wrapped error
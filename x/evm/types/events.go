@@ -19,6 +19,7 @@ package types
 const (
 	EventTypeEthereumTx = TypeMsgEthereumTx
 	EventTypeBlockBloom = "block_bloom"
+	EventTypeInternalTx = "ethereum_internal_tx"
 
 	AttributeKeyContractAddress = "contract"
 	AttributeKeyRecipient       = "recipient"
@@ -32,6 +33,11 @@ const (
 	AttributeKeyEthereumTxFailed = "ethereumTxFailed"
 	AttributeValueCategory       = ModuleName
 	AttributeKeyEthereumBloom    = "bloom"
+	// blob gas fee charged to the sender of a blob transaction
+	AttributeKeyBlobFee = "blob_fee"
+	// opcode and call depth of the frame of an internal transaction
+	AttributeKeyInternalTxType  = "internalTxType"
+	AttributeKeyInternalTxDepth = "internalTxDepth"
 
 	MetricKeyTransitionDB = "transition_db"
 	MetricKeyStaticCall   = "static_call"
@@ -26,6 +26,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	feemarkettypes "github.com/evmos/ethermint/x/feemarket/types"
@@ -61,8 +62,12 @@ type StakingKeeper interface {
 // FeeMarketKeeper
 type FeeMarketKeeper interface {
 	GetBaseFee(ctx sdk.Context) *big.Int
+	GetBlobBaseFee(ctx sdk.Context) *big.Int
 	GetParams(ctx sdk.Context) feemarkettypes.Params
 	AddTransientGasWanted(ctx sdk.Context, gasWanted uint64) (uint64, error)
+	GetTransientFeePayment(ctx sdk.Context, txHash common.Hash) (feemarkettypes.FeePayment, bool)
+	AddTransientBlockFees(ctx sdk.Context, baseFees, priorityFees sdk.Coins, proposer sdk.AccAddress)
+	AddTransientTxReward(ctx sdk.Context, txHash common.Hash, gasUsed uint64, reward *big.Int)
 }
 
 // Event Hooks
@@ -0,0 +1,191 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	ethermint "github.com/evmos/ethermint/types"
+)
+
+// InternalTx is a value transfer, contract creation or self-destruct performed
+// by a nested call frame of an EVM transaction.
+type InternalTx struct {
+	Type  vm.OpCode
+	From  common.Address
+	To    common.Address
+	Value *big.Int
+	// Depth of the call frame, the frames entered by the transaction itself
+	// have depth 1.
+	Depth int
+}
+
+// internalTxFrame is a call frame entered by the EVM, with the internal
+// transactions of the frame and its succeeded sub-frames.
+type internalTxFrame struct {
+	txs []InternalTx
+}
+
+var _ vm.EVMLogger = &InternalTxTracer{}
+
+// InternalTxTracer is a lightweight call tracer that records the internal
+// transactions of an EVM transaction: the calls transferring value, the
+// contract creations and the self-destructs. The ones of the reverted frames
+// are discarded.
+type InternalTxTracer struct {
+	NoOpTracer
+
+	stack  []*internalTxFrame
+	result []InternalTx
+	failed bool
+}
+
+// NewInternalTxTracer creates a new InternalTxTracer
+func NewInternalTxTracer() *InternalTxTracer {
+	return &InternalTxTracer{}
+}
+
+// CaptureEnter implements vm.Tracer interface
+func (t *InternalTxTracer) CaptureEnter(typ vm.OpCode, from common.Address, to common.Address, _ []byte, _ uint64, value *big.Int) {
+	frame := &internalTxFrame{}
+	switch typ {
+	case vm.CALL:
+		if value == nil || value.Sign() == 0 {
+			break
+		}
+		fallthrough
+	case vm.CREATE, vm.CREATE2, vm.SELFDESTRUCT:
+		if value == nil {
+			value = new(big.Int)
+		}
+		frame.txs = append(frame.txs, InternalTx{
+			Type:  typ,
+			From:  from,
+			To:    to,
+			Value: new(big.Int).Set(value),
+			Depth: len(t.stack) + 1,
+		})
+	}
+	t.stack = append(t.stack, frame)
+}
+
+// CaptureExit implements vm.Tracer interface
+func (t *InternalTxTracer) CaptureExit(_ []byte, _ uint64, err error) {
+	if len(t.stack) == 0 {
+		return
+	}
+	frame := t.stack[len(t.stack)-1]
+	t.stack = t.stack[:len(t.stack)-1]
+	if err != nil {
+		return
+	}
+	if len(t.stack) == 0 {
+		t.result = append(t.result, frame.txs...)
+		return
+	}
+	parent := t.stack[len(t.stack)-1]
+	parent.txs = append(parent.txs, frame.txs...)
+}
+
+// CaptureEnd implements vm.Tracer interface
+func (t *InternalTxTracer) CaptureEnd(_ []byte, _ uint64, err error) {
+	t.failed = err != nil
+}
+
+// InternalTxs returns the internal transactions recorded in execution order,
+// none if the transaction failed.
+func (t *InternalTxTracer) InternalTxs() []InternalTx {
+	if t.failed {
+		return nil
+	}
+	return t.result
+}
+
+// NewInternalTxEvents returns the events of the internal transactions of the
+// EVM transaction.
+func NewInternalTxEvents(txHash common.Hash, txIndex uint, internalTxs []InternalTx) sdk.Events {
+	events := make(sdk.Events, len(internalTxs))
+	for i, itx := range internalTxs {
+		events[i] = sdk.NewEvent(
+			EventTypeInternalTx,
+			sdk.NewAttribute(AttributeKeyEthereumTxHash, txHash.Hex()),
+			sdk.NewAttribute(AttributeKeyTxIndex, strconv.FormatUint(uint64(txIndex), 10)),
+			sdk.NewAttribute(AttributeKeyInternalTxType, itx.Type.String()),
+			sdk.NewAttribute(sdk.AttributeKeySender, itx.From.Hex()),
+			sdk.NewAttribute(AttributeKeyRecipient, itx.To.Hex()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, itx.Value.String()),
+			sdk.NewAttribute(AttributeKeyInternalTxDepth, strconv.Itoa(itx.Depth)),
+		)
+	}
+	return events
+}
+
+// DecodeInternalTxsFromEvents parses the internal transactions from the events
+// of a tx result, they are indexed in the order of emission per eth tx.
+func DecodeInternalTxsFromEvents(events []abci.Event, height int64) ([]*ethermint.InternalTxResult, error) {
+	var (
+		results []*ethermint.InternalTxResult
+		indexes = make(map[string]uint32)
+	)
+	for _, event := range events {
+		if event.Type != EventTypeInternalTx {
+			continue
+		}
+
+		result := &ethermint.InternalTxResult{Height: height}
+		for _, attr := range event.Attributes {
+			switch attr.Key {
+			case AttributeKeyEthereumTxHash:
+				result.EthTxHash = attr.Value
+			case AttributeKeyTxIndex:
+				txIndex, err := strconv.ParseInt(attr.Value, 10, 32)
+				if err != nil {
+					return nil, fmt.Errorf("invalid internal tx index %s: %w", attr.Value, err)
+				}
+				result.EthTxIndex = int32(txIndex)
+			case AttributeKeyInternalTxType:
+				result.Type = attr.Value
+			case sdk.AttributeKeySender:
+				result.From = attr.Value
+			case AttributeKeyRecipient:
+				result.To = attr.Value
+			case sdk.AttributeKeyAmount:
+				result.Value = attr.Value
+			case AttributeKeyInternalTxDepth:
+				depth, err := strconv.ParseUint(attr.Value, 10, 32)
+				if err != nil {
+					return nil, fmt.Errorf("invalid internal tx depth %s: %w", attr.Value, err)
+				}
+				result.Depth = uint32(depth)
+			}
+		}
+		if result.EthTxHash == "" {
+			return nil, fmt.Errorf("internal tx event without %s attribute", AttributeKeyEthereumTxHash)
+		}
+
+		result.Index = indexes[result.EthTxHash]
+		indexes[result.EthTxHash]++
+		results = append(results, result)
+	}
+	return results, nil
+}
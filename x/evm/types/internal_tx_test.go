@@ -0,0 +1,136 @@
+package types
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInternalTxTracer(t *testing.T) {
+	var (
+		sender   = common.BigToAddress(big.NewInt(1))
+		contract = common.BigToAddress(big.NewInt(2))
+		callee   = common.BigToAddress(big.NewInt(3))
+		created  = common.BigToAddress(big.NewInt(4))
+		reverted = errors.New("execution reverted")
+	)
+
+	testCases := []struct {
+		name   string
+		trace  func(*InternalTxTracer)
+		expTxs []InternalTx
+	}{
+		{
+			"value transfers, creations and self-destructs",
+			func(tracer *InternalTxTracer) {
+				tracer.CaptureStart(nil, sender, contract, false, nil, 100000, big.NewInt(0))
+				tracer.CaptureEnter(vm.CALL, contract, callee, nil, 1000, big.NewInt(5))
+				tracer.CaptureEnter(vm.SELFDESTRUCT, callee, contract, nil, 0, big.NewInt(5))
+				tracer.CaptureExit(nil, 0, nil)
+				tracer.CaptureExit(nil, 0, nil)
+				tracer.CaptureEnter(vm.CREATE2, contract, created, nil, 1000, big.NewInt(0))
+				tracer.CaptureExit(nil, 0, nil)
+				tracer.CaptureEnd(nil, 0, nil)
+			},
+			[]InternalTx{
+				{Type: vm.CALL, From: contract, To: callee, Value: big.NewInt(5), Depth: 1},
+				{Type: vm.SELFDESTRUCT, From: callee, To: contract, Value: big.NewInt(5), Depth: 2},
+				{Type: vm.CREATE2, From: contract, To: created, Value: big.NewInt(0), Depth: 1},
+			},
+		},
+		{
+			"calls without value and delegate calls are skipped",
+			func(tracer *InternalTxTracer) {
+				tracer.CaptureStart(nil, sender, contract, false, nil, 100000, big.NewInt(0))
+				tracer.CaptureEnter(vm.CALL, contract, callee, nil, 1000, big.NewInt(0))
+				tracer.CaptureEnter(vm.DELEGATECALL, callee, created, nil, 1000, big.NewInt(5))
+				tracer.CaptureExit(nil, 0, nil)
+				tracer.CaptureEnter(vm.STATICCALL, callee, created, nil, 1000, nil)
+				tracer.CaptureExit(nil, 0, nil)
+				tracer.CaptureExit(nil, 0, nil)
+				tracer.CaptureEnd(nil, 0, nil)
+			},
+			nil,
+		},
+		{
+			"reverted frames are discarded with their sub-frames",
+			func(tracer *InternalTxTracer) {
+				tracer.CaptureStart(nil, sender, contract, false, nil, 100000, big.NewInt(0))
+				tracer.CaptureEnter(vm.CALL, contract, callee, nil, 1000, big.NewInt(5))
+				tracer.CaptureEnter(vm.CALL, callee, created, nil, 1000, big.NewInt(1))
+				tracer.CaptureExit(nil, 0, nil)
+				tracer.CaptureExit(nil, 0, reverted)
+				tracer.CaptureEnter(vm.CALL, contract, created, nil, 1000, big.NewInt(2))
+				tracer.CaptureExit(nil, 0, nil)
+				tracer.CaptureEnd(nil, 0, nil)
+			},
+			[]InternalTx{
+				{Type: vm.CALL, From: contract, To: created, Value: big.NewInt(2), Depth: 1},
+			},
+		},
+		{
+			"failed transaction",
+			func(tracer *InternalTxTracer) {
+				tracer.CaptureStart(nil, sender, contract, false, nil, 100000, big.NewInt(0))
+				tracer.CaptureEnter(vm.CALL, contract, callee, nil, 1000, big.NewInt(5))
+				tracer.CaptureExit(nil, 0, nil)
+				tracer.CaptureEnd(nil, 0, reverted)
+			},
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tracer := NewInternalTxTracer()
+			tc.trace(tracer)
+			require.Equal(t, tc.expTxs, tracer.InternalTxs())
+		})
+	}
+}
+
+func TestDecodeInternalTxsFromEvents(t *testing.T) {
+	var (
+		txHash1  = common.BigToHash(big.NewInt(1))
+		txHash2  = common.BigToHash(big.NewInt(2))
+		contract = common.BigToAddress(big.NewInt(2))
+		callee   = common.BigToAddress(big.NewInt(3))
+	)
+
+	events := append(
+		NewInternalTxEvents(txHash1, 3, []InternalTx{
+			{Type: vm.CALL, From: contract, To: callee, Value: big.NewInt(5), Depth: 1},
+			{Type: vm.SELFDESTRUCT, From: callee, To: contract, Value: big.NewInt(5), Depth: 2},
+		}),
+		NewInternalTxEvents(txHash2, 4, []InternalTx{
+			{Type: vm.CREATE, From: contract, To: callee, Value: big.NewInt(0), Depth: 1},
+		})...,
+	).ToABCIEvents()
+
+	results, err := DecodeInternalTxsFromEvents(events, 10)
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+
+	require.Equal(t, int64(10), results[0].Height)
+	require.Equal(t, txHash1.Hex(), results[0].EthTxHash)
+	require.Equal(t, int32(3), results[0].EthTxIndex)
+	require.Equal(t, uint32(0), results[0].Index)
+	require.Equal(t, "CALL", results[0].Type)
+	require.Equal(t, contract.Hex(), results[0].From)
+	require.Equal(t, callee.Hex(), results[0].To)
+	require.Equal(t, "5", results[0].Value)
+	require.Equal(t, uint32(1), results[0].Depth)
+
+	require.Equal(t, uint32(1), results[1].Index)
+	require.Equal(t, "SELFDESTRUCT", results[1].Type)
+	require.Equal(t, uint32(2), results[1].Depth)
+
+	require.Equal(t, txHash2.Hex(), results[2].EthTxHash)
+	require.Equal(t, int32(4), results[2].EthTxIndex)
+	require.Equal(t, uint32(0), results[2].Index)
+	require.Equal(t, "CREATE", results[2].Type)
+}
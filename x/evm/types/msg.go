@@ -39,6 +39,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 )
 
 var (
@@ -78,6 +79,44 @@ func NewTxContract(
 	return newMsgEthereumTx(chainID, nonce, nil, amount, gasLimit, gasPrice, gasFeeCap, gasTipCap, input, accesses)
 }
 
+// NewSetCodeTx returns a reference to a new EIP-7702 set-code transaction
+// message.
+func NewSetCodeTx(
+	chainID *big.Int, nonce uint64, to common.Address, amount *big.Int,
+	gasLimit uint64, gasFeeCap, gasTipCap *big.Int, input []byte, accesses *ethtypes.AccessList,
+	authorizations []SetCodeAuthorization,
+) *MsgEthereumTx {
+	var amt *sdkmath.Int
+	if amount != nil {
+		amountInt := sdkmath.NewIntFromBigInt(amount)
+		amt = &amountInt
+	}
+	cid := sdkmath.NewIntFromBigInt(chainID)
+	gtc := sdkmath.NewIntFromBigInt(gasTipCap)
+	gfc := sdkmath.NewIntFromBigInt(gasFeeCap)
+	txData := &SetCodeTx{
+		ChainID:        &cid,
+		Nonce:          nonce,
+		To:             to.Hex(),
+		Amount:         amt,
+		GasLimit:       gasLimit,
+		GasTipCap:      &gtc,
+		GasFeeCap:      &gfc,
+		Data:           input,
+		Accesses:       NewAccessList(accesses),
+		Authorizations: authorizations,
+	}
+
+	dataAny, err := PackTxData(txData)
+	if err != nil {
+		panic(err)
+	}
+
+	msg := MsgEthereumTx{Data: dataAny}
+	msg.Hash = msg.ComputeHash().Hex()
+	return &msg
+}
+
 func newMsgEthereumTx(
 	chainID *big.Int, nonce uint64, to *common.Address, amount *big.Int,
 	gasLimit uint64, gasPrice, gasFeeCap, gasTipCap *big.Int, input []byte, accesses *ethtypes.AccessList,
@@ -150,7 +189,7 @@ func newMsgEthereumTx(
 	}
 
 	msg := MsgEthereumTx{Data: dataAny}
-	msg.Hash = msg.AsTransaction().Hash().Hex()
+	msg.Hash = msg.ComputeHash().Hex()
 	return &msg
 }
 
@@ -223,7 +262,7 @@ func (msg MsgEthereumTx) ValidateBasic() error {
 	}
 
 	// Validate Hash field after validated txData to avoid panic
-	txHash := msg.AsTransaction().Hash().Hex()
+	txHash := msg.ComputeHash().Hex()
 	if msg.Hash != txHash {
 		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid tx hash %s, expected: %s", msg.Hash, txHash)
 	}
@@ -261,9 +300,54 @@ func (msg *MsgEthereumTx) GetSenderLegacy(chainID *big.Int) (common.Address, err
 
 // recoverSender recovers the sender address from the transaction signature.
 func (msg *MsgEthereumTx) recoverSender(chainID *big.Int) (common.Address, error) {
+	if tx, ok := msg.setCodeTx(); ok {
+		return tx.Sender(chainID)
+	}
 	return ethtypes.LatestSignerForChainID(chainID).Sender(msg.AsTransaction())
 }
 
+// StripBlobSidecar removes the sidecar of a blob transaction and returns it, nil
+// for the other transactions or if it's already stripped. The hash and the
+// signature of the transaction don't cover the sidecar, only the versioned
+// hashes of its blobs.
+func (msg *MsgEthereumTx) StripBlobSidecar() (*BlobTxSidecar, error) {
+	txData, err := UnpackTxData(msg.Data)
+	if err != nil {
+		return nil, err
+	}
+	blobTx, ok := txData.(*BlobTx)
+	if !ok || blobTx.Sidecar == nil {
+		return nil, nil
+	}
+
+	stripped := *blobTx
+	stripped.Sidecar = nil
+	if msg.Data, err = PackTxData(&stripped); err != nil {
+		return nil, err
+	}
+	return blobTx.Sidecar, nil
+}
+
+// setCodeTx returns the tx data of set-code transactions, which go-ethereum
+// can't represent.
+func (msg MsgEthereumTx) setCodeTx() (*SetCodeTx, bool) {
+	txData, err := UnpackTxData(msg.Data)
+	if err != nil {
+		return nil, false
+	}
+	tx, ok := txData.(*SetCodeTx)
+	return tx, ok
+}
+
+// SigHash returns the hash the sender signs with the signer, which is computed
+// by the transaction itself for the set-code transactions.
+func (msg MsgEthereumTx) SigHash(signer ethtypes.Signer) common.Hash {
+	if tx, ok := msg.setCodeTx(); ok {
+		return tx.SigHash(signer.ChainID())
+	}
+	return signer.Hash(msg.AsTransaction())
+}
+
 // GetSignBytes returns the Amino bytes of an Ethereum transaction message used
 // for signing.
 //
@@ -286,6 +370,26 @@ func (msg *MsgEthereumTx) Sign(ethSigner ethtypes.Signer, keyringSigner keyring.
 		return fmt.Errorf("sender address not defined for message")
 	}
 
+	if txData, ok := msg.setCodeTx(); ok {
+		chainID := ethSigner.ChainID()
+		sig, _, err := keyringSigner.SignByAddress(from, txData.SigHash(chainID).Bytes(), signing.SignMode_SIGN_MODE_TEXTUAL)
+		if err != nil {
+			return err
+		}
+		if len(sig) != crypto.SignatureLength {
+			return fmt.Errorf("wrong size for signature: got %d, want %d", len(sig), crypto.SignatureLength)
+		}
+
+		txData = txData.Copy().(*SetCodeTx)
+		r, s, v := new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:64]), new(big.Int).SetUint64(uint64(sig[64]))
+		txData.SetSignatureValues(chainID, v, r, s)
+		if msg.Data, err = PackTxData(txData); err != nil {
+			return err
+		}
+		msg.Hash = txData.Hash().Hex()
+		return nil
+	}
+
 	tx := msg.AsTransaction()
 	txHash := ethSigner.Hash(tx)
 
@@ -335,7 +439,46 @@ func (msg *MsgEthereumTx) GetFrom() sdk.AccAddress {
 	return sdk.AccAddress(msg.From)
 }
 
-// AsTransaction creates an Ethereum Transaction type from the msg fields
+// ComputeHash returns the Ethereum hash of the transaction.
+func (msg MsgEthereumTx) ComputeHash() common.Hash {
+	if tx, ok := msg.setCodeTx(); ok {
+		return tx.Hash()
+	}
+	return msg.AsTransaction().Hash()
+}
+
+// TxType returns the Ethereum type of the transaction.
+func (msg MsgEthereumTx) TxType() uint8 {
+	txData, err := UnpackTxData(msg.Data)
+	if err != nil {
+		return 0
+	}
+	return txData.TxType()
+}
+
+// GetSetCodeAuthorizations returns the authorizations of a set-code
+// transaction, nil for the other types.
+func (msg MsgEthereumTx) GetSetCodeAuthorizations() []SetCodeAuthorization {
+	tx, ok := msg.setCodeTx()
+	if !ok {
+		return nil
+	}
+	return tx.GetAuthorizations()
+}
+
+// MarshalBinary returns the canonical encoding of the transaction.
+func (msg MsgEthereumTx) MarshalBinary() ([]byte, error) {
+	if tx, ok := msg.setCodeTx(); ok {
+		return tx.MarshalBinary()
+	}
+	return msg.AsTransaction().MarshalBinary()
+}
+
+// AsTransaction creates an Ethereum Transaction type from the msg fields. The
+// set-code transactions are converted to dynamic fee ones without their
+// authorizations, whose type, hash, signature hash and sender differ: use
+// TxType, ComputeHash, SigHash, GetSenderLegacy and MarshalBinary instead, and
+// check TxType before handing the transaction to go-ethereum.
 func (msg MsgEthereumTx) AsTransaction() *ethtypes.Transaction {
 	txData, err := UnpackTxData(msg.Data)
 	if err != nil {
@@ -369,6 +512,10 @@ func (msg MsgEthereumTx) AsMessage(baseFee *big.Int) (core.Message, error) {
 		AccessList:        txData.GetAccessList(),
 		SkipAccountChecks: false,
 	}
+	if blobTx, ok := txData.(*BlobTx); ok {
+		ethMsg.BlobGasFeeCap = blobTx.GetBlobFeeCap()
+		ethMsg.BlobHashes = blobTx.GetBlobVersionedHashes()
+	}
 
 	return ethMsg, nil
 }
@@ -393,6 +540,23 @@ func (msg MsgEthereumTx) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error
 
 // UnmarshalBinary decodes the canonical encoding of transactions.
 func (msg *MsgEthereumTx) UnmarshalBinary(b []byte, chainID *big.Int) error {
+	if len(b) > 0 && b[0] == SetCodeTxType {
+		txData, err := DecodeSetCodeTx(b)
+		if err != nil {
+			return err
+		}
+		from, err := txData.Sender(chainID)
+		if err != nil {
+			return err
+		}
+		if msg.Data, err = PackTxData(txData); err != nil {
+			return err
+		}
+		msg.Hash = txData.Hash().Hex()
+		msg.From = from.Bytes()
+		return nil
+	}
+
 	tx := &ethtypes.Transaction{}
 	if err := tx.UnmarshalBinary(b); err != nil {
 		return err
@@ -400,6 +564,39 @@ func (msg *MsgEthereumTx) UnmarshalBinary(b []byte, chainID *big.Int) error {
 	return msg.FromSignedEthereumTx(tx, chainID)
 }
 
+// feeDenomSigPrefix separates the fee denom signatures from the other signed digests.
+const feeDenomSigPrefix = "\x19Ethermint fee denom:\n"
+
+// FeeDenomSigHash returns the hash the sender of the Ethereum transaction signs to pay
+// for its gas with the given fee token, see ExtensionOptionsEthereumTx.
+func FeeDenomSigHash(txHash common.Hash, denom string) common.Hash {
+	return crypto.Keccak256Hash([]byte(feeDenomSigPrefix), txHash.Bytes(), []byte(denom))
+}
+
+// SignFeeDenom signs the selection of the fee token paying for the gas of the
+// transaction with the key of its sender.
+func (msg MsgEthereumTx) SignFeeDenom(denom string, keyringSigner keyring.Signer) ([]byte, error) {
+	hash := FeeDenomSigHash(msg.ComputeHash(), denom)
+	sig, _, err := keyringSigner.SignByAddress(sdk.AccAddress(msg.From), hash.Bytes(), signing.SignMode_SIGN_MODE_TEXTUAL)
+	return sig, err
+}
+
+// VerifyFeeDenomSignature verifies that the selection of the fee token paying for
+// the gas of the transaction is signed by its sender.
+func (msg MsgEthereumTx) VerifyFeeDenomSignature(denom string, sig []byte) error {
+	pubKey, err := crypto.SigToPub(FeeDenomSigHash(msg.ComputeHash(), denom).Bytes(), sig)
+	if err != nil {
+		return errorsmod.Wrapf(errortypes.ErrorInvalidSigner, "invalid fee denom signature: %s", err)
+	}
+	if signer := crypto.PubkeyToAddress(*pubKey); signer != common.BytesToAddress(msg.From) {
+		return errorsmod.Wrapf(
+			errortypes.ErrorInvalidSigner,
+			"fee denom signed by %s instead of the sender %s", signer, common.BytesToAddress(msg.From),
+		)
+	}
+	return nil
+}
+
 // BuildTx builds the canonical cosmos tx from ethereum msg
 func (msg *MsgEthereumTx) BuildTx(b client.TxBuilder, evmDenom string) (authsigning.Tx, error) {
 	builder, ok := b.(authtx.ExtensionOptionsTxBuilder)
@@ -541,6 +541,26 @@ func (suite *MsgsTestSuite) TestMsgEthereumTx_Sign() {
 	}
 }
 
+func (suite *MsgsTestSuite) TestMsgEthereumTx_FeeDenomSignature() {
+	tx := types.NewTx(suite.chainID, 0, &suite.to, nil, 100000, nil, nil, nil, nil, nil)
+	tx.From = suite.from.Bytes()
+	suite.Require().NoError(tx.Sign(ethtypes.LatestSignerForChainID(suite.chainID), suite.signer))
+
+	sig, err := tx.SignFeeDenom("ibc/usdc", suite.signer)
+	suite.Require().NoError(err)
+	suite.Require().NoError(tx.VerifyFeeDenomSignature("ibc/usdc", sig))
+
+	// the signature is bound to the denom, the tx and its sender
+	suite.Require().Error(tx.VerifyFeeDenomSignature("ibc/atom", sig))
+	suite.Require().Error(tx.VerifyFeeDenomSignature("ibc/usdc", nil))
+	other := types.NewTx(suite.chainID, 1, &suite.to, nil, 100000, nil, nil, nil, nil, nil)
+	other.From = suite.from.Bytes()
+	suite.Require().NoError(other.Sign(ethtypes.LatestSignerForChainID(suite.chainID), suite.signer))
+	suite.Require().Error(other.VerifyFeeDenomSignature("ibc/usdc", sig))
+	tx.From = tests.GenerateAddress().Bytes()
+	suite.Require().Error(tx.VerifyFeeDenomSignature("ibc/usdc", sig))
+}
+
 func (suite *MsgsTestSuite) TestMsgEthereumTx_Getters() {
 	testCases := []struct {
 		name      string
@@ -1471,6 +1471,8 @@ var xxx_messageInfo_QueryBaseFeeResponse proto.InternalMessageInfo
 
 type GetHashRequest struct {
 	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// context_id is the context id of the session, from EnclavePrepareTxRequest
+	ContextID uint64 `protobuf:"varint,2,opt,name=context_id,json=contextId,proto3" json:"context_id,omitempty"`
 }
 
 func (m *GetHashRequest) Reset()         { *m = GetHashRequest{} }
@@ -1513,6 +1515,13 @@ func (m *GetHashRequest) GetHeight() uint64 {
 	return 0
 }
 
+func (m *GetHashRequest) GetContextID() uint64 {
+	if m != nil {
+		return m.ContextID
+	}
+	return 0
+}
+
 type GetHashResponse struct {
 	// hash *common.Hash
 	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
@@ -1563,6 +1572,8 @@ type AddBalanceRequest struct {
 	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
 	// Amount sdk.Coins
 	Amount []types1.Coin `protobuf:"bytes,2,rep,name=amount,proto3" json:"amount"`
+	// context_id is the context id of the session, from EnclavePrepareTxRequest
+	ContextID uint64 `protobuf:"varint,3,opt,name=context_id,json=contextId,proto3" json:"context_id,omitempty"`
 }
 
 func (m *AddBalanceRequest) Reset()         { *m = AddBalanceRequest{} }
@@ -1612,6 +1623,13 @@ func (m *AddBalanceRequest) GetAmount() []types1.Coin {
 	return nil
 }
 
+func (m *AddBalanceRequest) GetContextID() uint64 {
+	if m != nil {
+		return m.ContextID
+	}
+	return 0
+}
+
 type AddBalanceResponse struct {
 }
 
@@ -1653,6 +1671,8 @@ type SubBalanceRequest struct {
 	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
 	// Amount sdk.Coins
 	Amount []types1.Coin `protobuf:"bytes,2,rep,name=amount,proto3" json:"amount"`
+	// context_id is the context id of the session, from EnclavePrepareTxRequest
+	ContextID uint64 `protobuf:"varint,3,opt,name=context_id,json=contextId,proto3" json:"context_id,omitempty"`
 }
 
 func (m *SubBalanceRequest) Reset()         { *m = SubBalanceRequest{} }
@@ -1702,6 +1722,13 @@ func (m *SubBalanceRequest) GetAmount() []types1.Coin {
 	return nil
 }
 
+func (m *SubBalanceRequest) GetContextID() uint64 {
+	if m != nil {
+		return m.ContextID
+	}
+	return 0
+}
+
 type SubBalanceResponse struct {
 }
 
@@ -1743,6 +1770,8 @@ type GetBalanceRequest struct {
 	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
 	// Denom string
 	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// context_id is the context id of the session, from EnclavePrepareTxRequest
+	ContextID uint64 `protobuf:"varint,3,opt,name=context_id,json=contextId,proto3" json:"context_id,omitempty"`
 }
 
 func (m *GetBalanceRequest) Reset()         { *m = GetBalanceRequest{} }
@@ -1792,6 +1821,13 @@ func (m *GetBalanceRequest) GetDenom() string {
 	return ""
 }
 
+func (m *GetBalanceRequest) GetContextID() uint64 {
+	if m != nil {
+		return m.ContextID
+	}
+	return 0
+}
+
 type GetBalanceResponse struct {
 	//	Balance *big.Int
 	Balance cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=balance,proto3,customtype=cosmossdk.io/math.Int" json:"balance"`
@@ -1833,6 +1869,8 @@ var xxx_messageInfo_GetBalanceResponse proto.InternalMessageInfo
 type GetAccountRequest struct {
 	// Addr common.Address
 	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	// context_id is the context id of the session, from EnclavePrepareTxRequest
+	ContextID uint64 `protobuf:"varint,2,opt,name=context_id,json=contextId,proto3" json:"context_id,omitempty"`
 }
 
 func (m *GetAccountRequest) Reset()         { *m = GetAccountRequest{} }
@@ -1875,6 +1913,13 @@ func (m *GetAccountRequest) GetAddr() string {
 	return ""
 }
 
+func (m *GetAccountRequest) GetContextID() uint64 {
+	if m != nil {
+		return m.ContextID
+	}
+	return 0
+}
+
 type GetAccountResponse struct {
 	// accutal type is *statedb.Account
 	// Account *statedb.Account
@@ -1926,6 +1971,8 @@ type GetStateRequest struct {
 	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
 	// Key  common.Hash
 	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// context_id is the context id of the session, from EnclavePrepareTxRequest
+	ContextID uint64 `protobuf:"varint,3,opt,name=context_id,json=contextId,proto3" json:"context_id,omitempty"`
 }
 
 func (m *GetStateRequest) Reset()         { *m = GetStateRequest{} }
@@ -1975,6 +2022,13 @@ func (m *GetStateRequest) GetKey() string {
 	return ""
 }
 
+func (m *GetStateRequest) GetContextID() uint64 {
+	if m != nil {
+		return m.ContextID
+	}
+	return 0
+}
+
 type GetStateResponse struct {
 	// Hash common.Hash
 	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
@@ -2023,6 +2077,8 @@ func (m *GetStateResponse) GetHash() string {
 type GetCodeRequest struct {
 	// CodeHash common.Hash
 	CodeHash string `protobuf:"bytes,1,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
+	// context_id is the context id of the session, from EnclavePrepareTxRequest
+	ContextID uint64 `protobuf:"varint,2,opt,name=context_id,json=contextId,proto3" json:"context_id,omitempty"`
 }
 
 func (m *GetCodeRequest) Reset()         { *m = GetCodeRequest{} }
@@ -2065,6 +2121,13 @@ func (m *GetCodeRequest) GetCodeHash() string {
 	return ""
 }
 
+func (m *GetCodeRequest) GetContextID() uint64 {
+	if m != nil {
+		return m.ContextID
+	}
+	return 0
+}
+
 type GetCodeResponse struct {
 	// Code []byte
 	Code []byte `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
@@ -2115,6 +2178,8 @@ type SetAccountRequest struct {
 	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
 	// Account statedb.Account
 	Account []byte `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	// context_id is the context id of the session, from EnclavePrepareTxRequest
+	ContextID uint64 `protobuf:"varint,3,opt,name=context_id,json=contextId,proto3" json:"context_id,omitempty"`
 }
 
 func (m *SetAccountRequest) Reset()         { *m = SetAccountRequest{} }
@@ -2164,6 +2229,13 @@ func (m *SetAccountRequest) GetAccount() []byte {
 	return nil
 }
 
+func (m *SetAccountRequest) GetContextID() uint64 {
+	if m != nil {
+		return m.ContextID
+	}
+	return 0
+}
+
 type SetAccountResponse struct {
 	Nonce    uint64 `protobuf:"varint,1,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
 	CodeHash []byte `protobuf:"bytes,2,opt,name=CodeHash,proto3" json:"CodeHash,omitempty"`
@@ -2223,6 +2295,8 @@ type SetStateRequest struct {
 	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
 	// Value []byte
 	Value []byte `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	// context_id is the context id of the session, from EnclavePrepareTxRequest
+	ContextID uint64 `protobuf:"varint,4,opt,name=context_id,json=contextId,proto3" json:"context_id,omitempty"`
 }
 
 func (m *SetStateRequest) Reset()         { *m = SetStateRequest{} }
@@ -2279,6 +2353,13 @@ func (m *SetStateRequest) GetValue() []byte {
 	return nil
 }
 
+func (m *SetStateRequest) GetContextID() uint64 {
+	if m != nil {
+		return m.ContextID
+	}
+	return 0
+}
+
 type SetStateResponse struct {
 }
 
@@ -2320,6 +2401,8 @@ type SetCodeRequest struct {
 	CodeHash []byte `protobuf:"bytes,1,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
 	// Code     []byte
 	Code []byte `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	// context_id is the context id of the session, from EnclavePrepareTxRequest
+	ContextID uint64 `protobuf:"varint,3,opt,name=context_id,json=contextId,proto3" json:"context_id,omitempty"`
 }
 
 func (m *SetCodeRequest) Reset()         { *m = SetCodeRequest{} }
@@ -2369,6 +2452,13 @@ func (m *SetCodeRequest) GetCode() []byte {
 	return nil
 }
 
+func (m *SetCodeRequest) GetContextID() uint64 {
+	if m != nil {
+		return m.ContextID
+	}
+	return 0
+}
+
 type SetCodeResponse struct {
 }
 
@@ -2408,6 +2498,8 @@ var xxx_messageInfo_SetCodeResponse proto.InternalMessageInfo
 type DeleteAccountRequest struct {
 	// Addr common.Address
 	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	// context_id is the context id of the session, from EnclavePrepareTxRequest
+	ContextID uint64 `protobuf:"varint,2,opt,name=context_id,json=contextId,proto3" json:"context_id,omitempty"`
 }
 
 func (m *DeleteAccountRequest) Reset()         { *m = DeleteAccountRequest{} }
@@ -2450,6 +2542,13 @@ func (m *DeleteAccountRequest) GetAddr() string {
 	return ""
 }
 
+func (m *DeleteAccountRequest) GetContextID() uint64 {
+	if m != nil {
+		return m.ContextID
+	}
+	return 0
+}
+
 type DeleteAccountResponse struct {
 }
 
@@ -2540,140 +2639,143 @@ func init() {
 func init() { proto.RegisterFile("ethermint/evm/v1/query.proto", fileDescriptor_e15a877459347994) }
 
 var fileDescriptor_e15a877459347994 = []byte{
-	// 2120 bytes of a gzipped FileDescriptorProto
+	// 2169 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x5f, 0x6f, 0x1b, 0xc7,
-	0x11, 0xd7, 0x89, 0x94, 0x48, 0x8d, 0x64, 0x5b, 0x5a, 0x4b, 0x31, 0x75, 0xd6, 0x3f, 0xaf, 0xfe,
-	0xda, 0x96, 0x8f, 0x95, 0x1a, 0xc4, 0x68, 0x80, 0xa2, 0x91, 0x18, 0xdb, 0x4d, 0x63, 0x07, 0x2e,
-	0x29, 0xf4, 0xa1, 0x40, 0xc1, 0x1e, 0x79, 0xeb, 0x23, 0x21, 0x92, 0xcb, 0xdc, 0x2e, 0x59, 0x3a,
-	0x89, 0x03, 0x34, 0x68, 0xd3, 0xa4, 0x01, 0x8a, 0x00, 0x45, 0x5f, 0x8b, 0x7c, 0x83, 0x7e, 0x8d,
-	0x3c, 0x06, 0x68, 0x0b, 0x14, 0x7d, 0x70, 0x03, 0xbb, 0x0f, 0xfd, 0x0c, 0x7d, 0x2a, 0x76, 0x6f,
-	0x97, 0xbc, 0xe3, 0x1d, 0x8f, 0x74, 0x90, 0x00, 0x06, 0xfa, 0xc4, 0xdb, 0xdd, 0xd9, 0x99, 0xdf,
-	0xce, 0xcc, 0xce, 0x9f, 0x25, 0xac, 0x11, 0x5e, 0x23, 0x5e, 0xb3, 0xde, 0xe2, 0x79, 0xd2, 0x6d,
-	0xe6, 0xbb, 0x47, 0xf9, 0x77, 0x3b, 0xc4, 0x7b, 0x6c, 0xb5, 0x3d, 0xca, 0x29, 0x5a, 0xec, 0xaf,
-	0x5a, 0xa4, 0xdb, 0xb4, 0xba, 0x47, 0xe6, 0x8d, 0x2a, 0x65, 0x4d, 0xca, 0xf2, 0x15, 0x9b, 0x11,
-	0x9f, 0x34, 0xdf, 0x3d, 0xaa, 0x10, 0x6e, 0x1f, 0xe5, 0xdb, 0xb6, 0x5b, 0x6f, 0xd9, 0xbc, 0x4e,
-	0x5b, 0xfe, 0x6e, 0x73, 0x23, 0x48, 0xab, 0xa9, 0xaa, 0xb4, 0xae, 0xd7, 0x57, 0x23, 0xb2, 0x79,
-	0x4f, 0x2d, 0x99, 0x91, 0xa5, 0x06, 0x75, 0xd5, 0xda, 0x7a, 0x64, 0xad, 0x6d, 0x7b, 0x76, 0x93,
-	0xa9, 0xe5, 0xed, 0x28, 0x57, 0xcf, 0xae, 0x92, 0x72, 0x95, 0xb6, 0x1e, 0xd5, 0x35, 0x8f, 0x65,
-	0x97, 0xba, 0x54, 0x7e, 0xe6, 0xc5, 0x97, 0x9a, 0x5d, 0x73, 0x29, 0x75, 0x1b, 0x24, 0x6f, 0xb7,
-	0xeb, 0x79, 0xbb, 0xd5, 0xa2, 0x5c, 0x9e, 0x46, 0x33, 0xde, 0x54, 0xab, 0x72, 0x54, 0xe9, 0x3c,
-	0xca, 0xf3, 0x7a, 0x93, 0x30, 0x6e, 0x37, 0xdb, 0x3e, 0x01, 0xfe, 0x01, 0x5c, 0xfe, 0xa9, 0xd0,
-	0xc8, 0x49, 0xb5, 0x4a, 0x3b, 0x2d, 0x5e, 0x24, 0xef, 0x76, 0x08, 0xe3, 0x28, 0x07, 0x19, 0xdb,
-	0x71, 0x3c, 0xc2, 0x58, 0xce, 0xd8, 0x32, 0x0e, 0xe6, 0x8a, 0x7a, 0xf8, 0x7a, 0xf6, 0x93, 0x2f,
-	0x36, 0xa7, 0xfe, 0xf3, 0xc5, 0xe6, 0x14, 0xae, 0xc2, 0x72, 0x78, 0x2b, 0x6b, 0xd3, 0x16, 0x23,
-	0x62, 0x6f, 0xc5, 0x6e, 0xd8, 0xad, 0x2a, 0xd1, 0x7b, 0xd5, 0x10, 0x5d, 0x85, 0xb9, 0x2a, 0x75,
-	0x48, 0xb9, 0x66, 0xb3, 0x5a, 0x6e, 0x5a, 0xae, 0x65, 0xc5, 0xc4, 0x8f, 0x6d, 0x56, 0x43, 0xcb,
-	0x30, 0xd3, 0xa2, 0x62, 0x53, 0x6a, 0xcb, 0x38, 0x48, 0x17, 0xfd, 0x01, 0xfe, 0x11, 0xac, 0x4a,
-	0x21, 0x05, 0x69, 0x96, 0x6f, 0x80, 0xf2, 0x63, 0x03, 0xcc, 0x38, 0x0e, 0x0a, 0xec, 0x2e, 0x5c,
-	0xf4, 0x2d, 0x5e, 0x0e, 0x73, 0xba, 0xe0, 0xcf, 0x9e, 0xf8, 0x93, 0xc8, 0x84, 0x2c, 0x13, 0x42,
-	0x05, 0xbe, 0x69, 0x89, 0xaf, 0x3f, 0x16, 0x2c, 0x6c, 0x9f, 0x6b, 0xb9, 0xd5, 0x69, 0x56, 0x88,
-	0xa7, 0x4e, 0x70, 0x41, 0xcd, 0xbe, 0x23, 0x27, 0xf1, 0xdb, 0xb0, 0x26, 0x71, 0xfc, 0xcc, 0x6e,
-	0xd4, 0x1d, 0x9b, 0x53, 0x6f, 0xe8, 0x30, 0xd7, 0x60, 0xa1, 0x4a, 0x5b, 0xc3, 0x38, 0xe6, 0xc5,
-	0xdc, 0x49, 0xe4, 0x54, 0x9f, 0x19, 0xb0, 0x3e, 0x82, 0x9b, 0x3a, 0xd8, 0x3e, 0x5c, 0xd2, 0xa8,
-	0xc2, 0x1c, 0x35, 0xd8, 0x6f, 0xf1, 0x68, 0xda, 0x89, 0x4e, 0x7d, 0x3b, 0xbf, 0x88, 0x79, 0xbe,
-	0xa7, 0x9c, 0xa8, 0xbf, 0x75, 0x9c, 0x13, 0xe1, 0xb7, 0x95, 0xb0, 0x12, 0xa7, 0x9e, 0xed, 0x8e,
-	0x17, 0x86, 0x16, 0x21, 0x75, 0x4e, 0x1e, 0x2b, 0x7f, 0x13, 0x9f, 0x01, 0xf1, 0x87, 0x4a, 0x7c,
-	0x9f, 0x99, 0x12, 0xbf, 0x0c, 0x33, 0x5d, 0xbb, 0xd1, 0xd1, 0xc2, 0xfd, 0x01, 0x7e, 0x0d, 0x16,
-	0x95, 0x2b, 0x39, 0x2f, 0x74, 0xc8, 0x7d, 0x58, 0x0a, 0xec, 0x53, 0x22, 0x10, 0xa4, 0x85, 0xef,
-	0xcb, 0x5d, 0x0b, 0x45, 0xf9, 0x8d, 0xdf, 0x03, 0x24, 0x09, 0xcf, 0x7a, 0xf7, 0xa9, 0xcb, 0xb4,
-	0x08, 0x04, 0x69, 0x79, 0x63, 0x7c, 0xfe, 0xf2, 0x1b, 0xdd, 0x05, 0x18, 0xc4, 0x2e, 0x79, 0xb6,
-	0xf9, 0xe3, 0x3d, 0xcb, 0x77, 0x5a, 0x4b, 0x04, 0x2f, 0xcb, 0x8f, 0x89, 0x2a, 0x84, 0x59, 0x0f,
-	0x07, 0xaa, 0x2a, 0x06, 0x76, 0x06, 0x40, 0x7e, 0x6a, 0x28, 0xc5, 0x6a, 0xe1, 0x0a, 0xe7, 0x75,
-	0x48, 0x37, 0xa8, 0x2b, 0x4e, 0x97, 0x3a, 0x98, 0x3f, 0x5e, 0xb1, 0x86, 0xc3, 0xab, 0x75, 0x9f,
-	0xba, 0x45, 0x49, 0x82, 0xee, 0xc5, 0x80, 0xda, 0x1f, 0x0b, 0xca, 0x97, 0x13, 0x44, 0x85, 0x97,
-	0x95, 0x1e, 0x1e, 0xca, 0x20, 0xa9, 0x70, 0xe3, 0x07, 0x0a, 0xa0, 0x9e, 0x55, 0x00, 0x5f, 0x83,
-	0x59, 0x3f, 0x98, 0x4a, 0x05, 0xcd, 0x1f, 0xe7, 0xa2, 0x10, 0xfd, 0x1d, 0xa7, 0xe9, 0x2f, 0x9f,
-	0x6e, 0x4e, 0x15, 0x15, 0x35, 0xfe, 0xbb, 0x01, 0x17, 0xef, 0xf0, 0x5a, 0xc1, 0x6e, 0x34, 0x02,
-	0x9a, 0xb6, 0x3d, 0x97, 0x69, 0x9b, 0x88, 0x6f, 0x74, 0x05, 0x32, 0xae, 0xcd, 0xca, 0x55, 0xbb,
-	0xad, 0xae, 0xc7, 0xac, 0x6b, 0xb3, 0x82, 0xdd, 0x46, 0xbf, 0x80, 0xc5, 0xb6, 0x47, 0xdb, 0x94,
-	0x11, 0xaf, 0x7f, 0xc5, 0xc4, 0xf5, 0x58, 0x38, 0x3d, 0xfe, 0xef, 0xd3, 0x4d, 0xcb, 0xad, 0xf3,
-	0x5a, 0xa7, 0x62, 0x55, 0x69, 0x33, 0xaf, 0x72, 0x8a, 0xff, 0x73, 0x8b, 0x39, 0xe7, 0x79, 0xfe,
-	0xb8, 0x4d, 0x98, 0x55, 0x18, 0xdc, 0xed, 0xe2, 0x25, 0xcd, 0x4b, 0xdf, 0xcb, 0x55, 0xc8, 0x56,
-	0x6b, 0x76, 0xbd, 0x55, 0xae, 0x3b, 0xb9, 0xf4, 0x96, 0x71, 0x90, 0x2a, 0x66, 0xe4, 0xf8, 0x2d,
-	0x07, 0xad, 0xc1, 0x1c, 0xed, 0x12, 0xcf, 0xab, 0x3b, 0x84, 0xe5, 0x66, 0x24, 0xd6, 0xc1, 0x04,
-	0x3e, 0x83, 0xcb, 0x77, 0x18, 0xaf, 0x37, 0x6d, 0x4e, 0xee, 0xd9, 0x03, 0x35, 0x2d, 0x42, 0xca,
-	0xb5, 0xfd, 0xa3, 0xa5, 0x8b, 0xe2, 0x53, 0xcc, 0x78, 0x84, 0xcb, 0x53, 0x2d, 0x14, 0xc5, 0xa7,
-	0x90, 0xd9, 0x6d, 0x96, 0x89, 0xe7, 0x51, 0xff, 0xa6, 0xcf, 0x15, 0x33, 0xdd, 0xe6, 0x1d, 0x31,
-	0xc4, 0x5f, 0xa7, 0xb4, 0x7b, 0x88, 0xcc, 0x74, 0xd6, 0xd3, 0x2a, 0x3b, 0x82, 0x54, 0x93, 0xb9,
-	0x4a, 0xf5, 0x9b, 0x51, 0xd5, 0x3f, 0x60, 0xee, 0x1d, 0x31, 0x47, 0x3a, 0xcd, 0xb3, 0x5e, 0x51,
-	0xd0, 0xa2, 0x37, 0x60, 0x21, 0x98, 0xde, 0xa4, 0xa4, 0xf9, 0xe3, 0xf5, 0xe8, 0x5e, 0x29, 0xaa,
-	0x20, 0x89, 0x8a, 0xf3, 0x7c, 0x30, 0x40, 0x05, 0x58, 0x68, 0x7b, 0xc4, 0x21, 0x55, 0xc2, 0x18,
-	0xf5, 0x58, 0x2e, 0x2d, 0x7d, 0x73, 0xac, 0xf4, 0xd0, 0x26, 0x11, 0x70, 0x2b, 0x0d, 0x5a, 0x3d,
-	0xd7, 0xa1, 0x6d, 0x46, 0x2a, 0x79, 0x5e, 0xce, 0xf9, 0x81, 0x0d, 0xad, 0x03, 0xf8, 0x24, 0xf2,
-	0xfe, 0xcd, 0x4a, 0x8d, 0xcc, 0xc9, 0x19, 0x99, 0xb2, 0x0a, 0x7a, 0x59, 0x64, 0xd5, 0x5c, 0x46,
-	0x1e, 0xc3, 0xb4, 0xfc, 0x94, 0x6b, 0xe9, 0x94, 0x6b, 0x9d, 0xe9, 0x94, 0x7b, 0x9a, 0x15, 0xfe,
-	0xf7, 0xf9, 0xbf, 0x36, 0x0d, 0xc5, 0x44, 0xac, 0xc4, 0xba, 0x51, 0xf6, 0xbb, 0x71, 0xa3, 0xb9,
-	0x90, 0x1b, 0xfd, 0x24, 0x9d, 0x9d, 0x5e, 0x4c, 0x15, 0xb3, 0xbc, 0x57, 0xae, 0xb7, 0x1c, 0xd2,
-	0xc3, 0x37, 0x54, 0x30, 0xec, 0x5b, 0x78, 0x10, 0xa9, 0x1c, 0x9b, 0xdb, 0xfa, 0x56, 0x88, 0x6f,
-	0xfc, 0xbb, 0x14, 0xac, 0x0c, 0x88, 0x5f, 0xd6, 0x3b, 0x34, 0xec, 0x69, 0xe9, 0x17, 0xf6, 0xb4,
-	0x97, 0xc4, 0x49, 0x82, 0x56, 0xcc, 0x86, 0xac, 0x88, 0x0f, 0xe1, 0x95, 0x61, 0x43, 0x24, 0xd8,
-	0xed, 0x0f, 0xa9, 0x20, 0xf9, 0xa9, 0x10, 0x10, 0xb8, 0xc9, 0xbc, 0xa7, 0xe3, 0xfc, 0xf8, 0x9b,
-	0xcc, 0x7b, 0xec, 0x5b, 0xb8, 0xc9, 0xff, 0xef, 0x97, 0x10, 0xdf, 0x82, 0x2b, 0x11, 0x7b, 0x24,
-	0xd8, 0x6f, 0xa5, 0x5f, 0x6a, 0x31, 0x72, 0x97, 0xe8, 0x94, 0x8e, 0xef, 0xf7, 0xcb, 0x28, 0x35,
-	0xad, 0x58, 0xbc, 0x0a, 0x59, 0x91, 0x77, 0xcb, 0x8f, 0x88, 0x2a, 0x65, 0x4e, 0x57, 0xff, 0xf9,
-	0x74, 0x73, 0xc5, 0x47, 0xcf, 0x9c, 0x73, 0xab, 0x4e, 0xf3, 0x4d, 0x9b, 0xd7, 0xac, 0xb7, 0x5a,
-	0x5c, 0x94, 0x58, 0x72, 0x37, 0x3e, 0x80, 0x8b, 0xf7, 0x08, 0x17, 0xda, 0xd5, 0xbe, 0xf1, 0x0a,
-	0xcc, 0xd6, 0x48, 0xdd, 0xad, 0x71, 0x95, 0x3f, 0xd4, 0x08, 0xef, 0xc2, 0xa5, 0x3e, 0xe5, 0x00,
-	0xf5, 0x70, 0xb5, 0x82, 0x7f, 0x09, 0x4b, 0x27, 0x8e, 0x33, 0x54, 0x1e, 0x8a, 0x40, 0xe1, 0x38,
-	0x9e, 0x26, 0x14, 0xdf, 0xe8, 0x36, 0xcc, 0xda, 0x4d, 0x51, 0x59, 0xe6, 0xa6, 0xa5, 0x1b, 0xae,
-	0x86, 0xaa, 0x07, 0x5d, 0x37, 0x14, 0x68, 0xbd, 0xa5, 0x93, 0xb9, 0x4f, 0x2e, 0x2a, 0x86, 0xa0,
-	0x04, 0x1f, 0x8b, 0x90, 0x5b, 0xea, 0x54, 0xbe, 0x63, 0xb9, 0x41, 0x09, 0x4a, 0xee, 0x0f, 0x61,
-	0xe9, 0x1e, 0xe1, 0x13, 0xc8, 0x5d, 0x86, 0x19, 0x87, 0xb4, 0x68, 0x53, 0x55, 0xa7, 0xfe, 0x00,
-	0x3f, 0x00, 0x14, 0xdc, 0xae, 0x14, 0x7b, 0x7b, 0xa8, 0x24, 0x3e, 0x5d, 0x17, 0x48, 0x12, 0xcd,
-	0xe9, 0x57, 0xcc, 0xfb, 0x12, 0xcd, 0x50, 0xbb, 0x11, 0x83, 0x06, 0x5b, 0x52, 0x6e, 0x4c, 0x3f,
-	0xa7, 0xca, 0x7d, 0xe5, 0x89, 0x7a, 0x88, 0x6f, 0x4b, 0xeb, 0x97, 0xb8, 0xcd, 0x13, 0x0f, 0x19,
-	0x29, 0xc0, 0xf1, 0x1e, 0x2c, 0x0e, 0x36, 0x26, 0xf8, 0xcd, 0x2d, 0xe9, 0x88, 0xc1, 0x72, 0x3b,
-	0xd4, 0x42, 0x1a, 0xe1, 0x16, 0x52, 0x79, 0xe3, 0xd8, 0x2a, 0xfb, 0x04, 0x96, 0x4a, 0x93, 0xe8,
-	0x23, 0x78, 0xf2, 0xe9, 0xf0, 0xc9, 0xef, 0x02, 0x2a, 0x45, 0x35, 0xb5, 0x0c, 0x33, 0xef, 0x50,
-	0x6d, 0x9f, 0x74, 0xd1, 0x1f, 0x88, 0x06, 0xab, 0xa0, 0x10, 0x2a, 0x36, 0xfd, 0x31, 0x7e, 0x00,
-	0x97, 0x4a, 0xdf, 0x44, 0x83, 0x83, 0x06, 0x45, 0x66, 0x4b, 0xdd, 0xa0, 0x20, 0x58, 0x2c, 0x0d,
-	0xe9, 0x15, 0x9f, 0xc0, 0xc5, 0xd2, 0x18, 0x1d, 0x2e, 0x04, 0xda, 0x70, 0xad, 0xb0, 0xe9, 0x80,
-	0xc2, 0x96, 0x24, 0xca, 0xa0, 0x5e, 0x45, 0xad, 0xf0, 0x26, 0x69, 0x10, 0x4e, 0x26, 0x70, 0xab,
-	0x2b, 0xb0, 0x32, 0x44, 0xeb, 0x33, 0x39, 0xfe, 0xdb, 0x2a, 0xcc, 0xc8, 0xb0, 0x85, 0x7e, 0x6b,
-	0x40, 0x46, 0xad, 0xa2, 0xdd, 0x68, 0xfa, 0x88, 0x79, 0xa2, 0x30, 0xf7, 0xc6, 0x91, 0x29, 0xa4,
-	0x37, 0x3f, 0xfa, 0xeb, 0xbf, 0xff, 0x38, 0xbd, 0x8b, 0xb6, 0xf3, 0x91, 0x47, 0x16, 0x65, 0xcd,
-	0xfc, 0xfb, 0x2a, 0xe4, 0x3f, 0x41, 0x7f, 0x36, 0xe0, 0x42, 0xe8, 0xa1, 0x00, 0xdd, 0x1c, 0x21,
-	0x26, 0xee, 0x41, 0xc2, 0x3c, 0x9c, 0x8c, 0x58, 0x21, 0x3b, 0x96, 0xc8, 0x0e, 0xd1, 0x8d, 0x28,
-	0x32, 0xfd, 0x26, 0x11, 0x01, 0xf8, 0x17, 0x03, 0x16, 0x87, 0x7b, 0x7e, 0x64, 0x8d, 0x10, 0x3b,
-	0xe2, 0xa9, 0xc1, 0xcc, 0x4f, 0x4c, 0xaf, 0x90, 0xbe, 0x2e, 0x91, 0xbe, 0x8a, 0x8e, 0xa3, 0x48,
-	0xbb, 0x7a, 0xcf, 0x00, 0x6c, 0xf0, 0x19, 0xe3, 0x09, 0xfa, 0xd8, 0x80, 0x8c, 0x0a, 0x65, 0x23,
-	0x4d, 0x1b, 0x8e, 0x94, 0x23, 0x4d, 0x3b, 0x1c, 0x66, 0x0f, 0x25, 0xac, 0x3d, 0xb4, 0x13, 0x85,
-	0xa5, 0x62, 0x1f, 0x0b, 0xa8, 0xee, 0x33, 0x03, 0x32, 0xaa, 0xcf, 0x1f, 0x09, 0x24, 0xfc, 0xa8,
-	0x30, 0x12, 0xc8, 0xd0, 0x73, 0x01, 0x3e, 0x92, 0x40, 0x6e, 0xa2, 0xeb, 0x51, 0x20, 0xcc, 0x27,
-	0x1d, 0xe0, 0xc8, 0xbf, 0x7f, 0x4e, 0x1e, 0x3f, 0x41, 0xef, 0x41, 0x5a, 0x5c, 0x28, 0x84, 0x47,
-	0xba, 0x4c, 0xff, 0xc2, 0x9a, 0xdb, 0x89, 0x34, 0x0a, 0xc3, 0x75, 0x89, 0x61, 0x1b, 0x5d, 0x8b,
-	0xf3, 0x26, 0x27, 0xa4, 0x89, 0x5f, 0xc1, 0xac, 0xdf, 0x11, 0xa3, 0x9d, 0x11, 0x9c, 0x43, 0x8d,
-	0xb7, 0xb9, 0x3b, 0x86, 0x4a, 0x21, 0xd8, 0x92, 0x08, 0x4c, 0x94, 0xcb, 0x8f, 0x78, 0xed, 0x44,
-	0x3d, 0xc8, 0xa8, 0x8e, 0x1b, 0x6d, 0x45, 0x79, 0x86, 0x9b, 0x71, 0x73, 0x7f, 0x5c, 0x09, 0xaa,
-	0xe5, 0x62, 0x29, 0x77, 0x0d, 0x99, 0x51, 0xb9, 0x84, 0xd7, 0xca, 0x55, 0x21, 0xee, 0x43, 0x98,
-	0x0f, 0x34, 0xc5, 0x13, 0x48, 0x8f, 0x39, 0x73, 0x4c, 0x57, 0x8d, 0xf7, 0xa4, 0xec, 0x2d, 0xb4,
-	0x11, 0x23, 0x5b, 0x91, 0x97, 0x45, 0xaf, 0xfd, 0x01, 0x64, 0x54, 0x5b, 0x35, 0xd2, 0xf7, 0xc2,
-	0x8d, 0xf5, 0x48, 0xdf, 0x1b, 0xea, 0xce, 0x92, 0x4e, 0xef, 0xd7, 0xe6, 0xbc, 0x87, 0x3e, 0x31,
-	0x00, 0x06, 0x05, 0x26, 0x3a, 0x48, 0x62, 0x1d, 0xec, 0x09, 0xcc, 0xeb, 0x13, 0x50, 0x2a, 0x1c,
-	0xbb, 0x12, 0xc7, 0x26, 0x5a, 0x1f, 0x85, 0x43, 0x56, 0xdb, 0xe8, 0x37, 0x06, 0xcc, 0xf5, 0x5b,
-	0x15, 0xb4, 0x9f, 0xc4, 0x3f, 0x68, 0x8e, 0x83, 0xf1, 0x84, 0x0a, 0xc7, 0x8e, 0xc4, 0xb1, 0x81,
-	0xd6, 0x46, 0xe1, 0x90, 0xfe, 0xf0, 0x81, 0x08, 0x4a, 0xb2, 0xda, 0x4d, 0x08, 0x4a, 0xc1, 0x12,
-	0x3b, 0x21, 0x28, 0x85, 0x4a, 0xee, 0x24, 0x7b, 0xe8, 0x52, 0x1c, 0x7d, 0xa8, 0xaa, 0x78, 0x55,
-	0x3b, 0xcb, 0x84, 0xfd, 0xe6, 0x69, 0x9c, 0x57, 0x86, 0xeb, 0x70, 0xf3, 0x5a, 0x02, 0xc5, 0x78,
-	0xf9, 0x2e, 0xe1, 0x32, 0xe9, 0xa3, 0x4f, 0x0d, 0x58, 0x79, 0x48, 0x19, 0x1f, 0x94, 0xcc, 0x1a,
-	0x42, 0x4c, 0xa8, 0x89, 0x54, 0xee, 0xe6, 0x4e, 0x32, 0x51, 0xd8, 0x21, 0x70, 0x8c, 0x43, 0xd8,
-	0x8e, 0x53, 0xd6, 0x7f, 0x0a, 0x68, 0x2c, 0x83, 0x32, 0x3a, 0x01, 0x4b, 0xa4, 0x9a, 0x8f, 0xc3,
-	0x12, 0x53, 0x90, 0x27, 0x60, 0x61, 0x9d, 0x4a, 0x1f, 0xcb, 0xef, 0x0d, 0xd5, 0x1d, 0x0f, 0xca,
-	0xef, 0x04, 0x30, 0x91, 0x12, 0x3f, 0x0e, 0x4c, 0xb4, 0x90, 0x4f, 0xba, 0x29, 0xc2, 0x42, 0x71,
-	0x60, 0x54, 0x42, 0x4e, 0x06, 0x33, 0x94, 0xe5, 0x77, 0x92, 0x89, 0x26, 0x03, 0xa3, 0x92, 0x3a,
-	0xfa, 0xc8, 0x50, 0x1d, 0xa6, 0xae, 0xdb, 0x35, 0x94, 0x78, 0x8f, 0x0c, 0x56, 0xb4, 0x26, 0x4e,
-	0x22, 0x51, 0x30, 0xb6, 0x25, 0x8c, 0x75, 0x74, 0x35, 0x1e, 0x06, 0x13, 0xc4, 0xc1, 0x6b, 0x23,
-	0x52, 0x5f, 0xf2, 0xb5, 0x09, 0x26, 0xd0, 0x6b, 0x09, 0x14, 0x93, 0x5d, 0x1b, 0x91, 0x42, 0x07,
-	0xae, 0x3a, 0x89, 0x41, 0x4a, 0x93, 0x18, 0xa4, 0x34, 0xd2, 0x20, 0xb1, 0xae, 0x1a, 0x30, 0xc8,
-	0xaf, 0x0d, 0xb8, 0xac, 0xb0, 0x8c, 0xb3, 0x47, 0x69, 0xbc, 0x3d, 0x4a, 0x23, 0xec, 0x81, 0xaf,
-	0xc6, 0xa3, 0xf0, 0xed, 0xf1, 0x04, 0x90, 0x82, 0x30, 0xc6, 0x1c, 0xa5, 0xb1, 0xe6, 0x28, 0xc5,
-	0x9b, 0x03, 0x9b, 0xf1, 0xf2, 0xa5, 0x39, 0xfe, 0x64, 0x40, 0x4e, 0xc8, 0x0f, 0x35, 0x17, 0x1a,
-	0x45, 0x4c, 0xb8, 0x8e, 0x6b, 0x58, 0xe2, 0xaa, 0x8c, 0xd8, 0x66, 0x05, 0x1f, 0x48, 0x44, 0x18,
-	0x6f, 0x45, 0x11, 0x39, 0x72, 0x83, 0x36, 0xcd, 0xe9, 0x1b, 0x5f, 0x3e, 0xdb, 0x30, 0xbe, 0x7a,
-	0xb6, 0x61, 0x7c, 0xfd, 0x6c, 0xc3, 0xf8, 0xfc, 0xf9, 0xc6, 0xd4, 0x57, 0xcf, 0x37, 0xa6, 0xfe,
-	0xf1, 0x7c, 0x63, 0xea, 0xe7, 0x7b, 0x81, 0x87, 0x24, 0xd2, 0x6d, 0x52, 0x16, 0xe0, 0xd5, 0x93,
-	0xdc, 0xe4, 0x63, 0x52, 0x65, 0x56, 0xbe, 0x5b, 0x7d, 0xff, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x1e, 0x07, 0xf1, 0x4b, 0xea, 0x1e, 0x00, 0x00,
+	0x11, 0xd7, 0x89, 0x94, 0x48, 0x8d, 0x64, 0x4b, 0x5a, 0x51, 0x31, 0x75, 0x96, 0x44, 0xf9, 0x24,
+	0x51, 0xb2, 0xad, 0x90, 0x95, 0x1a, 0x24, 0x68, 0x5e, 0x1a, 0x93, 0xfe, 0x53, 0x37, 0x76, 0xe0,
+	0x92, 0x6a, 0x50, 0xb4, 0x28, 0x88, 0x23, 0x6f, 0x7d, 0x24, 0x44, 0x72, 0x99, 0xbb, 0x25, 0x4b,
+	0xc7, 0x71, 0x80, 0x06, 0x6d, 0x9a, 0x34, 0x40, 0x10, 0xa0, 0xe8, 0x6b, 0x91, 0x6f, 0xd0, 0xaf,
+	0x91, 0xc7, 0x00, 0x6d, 0x81, 0xa2, 0x0f, 0x6e, 0x60, 0xf7, 0xa1, 0x9f, 0xa1, 0x4f, 0xc5, 0xee,
+	0xed, 0xf2, 0xee, 0x78, 0xc7, 0x3b, 0xca, 0x70, 0x01, 0x03, 0x7d, 0xe2, 0xed, 0xee, 0xec, 0xcc,
+	0x6f, 0x76, 0x66, 0x76, 0x76, 0x86, 0xb0, 0x89, 0x69, 0x13, 0x5b, 0x9d, 0x56, 0x97, 0x16, 0xf1,
+	0xa0, 0x53, 0x1c, 0x1c, 0x17, 0x3f, 0xe8, 0x63, 0xeb, 0x51, 0xa1, 0x67, 0x11, 0x4a, 0xd0, 0xca,
+	0x68, 0xb5, 0x80, 0x07, 0x9d, 0xc2, 0xe0, 0x58, 0xbd, 0xd6, 0x20, 0x76, 0x87, 0xd8, 0xc5, 0xba,
+	0x6e, 0x63, 0x87, 0xb4, 0x38, 0x38, 0xae, 0x63, 0xaa, 0x1f, 0x17, 0x7b, 0xba, 0xd9, 0xea, 0xea,
+	0xb4, 0x45, 0xba, 0xce, 0x6e, 0x75, 0xdb, 0x4b, 0x2b, 0xa9, 0x1a, 0xa4, 0x25, 0xd7, 0x37, 0x02,
+	0xb2, 0xe9, 0x50, 0x2c, 0xa9, 0x81, 0xa5, 0x36, 0x31, 0xc5, 0xda, 0x56, 0x60, 0xad, 0xa7, 0x5b,
+	0x7a, 0xc7, 0x16, 0xcb, 0xbb, 0x41, 0xae, 0x96, 0xde, 0xc0, 0xb5, 0x06, 0xe9, 0x3e, 0x6c, 0x49,
+	0x1e, 0x19, 0x93, 0x98, 0x84, 0x7f, 0x16, 0xd9, 0x97, 0x98, 0xdd, 0x34, 0x09, 0x31, 0xdb, 0xb8,
+	0xa8, 0xf7, 0x5a, 0x45, 0xbd, 0xdb, 0x25, 0x94, 0x6b, 0x23, 0x19, 0xe7, 0xc4, 0x2a, 0x1f, 0xd5,
+	0xfb, 0x0f, 0x8b, 0xb4, 0xd5, 0xc1, 0x36, 0xd5, 0x3b, 0x3d, 0x87, 0x40, 0xfb, 0x01, 0xac, 0xfd,
+	0x84, 0x9d, 0xc8, 0x8d, 0x46, 0x83, 0xf4, 0xbb, 0xb4, 0x82, 0x3f, 0xe8, 0x63, 0x9b, 0xa2, 0x2c,
+	0xa4, 0x74, 0xc3, 0xb0, 0xb0, 0x6d, 0x67, 0x95, 0x1d, 0xe5, 0x70, 0xa1, 0x22, 0x87, 0x6f, 0xa7,
+	0x3f, 0xfb, 0x3a, 0x37, 0xf3, 0xef, 0xaf, 0x73, 0x33, 0x5a, 0x03, 0x32, 0xfe, 0xad, 0x76, 0x8f,
+	0x74, 0x6d, 0xcc, 0xf6, 0xd6, 0xf5, 0xb6, 0xde, 0x6d, 0x60, 0xb9, 0x57, 0x0c, 0xd1, 0x65, 0x58,
+	0x68, 0x10, 0x03, 0xd7, 0x9a, 0xba, 0xdd, 0xcc, 0xce, 0xf2, 0xb5, 0x34, 0x9b, 0xf8, 0x91, 0x6e,
+	0x37, 0x51, 0x06, 0xe6, 0xba, 0x84, 0x6d, 0x4a, 0xec, 0x28, 0x87, 0xc9, 0x8a, 0x33, 0xd0, 0x7e,
+	0x08, 0x1b, 0x5c, 0x48, 0x99, 0x9b, 0xe5, 0x05, 0x50, 0x7e, 0xaa, 0x80, 0x1a, 0xc6, 0x41, 0x80,
+	0xdd, 0x87, 0x8b, 0x8e, 0xc5, 0x6b, 0x7e, 0x4e, 0x17, 0x9c, 0xd9, 0x1b, 0xce, 0x24, 0x52, 0x21,
+	0x6d, 0x33, 0xa1, 0x0c, 0xdf, 0x2c, 0xc7, 0x37, 0x1a, 0x33, 0x16, 0xba, 0xc3, 0xb5, 0xd6, 0xed,
+	0x77, 0xea, 0xd8, 0x12, 0x1a, 0x5c, 0x10, 0xb3, 0xef, 0xf1, 0x49, 0xed, 0x5d, 0xd8, 0xe4, 0x38,
+	0xde, 0xd7, 0xdb, 0x2d, 0x43, 0xa7, 0xc4, 0x1a, 0x53, 0xe6, 0x0a, 0x2c, 0x35, 0x48, 0x77, 0x1c,
+	0xc7, 0x22, 0x9b, 0xbb, 0x11, 0xd0, 0xea, 0x0b, 0x05, 0xb6, 0x26, 0x70, 0x13, 0x8a, 0x1d, 0xc0,
+	0xb2, 0x44, 0xe5, 0xe7, 0x28, 0xc1, 0xbe, 0x44, 0xd5, 0xa4, 0x13, 0x95, 0x1c, 0x3b, 0x9f, 0xc7,
+	0x3c, 0xdf, 0x83, 0x8c, 0x7f, 0x6b, 0x9c, 0x13, 0x69, 0xef, 0x0a, 0x61, 0x55, 0x4a, 0x2c, 0xdd,
+	0x8c, 0x17, 0x86, 0x56, 0x20, 0x71, 0x86, 0x1f, 0x09, 0x7f, 0x63, 0x9f, 0x1e, 0xf1, 0x47, 0x90,
+	0xf1, 0x33, 0x13, 0xe2, 0x33, 0x30, 0x37, 0xd0, 0xdb, 0x7d, 0x29, 0xdc, 0x19, 0x68, 0x6f, 0xc2,
+	0x8a, 0x70, 0x25, 0xe3, 0x5c, 0x4a, 0x1e, 0xc0, 0xaa, 0x67, 0x9f, 0x10, 0x81, 0x20, 0xc9, 0x7c,
+	0x9f, 0xef, 0x5a, 0xaa, 0xf0, 0x6f, 0xed, 0x43, 0x40, 0x9c, 0xf0, 0x74, 0x78, 0x8f, 0x98, 0xb6,
+	0x14, 0x81, 0x20, 0xc9, 0x23, 0xc6, 0xe1, 0xcf, 0xbf, 0xd1, 0x6d, 0x00, 0xf7, 0xee, 0xe2, 0xba,
+	0x2d, 0x9e, 0xe4, 0x0b, 0x8e, 0xd3, 0x16, 0xd8, 0xe5, 0x55, 0x70, 0xee, 0x44, 0x71, 0x85, 0x15,
+	0x1e, 0xb8, 0x47, 0x55, 0xf1, 0xec, 0xf4, 0x80, 0xfc, 0x5c, 0x81, 0x35, 0x9f, 0x70, 0x81, 0xf3,
+	0x2a, 0x24, 0xdb, 0xc4, 0x64, 0xda, 0x25, 0x0e, 0x17, 0x4f, 0xd6, 0x0b, 0xe3, 0xd7, 0x6b, 0xe1,
+	0x1e, 0x31, 0x2b, 0x9c, 0x04, 0xdd, 0x09, 0x01, 0x75, 0x10, 0x0b, 0xca, 0x91, 0xe3, 0x45, 0xa5,
+	0x65, 0xc4, 0x39, 0x3c, 0xe0, 0x97, 0xa4, 0xc0, 0xad, 0xdd, 0x87, 0x35, 0xdf, 0xac, 0x00, 0xf8,
+	0x26, 0xcc, 0x3b, 0x97, 0x29, 0x3f, 0xa0, 0xc5, 0x93, 0x6c, 0x10, 0xa2, 0xb3, 0xa3, 0x94, 0xfc,
+	0xe6, 0x69, 0x6e, 0xa6, 0x22, 0xa8, 0xb5, 0xbf, 0x29, 0x70, 0xf1, 0x16, 0x6d, 0x96, 0xf5, 0x76,
+	0xdb, 0x73, 0xd2, 0xba, 0x65, 0xda, 0xd2, 0x26, 0xec, 0x1b, 0x5d, 0x82, 0x94, 0xa9, 0xdb, 0xb5,
+	0x86, 0xde, 0x13, 0xe1, 0x31, 0x6f, 0xea, 0x76, 0x59, 0xef, 0xa1, 0x5f, 0xc2, 0x4a, 0xcf, 0x22,
+	0x3d, 0x62, 0x63, 0x6b, 0x14, 0x62, 0x2c, 0x3c, 0x96, 0x4a, 0x27, 0xff, 0x79, 0x9a, 0x2b, 0x98,
+	0x2d, 0xda, 0xec, 0xd7, 0x0b, 0x0d, 0xd2, 0x29, 0x8a, 0x9c, 0xe2, 0xfc, 0xbc, 0x6e, 0x1b, 0x67,
+	0x45, 0xfa, 0xa8, 0x87, 0xed, 0x42, 0xd9, 0x8d, 0xed, 0xca, 0xb2, 0xe4, 0x25, 0xe3, 0x72, 0x03,
+	0xd2, 0x8d, 0xa6, 0xde, 0xea, 0xd6, 0x5a, 0x46, 0x36, 0xb9, 0xa3, 0x1c, 0x26, 0x2a, 0x29, 0x3e,
+	0xbe, 0x6b, 0xa0, 0x4d, 0x58, 0x20, 0x03, 0x6c, 0x59, 0x2d, 0x03, 0xdb, 0xd9, 0x39, 0x8e, 0xd5,
+	0x9d, 0xd0, 0x4e, 0x61, 0xed, 0x96, 0x4d, 0x5b, 0x1d, 0x9d, 0xe2, 0x3b, 0xba, 0x7b, 0x4c, 0x2b,
+	0x90, 0x30, 0x75, 0x47, 0xb5, 0x64, 0x85, 0x7d, 0xb2, 0x19, 0x0b, 0x53, 0xae, 0xd5, 0x52, 0x85,
+	0x7d, 0x32, 0x99, 0x83, 0x4e, 0x0d, 0x5b, 0x16, 0x71, 0x22, 0x7d, 0xa1, 0x92, 0x1a, 0x74, 0x6e,
+	0xb1, 0xa1, 0xf6, 0x5d, 0x42, 0xba, 0x07, 0xcb, 0x4c, 0xa7, 0x43, 0x79, 0x64, 0xc7, 0x90, 0xe8,
+	0xd8, 0xa6, 0x38, 0xfa, 0x5c, 0xf0, 0xe8, 0xef, 0xdb, 0xe6, 0x2d, 0x36, 0x87, 0xfb, 0x9d, 0xd3,
+	0x61, 0x85, 0xd1, 0xa2, 0x77, 0x60, 0xc9, 0x9b, 0xde, 0xb8, 0xa4, 0xc5, 0x93, 0xad, 0xe0, 0x5e,
+	0x2e, 0xaa, 0xcc, 0x89, 0x2a, 0x8b, 0xd4, 0x1d, 0xa0, 0x32, 0x2c, 0xf5, 0x2c, 0x6c, 0xe0, 0x06,
+	0xb6, 0x6d, 0x62, 0xd9, 0xd9, 0xe4, 0x4e, 0x62, 0x1a, 0xe9, 0xbe, 0x4d, 0xec, 0xc2, 0xad, 0xb7,
+	0x49, 0xe3, 0x4c, 0x5e, 0x6d, 0x73, 0xfc, 0x90, 0x17, 0xf9, 0x9c, 0x73, 0xb1, 0xa1, 0x2d, 0x00,
+	0x87, 0x84, 0xc7, 0xdf, 0x3c, 0x3f, 0x91, 0x05, 0x3e, 0xc3, 0x53, 0x56, 0x59, 0x2e, 0xb3, 0xac,
+	0x9a, 0x4d, 0x71, 0x35, 0xd4, 0x82, 0x93, 0x72, 0x0b, 0x32, 0xe5, 0x16, 0x4e, 0x65, 0xca, 0x2d,
+	0xa5, 0x99, 0xff, 0x7d, 0xf5, 0xcf, 0x9c, 0x22, 0x98, 0xb0, 0x95, 0x50, 0x37, 0x4a, 0xff, 0x6f,
+	0xdc, 0x68, 0xc1, 0xe7, 0x46, 0x3f, 0x4e, 0xa6, 0x67, 0x57, 0x12, 0x95, 0x34, 0x1d, 0xd6, 0x5a,
+	0x5d, 0x03, 0x0f, 0xb5, 0x6b, 0xe2, 0x32, 0x1c, 0x59, 0xd8, 0xbd, 0xa9, 0x0c, 0x9d, 0xea, 0x32,
+	0x2a, 0xd8, 0xb7, 0xf6, 0xbb, 0x04, 0xac, 0xbb, 0xc4, 0xaf, 0x6a, 0x0c, 0x8d, 0x7b, 0x5a, 0xf2,
+	0xdc, 0x9e, 0xf6, 0x8a, 0x38, 0x89, 0xd7, 0x8a, 0x69, 0x9f, 0x15, 0xb5, 0x23, 0x78, 0x6d, 0xdc,
+	0x10, 0x11, 0x76, 0xfb, 0x32, 0xe1, 0x25, 0x2f, 0x31, 0x01, 0x9e, 0x48, 0xa6, 0x43, 0x79, 0xcf,
+	0xc7, 0x47, 0x32, 0x1d, 0xda, 0x2f, 0x21, 0x92, 0xff, 0xdf, 0x83, 0x50, 0x7b, 0x1d, 0x2e, 0x05,
+	0xec, 0x11, 0x61, 0xbf, 0xf5, 0xd1, 0x53, 0xcb, 0xc6, 0xb7, 0xb1, 0x4c, 0xe9, 0xda, 0x3d, 0xc8,
+	0xf8, 0xa7, 0x05, 0x8b, 0x37, 0x20, 0xcd, 0xf2, 0x6e, 0xed, 0x21, 0x16, 0x4f, 0x99, 0xd2, 0xc6,
+	0x3f, 0x9e, 0xe6, 0xd6, 0x1d, 0xf4, 0xb6, 0x71, 0x56, 0x68, 0x91, 0x62, 0x47, 0xa7, 0xcd, 0xc2,
+	0xdd, 0x2e, 0x65, 0x4f, 0x2c, 0xbe, 0x5b, 0x7b, 0x1f, 0x2e, 0xde, 0xc1, 0x94, 0x9d, 0xae, 0xf4,
+	0x8d, 0xd7, 0x60, 0xbe, 0x89, 0x5b, 0x66, 0x93, 0x8a, 0xfc, 0x21, 0x46, 0xe8, 0x08, 0xa0, 0x41,
+	0xba, 0x14, 0x0f, 0x29, 0x53, 0x8d, 0xc7, 0x76, 0xe9, 0xc2, 0xb3, 0xa7, 0xb9, 0x85, 0xb2, 0x33,
+	0x7b, 0xf7, 0x66, 0x65, 0x41, 0x10, 0xdc, 0x35, 0xb4, 0x7d, 0x58, 0x1e, 0xf1, 0x75, 0x75, 0x1c,
+	0x7f, 0xdb, 0x68, 0x5f, 0x2a, 0xb0, 0x7a, 0xc3, 0x30, 0xc6, 0x5e, 0x93, 0xec, 0x5e, 0x31, 0x0c,
+	0x4b, 0x52, 0xb2, 0x6f, 0xf4, 0x16, 0xcc, 0xeb, 0x1d, 0xf6, 0x10, 0xcd, 0xce, 0x72, 0xaf, 0xdd,
+	0xf0, 0x3d, 0x36, 0xe4, 0x33, 0xa3, 0x4c, 0x5a, 0x5d, 0x99, 0xfb, 0x1d, 0xf2, 0x31, 0xdc, 0x89,
+	0x18, 0xdc, 0x19, 0x40, 0x5e, 0x3c, 0x0e, 0x74, 0x0e, 0xb3, 0xda, 0xaf, 0xbf, 0x52, 0x30, 0xbd,
+	0x78, 0x04, 0xcc, 0x33, 0x58, 0xbd, 0x83, 0xe9, 0x14, 0x28, 0x33, 0x30, 0x67, 0xe0, 0x2e, 0xe9,
+	0x88, 0x97, 0xb2, 0x33, 0x38, 0x27, 0x84, 0xfb, 0x80, 0xbc, 0xc2, 0x84, 0x91, 0xdf, 0x1a, 0x7b,
+	0xcc, 0x97, 0xb6, 0x98, 0x96, 0x91, 0x8e, 0xe8, 0xbc, 0xf5, 0x7f, 0xca, 0xb1, 0x8f, 0x15, 0x4a,
+	0x61, 0xd8, 0xcf, 0xe7, 0x87, 0x05, 0x40, 0x5e, 0xb6, 0x6e, 0xc9, 0x21, 0xca, 0x1a, 0x11, 0x71,
+	0x72, 0xa8, 0x61, 0xee, 0xb7, 0x55, 0xaa, 0xd3, 0xc8, 0x03, 0x0c, 0x14, 0x1a, 0xe7, 0x3c, 0xbc,
+	0x3c, 0xac, 0xb8, 0x62, 0x22, 0xe2, 0xe3, 0x17, 0x3c, 0x3c, 0xbd, 0x45, 0x88, 0xaf, 0xb0, 0x56,
+	0xc6, 0x0a, 0xeb, 0x17, 0x89, 0xd1, 0xd8, 0x4a, 0x85, 0xc0, 0x6a, 0x75, 0x2a, 0xcb, 0x78, 0x4e,
+	0x75, 0xd6, 0x77, 0xaa, 0xe7, 0x3c, 0x9c, 0xdb, 0x80, 0xaa, 0x41, 0x9b, 0x65, 0x60, 0xee, 0x3d,
+	0x22, 0xfd, 0x2a, 0x59, 0x71, 0x06, 0xac, 0xa4, 0x2d, 0x0b, 0xed, 0x85, 0xd0, 0xd1, 0x58, 0x7b,
+	0x0c, 0xcb, 0xd5, 0x17, 0xb2, 0xe5, 0xa8, 0x24, 0xe4, 0xef, 0x13, 0x51, 0x12, 0x8e, 0x29, 0x91,
+	0x8c, 0x51, 0x02, 0xc1, 0x4a, 0x75, 0xcc, 0xc2, 0x1a, 0x81, 0x8b, 0xd5, 0x18, 0x6b, 0x2e, 0x79,
+	0xac, 0x29, 0x8d, 0x31, 0xeb, 0x1a, 0xe3, 0x9c, 0x27, 0xb9, 0x0a, 0xcb, 0x23, 0x81, 0x02, 0xc3,
+	0xcf, 0x20, 0x73, 0x13, 0xb7, 0x31, 0xc5, 0x2f, 0x3d, 0xd4, 0x2e, 0xc1, 0xfa, 0x18, 0x67, 0x47,
+	0xe4, 0xc9, 0x5f, 0x37, 0x60, 0x8e, 0xa7, 0x2c, 0xf4, 0x5b, 0x05, 0x52, 0x62, 0x15, 0xed, 0x07,
+	0x9f, 0x0e, 0x21, 0xed, 0x29, 0x35, 0x1f, 0x47, 0x26, 0xf4, 0xba, 0xfe, 0xc9, 0x5f, 0xfe, 0xf5,
+	0x87, 0xd9, 0x7d, 0xb4, 0x5b, 0x0c, 0x34, 0xd8, 0x84, 0x17, 0x16, 0x1f, 0x8b, 0x74, 0xff, 0x04,
+	0xfd, 0x49, 0x81, 0x0b, 0xbe, 0x26, 0x11, 0xba, 0x3e, 0x41, 0x4c, 0x58, 0x33, 0x4a, 0x3d, 0x9a,
+	0x8e, 0x58, 0x20, 0x3b, 0xe1, 0xc8, 0x8e, 0xd0, 0xb5, 0x20, 0x32, 0xd9, 0x8f, 0x0a, 0x00, 0xfc,
+	0xb3, 0x02, 0x2b, 0xe3, 0xfd, 0x1e, 0x54, 0x98, 0x20, 0x76, 0x42, 0x9b, 0x49, 0x2d, 0x4e, 0x4d,
+	0x2f, 0x90, 0xbe, 0xcd, 0x91, 0xbe, 0x81, 0x4e, 0x82, 0x48, 0x07, 0x72, 0x8f, 0x0b, 0xd6, 0xdb,
+	0xc2, 0x7a, 0x82, 0x3e, 0x55, 0x20, 0x25, 0x92, 0xc1, 0x44, 0xd3, 0xfa, 0x33, 0x93, 0x9a, 0x8f,
+	0x23, 0x13, 0xb0, 0x8e, 0x38, 0xac, 0x3c, 0xda, 0x0b, 0xc2, 0x12, 0xd9, 0xc3, 0xf6, 0x1c, 0xdd,
+	0x17, 0x0a, 0xa4, 0x44, 0x8f, 0x67, 0x22, 0x10, 0x7f, 0x43, 0x49, 0xcd, 0xc7, 0x91, 0x09, 0x20,
+	0xc7, 0x1c, 0xc8, 0x75, 0x74, 0x35, 0x08, 0xc4, 0x76, 0x48, 0x5d, 0x1c, 0xc5, 0xc7, 0x67, 0xf8,
+	0xd1, 0x13, 0xf4, 0x21, 0x24, 0x59, 0xf8, 0x21, 0x6d, 0xa2, 0xcb, 0x8c, 0x2e, 0x03, 0x75, 0x37,
+	0x92, 0x46, 0x60, 0xb8, 0xca, 0x31, 0xec, 0xa2, 0x2b, 0x61, 0xde, 0x64, 0xf8, 0x4e, 0xe2, 0x57,
+	0x30, 0xef, 0x74, 0x43, 0xd0, 0xde, 0x04, 0xce, 0xbe, 0xa6, 0x8b, 0xba, 0x1f, 0x43, 0x25, 0x10,
+	0xec, 0x70, 0x04, 0x2a, 0xca, 0x16, 0x27, 0x74, 0xba, 0xd1, 0x10, 0x52, 0xa2, 0xdb, 0x82, 0x76,
+	0x82, 0x3c, 0xfd, 0x8d, 0x18, 0xf5, 0x20, 0xae, 0xfc, 0x90, 0x72, 0x35, 0x2e, 0x77, 0x13, 0xa9,
+	0x41, 0xb9, 0x98, 0x36, 0x6b, 0x0d, 0x26, 0xee, 0x63, 0x58, 0xf4, 0x34, 0x44, 0xa6, 0x90, 0x1e,
+	0xa2, 0x73, 0x48, 0x47, 0x45, 0xcb, 0x73, 0xd9, 0x3b, 0x68, 0x3b, 0x44, 0xb6, 0x20, 0xaf, 0xb1,
+	0x3e, 0xcb, 0x47, 0x90, 0x12, 0x25, 0xf5, 0x44, 0xdf, 0xf3, 0x37, 0x55, 0xd4, 0x7c, 0x1c, 0x59,
+	0xbc, 0xf6, 0x4e, 0x5d, 0x46, 0x87, 0xe8, 0x33, 0x05, 0xc0, 0x2d, 0x2e, 0xd0, 0x61, 0x14, 0x6b,
+	0x6f, 0x3d, 0xa8, 0x5e, 0x9d, 0x82, 0x52, 0xe0, 0xd8, 0xe7, 0x38, 0x72, 0x68, 0x6b, 0x12, 0x0e,
+	0x5e, 0x69, 0xa1, 0xdf, 0x28, 0xb0, 0x30, 0x2a, 0x53, 0xd1, 0x41, 0x14, 0x7f, 0xaf, 0x39, 0x0e,
+	0xe3, 0x09, 0x05, 0x8e, 0x3d, 0x8e, 0x63, 0x1b, 0x6d, 0x4e, 0xc2, 0xc1, 0xfd, 0xe1, 0x23, 0x76,
+	0x29, 0xf1, 0x4a, 0x27, 0xe2, 0x52, 0xf2, 0x96, 0x57, 0x6a, 0x3e, 0x8e, 0x2c, 0xde, 0x1e, 0xb2,
+	0x0c, 0x43, 0x1f, 0x8b, 0x0a, 0x4e, 0x54, 0x42, 0xfc, 0x31, 0x70, 0xb3, 0x14, 0xe6, 0x95, 0xfe,
+	0x1a, 0x4c, 0xbd, 0x12, 0x41, 0x11, 0x2f, 0xdf, 0xc4, 0x94, 0x3f, 0x28, 0xd0, 0xe7, 0x0a, 0xac,
+	0x3f, 0x20, 0x36, 0x75, 0x2b, 0x1a, 0x09, 0x21, 0xe4, 0xaa, 0x09, 0x94, 0x61, 0xea, 0x5e, 0x34,
+	0x91, 0xdf, 0x21, 0xb4, 0x10, 0x87, 0xd0, 0x0d, 0xa3, 0x26, 0xff, 0x10, 0x92, 0x58, 0xdc, 0xb2,
+	0x25, 0x02, 0x4b, 0xa0, 0xd6, 0x52, 0xf7, 0xa2, 0x89, 0xe2, 0xb1, 0xd8, 0xfd, 0xfa, 0x08, 0xcb,
+	0xef, 0x15, 0xd1, 0x19, 0x71, 0x0b, 0x98, 0x08, 0x30, 0x81, 0x92, 0x4a, 0xdd, 0x8b, 0x26, 0x8a,
+	0x8f, 0x14, 0x66, 0xa1, 0x30, 0x30, 0x22, 0x21, 0x47, 0x83, 0x19, 0xcb, 0xf2, 0x7b, 0xd1, 0x44,
+	0xd3, 0x81, 0x91, 0x0f, 0xf5, 0x4f, 0x14, 0xd1, 0x5d, 0x90, 0xd5, 0x89, 0x84, 0x12, 0xee, 0x91,
+	0xde, 0xb7, 0xb5, 0xaa, 0x45, 0x91, 0x08, 0x18, 0xbb, 0x1c, 0xc6, 0x16, 0xba, 0x1c, 0x0e, 0xc3,
+	0x66, 0xc4, 0xde, 0xb0, 0x61, 0xa9, 0x2f, 0x3a, 0x6c, 0xbc, 0x09, 0xf4, 0x4a, 0x04, 0xc5, 0x74,
+	0x61, 0xc3, 0xdf, 0xd8, 0x23, 0x57, 0x9d, 0xc6, 0x20, 0xd5, 0x69, 0x0c, 0x52, 0x9d, 0x68, 0x90,
+	0x50, 0x57, 0xf5, 0x18, 0xe4, 0xd7, 0x0a, 0xac, 0x09, 0x2c, 0x71, 0xf6, 0xa8, 0xc6, 0xdb, 0xa3,
+	0x3a, 0xc1, 0x1e, 0xda, 0xe5, 0x70, 0x14, 0x8e, 0x3d, 0x9e, 0x00, 0x12, 0x10, 0x62, 0xcc, 0x51,
+	0x8d, 0x35, 0x47, 0x35, 0xdc, 0x1c, 0x9a, 0x1a, 0x2e, 0x9f, 0x9b, 0xe3, 0x8f, 0x0a, 0x64, 0x99,
+	0x7c, 0x5f, 0x71, 0x21, 0x51, 0x84, 0x5c, 0xd7, 0x61, 0xe5, 0x8d, 0x7a, 0x10, 0x4b, 0x27, 0x10,
+	0x1d, 0x72, 0x44, 0x9a, 0xb6, 0x13, 0x44, 0x64, 0xf0, 0x0d, 0xd2, 0x34, 0xa5, 0x77, 0xbe, 0x79,
+	0xb6, 0xad, 0x7c, 0xfb, 0x6c, 0x5b, 0xf9, 0xee, 0xd9, 0xb6, 0xf2, 0xd5, 0xf3, 0xed, 0x99, 0x6f,
+	0x9f, 0x6f, 0xcf, 0xfc, 0xfd, 0xf9, 0xf6, 0xcc, 0xcf, 0xf3, 0x9e, 0x26, 0x22, 0x1e, 0xb0, 0x1e,
+	0xa2, 0xcb, 0x6b, 0xc8, 0xb9, 0xf1, 0x46, 0x62, 0x7d, 0x9e, 0xf7, 0x2c, 0xbf, 0xff, 0xdf, 0x01,
+	0x00, 0x0d, 0xf6, 0x00, 0x54, 0xe6, 0x20, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -4683,6 +4785,11 @@ func (m *GetHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ContextID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ContextID))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.Height != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
 		i--
@@ -4741,6 +4848,11 @@ func (m *AddBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ContextID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ContextID))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Amount) > 0 {
 		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -4808,6 +4920,11 @@ func (m *SubBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ContextID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ContextID))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Amount) > 0 {
 		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -4875,6 +4992,11 @@ func (m *GetBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ContextID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ContextID))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Denom) > 0 {
 		i -= len(m.Denom)
 		copy(dAtA[i:], m.Denom)
@@ -4945,6 +5067,11 @@ func (m *GetAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ContextID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ContextID))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.Addr) > 0 {
 		i -= len(m.Addr)
 		copy(dAtA[i:], m.Addr)
@@ -5005,6 +5132,11 @@ func (m *GetStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ContextID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ContextID))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Key) > 0 {
 		i -= len(m.Key)
 		copy(dAtA[i:], m.Key)
@@ -5072,6 +5204,11 @@ func (m *GetCodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ContextID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ContextID))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.CodeHash) > 0 {
 		i -= len(m.CodeHash)
 		copy(dAtA[i:], m.CodeHash)
@@ -5132,6 +5269,11 @@ func (m *SetAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ContextID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ContextID))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Account) > 0 {
 		i -= len(m.Account)
 		copy(dAtA[i:], m.Account)
@@ -5204,6 +5346,11 @@ func (m *SetStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ContextID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ContextID))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Value) > 0 {
 		i -= len(m.Value)
 		copy(dAtA[i:], m.Value)
@@ -5271,6 +5418,11 @@ func (m *SetCodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ContextID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ContextID))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Code) > 0 {
 		i -= len(m.Code)
 		copy(dAtA[i:], m.Code)
@@ -5331,6 +5483,11 @@ func (m *DeleteAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ContextID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ContextID))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.Addr) > 0 {
 		i -= len(m.Addr)
 		copy(dAtA[i:], m.Addr)
@@ -5837,6 +5994,9 @@ func (m *GetHashRequest) Size() (n int) {
 	if m.Height != 0 {
 		n += 1 + sovQuery(uint64(m.Height))
 	}
+	if m.ContextID != 0 {
+		n += 1 + sovQuery(uint64(m.ContextID))
+	}
 	return n
 }
 
@@ -5869,6 +6029,9 @@ func (m *AddBalanceRequest) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if m.ContextID != 0 {
+		n += 1 + sovQuery(uint64(m.ContextID))
+	}
 	return n
 }
 
@@ -5897,6 +6060,9 @@ func (m *SubBalanceRequest) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if m.ContextID != 0 {
+		n += 1 + sovQuery(uint64(m.ContextID))
+	}
 	return n
 }
 
@@ -5923,6 +6089,9 @@ func (m *GetBalanceRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.ContextID != 0 {
+		n += 1 + sovQuery(uint64(m.ContextID))
+	}
 	return n
 }
 
@@ -5947,6 +6116,9 @@ func (m *GetAccountRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.ContextID != 0 {
+		n += 1 + sovQuery(uint64(m.ContextID))
+	}
 	return n
 }
 
@@ -5977,6 +6149,9 @@ func (m *GetStateRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.ContextID != 0 {
+		n += 1 + sovQuery(uint64(m.ContextID))
+	}
 	return n
 }
 
@@ -6003,6 +6178,9 @@ func (m *GetCodeRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.ContextID != 0 {
+		n += 1 + sovQuery(uint64(m.ContextID))
+	}
 	return n
 }
 
@@ -6033,6 +6211,9 @@ func (m *SetAccountRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.ContextID != 0 {
+		n += 1 + sovQuery(uint64(m.ContextID))
+	}
 	return n
 }
 
@@ -6070,6 +6251,9 @@ func (m *SetStateRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.ContextID != 0 {
+		n += 1 + sovQuery(uint64(m.ContextID))
+	}
 	return n
 }
 
@@ -6096,6 +6280,9 @@ func (m *SetCodeRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.ContextID != 0 {
+		n += 1 + sovQuery(uint64(m.ContextID))
+	}
 	return n
 }
 
@@ -6118,6 +6305,9 @@ func (m *DeleteAccountRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.ContextID != 0 {
+		n += 1 + sovQuery(uint64(m.ContextID))
+	}
 	return n
 }
 
@@ -9239,6 +9429,25 @@ func (m *GetHashRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContextID", wireType)
+			}
+			m.ContextID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContextID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9437,6 +9646,25 @@ func (m *AddBalanceRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContextID", wireType)
+			}
+			m.ContextID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContextID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9603,6 +9831,25 @@ func (m *SubBalanceRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContextID", wireType)
+			}
+			m.ContextID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContextID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9767,6 +10014,25 @@ func (m *GetBalanceRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContextID", wireType)
+			}
+			m.ContextID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContextID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9933,6 +10199,25 @@ func (m *GetAccountRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Addr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContextID", wireType)
+			}
+			m.ContextID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContextID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10131,6 +10416,25 @@ func (m *GetStateRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContextID", wireType)
+			}
+			m.ContextID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContextID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10295,6 +10599,25 @@ func (m *GetCodeRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.CodeHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContextID", wireType)
+			}
+			m.ContextID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContextID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10495,6 +10818,25 @@ func (m *SetAccountRequest) Unmarshal(dAtA []byte) error {
 				m.Account = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContextID", wireType)
+			}
+			m.ContextID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContextID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10746,6 +11088,25 @@ func (m *SetStateRequest) Unmarshal(dAtA []byte) error {
 				m.Value = []byte{}
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContextID", wireType)
+			}
+			m.ContextID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContextID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10914,6 +11275,25 @@ func (m *SetCodeRequest) Unmarshal(dAtA []byte) error {
 				m.Code = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContextID", wireType)
+			}
+			m.ContextID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContextID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -11046,6 +11426,25 @@ func (m *DeleteAccountRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Addr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContextID", wireType)
+			}
+			m.ContextID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContextID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,205 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/evmos/ethermint/types"
+)
+
+const (
+	// SetCodeAuthorizationGas is the intrinsic gas charged per authorization,
+	// PER_EMPTY_ACCOUNT_COST of EIP-7702.
+	SetCodeAuthorizationGas uint64 = 25000
+	// SetCodeAuthorizationBaseGas is the cost of an authorization of an
+	// existing account, PER_AUTH_BASE_COST of EIP-7702. The difference with
+	// SetCodeAuthorizationGas is refunded.
+	SetCodeAuthorizationBaseGas uint64 = 12500
+
+	// setCodeAuthorizationMagic prefixes the payload signed by the authorities.
+	setCodeAuthorizationMagic byte = 0x05
+)
+
+// DelegationPrefix prefixes the code of the accounts delegating their code, it's
+// followed by the address of the delegate.
+var DelegationPrefix = []byte{0xef, 0x01, 0x00}
+
+// SetCodeIntrinsicGas returns the intrinsic gas charged for the authorizations
+// of a set-code transaction.
+func SetCodeIntrinsicGas(auths []SetCodeAuthorization) uint64 {
+	return SetCodeAuthorizationGas * uint64(len(auths))
+}
+
+// AddressToDelegation returns the delegation designator of addr.
+func AddressToDelegation(addr common.Address) []byte {
+	return append(common.CopyBytes(DelegationPrefix), addr.Bytes()...)
+}
+
+// ParseDelegation returns the delegate of an account from its code, false if
+// the code isn't a delegation designator.
+func ParseDelegation(code []byte) (common.Address, bool) {
+	if len(code) != len(DelegationPrefix)+common.AddressLength || !bytes.HasPrefix(code, DelegationPrefix) {
+		return common.Address{}, false
+	}
+	return common.BytesToAddress(code[len(DelegationPrefix):]), true
+}
+
+// setCodeAuthorizationRLP is the consensus encoding of an authorization.
+type setCodeAuthorizationRLP struct {
+	ChainID *big.Int
+	Address common.Address
+	Nonce   uint64
+	V, R, S *big.Int
+}
+
+func (a setCodeAuthorizationRLP) toProto() SetCodeAuthorization {
+	auth := SetCodeAuthorization{
+		ChainID: sdkmath.NewIntFromBigInt(a.ChainID),
+		Address: a.Address.Hex(),
+		Nonce:   a.Nonce,
+	}
+	auth.V, auth.R, auth.S = a.V.Bytes(), a.R.Bytes(), a.S.Bytes()
+	return auth
+}
+
+// NewSetCodeAuthorization returns an unsigned authorization delegating to addr.
+func NewSetCodeAuthorization(chainID *big.Int, addr common.Address, nonce uint64) SetCodeAuthorization {
+	return SetCodeAuthorization{
+		ChainID: sdkmath.NewIntFromBigInt(chainID),
+		Address: addr.Hex(),
+		Nonce:   nonce,
+	}
+}
+
+// GetChainID returns the chain id of the authorization, zero if unset.
+func (a SetCodeAuthorization) GetChainID() *big.Int {
+	if a.ChainID.IsNil() {
+		return new(big.Int)
+	}
+	return a.ChainID.BigInt()
+}
+
+// GetAddress returns the delegate address.
+func (a SetCodeAuthorization) GetAddress() common.Address {
+	return common.HexToAddress(a.Address)
+}
+
+// GetRawSignatureValues returns the y parity, R and S signature values.
+func (a SetCodeAuthorization) GetRawSignatureValues() (v, r, s *big.Int) {
+	v, r, s = rawSignatureValues(a.V, a.R, a.S)
+	return bigOrZero(v), bigOrZero(r), bigOrZero(s)
+}
+
+func (a SetCodeAuthorization) encoding() setCodeAuthorizationRLP {
+	v, r, s := a.GetRawSignatureValues()
+	return setCodeAuthorizationRLP{
+		ChainID: a.GetChainID(),
+		Address: a.GetAddress(),
+		Nonce:   a.Nonce,
+		V:       v,
+		R:       r,
+		S:       s,
+	}
+}
+
+// SigHash returns the hash signed by the authority.
+func (a SetCodeAuthorization) SigHash() common.Hash {
+	return prefixedRLPHash(setCodeAuthorizationMagic, []interface{}{
+		a.GetChainID(),
+		a.GetAddress(),
+		a.Nonce,
+	})
+}
+
+// Sign signs the authorization with the key of the authority.
+func (a *SetCodeAuthorization) Sign(key *ecdsa.PrivateKey) error {
+	sig, err := crypto.Sign(a.SigHash().Bytes(), key)
+	if err != nil {
+		return err
+	}
+	a.R = new(big.Int).SetBytes(sig[:32]).Bytes()
+	a.S = new(big.Int).SetBytes(sig[32:64]).Bytes()
+	a.V = new(big.Int).SetUint64(uint64(sig[64])).Bytes()
+	return nil
+}
+
+// Authority recovers the account signing the authorization.
+func (a SetCodeAuthorization) Authority() (common.Address, error) {
+	v, r, s := a.GetRawSignatureValues()
+	authority, err := recoverPlain(a.SigHash(), v, r, s)
+	if err != nil {
+		return common.Address{}, errorsmod.Wrap(ErrInvalidAuthorization, err.Error())
+	}
+	return authority, nil
+}
+
+// Validate performs a stateless validation of the authorization fields, the
+// signature is checked by Authority.
+func (a SetCodeAuthorization) Validate() error {
+	if !a.ChainID.IsNil() && (a.ChainID.IsNegative() || !types.IsValidInt256(a.ChainID.BigInt())) {
+		return errorsmod.Wrapf(ErrInvalidAuthorization, "invalid chain id %s", a.ChainID)
+	}
+	if err := types.ValidateAddress(a.Address); err != nil {
+		return errorsmod.Wrap(err, "invalid delegate address")
+	}
+	// the nonce of the authority is incremented
+	if a.Nonce == ^uint64(0) {
+		return errorsmod.Wrap(ErrInvalidAuthorization, "nonce overflow")
+	}
+	return nil
+}
+
+// RPCSetCodeAuthorization is the JSON-RPC representation of an authorization.
+type RPCSetCodeAuthorization struct {
+	ChainID hexutil.Big    `json:"chainId"`
+	Address common.Address `json:"address"`
+	Nonce   hexutil.Uint64 `json:"nonce"`
+	YParity hexutil.Uint64 `json:"yParity"`
+	R       hexutil.Big    `json:"r"`
+	S       hexutil.Big    `json:"s"`
+}
+
+// NewRPCSetCodeAuthorization returns the JSON-RPC representation of auth.
+func NewRPCSetCodeAuthorization(auth SetCodeAuthorization) RPCSetCodeAuthorization {
+	v, r, s := auth.GetRawSignatureValues()
+	return RPCSetCodeAuthorization{
+		ChainID: hexutil.Big(*auth.GetChainID()),
+		Address: auth.GetAddress(),
+		Nonce:   hexutil.Uint64(auth.Nonce),
+		YParity: hexutil.Uint64(v.Uint64()),
+		R:       hexutil.Big(*r),
+		S:       hexutil.Big(*s),
+	}
+}
+
+// ToAuthorization converts the JSON-RPC representation to an authorization.
+func (a RPCSetCodeAuthorization) ToAuthorization() SetCodeAuthorization {
+	auth := NewSetCodeAuthorization(a.ChainID.ToInt(), a.Address, uint64(a.Nonce))
+	auth.V = new(big.Int).SetUint64(uint64(a.YParity)).Bytes()
+	auth.R = a.R.ToInt().Bytes()
+	auth.S = a.S.ToInt().Bytes()
+	return auth
+}
@@ -0,0 +1,455 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+
+	"github.com/evmos/ethermint/types"
+)
+
+// SetCodeTxType is the type of the EIP-7702 set-code transactions, which
+// go-ethereum v1.13 doesn't implement: their hashing, signing and encoding are
+// done here instead of by ethtypes.Transaction.
+const SetCodeTxType = 0x04
+
+// setCodeTxRLP is the consensus encoding of a set-code transaction.
+type setCodeTxRLP struct {
+	ChainID        *big.Int
+	Nonce          uint64
+	GasTipCap      *big.Int
+	GasFeeCap      *big.Int
+	Gas            uint64
+	To             common.Address
+	Value          *big.Int
+	Data           []byte
+	AccessList     ethtypes.AccessList
+	Authorizations []setCodeAuthorizationRLP
+	V, R, S        *big.Int
+}
+
+// DecodeSetCodeTx decodes the canonical encoding of a set-code transaction, that
+// is the transaction type followed by its RLP encoding.
+func DecodeSetCodeTx(b []byte) (*SetCodeTx, error) {
+	if len(b) == 0 || b[0] != SetCodeTxType {
+		return nil, errors.New("not a set-code transaction")
+	}
+
+	var dec setCodeTxRLP
+	if err := rlp.DecodeBytes(b[1:], &dec); err != nil {
+		return nil, err
+	}
+
+	txData := &SetCodeTx{
+		Nonce:    dec.Nonce,
+		GasLimit: dec.Gas,
+		To:       dec.To.Hex(),
+		Data:     dec.Data,
+		Accesses: NewAccessList(&dec.AccessList),
+	}
+	for _, value := range []struct {
+		dst **sdkmath.Int
+		src *big.Int
+	}{
+		{&txData.GasTipCap, dec.GasTipCap},
+		{&txData.GasFeeCap, dec.GasFeeCap},
+		{&txData.Amount, dec.Value},
+	} {
+		i, err := types.SafeNewIntFromBigInt(value.src)
+		if err != nil {
+			return nil, err
+		}
+		*value.dst = &i
+	}
+	for _, auth := range dec.Authorizations {
+		txData.Authorizations = append(txData.Authorizations, auth.toProto())
+	}
+
+	txData.SetSignatureValues(dec.ChainID, dec.V, dec.R, dec.S)
+	return txData, nil
+}
+
+// TxType returns the tx type
+func (tx *SetCodeTx) TxType() uint8 {
+	return SetCodeTxType
+}
+
+// Copy returns an instance with the same field values
+func (tx *SetCodeTx) Copy() TxData {
+	return &SetCodeTx{
+		ChainID:        tx.ChainID,
+		Nonce:          tx.Nonce,
+		GasTipCap:      tx.GasTipCap,
+		GasFeeCap:      tx.GasFeeCap,
+		GasLimit:       tx.GasLimit,
+		To:             tx.To,
+		Amount:         tx.Amount,
+		Data:           common.CopyBytes(tx.Data),
+		Accesses:       tx.Accesses,
+		Authorizations: append([]SetCodeAuthorization(nil), tx.Authorizations...),
+		V:              common.CopyBytes(tx.V),
+		R:              common.CopyBytes(tx.R),
+		S:              common.CopyBytes(tx.S),
+	}
+}
+
+// GetChainID returns the chain id field from the SetCodeTx
+func (tx *SetCodeTx) GetChainID() *big.Int {
+	if tx.ChainID == nil {
+		return nil
+	}
+
+	return tx.ChainID.BigInt()
+}
+
+// GetAccessList returns the AccessList field.
+func (tx *SetCodeTx) GetAccessList() ethtypes.AccessList {
+	if tx.Accesses == nil {
+		return nil
+	}
+	return *tx.Accesses.ToEthAccessList()
+}
+
+// GetAuthorizations returns the authorization list.
+func (tx *SetCodeTx) GetAuthorizations() []SetCodeAuthorization {
+	return tx.Authorizations
+}
+
+// GetData returns the a copy of the input data bytes.
+func (tx *SetCodeTx) GetData() []byte {
+	return common.CopyBytes(tx.Data)
+}
+
+// GetGas returns the gas limit.
+func (tx *SetCodeTx) GetGas() uint64 {
+	return tx.GasLimit
+}
+
+// GetGasPrice returns the gas fee cap field.
+func (tx *SetCodeTx) GetGasPrice() *big.Int {
+	return tx.GetGasFeeCap()
+}
+
+// GetGasTipCap returns the gas tip cap field.
+func (tx *SetCodeTx) GetGasTipCap() *big.Int {
+	if tx.GasTipCap == nil {
+		return nil
+	}
+	return tx.GasTipCap.BigInt()
+}
+
+// GetGasFeeCap returns the gas fee cap field.
+func (tx *SetCodeTx) GetGasFeeCap() *big.Int {
+	if tx.GasFeeCap == nil {
+		return nil
+	}
+	return tx.GasFeeCap.BigInt()
+}
+
+// GetValue returns the tx amount.
+func (tx *SetCodeTx) GetValue() *big.Int {
+	if tx.Amount == nil {
+		return nil
+	}
+
+	return tx.Amount.BigInt()
+}
+
+// GetNonce returns the account sequence for the transaction.
+func (tx *SetCodeTx) GetNonce() uint64 { return tx.Nonce }
+
+// GetTo returns the pointer to the recipient address.
+func (tx *SetCodeTx) GetTo() *common.Address {
+	if tx.To == "" {
+		return nil
+	}
+	to := common.HexToAddress(tx.To)
+	return &to
+}
+
+// AsEthereumData returns the dynamic fee transaction matching the set-code
+// transaction without its authorizations, as go-ethereum v1.13 has no set-code
+// envelope. Its hash and signature differ from the set-code transaction ones,
+// use Hash and Sender instead.
+func (tx *SetCodeTx) AsEthereumData() ethtypes.TxData {
+	v, r, s := tx.GetRawSignatureValues()
+	return &ethtypes.DynamicFeeTx{
+		ChainID:    tx.GetChainID(),
+		Nonce:      tx.GetNonce(),
+		GasTipCap:  tx.GetGasTipCap(),
+		GasFeeCap:  tx.GetGasFeeCap(),
+		Gas:        tx.GetGas(),
+		To:         tx.GetTo(),
+		Value:      tx.GetValue(),
+		Data:       tx.GetData(),
+		AccessList: tx.GetAccessList(),
+		V:          v,
+		R:          r,
+		S:          s,
+	}
+}
+
+// GetRawSignatureValues returns the V, R, S signature values of the transaction.
+// The return values should not be modified by the caller.
+func (tx *SetCodeTx) GetRawSignatureValues() (v, r, s *big.Int) {
+	return rawSignatureValues(tx.V, tx.R, tx.S)
+}
+
+// SetSignatureValues sets the signature values to the transaction.
+func (tx *SetCodeTx) SetSignatureValues(chainID, v, r, s *big.Int) {
+	if v != nil {
+		tx.V = v.Bytes()
+	}
+	if r != nil {
+		tx.R = r.Bytes()
+	}
+	if s != nil {
+		tx.S = s.Bytes()
+	}
+	if chainID != nil {
+		chainIDInt := sdkmath.NewIntFromBigInt(chainID)
+		tx.ChainID = &chainIDInt
+	}
+}
+
+// Validate performs a stateless validation of the tx fields.
+func (tx SetCodeTx) Validate() error {
+	if tx.GasTipCap == nil {
+		return errorsmod.Wrap(ErrInvalidGasCap, "gas tip cap cannot nil")
+	}
+
+	if tx.GasFeeCap == nil {
+		return errorsmod.Wrap(ErrInvalidGasCap, "gas fee cap cannot nil")
+	}
+
+	if tx.GasTipCap.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidGasCap, "gas tip cap cannot be negative %s", tx.GasTipCap)
+	}
+
+	if tx.GasFeeCap.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidGasCap, "gas fee cap cannot be negative %s", tx.GasFeeCap)
+	}
+
+	if !types.IsValidInt256(tx.GetGasTipCap()) {
+		return errorsmod.Wrap(ErrInvalidGasCap, "out of bound")
+	}
+
+	if !types.IsValidInt256(tx.GetGasFeeCap()) {
+		return errorsmod.Wrap(ErrInvalidGasCap, "out of bound")
+	}
+
+	if tx.GasFeeCap.LT(*tx.GasTipCap) {
+		return errorsmod.Wrapf(
+			ErrInvalidGasCap, "max priority fee per gas higher than max fee per gas (%s > %s)",
+			tx.GasTipCap, tx.GasFeeCap,
+		)
+	}
+
+	if !types.IsValidInt256(tx.Fee()) {
+		return errorsmod.Wrap(ErrInvalidGasFee, "out of bound")
+	}
+
+	amount := tx.GetValue()
+	// Amount can be 0
+	if amount != nil && amount.Sign() == -1 {
+		return errorsmod.Wrapf(ErrInvalidAmount, "amount cannot be negative %s", amount)
+	}
+	if !types.IsValidInt256(amount) {
+		return errorsmod.Wrap(ErrInvalidAmount, "out of bound")
+	}
+
+	// set-code transactions can't create contracts
+	if err := types.ValidateAddress(tx.To); err != nil {
+		return errorsmod.Wrap(err, "invalid to address")
+	}
+
+	if len(tx.Authorizations) == 0 {
+		return errorsmod.Wrap(ErrInvalidAuthorization, "empty authorization list")
+	}
+	for i, auth := range tx.Authorizations {
+		if err := auth.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "authorization %d", i)
+		}
+	}
+
+	if tx.GetChainID() == nil {
+		return errorsmod.Wrap(
+			errortypes.ErrInvalidChainID,
+			"chain ID must be present on SetCode txs",
+		)
+	}
+
+	return nil
+}
+
+// Fee returns gasprice * gaslimit.
+func (tx SetCodeTx) Fee() *big.Int {
+	return fee(tx.GetGasFeeCap(), tx.GasLimit)
+}
+
+// Cost returns amount + gasprice * gaslimit.
+func (tx SetCodeTx) Cost() *big.Int {
+	return cost(tx.Fee(), tx.GetValue())
+}
+
+// EffectiveGasPrice returns the effective gas price
+func (tx *SetCodeTx) EffectiveGasPrice(baseFee *big.Int) *big.Int {
+	return EffectiveGasPrice(baseFee, tx.GasFeeCap.BigInt(), tx.GasTipCap.BigInt())
+}
+
+// EffectiveFee returns effective_gasprice * gaslimit.
+func (tx SetCodeTx) EffectiveFee(baseFee *big.Int) *big.Int {
+	return fee(tx.EffectiveGasPrice(baseFee), tx.GasLimit)
+}
+
+// EffectiveCost returns amount + effective_gasprice * gaslimit.
+func (tx SetCodeTx) EffectiveCost(baseFee *big.Int) *big.Int {
+	return cost(tx.EffectiveFee(baseFee), tx.GetValue())
+}
+
+// encoding returns the consensus encoding of the transaction.
+func (tx *SetCodeTx) encoding() setCodeTxRLP {
+	v, r, s := tx.GetRawSignatureValues()
+	enc := setCodeTxRLP{
+		ChainID:    bigOrZero(tx.GetChainID()),
+		Nonce:      tx.Nonce,
+		GasTipCap:  bigOrZero(tx.GetGasTipCap()),
+		GasFeeCap:  bigOrZero(tx.GetGasFeeCap()),
+		Gas:        tx.GasLimit,
+		To:         common.HexToAddress(tx.To),
+		Value:      bigOrZero(tx.GetValue()),
+		Data:       tx.Data,
+		AccessList: tx.GetAccessList(),
+		V:          bigOrZero(v),
+		R:          bigOrZero(r),
+		S:          bigOrZero(s),
+	}
+	if enc.AccessList == nil {
+		enc.AccessList = ethtypes.AccessList{}
+	}
+	enc.Authorizations = make([]setCodeAuthorizationRLP, len(tx.Authorizations))
+	for i, auth := range tx.Authorizations {
+		enc.Authorizations[i] = auth.encoding()
+	}
+	return enc
+}
+
+// MarshalBinary returns the canonical encoding of the transaction.
+func (tx *SetCodeTx) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte(SetCodeTxType)
+	if err := rlp.Encode(&buf, tx.encoding()); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Hash returns the transaction hash, the hash of its canonical encoding.
+func (tx *SetCodeTx) Hash() common.Hash {
+	bz, err := tx.MarshalBinary()
+	if err != nil {
+		// the encoding of in-memory values can't fail
+		panic(err)
+	}
+	return crypto.Keccak256Hash(bz)
+}
+
+// SigHash returns the hash signed by the sender.
+func (tx *SetCodeTx) SigHash(chainID *big.Int) common.Hash {
+	enc := tx.encoding()
+	return prefixedRLPHash(SetCodeTxType, []interface{}{
+		chainID,
+		enc.Nonce,
+		enc.GasTipCap,
+		enc.GasFeeCap,
+		enc.Gas,
+		enc.To,
+		enc.Value,
+		enc.Data,
+		enc.AccessList,
+		enc.Authorizations,
+	})
+}
+
+// Sender recovers the sender of the transaction signed for chainID.
+func (tx *SetCodeTx) Sender(chainID *big.Int) (common.Address, error) {
+	if tx.GetChainID() == nil || tx.GetChainID().Cmp(chainID) != 0 {
+		return common.Address{}, fmt.Errorf("invalid chain id for signer: have %s want %s", tx.GetChainID(), chainID)
+	}
+	v, r, s := tx.GetRawSignatureValues()
+	return recoverPlain(tx.SigHash(chainID), bigOrZero(v), bigOrZero(r), bigOrZero(s))
+}
+
+// GetSetCodeAuthorizations returns the authorization list of set-code
+// transactions, nil for the other transaction types.
+func GetSetCodeAuthorizations(txData TxData) []SetCodeAuthorization {
+	if tx, ok := txData.(*SetCodeTx); ok {
+		return tx.GetAuthorizations()
+	}
+	return nil
+}
+
+func prefixedRLPHash(prefix byte, x interface{}) common.Hash {
+	var buf bytes.Buffer
+	buf.WriteByte(prefix)
+	if err := rlp.Encode(&buf, x); err != nil {
+		panic(err)
+	}
+	return crypto.Keccak256Hash(buf.Bytes())
+}
+
+// recoverPlain recovers the address signing hash with the y parity v.
+func recoverPlain(hash common.Hash, v, r, s *big.Int) (common.Address, error) {
+	if v.BitLen() > 8 {
+		return common.Address{}, ethtypes.ErrInvalidSig
+	}
+	parity := byte(v.Uint64())
+	if !crypto.ValidateSignatureValues(parity, r, s, true) {
+		return common.Address{}, ethtypes.ErrInvalidSig
+	}
+
+	sig := make([]byte, crypto.SignatureLength)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:64])
+	sig[64] = parity
+	pub, err := crypto.Ecrecover(hash.Bytes(), sig)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if len(pub) == 0 || pub[0] != 4 {
+		return common.Address{}, errors.New("invalid public key")
+	}
+	return common.BytesToAddress(crypto.Keccak256(pub[1:])[12:]), nil
+}
+
+func bigOrZero(i *big.Int) *big.Int {
+	if i == nil {
+		return new(big.Int)
+	}
+	return i
+}
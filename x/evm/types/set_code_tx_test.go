@@ -0,0 +1,184 @@
+package types
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+	"github.com/evmos/ethermint/tests"
+)
+
+func newSignedAuthorization(t *testing.T, chainID *big.Int, delegate common.Address, nonce uint64) (SetCodeAuthorization, common.Address) {
+	addr, priv := tests.NewAddrKey()
+	key, err := priv.(*ethsecp256k1.PrivKey).ToECDSA()
+	require.NoError(t, err)
+
+	auth := NewSetCodeAuthorization(chainID, delegate, nonce)
+	require.NoError(t, auth.Sign(key))
+	return auth, addr
+}
+
+func TestSetCodeAuthorizationAuthority(t *testing.T) {
+	chainID := big.NewInt(9000)
+	delegate := tests.GenerateAddress()
+	auth, authority := newSignedAuthorization(t, chainID, delegate, 3)
+
+	recovered, err := auth.Authority()
+	require.NoError(t, err)
+	require.Equal(t, authority, recovered)
+	require.NoError(t, auth.Validate())
+
+	// the signature doesn't match the tampered authorization anymore
+	auth.Nonce++
+	recovered, err = auth.Authority()
+	if err == nil {
+		require.NotEqual(t, authority, recovered)
+	}
+
+	unsigned := NewSetCodeAuthorization(chainID, delegate, 0)
+	_, err = unsigned.Authority()
+	require.ErrorIs(t, err, ErrInvalidAuthorization)
+}
+
+func TestSetCodeAuthorizationValidate(t *testing.T) {
+	delegate := tests.GenerateAddress()
+	testCases := []struct {
+		name     string
+		malleate func(*SetCodeAuthorization)
+		expError bool
+	}{
+		{"valid", func(*SetCodeAuthorization) {}, false},
+		{"any chain", func(a *SetCodeAuthorization) { *a = NewSetCodeAuthorization(big.NewInt(0), delegate, 0) }, false},
+		{"negative chain id", func(a *SetCodeAuthorization) { *a = NewSetCodeAuthorization(big.NewInt(-1), delegate, 0) }, true},
+		{"invalid address", func(a *SetCodeAuthorization) { a.Address = "0x123" }, true},
+		{"max nonce", func(a *SetCodeAuthorization) { a.Nonce = ^uint64(0) }, true},
+	}
+
+	for _, tc := range testCases {
+		auth := NewSetCodeAuthorization(big.NewInt(9000), delegate, 1)
+		tc.malleate(&auth)
+		err := auth.Validate()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestDelegation(t *testing.T) {
+	addr := tests.GenerateAddress()
+	code := AddressToDelegation(addr)
+	require.Len(t, code, 23)
+
+	parsed, ok := ParseDelegation(code)
+	require.True(t, ok)
+	require.Equal(t, addr, parsed)
+
+	_, ok = ParseDelegation(code[:22])
+	require.False(t, ok)
+	_, ok = ParseDelegation(append([]byte{0xef, 0x00, 0x00}, addr.Bytes()...))
+	require.False(t, ok)
+	_, ok = ParseDelegation(nil)
+	require.False(t, ok)
+}
+
+func TestSetCodeTxSignAndEncode(t *testing.T) {
+	chainID := big.NewInt(9000)
+	from, priv := tests.NewAddrKey()
+	to := tests.GenerateAddress()
+	auth, _ := newSignedAuthorization(t, chainID, tests.GenerateAddress(), 0)
+	accesses := &ethtypes.AccessList{{Address: to, StorageKeys: []common.Hash{{1}}}}
+
+	msg := NewSetCodeTx(chainID, 1, to, big.NewInt(10), 100_000, big.NewInt(20), big.NewInt(2), []byte("input"), accesses, []SetCodeAuthorization{auth})
+	msg.From = from.Bytes()
+	signer := ethtypes.LatestSignerForChainID(chainID)
+	require.NoError(t, msg.Sign(signer, tests.NewSigner(priv)))
+	require.NoError(t, msg.ValidateBasic())
+	require.NoError(t, msg.VerifySender(chainID))
+	require.Equal(t, uint8(SetCodeTxType), msg.TxType())
+	require.Equal(t, msg.ComputeHash().Hex(), msg.Hash)
+	require.NotEqual(t, msg.AsTransaction().Hash(), msg.ComputeHash())
+	// the dynamic fee representation has another signature hash
+	require.NotEqual(t, signer.Hash(msg.AsTransaction()), msg.SigHash(signer))
+	sender, err := ethtypes.Sender(signer, msg.AsTransaction())
+	if err == nil {
+		require.NotEqual(t, from, sender)
+	}
+	v, r, s := msg.AsTransaction().RawSignatureValues()
+	sig := append(append(common.LeftPadBytes(r.Bytes(), 32), common.LeftPadBytes(s.Bytes(), 32)...), byte(v.Uint64()))
+	pub, err := crypto.SigToPub(msg.SigHash(signer).Bytes(), sig)
+	require.NoError(t, err)
+	require.Equal(t, from, crypto.PubkeyToAddress(*pub))
+	require.Equal(t, []SetCodeAuthorization{auth}, msg.GetSetCodeAuthorizations())
+
+	bz, err := msg.MarshalBinary()
+	require.NoError(t, err)
+	require.Equal(t, byte(SetCodeTxType), bz[0])
+	require.Equal(t, crypto.Keccak256Hash(bz), msg.ComputeHash())
+
+	var decoded MsgEthereumTx
+	require.NoError(t, decoded.UnmarshalBinary(bz, chainID))
+	require.Equal(t, msg.ComputeHash(), decoded.ComputeHash())
+	require.Equal(t, msg.From, decoded.From)
+	require.Equal(t, msg.Hash, decoded.Hash)
+
+	// the sender is bound to the chain id
+	require.Error(t, decoded.UnmarshalBinary(bz, big.NewInt(1)))
+
+	_, err = DecodeSetCodeTx(bz[1:])
+	require.Error(t, err)
+}
+
+func TestSetCodeTxValidate(t *testing.T) {
+	chainID := big.NewInt(9000)
+	auth, _ := newSignedAuthorization(t, chainID, tests.GenerateAddress(), 0)
+	testCases := []struct {
+		name     string
+		malleate func(*SetCodeTx)
+		expError bool
+	}{
+		{"valid", func(*SetCodeTx) {}, false},
+		{"no recipient", func(tx *SetCodeTx) { tx.To = "" }, true},
+		{"no authorization", func(tx *SetCodeTx) { tx.Authorizations = nil }, true},
+		{"invalid authorization", func(tx *SetCodeTx) { tx.Authorizations[0].Address = "0x123" }, true},
+	}
+
+	for _, tc := range testCases {
+		msg := NewSetCodeTx(chainID, 0, tests.GenerateAddress(), big.NewInt(0), 100_000, big.NewInt(20), big.NewInt(2), nil, nil, []SetCodeAuthorization{auth})
+		txData, err := UnpackTxData(msg.Data)
+		require.NoError(t, err)
+		tx := txData.(*SetCodeTx)
+		tc.malleate(tx)
+		err = tx.Validate()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestTransactionArgsAuthorizationList(t *testing.T) {
+	chainID := big.NewInt(9000)
+	auth, _ := newSignedAuthorization(t, chainID, tests.GenerateAddress(), 7)
+
+	bz, err := json.Marshal(NewRPCSetCodeAuthorization(auth))
+	require.NoError(t, err)
+	var rpcAuth RPCSetCodeAuthorization
+	require.NoError(t, json.Unmarshal(bz, &rpcAuth))
+	require.Equal(t, auth, rpcAuth.ToAuthorization())
+
+	to := tests.GenerateAddress()
+	args := TransactionArgs{To: &to, AuthorizationList: []RPCSetCodeAuthorization{rpcAuth}}
+	msg := args.ToTransaction()
+	require.Equal(t, uint8(SetCodeTxType), msg.TxType())
+	require.Equal(t, []SetCodeAuthorization{auth}, msg.GetSetCodeAuthorizations())
+	require.Equal(t, msg.ComputeHash().Hex(), msg.Hash)
+}
@@ -36,15 +36,25 @@ func GetTxPriority(txData TxData, baseFee *big.Int) (priority int64) {
 		tipPrice = new(big.Int).Sub(tipPrice, baseFee)
 	}
 
-	priority = math.MaxInt64
+	return GetTipPriority(tipPrice)
+}
+
+// GetTipPriority returns the priority of a tx paying the given tip price, i.e. the effective
+// gas price above the base fee. Both Ethereum and Cosmos txs are prioritized by it, so their
+// priorities are comparable. The priority is floored at 0 and capped at MaxInt64.
+func GetTipPriority(tipPrice *big.Int) int64 {
+	if tipPrice.Sign() <= 0 {
+		return 0
+	}
+
 	priorityBig := new(big.Int).Quo(tipPrice, DefaultPriorityReduction.BigInt())
 
 	// safety check
-	if priorityBig.IsInt64() {
-		priority = priorityBig.Int64()
+	if !priorityBig.IsInt64() {
+		return math.MaxInt64
 	}
 
-	return priority
+	return priorityBig.Int64()
 }
 
 // Failed returns if the contract execution failed in vm errors
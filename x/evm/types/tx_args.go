@@ -52,6 +52,9 @@ type TransactionArgs struct {
 	// Introduced by AccessListTxType transaction.
 	AccessList *types.AccessList `json:"accessList,omitempty"`
 	ChainID    *hexutil.Big      `json:"chainId,omitempty"`
+
+	// Introduced by SetCodeTxType transaction.
+	AuthorizationList []RPCSetCodeAuthorization `json:"authorizationList,omitempty"`
 }
 
 // String return the struct in a string format
@@ -113,6 +116,24 @@ func (args *TransactionArgs) ToTransaction() *MsgEthereumTx {
 
 	var data TxData
 	switch {
+	case len(args.AuthorizationList) > 0:
+		al := AccessList{}
+		if args.AccessList != nil {
+			al = NewAccessList(args.AccessList)
+		}
+
+		data = &SetCodeTx{
+			To:             to,
+			ChainID:        &chainID,
+			Nonce:          nonce,
+			GasLimit:       gas,
+			GasFeeCap:      &maxFeePerGas,
+			GasTipCap:      &maxPriorityFeePerGas,
+			Amount:         &value,
+			Data:           args.GetData(),
+			Accesses:       al,
+			Authorizations: args.GetAuthorizations(),
+		}
 	case args.MaxFeePerGas != nil:
 		al := AccessList{}
 		if args.AccessList != nil {
@@ -165,7 +186,7 @@ func (args *TransactionArgs) ToTransaction() *MsgEthereumTx {
 		Data: anyData,
 		From: from,
 	}
-	msg.Hash = msg.AsTransaction().Hash().Hex()
+	msg.Hash = msg.ComputeHash().Hex()
 	return &msg
 }
 
@@ -265,6 +286,18 @@ func (args *TransactionArgs) GetFrom() common.Address {
 	return *args.From
 }
 
+// GetAuthorizations returns the set-code authorizations of the transaction.
+func (args *TransactionArgs) GetAuthorizations() []SetCodeAuthorization {
+	if len(args.AuthorizationList) == 0 {
+		return nil
+	}
+	auths := make([]SetCodeAuthorization, len(args.AuthorizationList))
+	for i, auth := range args.AuthorizationList {
+		auths[i] = auth.ToAuthorization()
+	}
+	return auths
+}
+
 // GetData retrieves the transaction calldata. Input field is preferred.
 func (args *TransactionArgs) GetData() []byte {
 	if args.Input != nil {
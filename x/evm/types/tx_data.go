@@ -26,6 +26,8 @@ var (
 	_ TxData = &LegacyTx{}
 	_ TxData = &AccessListTx{}
 	_ TxData = &DynamicFeeTx{}
+	_ TxData = &SetCodeTx{}
+	_ TxData = &BlobTx{}
 )
 
 // TxData implements the Ethereum transaction tx structure. It is used
@@ -72,6 +74,8 @@ func NewTxDataFromTx(tx *ethtypes.Transaction) (TxData, error) {
 		txData, err = newDynamicFeeTx(tx)
 	case ethtypes.AccessListTxType:
 		txData, err = newAccessListTx(tx)
+	case ethtypes.BlobTxType:
+		txData, err = newBlobTx(tx)
 	default:
 		txData, err = newLegacyTx(tx)
 	}
@@ -149,7 +149,7 @@ func UnwrapEthereumMsg(tx *sdk.Tx, ethHash common.Hash) (*MsgEthereumTx, error)
 		if !ok {
 			return nil, fmt.Errorf("invalid tx type: %T", tx)
 		}
-		txHash := ethMsg.AsTransaction().Hash()
+		txHash := ethMsg.ComputeHash()
 		ethMsg.Hash = txHash.Hex()
 		if txHash == ethHash {
 			return ethMsg, nil
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"errors"
+	"math"
 	"math/big"
 	"testing"
 
@@ -113,3 +114,26 @@ func TestTransactionLogsEncodeDecode(t *testing.T) {
 	require.Nil(t, decodeErr)
 	require.Equal(t, txLogs, txLogsEncodedDecoded)
 }
+
+func TestGetTipPriority(t *testing.T) {
+	reduction := evmtypes.DefaultPriorityReduction.BigInt()
+	overflow := new(big.Int).Mul(new(big.Int).SetUint64(math.MaxUint64), reduction)
+
+	testCases := []struct {
+		name     string
+		tip      *big.Int
+		expected int64
+	}{
+		{"negative tip", big.NewInt(-1), 0},
+		{"zero tip", big.NewInt(0), 0},
+		{"below the reduction", new(big.Int).Sub(reduction, big.NewInt(1)), 0},
+		{"tip", new(big.Int).Mul(big.NewInt(7), reduction), 7},
+		{"overflow", overflow, math.MaxInt64},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, evmtypes.GetTipPriority(tc.tip))
+		})
+	}
+}
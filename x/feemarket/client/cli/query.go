@@ -16,6 +16,8 @@
 package cli
 
 import (
+	"strconv"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -37,6 +39,10 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetBlockGasCmd(),
 		GetBaseFeeCmd(),
+		GetBlobBaseFeeCmd(),
+		GetFeeTokenPricesCmd(),
+		GetBlockFeesCmd(),
+		GetFeeHistoryCmd(),
 		GetParamsCmd(),
 	)
 	return cmd
@@ -129,3 +135,136 @@ If the height is not provided, it will use the latest height from context.`,
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetBlobBaseFeeCmd queries the blob base fee at a given height
+func GetBlobBaseFeeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "blob-base-fee",
+		Short: "Get the blob base fee amount at a given block height",
+		Long: `Get the EIP4844 blob base fee amount and the excess blob gas at a given block height.
+If the height is not provided, it will use the latest height from context.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			ctx := cmd.Context()
+			res, err := queryClient.BlobBaseFee(ctx, &types.QueryBlobBaseFeeRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetFeeTokenPricesCmd queries the prices of the fee tokens at a given height
+func GetFeeTokenPricesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-token-prices",
+		Short: "Get the prices of the fee tokens at a given block height",
+		Long: `Get the amount of each fee token paid per unit of the evm denom, including its markup, at a given block height.
+If the height is not provided, it will use the latest height from context.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			ctx := cmd.Context()
+			res, err := queryClient.FeeTokenPrices(ctx, &types.QueryFeeTokenPricesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetBlockFeesCmd queries the fees collected and distributed in a block
+func GetBlockFeesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "block-fees",
+		Short: "Get the fees collected and distributed at a given block height",
+		Long: `Get the base fees and priority fees paid by the ethereum transactions of a block, and the parts of them
+burned, sent to the proposer and to the community pool.
+If the height is not provided, it will use the latest height from context.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			ctx := cmd.Context()
+			res, err := queryClient.BlockFees(ctx, &types.QueryBlockFeesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetFeeHistoryCmd queries the fee history kept for a range of blocks
+func GetFeeHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-history [from-height] [to-height]",
+		Short: "Get the fee history kept for a range of blocks",
+		Long: `Get the base fee, gas and transaction rewards of the blocks of a range, among the last blocks
+kept by the fee market.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromHeight, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			toHeight, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			ctx := cmd.Context()
+			res, err := queryClient.FeeHistory(ctx, &types.QueryFeeHistoryRequest{
+				FromHeight: fromHeight,
+				ToHeight:   toHeight,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
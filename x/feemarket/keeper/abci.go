@@ -37,9 +37,11 @@ func (k *Keeper) BeginBlock(ctx sdk.Context) error {
 	}
 
 	k.SetBaseFee(ctx, baseFee)
+	blobBaseFee := k.GetBlobBaseFee(ctx)
 
 	defer func() {
 		telemetry.SetGauge(float32(baseFee.Int64()), "feemarket", "base_fee")
+		telemetry.SetGauge(float32(blobBaseFee.Int64()), "feemarket", "blob_base_fee")
 	}()
 
 	// Store current base fee in event
@@ -47,6 +49,7 @@ func (k *Keeper) BeginBlock(ctx sdk.Context) error {
 		sdk.NewEvent(
 			types.EventTypeFeeMarket,
 			sdk.NewAttribute(types.AttributeKeyBaseFee, baseFee.String()),
+			sdk.NewAttribute(types.AttributeKeyBlobBaseFee, blobBaseFee.String()),
 		),
 	})
 	return nil
@@ -79,6 +82,11 @@ func (k *Keeper) EndBlock(ctx sdk.Context) error {
 	limitedGasWanted := sdkmath.LegacyNewDec(int64(gasWanted)).Mul(minGasMultiplier)
 	gasWanted = sdkmath.LegacyMaxDec(limitedGasWanted, sdkmath.LegacyNewDec(int64(gasUsed))).TruncateInt().Uint64()
 	k.SetBlockGasWanted(ctx, gasWanted)
+	k.updateBaseFeeHistory(ctx, gasWanted)
+	nextBaseFee := k.updateNextBaseFee(ctx)
+	k.updateExcessBlobGas(ctx)
+	k.distributeBlockFees(ctx)
+	k.recordFeeHistory(ctx, gasWanted, gasUsed, nextBaseFee)
 
 	defer func() {
 		telemetry.SetGauge(float32(gasWanted), "feemarket", "block_gas")
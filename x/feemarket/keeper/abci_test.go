@@ -2,10 +2,14 @@ package keeper_test
 
 import (
 	"fmt"
+	"math/big"
 	"testing"
 
 	storetypes "cosmossdk.io/store/types"
+	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/evmos/ethermint/testutil"
+	"github.com/evmos/ethermint/x/feemarket/types"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -56,3 +60,36 @@ func (suite *ABCITestSuite) TestEndBlock() {
 		})
 	}
 }
+
+func (suite *ABCITestSuite) TestEndBlockExcessBlobGas() {
+	suite.SetupTest()
+	k := suite.App.FeeMarketKeeper
+	suite.Ctx = suite.Ctx.WithBlockGasMeter(storetypes.NewGasMeter(uint64(1000000000)))
+
+	// the blob base fee starts at the minimum
+	suite.Require().Equal(big.NewInt(params.BlobTxMinBlobGasprice), k.GetBlobBaseFee(suite.Ctx))
+
+	// a full block raises the excess blob gas by the target surplus
+	k.AddTransientBlobGasUsed(suite.Ctx, params.MaxBlobGasPerBlock)
+	suite.Require().NoError(k.EndBlock(suite.Ctx))
+	excess := uint64(params.MaxBlobGasPerBlock - params.BlobTxTargetBlobGasPerBlock)
+	suite.Require().Equal(excess, k.GetExcessBlobGas(suite.Ctx))
+	suite.Require().Equal(eip4844.CalcBlobFee(excess), k.GetBlobBaseFee(suite.Ctx))
+
+	res, err := k.BlobBaseFee(suite.Ctx, &types.QueryBlobBaseFeeRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(excess, res.ExcessBlobGas)
+	suite.Require().Equal(eip4844.CalcBlobFee(excess), res.BlobBaseFee.BigInt())
+
+	// an empty block consumes the excess
+	store := suite.Ctx.TransientStore(suite.App.GetTKey(types.TransientKey))
+	store.Delete(types.KeyPrefixTransientBlobGasUsed)
+	suite.Require().NoError(k.EndBlock(suite.Ctx))
+	suite.Require().Zero(k.GetExcessBlobGas(suite.Ctx))
+
+	// the blob base fee is disabled along the base fee
+	feemarketParams := k.GetParams(suite.Ctx)
+	feemarketParams.NoBaseFee = true
+	suite.Require().NoError(k.SetParams(suite.Ctx, feemarketParams))
+	suite.Require().Nil(k.GetBlobBaseFee(suite.Ctx))
+}
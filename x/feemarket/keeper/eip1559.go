@@ -21,14 +21,12 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/evmos/ethermint/x/feemarket/types"
 )
 
 // CalculateBaseFee calculates the base fee for the current block. This is only calculated once per
 // block during BeginBlock. If the NoBaseFee parameter is enabled or below activation height, this function returns nil.
-// NOTE: This code is inspired from the go-ethereum EIP1559 implementation and adapted to Cosmos SDK-based
-// chains. For the canonical code refer to: https://github.com/ethereum/go-ethereum/blob/master/consensus/misc/eip1559.go
+// The base fee is computed by the BaseFeeAlgorithm of the params from the gas of the previous blocks.
 func (k Keeper) CalculateBaseFee(ctx sdk.Context) *big.Int {
 	params := k.GetParams(ctx)
 
@@ -60,46 +58,69 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) *big.Int {
 	if consParams.Block == nil || consParams.Block.MaxGas <= -1 {
 		panic(fmt.Sprintf("get invalid consensus params: %s", consParams))
 	}
-	gasLimit := big.NewInt(consParams.Block.MaxGas)
-	// CONTRACT: ElasticityMultiplier cannot be 0 as it's checked in the params
-	// validation
-	parentGasTargetBig := new(big.Int).Div(gasLimit, new(big.Int).SetUint64(uint64(params.ElasticityMultiplier)))
-	if !parentGasTargetBig.IsUint64() {
-		return nil
-	}
 
-	parentGasTarget := parentGasTargetBig.Uint64()
-	baseFeeChangeDenominator := new(big.Int).SetUint64(uint64(params.BaseFeeChangeDenominator))
+	history := k.GetBaseFeeHistory(ctx)
+	if params.BaseFeeAlgorithm != types.BASE_FEE_ALGORITHM_MOVING_WINDOW || len(history.GasUsed) == 0 {
+		history.GasUsed = []uint64{parentGasUsed}
+	}
+	return params.CalcBaseFee(parentBaseFee, uint64(consParams.Block.MaxGas), history)
+}
 
-	// If the parent gasUsed is the same as the target, the baseFee remains
-	// unchanged.
-	if parentGasUsed == parentGasTarget {
-		return new(big.Int).Set(parentBaseFee)
+// GetNextBaseFee returns the base fee of the next block, as computed at the end of the
+// current block, or nil if the base fee isn't enabled for it.
+func (k Keeper) GetNextBaseFee(ctx sdk.Context) *big.Int {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyPrefixNextBaseFee)
+	if len(bz) == 0 {
+		return nil
 	}
+	return new(big.Int).SetBytes(bz)
+}
 
-	if parentGasUsed > parentGasTarget {
-		// If the parent block used more gas than its target, the baseFee should
-		// increase.
-		gasUsedDelta := new(big.Int).SetUint64(parentGasUsed - parentGasTarget)
-		x := new(big.Int).Mul(parentBaseFee, gasUsedDelta)
-		y := x.Div(x, parentGasTargetBig)
-		baseFeeDelta := math.BigMax(
-			x.Div(y, baseFeeChangeDenominator),
-			common.Big1,
-		)
+// updateNextBaseFee computes the base fee of the next block from the gas of the current
+// one and the state of the base fee algorithm, so that the JSON-RPC doesn't have to
+// reproduce the algorithm to serve the fee history.
+// CONTRACT: this should be only called during EndBlock, after the block gas wanted and
+// the base fee history are updated.
+func (k Keeper) updateNextBaseFee(ctx sdk.Context) *big.Int {
+	store := ctx.KVStore(k.storeKey)
+	var nextBaseFee *big.Int
+	if consParams := ctx.ConsensusParams(); consParams.Block != nil && consParams.Block.MaxGas > -1 {
+		nextBaseFee = k.CalculateBaseFee(ctx.WithBlockHeight(ctx.BlockHeight() + 1))
+	}
+	if nextBaseFee == nil {
+		store.Delete(types.KeyPrefixNextBaseFee)
+		return nil
+	}
+	store.Set(types.KeyPrefixNextBaseFee, nextBaseFee.Bytes())
+	return nextBaseFee
+}
 
-		return x.Add(parentBaseFee, baseFeeDelta)
+// GetBaseFeeHistory returns the state kept by the base fee algorithm across blocks.
+func (k Keeper) GetBaseFeeHistory(ctx sdk.Context) types.BaseFeeHistory {
+	var history types.BaseFeeHistory
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyPrefixBaseFeeHistory)
+	if len(bz) > 0 {
+		k.cdc.MustUnmarshal(bz, &history)
 	}
+	return history
+}
 
-	// Otherwise if the parent block used less gas than its target, the baseFee
-	// should decrease.
-	gasUsedDelta := new(big.Int).SetUint64(parentGasTarget - parentGasUsed)
-	x := new(big.Int).Mul(parentBaseFee, gasUsedDelta)
-	y := x.Div(x, parentGasTargetBig)
-	baseFeeDelta := x.Div(y, baseFeeChangeDenominator)
+// SetBaseFeeHistory sets the state kept by the base fee algorithm across blocks.
+func (k Keeper) SetBaseFeeHistory(ctx sdk.Context, history types.BaseFeeHistory) {
+	ctx.KVStore(k.storeKey).Set(types.KeyPrefixBaseFeeHistory, k.cdc.MustMarshal(&history))
+}
 
-	// Set global min gas price as lower bound of the base fee, transactions below
-	// the min gas price don't even reach the mempool.
-	minGasPrice := params.MinGasPrice.TruncateInt().BigInt()
-	return math.BigMax(x.Sub(parentBaseFee, baseFeeDelta), minGasPrice)
+// updateBaseFeeHistory appends the gas wanted by the current block to the state of the
+// base fee algorithm, the EIP-1559 algorithm being stateless.
+func (k Keeper) updateBaseFeeHistory(ctx sdk.Context, gasWanted uint64) {
+	params := k.GetParams(ctx)
+	if params.BaseFeeAlgorithm == types.BASE_FEE_ALGORITHM_EIP1559 {
+		return
+	}
+	consParams := ctx.ConsensusParams()
+	if consParams.Block == nil || consParams.Block.MaxGas <= 0 {
+		return
+	}
+	history := params.UpdateBaseFeeHistory(k.GetBaseFeeHistory(ctx), gasWanted, uint64(consParams.Block.MaxGas))
+	k.SetBaseFeeHistory(ctx, history)
 }
@@ -5,8 +5,10 @@ import (
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/evmos/ethermint/testutil"
+	"github.com/evmos/ethermint/x/feemarket/types"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -125,3 +127,57 @@ func (suite *EIP1559TestSuite) TestCalculateBaseFee() {
 		})
 	}
 }
+
+func (suite *EIP1559TestSuite) TestCalculateBaseFeeAlgorithms() {
+	testCases := []struct {
+		name      string
+		algorithm types.BaseFeeAlgorithm
+		// expected base fee from the base fee of the params, with a block gas limit of
+		// 100 and blocks wanting 100, 100 and 0 gas
+		expFee func(baseFee sdkmath.Int) sdkmath.Int
+	}{
+		{
+			"EIP-1559 only depends on the parent block",
+			types.BASE_FEE_ALGORITHM_EIP1559,
+			func(baseFee sdkmath.Int) sdkmath.Int { return baseFee.Sub(baseFee.QuoRaw(8)) },
+		},
+		{
+			"AIMD decreases the base fee by the learning rate",
+			types.BASE_FEE_ALGORITHM_AIMD,
+			func(baseFee sdkmath.Int) sdkmath.Int { return baseFee.Sub(baseFee.QuoRaw(8)) },
+		},
+		{
+			"moving window averages the last blocks",
+			types.BASE_FEE_ALGORITHM_MOVING_WINDOW,
+			func(baseFee sdkmath.Int) sdkmath.Int { return baseFee.Add(baseFee.MulRaw(66 - 50).QuoRaw(50 * 8)) },
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			params := suite.App.FeeMarketKeeper.GetParams(suite.Ctx)
+			params.BaseFeeAlgorithm = tc.algorithm
+			params.MinGasMultiplier = sdkmath.LegacyZeroDec()
+			suite.Require().NoError(suite.App.FeeMarketKeeper.SetParams(suite.Ctx, params))
+
+			consParams := tmproto.ConsensusParams{Block: &tmproto.BlockParams{MaxGas: 100, MaxBytes: 10}}
+			ctx := suite.Ctx.WithConsensusParams(consParams).WithBlockHeight(1)
+			for _, gas := range []uint64{100, 100, 0} {
+				ctx = ctx.WithBlockGasMeter(storetypes.NewGasMeter(100))
+				suite.App.FeeMarketKeeper.SetTransientBlockGasWanted(ctx, gas)
+				ctx.BlockGasMeter().ConsumeGas(gas, "test")
+				suite.Require().NoError(suite.App.FeeMarketKeeper.EndBlock(ctx))
+			}
+
+			fee := suite.App.FeeMarketKeeper.CalculateBaseFee(ctx.WithBlockHeight(2))
+			suite.Require().Equal(tc.expFee(params.BaseFee), sdkmath.NewIntFromBigInt(fee))
+
+			// the next base fee recorded at the end of the block is the one of the next block
+			res, err := suite.App.FeeMarketKeeper.NextBaseFee(ctx, &types.QueryNextBaseFeeRequest{})
+			suite.Require().NoError(err)
+			suite.Require().NotNil(res.BaseFee)
+			suite.Require().Equal(sdkmath.NewIntFromBigInt(fee), *res.BaseFee)
+		})
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
+
+	"github.com/evmos/ethermint/x/feemarket/types"
+)
+
+// ----------------------------------------------------------------------------
+// Blob gas
+// Required by EIP4844 blob base fee calculation.
+// ----------------------------------------------------------------------------
+
+// GetExcessBlobGas returns the excess blob gas accumulated up to the parent block.
+func (k Keeper) GetExcessBlobGas(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPrefixExcessBlobGas)
+	if len(bz) == 0 {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetExcessBlobGas sets the excess blob gas to the store.
+// CONTRACT: this should be only called during EndBlock.
+func (k Keeper) SetExcessBlobGas(ctx sdk.Context, excessBlobGas uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPrefixExcessBlobGas, sdk.Uint64ToBigEndian(excessBlobGas))
+}
+
+// GetTransientBlobGasUsed returns the blob gas used in the current block from transient store.
+func (k Keeper) GetTransientBlobGasUsed(ctx sdk.Context) uint64 {
+	store := ctx.TransientStore(k.transientKey)
+	bz := store.Get(types.KeyPrefixTransientBlobGasUsed)
+	if len(bz) == 0 {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// AddTransientBlobGasUsed adds the cumulative blob gas used in the transient store
+func (k Keeper) AddTransientBlobGasUsed(ctx sdk.Context, blobGas uint64) uint64 {
+	result := k.GetTransientBlobGasUsed(ctx) + blobGas
+	store := ctx.TransientStore(k.transientKey)
+	store.Set(types.KeyPrefixTransientBlobGasUsed, sdk.Uint64ToBigEndian(result))
+	return result
+}
+
+// GetBlobBaseFee returns the price of the blob gas in the current block, it's
+// derived from the excess blob gas like on Ethereum.
+// return nil if base fee is not enabled
+func (k Keeper) GetBlobBaseFee(ctx sdk.Context) *big.Int {
+	if k.GetParams(ctx).NoBaseFee {
+		return nil
+	}
+	return eip4844.CalcBlobFee(k.GetExcessBlobGas(ctx))
+}
+
+// updateExcessBlobGas carries the blob gas used in the current block over to
+// the excess blob gas of the next one.
+func (k Keeper) updateExcessBlobGas(ctx sdk.Context) uint64 {
+	excessBlobGas := eip4844.CalcExcessBlobGas(k.GetExcessBlobGas(ctx), k.GetTransientBlobGasUsed(ctx))
+	k.SetExcessBlobGas(ctx, excessBlobGas)
+	return excessBlobGas
+}
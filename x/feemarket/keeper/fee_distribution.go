@@ -0,0 +1,125 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/evmos/ethermint/x/feemarket/types"
+)
+
+// AddTransientBlockFees adds the part of the fees of an ethereum transaction paid at the
+// base fee and above it to the fees of the current block, the latter being owed to the
+// given proposer.
+func (k Keeper) AddTransientBlockFees(ctx sdk.Context, baseFees, priorityFees sdk.Coins, proposer sdk.AccAddress) {
+	fees := k.GetTransientBlockFees(ctx)
+	fees.BaseFees = fees.BaseFees.Add(baseFees...)
+	fees.PriorityFees = fees.PriorityFees.Add(priorityFees...)
+	fees.Proposer = proposer.String()
+
+	store := ctx.TransientStore(k.transientKey)
+	store.Set(types.KeyPrefixTransientBlockFees, k.cdc.MustMarshal(&fees))
+}
+
+// GetTransientBlockFees returns the fees of the ethereum transactions of the current block.
+func (k Keeper) GetTransientBlockFees(ctx sdk.Context) types.BlockFees {
+	store := ctx.TransientStore(k.transientKey)
+	bz := store.Get(types.KeyPrefixTransientBlockFees)
+	var fees types.BlockFees
+	if len(bz) > 0 {
+		k.cdc.MustUnmarshal(bz, &fees)
+	}
+	return fees
+}
+
+// SetBlockFees stores the fees of the block at their height, the fees of the blocks older
+// than BlockFeesRetention are pruned.
+func (k Keeper) SetBlockFees(ctx sdk.Context, fees types.BlockFees) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBlockFees)
+	store.Set(sdk.Uint64ToBigEndian(uint64(fees.Height)), k.cdc.MustMarshal(&fees))
+	if pruned := fees.Height - types.BlockFeesRetention; pruned > 0 {
+		store.Delete(sdk.Uint64ToBigEndian(uint64(pruned)))
+	}
+}
+
+// GetBlockFees returns the fees of the block at the given height, if they are still stored.
+func (k Keeper) GetBlockFees(ctx sdk.Context, height int64) (types.BlockFees, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBlockFees)
+	bz := store.Get(sdk.Uint64ToBigEndian(uint64(height)))
+	if len(bz) == 0 {
+		return types.BlockFees{}, false
+	}
+	var fees types.BlockFees
+	k.cdc.MustUnmarshal(bz, &fees)
+	return fees, true
+}
+
+// distributeBlockFees records the fees of the current block and, if the fee distribution
+// is enabled, burns the burn fraction of the base fees, sends the tips to the proposer and
+// the remainder of the base fees to the community pool, out of the fee collector.
+// A failed distribution leaves the fees in the fee collector instead of halting the chain.
+func (k Keeper) distributeBlockFees(ctx sdk.Context) {
+	fees := k.GetTransientBlockFees(ctx)
+	fees.Height = ctx.BlockHeight()
+
+	params := k.GetParams(ctx)
+	if params.FeeDistributionEnabled {
+		burned, remainder := fees.SplitBaseFees(params.GetBaseFeeBurnFraction())
+		cacheCtx, commit := ctx.CacheContext()
+		if err := k.sendBlockFees(cacheCtx, fees, burned, remainder); err != nil {
+			k.Logger(ctx).Error("failed to distribute the block fees", "height", fees.Height, "error", err)
+		} else {
+			commit()
+			fees.Burned = burned
+			fees.CommunityPool = remainder
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeFeeDistribution,
+				sdk.NewAttribute(types.AttributeKeyBurned, burned.String()),
+				sdk.NewAttribute(types.AttributeKeyPriorityFees, fees.PriorityFees.String()),
+				sdk.NewAttribute(types.AttributeKeyCommunityPool, remainder.String()),
+				sdk.NewAttribute(types.AttributeKeyProposer, fees.Proposer),
+			))
+		}
+	}
+
+	k.SetBlockFees(ctx, fees)
+}
+
+func (k Keeper) sendBlockFees(ctx sdk.Context, fees types.BlockFees, burned, remainder sdk.Coins) error {
+	if !burned.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.ModuleName, burned); err != nil {
+			return err
+		}
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burned); err != nil {
+			return err
+		}
+	}
+	if !fees.PriorityFees.IsZero() {
+		proposer, err := sdk.AccAddressFromBech32(fees.Proposer)
+		if err != nil {
+			return err
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, proposer, fees.PriorityFees); err != nil {
+			return err
+		}
+	}
+	if !remainder.IsZero() {
+		return k.distrKeeper.FundCommunityPool(ctx, remainder, authtypes.NewModuleAddress(authtypes.FeeCollectorName))
+	}
+	return nil
+}
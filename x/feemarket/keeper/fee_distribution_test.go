@@ -0,0 +1,101 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/ethermint/testutil"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	"github.com/evmos/ethermint/x/feemarket/types"
+)
+
+type FeeDistributionTestSuite struct {
+	testutil.BaseTestSuite
+}
+
+func TestFeeDistributionTestSuite(t *testing.T) {
+	suite.Run(t, new(FeeDistributionTestSuite))
+}
+
+func (suite *FeeDistributionTestSuite) TestDistributeBlockFees() {
+	denom := suite.App.EvmKeeper.GetParams(suite.Ctx).EvmDenom
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(amount)))
+	}
+
+	testCases := []struct {
+		name             string
+		enabled          bool
+		burnFraction     sdkmath.LegacyDec
+		expBurned        sdk.Coins
+		expCommunityPool sdk.Coins
+		expProposer      sdk.Coins
+	}{
+		{"disabled", false, sdkmath.LegacyNewDecWithPrec(5, 1), nil, nil, nil},
+		{"burn all the base fees", true, sdkmath.LegacyOneDec(), coins(1000), nil, coins(300)},
+		{"burn part of the base fees", true, sdkmath.LegacyNewDecWithPrec(25, 2), coins(250), coins(750), coins(300)},
+		{"burn none of the base fees", true, sdkmath.LegacyZeroDec(), nil, coins(1000), coins(300)},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx := suite.Ctx.WithBlockGasMeter(storetypes.NewGasMeter(1000000))
+			k := suite.App.FeeMarketKeeper
+
+			params := k.GetParams(ctx)
+			params.FeeDistributionEnabled = tc.enabled
+			params.BaseFeeBurnFraction = tc.burnFraction
+			suite.Require().NoError(k.SetParams(ctx, params))
+
+			collected := coins(1300)
+			suite.Require().NoError(suite.App.BankKeeper.MintCoins(ctx, evmtypes.ModuleName, collected))
+			suite.Require().NoError(suite.App.BankKeeper.SendCoinsFromModuleToModule(ctx, evmtypes.ModuleName, authtypes.FeeCollectorName, collected))
+			supply := suite.App.BankKeeper.GetSupply(ctx, denom)
+			poolBefore, err := suite.App.DistrKeeper.FeePool.Get(ctx)
+			suite.Require().NoError(err)
+
+			proposer := sdk.AccAddress(tests.GenerateAddress().Bytes())
+			k.AddTransientBlockFees(ctx, coins(600), coins(100), proposer)
+			k.AddTransientBlockFees(ctx, coins(400), coins(200), proposer)
+			suite.Require().NoError(k.EndBlock(ctx))
+
+			fees, found := k.GetBlockFees(ctx, ctx.BlockHeight())
+			suite.Require().True(found)
+			suite.Require().Equal(coins(1000), fees.BaseFees)
+			suite.Require().Equal(coins(300), fees.PriorityFees)
+			suite.Require().Equal(tc.expBurned, fees.Burned)
+			suite.Require().Equal(tc.expCommunityPool, fees.CommunityPool)
+
+			suite.Require().Equal(tc.expProposer.AmountOf(denom), suite.App.BankKeeper.GetBalance(ctx, proposer, denom).Amount)
+			suite.Require().Equal(supply.Amount.Sub(tc.expBurned.AmountOf(denom)), suite.App.BankKeeper.GetSupply(ctx, denom).Amount)
+			pool, err := suite.App.DistrKeeper.FeePool.Get(ctx)
+			suite.Require().NoError(err)
+			suite.Require().Equal(poolBefore.CommunityPool.Add(sdk.NewDecCoinsFromCoins(tc.expCommunityPool...)...), pool.CommunityPool)
+
+			res, err := k.BlockFees(ctx, &types.QueryBlockFeesRequest{})
+			suite.Require().NoError(err)
+			suite.Require().Equal(fees, res.BlockFees)
+		})
+	}
+}
+
+func (suite *FeeDistributionTestSuite) TestBlockFeesRetention() {
+	k := suite.App.FeeMarketKeeper
+	k.SetBlockFees(suite.Ctx, types.BlockFees{Height: 1})
+	k.SetBlockFees(suite.Ctx, types.BlockFees{Height: 1 + types.BlockFeesRetention})
+
+	_, found := k.GetBlockFees(suite.Ctx, 1)
+	suite.Require().False(found)
+	_, found = k.GetBlockFees(suite.Ctx, 1+types.BlockFeesRetention)
+	suite.Require().True(found)
+
+	_, err := k.BlockFees(suite.Ctx, &types.QueryBlockFeesRequest{Height: 1})
+	suite.Require().Error(err)
+}
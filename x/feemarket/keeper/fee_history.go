@@ -0,0 +1,116 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	ethermint "github.com/evmos/ethermint/types"
+	"github.com/evmos/ethermint/x/feemarket/types"
+)
+
+// AddTransientTxReward records the gas used and the tip per gas of the ethereum
+// transaction with the given hash, for the rewards of the fee history.
+func (k Keeper) AddTransientTxReward(ctx sdk.Context, txHash common.Hash, gasUsed uint64, reward *big.Int) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientTxRewards)
+	txReward := types.TxReward{GasUsed: gasUsed, Reward: sdkmath.NewIntFromBigInt(reward)}
+	store.Set(txHash.Bytes(), k.cdc.MustMarshal(&txReward))
+}
+
+// GetTransientTxRewards returns the rewards of the ethereum transactions of the current block.
+func (k Keeper) GetTransientTxRewards(ctx sdk.Context) []types.TxReward {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientTxRewards)
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+
+	var rewards []types.TxReward
+	for ; it.Valid(); it.Next() {
+		var reward types.TxReward
+		k.cdc.MustUnmarshal(it.Value(), &reward)
+		rewards = append(rewards, reward)
+	}
+	return rewards
+}
+
+// SetFeeHistoryEntry stores the fee history of a block in the slot of its height in the
+// ring buffer of the given size, replacing the oldest entry.
+func (k Keeper) SetFeeHistoryEntry(ctx sdk.Context, entry types.FeeHistoryEntry, size uint32) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFeeHistory)
+	store.Set(feeHistorySlot(entry.Height, size), k.cdc.MustMarshal(&entry))
+}
+
+// GetFeeHistoryEntry returns the fee history of the block at the given height, if it's
+// still in the ring buffer.
+func (k Keeper) GetFeeHistoryEntry(ctx sdk.Context, height int64) (types.FeeHistoryEntry, bool) {
+	size := k.GetParams(ctx).FeeHistorySize
+	if size == 0 || height <= 0 {
+		return types.FeeHistoryEntry{}, false
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFeeHistory)
+	bz := store.Get(feeHistorySlot(height, size))
+	if len(bz) == 0 {
+		return types.FeeHistoryEntry{}, false
+	}
+	var entry types.FeeHistoryEntry
+	k.cdc.MustUnmarshal(bz, &entry)
+	// the slot may hold an older block, or a block of a different ring size
+	if entry.Height != height {
+		return types.FeeHistoryEntry{}, false
+	}
+	return entry, true
+}
+
+// recordFeeHistory records the fee history of the current block, if enabled.
+func (k Keeper) recordFeeHistory(ctx sdk.Context, gasWanted, gasUsed uint64, nextBaseFee *big.Int) {
+	params := k.GetParams(ctx)
+	if params.FeeHistorySize == 0 {
+		return
+	}
+
+	gasLimit := ethermint.BlockGasLimit(ctx)
+	if gasLimit == 0 || gasLimit == ^uint64(0) {
+		// the gas limit of the blocks without limit is reported as max uint32 by the
+		// JSON-RPC
+		gasLimit = uint64(^uint32(0))
+	}
+	baseFee := sdkmath.ZeroInt()
+	if fee := k.GetBaseFee(ctx); fee != nil {
+		baseFee = sdkmath.NewIntFromBigInt(fee)
+	}
+	next := sdkmath.ZeroInt()
+	if nextBaseFee != nil {
+		next = sdkmath.NewIntFromBigInt(nextBaseFee)
+	}
+
+	k.SetFeeHistoryEntry(ctx, types.FeeHistoryEntry{
+		Height:      ctx.BlockHeight(),
+		BaseFee:     baseFee,
+		GasWanted:   gasWanted,
+		GasUsed:     gasUsed,
+		GasLimit:    gasLimit,
+		NextBaseFee: next,
+		TxRewards:   types.SortTxRewards(k.GetTransientTxRewards(ctx)),
+	}, params.FeeHistorySize)
+}
+
+func feeHistorySlot(height int64, size uint32) []byte {
+	return sdk.Uint64ToBigEndian(uint64(height) % uint64(size))
+}
@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/evmos/ethermint/testutil"
+	"github.com/evmos/ethermint/x/feemarket/types"
+)
+
+type FeeHistoryTestSuite struct {
+	testutil.BaseTestSuite
+}
+
+func TestFeeHistoryTestSuite(t *testing.T) {
+	suite.Run(t, new(FeeHistoryTestSuite))
+}
+
+func (suite *FeeHistoryTestSuite) endBlock(height int64, gasUsed uint64, tips ...int64) {
+	ctx := suite.Ctx.WithBlockHeight(height).
+		WithConsensusParams(tmproto.ConsensusParams{Block: &tmproto.BlockParams{MaxGas: 1000}}).
+		WithBlockGasMeter(storetypes.NewGasMeter(1000))
+	ctx.BlockGasMeter().ConsumeGas(gasUsed, "test")
+	for i, tip := range tips {
+		suite.App.FeeMarketKeeper.AddTransientTxReward(ctx, common.BigToHash(big.NewInt(int64(i))), gasUsed/uint64(len(tips)), big.NewInt(tip))
+	}
+	suite.Require().NoError(suite.App.FeeMarketKeeper.EndBlock(ctx))
+}
+
+func (suite *FeeHistoryTestSuite) TestFeeHistoryRingBuffer() {
+	k := suite.App.FeeMarketKeeper
+	params := k.GetParams(suite.Ctx)
+	params.FeeHistorySize = 3
+	suite.Require().NoError(k.SetParams(suite.Ctx, params))
+
+	for height := int64(1); height <= 5; height++ {
+		suite.endBlock(height, uint64(100*height), 1, 2)
+	}
+
+	for height := int64(1); height <= 2; height++ {
+		_, found := k.GetFeeHistoryEntry(suite.Ctx, height)
+		suite.Require().False(found, "height %d should be evicted", height)
+	}
+	for height := int64(3); height <= 5; height++ {
+		entry, found := k.GetFeeHistoryEntry(suite.Ctx, height)
+		suite.Require().True(found)
+		suite.Require().Equal(height, entry.Height)
+		suite.Require().Equal(uint64(100*height), entry.GasUsed)
+		suite.Require().Equal(uint64(1000), entry.GasLimit)
+		suite.Require().Equal(params.BaseFee, entry.BaseFee)
+		suite.Require().Len(entry.TxRewards, 2)
+		suite.Require().Equal(sdkmath.NewInt(1), entry.RewardAt(0))
+		suite.Require().Equal(sdkmath.NewInt(1), entry.RewardAt(50))
+		suite.Require().Equal(sdkmath.NewInt(2), entry.RewardAt(50.5))
+		suite.Require().Equal(sdkmath.NewInt(2), entry.RewardAt(100))
+	}
+
+	// the last entry records the base fee of the next block
+	last, _ := k.GetFeeHistoryEntry(suite.Ctx, 5)
+	nextCtx := suite.Ctx.WithBlockHeight(6).
+		WithConsensusParams(tmproto.ConsensusParams{Block: &tmproto.BlockParams{MaxGas: 1000}})
+	suite.Require().Equal(sdkmath.NewIntFromBigInt(k.CalculateBaseFee(nextCtx)), last.NextBaseFee)
+
+	res, err := k.FeeHistory(suite.Ctx, &types.QueryFeeHistoryRequest{FromHeight: 1, ToHeight: 5})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Entries, 3)
+	suite.Require().Equal(int64(3), res.Entries[0].Height)
+	suite.Require().Equal(int64(5), res.Entries[2].Height)
+
+	_, err = k.FeeHistory(suite.Ctx, &types.QueryFeeHistoryRequest{FromHeight: 5, ToHeight: 1})
+	suite.Require().Error(err)
+
+	// resizing the ring restarts the history, the slots of the blocks don't match
+	params.FeeHistorySize = 4
+	suite.Require().NoError(k.SetParams(suite.Ctx, params))
+	for height := int64(3); height <= 5; height++ {
+		_, found := k.GetFeeHistoryEntry(suite.Ctx, height)
+		suite.Require().False(found, "height %d", height)
+	}
+	suite.endBlock(6, 100)
+	_, found := k.GetFeeHistoryEntry(suite.Ctx, 6)
+	suite.Require().True(found)
+}
+
+func (suite *FeeHistoryTestSuite) TestFeeHistoryDisabled() {
+	k := suite.App.FeeMarketKeeper
+	params := k.GetParams(suite.Ctx)
+	params.FeeHistorySize = 0
+	suite.Require().NoError(k.SetParams(suite.Ctx, params))
+
+	suite.endBlock(1, 100)
+	_, found := k.GetFeeHistoryEntry(suite.Ctx, 1)
+	suite.Require().False(found)
+}
@@ -0,0 +1,86 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/ethermint/x/feemarket/types"
+)
+
+// WithFeeTokenOracle returns a copy of the keeper reading the rates of the fee tokens
+// priced by an oracle from the given one.
+func (k Keeper) WithFeeTokenOracle(oracle types.FeeTokenOracle) Keeper {
+	k.oracle = oracle
+	return k
+}
+
+// GetFeeTokenPayment returns the payment of the gas in the given fee token at its current
+// price, including the markup.
+func (k Keeper) GetFeeTokenPayment(ctx sdk.Context, denom string) (types.FeePayment, error) {
+	token, found := k.GetParams(ctx).GetFeeToken(denom)
+	if !found {
+		return types.FeePayment{}, errorsmod.Wrapf(types.ErrUnknownFeeToken, "denom %s", denom)
+	}
+
+	rate, err := k.getFeeTokenRate(ctx, token)
+	if err != nil {
+		return types.FeePayment{}, err
+	}
+	return types.NewFeePayment(denom, token.Price(rate)), nil
+}
+
+func (k Keeper) getFeeTokenRate(ctx sdk.Context, token types.FeeToken) (sdkmath.LegacyDec, error) {
+	if token.PriceSource == types.FEE_TOKEN_PRICE_SOURCE_GOVERNANCE {
+		return token.Rate, nil
+	}
+	if k.oracle == nil {
+		return sdkmath.LegacyDec{}, errorsmod.Wrapf(types.ErrInvalidFeeTokenRate, "no oracle for fee token %s", token.Denom)
+	}
+	rate, err := k.oracle.GetFeeTokenRate(ctx, token.Denom)
+	if err != nil {
+		return sdkmath.LegacyDec{}, errorsmod.Wrapf(types.ErrInvalidFeeTokenRate, "fee token %s: %s", token.Denom, err)
+	}
+	if rate.IsNil() || !rate.IsPositive() {
+		return sdkmath.LegacyDec{}, errorsmod.Wrapf(types.ErrInvalidFeeTokenRate, "fee token %s: non positive rate %s", token.Denom, rate)
+	}
+	return rate, nil
+}
+
+// SetTransientFeePayment records the fee token paying for the gas of the ethereum
+// transaction with the given hash, so that its leftover gas is refunded in the same
+// token at the same price.
+func (k Keeper) SetTransientFeePayment(ctx sdk.Context, txHash common.Hash, payment types.FeePayment) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientFeePayment)
+	store.Set(txHash.Bytes(), k.cdc.MustMarshal(&payment))
+}
+
+// GetTransientFeePayment returns the fee token paying for the gas of the ethereum
+// transaction with the given hash, if it isn't paid with the evm denom.
+func (k Keeper) GetTransientFeePayment(ctx sdk.Context, txHash common.Hash) (types.FeePayment, bool) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientFeePayment)
+	bz := store.Get(txHash.Bytes())
+	if len(bz) == 0 {
+		return types.FeePayment{}, false
+	}
+	var payment types.FeePayment
+	k.cdc.MustUnmarshal(bz, &payment)
+	return payment, true
+}
@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/evmos/ethermint/testutil"
+	"github.com/evmos/ethermint/x/feemarket/types"
+)
+
+type FeeTokenTestSuite struct {
+	testutil.BaseTestSuite
+}
+
+func TestFeeTokenTestSuite(t *testing.T) {
+	suite.Run(t, new(FeeTokenTestSuite))
+}
+
+type mockFeeTokenOracle map[string]sdkmath.LegacyDec
+
+func (o mockFeeTokenOracle) GetFeeTokenRate(_ sdk.Context, denom string) (sdkmath.LegacyDec, error) {
+	rate, ok := o[denom]
+	if !ok {
+		return sdkmath.LegacyDec{}, errors.New("no rate")
+	}
+	return rate, nil
+}
+
+func (suite *FeeTokenTestSuite) TestGetFeeTokenPayment() {
+	params := types.DefaultParams()
+	params.FeeTokens = []types.FeeToken{
+		{Denom: "ibc/usdc", Rate: sdkmath.LegacyNewDec(2), Markup: sdkmath.LegacyNewDecWithPrec(5, 2)},
+		{Denom: "ibc/atom", PriceSource: types.FEE_TOKEN_PRICE_SOURCE_ORACLE, Markup: sdkmath.LegacyZeroDec()},
+		{Denom: "ibc/osmo", PriceSource: types.FEE_TOKEN_PRICE_SOURCE_ORACLE, Markup: sdkmath.LegacyZeroDec()},
+	}
+	suite.Require().NoError(suite.App.FeeMarketKeeper.SetParams(suite.Ctx, params))
+	oracle := mockFeeTokenOracle{"ibc/atom": sdkmath.LegacyNewDecWithPrec(5, 1)}
+
+	testCases := []struct {
+		name     string
+		denom    string
+		oracle   types.FeeTokenOracle
+		expPrice sdkmath.LegacyDec
+		expErr   error
+	}{
+		{"governance rate with markup", "ibc/usdc", nil, sdkmath.LegacyNewDecWithPrec(21, 1), nil},
+		{"oracle rate", "ibc/atom", oracle, sdkmath.LegacyNewDecWithPrec(5, 1), nil},
+		{"no oracle", "ibc/atom", nil, sdkmath.LegacyDec{}, types.ErrInvalidFeeTokenRate},
+		{"no oracle rate", "ibc/osmo", oracle, sdkmath.LegacyDec{}, types.ErrInvalidFeeTokenRate},
+		{"unknown token", "ibc/juno", oracle, sdkmath.LegacyDec{}, types.ErrUnknownFeeToken},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			k := suite.App.FeeMarketKeeper.WithFeeTokenOracle(tc.oracle)
+			payment, err := k.GetFeeTokenPayment(suite.Ctx, tc.denom)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.denom, payment.Denom)
+			suite.Require().Equal(tc.expPrice, payment.Price)
+		})
+	}
+
+	k := suite.App.FeeMarketKeeper.WithFeeTokenOracle(oracle)
+	res, err := k.FeeTokenPrices(suite.Ctx, &types.QueryFeeTokenPricesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Prices, 2)
+}
+
+func (suite *FeeTokenTestSuite) TestTransientFeePayment() {
+	txHash := common.HexToHash("0x01")
+	_, found := suite.App.FeeMarketKeeper.GetTransientFeePayment(suite.Ctx, txHash)
+	suite.Require().False(found)
+
+	payment := types.NewFeePayment("ibc/usdc", sdkmath.LegacyNewDec(2))
+	suite.App.FeeMarketKeeper.SetTransientFeePayment(suite.Ctx, txHash, payment)
+	res, found := suite.App.FeeMarketKeeper.GetTransientFeePayment(suite.Ctx, txHash)
+	suite.Require().True(found)
+	suite.Require().Equal(payment, res)
+}
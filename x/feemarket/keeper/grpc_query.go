@@ -20,6 +20,8 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/evmos/ethermint/x/feemarket/types"
 )
@@ -51,6 +53,19 @@ func (k Keeper) BaseFee(c context.Context, _ *types.QueryBaseFeeRequest) (*types
 	return res, nil
 }
 
+// NextBaseFee implements the Query/NextBaseFee gRPC method
+func (k Keeper) NextBaseFee(c context.Context, _ *types.QueryNextBaseFeeRequest) (*types.QueryNextBaseFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res := &types.QueryNextBaseFeeResponse{}
+	if nextBaseFee := k.GetNextBaseFee(ctx); nextBaseFee != nil {
+		aux := sdkmath.NewIntFromBigInt(nextBaseFee)
+		res.BaseFee = &aux
+	}
+
+	return res, nil
+}
+
 // BlockGas implements the Query/BlockGas gRPC method
 func (k Keeper) BlockGas(c context.Context, _ *types.QueryBlockGasRequest) (*types.QueryBlockGasResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -60,3 +75,79 @@ func (k Keeper) BlockGas(c context.Context, _ *types.QueryBlockGasRequest) (*typ
 		Gas: int64(gas),
 	}, nil
 }
+
+// BlobBaseFee implements the Query/BlobBaseFee gRPC method
+func (k Keeper) BlobBaseFee(c context.Context, _ *types.QueryBlobBaseFeeRequest) (*types.QueryBlobBaseFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res := &types.QueryBlobBaseFeeResponse{
+		ExcessBlobGas: k.GetExcessBlobGas(ctx),
+	}
+	if blobBaseFee := k.GetBlobBaseFee(ctx); blobBaseFee != nil {
+		aux := sdkmath.NewIntFromBigInt(blobBaseFee)
+		res.BlobBaseFee = &aux
+	}
+
+	return res, nil
+}
+
+// FeeTokenPrices implements the Query/FeeTokenPrices gRPC method
+func (k Keeper) FeeTokenPrices(c context.Context, _ *types.QueryFeeTokenPricesRequest) (*types.QueryFeeTokenPricesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res := &types.QueryFeeTokenPricesResponse{}
+	for _, token := range k.GetParams(ctx).FeeTokens {
+		payment, err := k.GetFeeTokenPayment(ctx, token.Denom)
+		if err != nil {
+			// the oracle has no rate for the token, it can't pay for gas
+			continue
+		}
+		res.Prices = append(res.Prices, payment)
+	}
+
+	return res, nil
+}
+
+// BlockFees implements the Query/BlockFees gRPC method
+func (k Keeper) BlockFees(c context.Context, req *types.QueryBlockFeesRequest) (*types.QueryBlockFeesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	height := req.Height
+	if height == 0 {
+		height = ctx.BlockHeight()
+	}
+	fees, found := k.GetBlockFees(ctx, height)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no fees recorded at height %d", height)
+	}
+
+	return &types.QueryBlockFeesResponse{BlockFees: fees}, nil
+}
+
+// FeeHistory implements the Query/FeeHistory gRPC method
+func (k Keeper) FeeHistory(c context.Context, req *types.QueryFeeHistoryRequest) (*types.QueryFeeHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.FromHeight > req.ToHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid range %d > %d", req.FromHeight, req.ToHeight)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// only the last FeeHistorySize blocks are kept
+	fromHeight := req.FromHeight
+	if oldest := req.ToHeight - int64(k.GetParams(ctx).FeeHistorySize) + 1; fromHeight < oldest {
+		fromHeight = oldest
+	}
+	res := &types.QueryFeeHistoryResponse{}
+	for height := fromHeight; height <= req.ToHeight; height++ {
+		if entry, found := k.GetFeeHistoryEntry(ctx, height); found {
+			res.Entries = append(res.Entries, entry)
+		}
+	}
+
+	return res, nil
+}
@@ -40,6 +40,11 @@ type Keeper struct {
 	transientKey storetypes.StoreKey
 	// the address capable of executing a MsgUpdateParams message. Typically, this should be the x/gov module account.
 	authority sdk.AccAddress
+	// oracle provides the rates of the fee tokens priced by an oracle
+	oracle types.FeeTokenOracle
+	// keepers used to distribute the fees of the ethereum transactions
+	bankKeeper  types.BankKeeper
+	distrKeeper types.DistributionKeeper
 }
 
 // NewKeeper generates new fee market module keeper
@@ -48,6 +53,8 @@ func NewKeeper(
 	storeService corestoretypes.KVStoreService,
 	authority sdk.AccAddress,
 	storeKey, transientKey storetypes.StoreKey,
+	bankKeeper types.BankKeeper,
+	distrKeeper types.DistributionKeeper,
 ) Keeper {
 	// ensure authority account is correctly formatted
 	if err := sdk.VerifyAddressFormat(authority); err != nil {
@@ -60,6 +67,8 @@ func NewKeeper(
 		storeKey:     storeKey,
 		authority:    authority,
 		transientKey: transientKey,
+		bankKeeper:   bankKeeper,
+		distrKeeper:  distrKeeper,
 	}
 }
 
@@ -0,0 +1,121 @@
+package simulation
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/x/feemarket/types"
+)
+
+const (
+	simGasLimit = 10_000_000
+	simBlocks   = 600
+)
+
+// simEquilibrium is the base fee at which the expected demand equals the gas target.
+var simEquilibrium = big.NewInt(1_000_000_000)
+
+// demand returns the gas wanted by the users at the given base fee, inversely
+// proportional to it and scaled by a random burst factor, as seen on chains with small
+// blocks.
+func demand(r *rand.Rand, p types.Params, baseFee *big.Int, burst float64) uint64 {
+	target := float64(simGasLimit / p.ElasticityMultiplier)
+	ratio, _ := new(big.Float).Quo(new(big.Float).SetInt(simEquilibrium), new(big.Float).SetInt(baseFee)).Float64()
+	gas := target * ratio * (1 - burst + 2*burst*r.Float64())
+	return uint64(math.Min(gas, simGasLimit))
+}
+
+// simulate runs the base fee algorithm of the params over simBlocks blocks starting
+// from the given base fee, and returns the base fee of each block.
+func simulate(p types.Params, initial *big.Int, burst float64) []float64 {
+	r := rand.New(rand.NewSource(1))
+	baseFee := initial
+	history := types.BaseFeeHistory{}
+	fees := make([]float64, simBlocks)
+	for i := range fees {
+		gas := demand(r, p, baseFee, burst)
+		history = p.UpdateBaseFeeHistory(history, gas, simGasLimit)
+		baseFee = p.CalcBaseFee(baseFee, simGasLimit, history)
+		fees[i], _ = new(big.Float).SetInt(baseFee).Float64()
+	}
+	return fees
+}
+
+// stats returns the mean of the base fees relative to the equilibrium and their
+// coefficient of variation.
+func stats(fees []float64) (mean, variation float64) {
+	for _, fee := range fees {
+		mean += fee
+	}
+	mean /= float64(len(fees))
+	var variance float64
+	for _, fee := range fees {
+		variance += (fee - mean) * (fee - mean)
+	}
+	variance /= float64(len(fees))
+	equilibrium, _ := new(big.Float).SetInt(simEquilibrium).Float64()
+	return mean / equilibrium, math.Sqrt(variance) / mean
+}
+
+// jitter returns the root mean square of the relative change of the base fee between
+// consecutive blocks.
+func jitter(fees []float64) float64 {
+	var sum float64
+	for i := 1; i < len(fees); i++ {
+		change := (fees[i] - fees[i-1]) / fees[i-1]
+		sum += change * change
+	}
+	return math.Sqrt(sum / float64(len(fees)-1))
+}
+
+func algorithmParams(algorithm types.BaseFeeAlgorithm) types.Params {
+	p := types.DefaultParams()
+	p.BaseFeeAlgorithm = algorithm
+	return p
+}
+
+// TestBaseFeeConvergence checks that each algorithm brings the base fee from far below and
+// far above to the equilibrium of a steady demand, and stays there.
+func TestBaseFeeConvergence(t *testing.T) {
+	for _, algorithm := range []types.BaseFeeAlgorithm{
+		types.BASE_FEE_ALGORITHM_EIP1559,
+		types.BASE_FEE_ALGORITHM_AIMD,
+		types.BASE_FEE_ALGORITHM_MOVING_WINDOW,
+	} {
+		for _, initial := range []*big.Int{big.NewInt(10_000_000), big.NewInt(100_000_000_000)} {
+			p := algorithmParams(algorithm)
+			require.NoError(t, p.Validate())
+
+			fees := simulate(p, initial, 0)
+			mean, variation := stats(fees[simBlocks-100:])
+			t.Logf("%s from %s: mean %.3f, variation %.4f", algorithm, initial, mean, variation)
+			require.InDelta(t, 1, mean, 0.02, algorithm.String())
+			require.Less(t, variation, 0.01, algorithm.String())
+		}
+	}
+}
+
+// TestBaseFeeOscillation checks that with a bursty demand around the equilibrium, the
+// AIMD and moving window algorithms keep the base fee around it while changing it much
+// less from block to block than EIP-1559.
+func TestBaseFeeOscillation(t *testing.T) {
+	jitters := make(map[types.BaseFeeAlgorithm]float64)
+	for _, algorithm := range []types.BaseFeeAlgorithm{
+		types.BASE_FEE_ALGORITHM_EIP1559,
+		types.BASE_FEE_ALGORITHM_AIMD,
+		types.BASE_FEE_ALGORITHM_MOVING_WINDOW,
+	} {
+		fees := simulate(algorithmParams(algorithm), simEquilibrium, 0.5)
+		mean, variation := stats(fees[simBlocks/2:])
+		jitters[algorithm] = jitter(fees[simBlocks/2:])
+		t.Logf("%s: mean %.3f, variation %.4f, jitter %.4f", algorithm, mean, variation, jitters[algorithm])
+		require.InDelta(t, 1, mean, 0.05, algorithm.String())
+	}
+
+	require.Less(t, jitters[types.BASE_FEE_ALGORITHM_AIMD], jitters[types.BASE_FEE_ALGORITHM_EIP1559]/2)
+	require.Less(t, jitters[types.BASE_FEE_ALGORITHM_MOVING_WINDOW], jitters[types.BASE_FEE_ALGORITHM_EIP1559]/2)
+}
@@ -18,6 +18,9 @@ func RandomizedGenState(simState *module.SimulationState) {
 		simState.Rand.Int63(),
 		sdkmath.LegacyZeroDec(),
 		types.DefaultMinGasMultiplier)
+	params.BaseFeeAlgorithm = types.BaseFeeAlgorithm(simState.Rand.Intn(len(types.BaseFeeAlgorithm_name)))
+	params.LearningRate = types.DefaultLearningRate
+	params.WindowSize = uint32(simState.Rand.Intn(100)) + 1
 
 	blockGas := simState.Rand.Uint64()
 	feemarketGenesis := types.NewGenesisState(params, blockGas)
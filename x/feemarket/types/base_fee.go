@@ -0,0 +1,217 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+)
+
+// MaxWindowSize bounds the number of blocks averaged by the moving window algorithm.
+const MaxWindowSize = 1000
+
+var (
+	// DefaultLearningRate is 0.125, the maximum change of EIP-1559
+	DefaultLearningRate = sdkmath.LegacyNewDecWithPrec(125, 3)
+	// DefaultWindowSize is 10 blocks
+	DefaultWindowSize = uint32(10)
+
+	// aimdIncrease is the fraction of the learning rate param added to the current
+	// learning rate when the gas is far from the target.
+	aimdIncrease = sdkmath.LegacyNewDecWithPrec(125, 3)
+	// aimdDecrease is the factor the current learning rate is multiplied by when the gas
+	// is close to the target.
+	aimdDecrease = sdkmath.LegacyNewDecWithPrec(9, 1)
+	// aimdMinRate is the fraction of the learning rate param the current learning rate
+	// can't decrease below.
+	aimdMinRate = sdkmath.LegacyNewDecWithPrec(1, 1)
+	// aimdTolerance is the deviation of the gas from the target, relative to the target,
+	// above which the gas is far from the target.
+	aimdTolerance = sdkmath.LegacyNewDecWithPrec(5, 1)
+)
+
+// CalcBaseFee returns the base fee of the next block computed by the algorithm of the
+// params, from the base fee of the parent block, the gas limit of the blocks and the
+// history of the previous blocks, the parent gas being the last of history.GasUsed.
+// It returns nil if the gas target overflows.
+func (p Params) CalcBaseFee(parentBaseFee *big.Int, gasLimit uint64, history BaseFeeHistory) *big.Int {
+	var parentGasUsed uint64
+	if len(history.GasUsed) > 0 {
+		parentGasUsed = history.GasUsed[len(history.GasUsed)-1]
+	}
+
+	switch p.BaseFeeAlgorithm {
+	case BASE_FEE_ALGORITHM_AIMD:
+		return p.calcAIMDBaseFee(parentBaseFee, parentGasUsed, gasLimit, p.currentLearningRate(history))
+	case BASE_FEE_ALGORITHM_MOVING_WINDOW:
+		return p.CalcEIP1559BaseFee(parentBaseFee, averageGas(history.GasUsed), gasLimit)
+	default:
+		return p.CalcEIP1559BaseFee(parentBaseFee, parentGasUsed, gasLimit)
+	}
+}
+
+// UpdateBaseFeeHistory returns the history of the base fee algorithm of the params once
+// a block with the given gas used is appended to it.
+func (p Params) UpdateBaseFeeHistory(history BaseFeeHistory, gasUsed, gasLimit uint64) BaseFeeHistory {
+	switch p.BaseFeeAlgorithm {
+	case BASE_FEE_ALGORITHM_AIMD:
+		history.LearningRate = p.nextLearningRate(p.currentLearningRate(history), gasUsed, gasLimit)
+		history.GasUsed = []uint64{gasUsed}
+	case BASE_FEE_ALGORITHM_MOVING_WINDOW:
+		history.GasUsed = append(history.GasUsed, gasUsed)
+		if extra := len(history.GasUsed) - int(p.WindowSize); extra > 0 {
+			history.GasUsed = append([]uint64(nil), history.GasUsed[extra:]...)
+		}
+	default:
+		history.GasUsed = []uint64{gasUsed}
+	}
+	return history
+}
+
+// CalcEIP1559BaseFee returns the base fee following the EIP-1559 formula, bounded below
+// by the min gas price when decreasing.
+// NOTE: This code is inspired from the go-ethereum EIP1559 implementation and adapted to Cosmos SDK-based
+// chains. For the canonical code refer to: https://github.com/ethereum/go-ethereum/blob/master/consensus/misc/eip1559.go
+func (p Params) CalcEIP1559BaseFee(parentBaseFee *big.Int, parentGasUsed, gasLimit uint64) *big.Int {
+	// CONTRACT: ElasticityMultiplier cannot be 0 as it's checked in the params
+	// validation
+	parentGasTargetBig := new(big.Int).Div(new(big.Int).SetUint64(gasLimit), new(big.Int).SetUint64(uint64(p.ElasticityMultiplier)))
+	if !parentGasTargetBig.IsUint64() {
+		return nil
+	}
+
+	parentGasTarget := parentGasTargetBig.Uint64()
+	baseFeeChangeDenominator := new(big.Int).SetUint64(uint64(p.BaseFeeChangeDenominator))
+
+	// If the parent gasUsed is the same as the target, the baseFee remains
+	// unchanged.
+	if parentGasUsed == parentGasTarget {
+		return new(big.Int).Set(parentBaseFee)
+	}
+
+	if parentGasUsed > parentGasTarget {
+		// If the parent block used more gas than its target, the baseFee should
+		// increase.
+		gasUsedDelta := new(big.Int).SetUint64(parentGasUsed - parentGasTarget)
+		x := new(big.Int).Mul(parentBaseFee, gasUsedDelta)
+		y := x.Div(x, parentGasTargetBig)
+		baseFeeDelta := math.BigMax(
+			x.Div(y, baseFeeChangeDenominator),
+			common.Big1,
+		)
+
+		return x.Add(parentBaseFee, baseFeeDelta)
+	}
+
+	// Otherwise if the parent block used less gas than its target, the baseFee
+	// should decrease.
+	gasUsedDelta := new(big.Int).SetUint64(parentGasTarget - parentGasUsed)
+	x := new(big.Int).Mul(parentBaseFee, gasUsedDelta)
+	y := x.Div(x, parentGasTargetBig)
+	baseFeeDelta := x.Div(y, baseFeeChangeDenominator)
+
+	// Set global min gas price as lower bound of the base fee, transactions below
+	// the min gas price don't even reach the mempool.
+	return math.BigMax(x.Sub(parentBaseFee, baseFeeDelta), p.minBaseFee())
+}
+
+// calcAIMDBaseFee multiplies the base fee by 1 + learningRate * deviation, the deviation
+// being the difference between the gas and its target relative to the target.
+func (p Params) calcAIMDBaseFee(parentBaseFee *big.Int, parentGasUsed, gasLimit uint64, learningRate sdkmath.LegacyDec) *big.Int {
+	deviation, ok := p.gasDeviation(parentGasUsed, gasLimit)
+	if !ok {
+		return nil
+	}
+
+	factor := sdkmath.LegacyOneDec().Add(learningRate.Mul(deviation))
+	baseFee := factor.MulInt(sdkmath.NewIntFromBigInt(parentBaseFee)).TruncateInt().BigInt()
+	if deviation.IsPositive() {
+		// the base fee must increase even if it's too small for the factor to round up
+		baseFee = math.BigMax(baseFee, new(big.Int).Add(parentBaseFee, common.Big1))
+	}
+	return math.BigMax(baseFee, p.minBaseFee())
+}
+
+// nextLearningRate increases the learning rate additively when the gas is far from its
+// target, so that the base fee catches up with the demand, and decreases it
+// multiplicatively when it's close, so that the base fee stops oscillating.
+func (p Params) nextLearningRate(learningRate sdkmath.LegacyDec, gasUsed, gasLimit uint64) sdkmath.LegacyDec {
+	deviation, ok := p.gasDeviation(gasUsed, gasLimit)
+	if !ok {
+		return learningRate
+	}
+
+	if deviation.Abs().GT(aimdTolerance) {
+		return sdkmath.LegacyMinDec(learningRate.Add(p.LearningRate.Mul(aimdIncrease)), p.LearningRate)
+	}
+	return sdkmath.LegacyMaxDec(learningRate.Mul(aimdDecrease), p.LearningRate.Mul(aimdMinRate))
+}
+
+func (p Params) currentLearningRate(history BaseFeeHistory) sdkmath.LegacyDec {
+	if history.LearningRate.IsNil() {
+		return p.LearningRate
+	}
+	return history.LearningRate
+}
+
+// gasDeviation returns (gasUsed - target) / target, false if the target is zero.
+func (p Params) gasDeviation(gasUsed, gasLimit uint64) (sdkmath.LegacyDec, bool) {
+	target := gasLimit / uint64(p.ElasticityMultiplier)
+	if target == 0 {
+		return sdkmath.LegacyDec{}, false
+	}
+	targetDec := sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(target))
+	return sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(gasUsed)).Sub(targetDec).Quo(targetDec), true
+}
+
+func (p Params) minBaseFee() *big.Int {
+	return p.MinGasPrice.TruncateInt().BigInt()
+}
+
+func averageGas(gasUsed []uint64) uint64 {
+	if len(gasUsed) == 0 {
+		return 0
+	}
+	sum := new(big.Int)
+	for _, gas := range gasUsed {
+		sum.Add(sum, new(big.Int).SetUint64(gas))
+	}
+	return sum.Div(sum, big.NewInt(int64(len(gasUsed)))).Uint64()
+}
+
+func validateBaseFeeAlgorithm(p Params) error {
+	switch p.BaseFeeAlgorithm {
+	case BASE_FEE_ALGORITHM_EIP1559:
+	case BASE_FEE_ALGORITHM_AIMD:
+		if p.ElasticityMultiplier == 0 {
+			return fmt.Errorf("elasticity multiplier cannot be 0 with the AIMD base fee algorithm")
+		}
+		if p.LearningRate.IsNil() || !p.LearningRate.IsPositive() || p.LearningRate.GT(sdkmath.LegacyOneDec()) {
+			return fmt.Errorf("learning rate must be in (0, 1]: %s", p.LearningRate)
+		}
+	case BASE_FEE_ALGORITHM_MOVING_WINDOW:
+		if p.WindowSize == 0 || p.WindowSize > MaxWindowSize {
+			return fmt.Errorf("window size must be in [1, %d]: %d", MaxWindowSize, p.WindowSize)
+		}
+	default:
+		return fmt.Errorf("invalid base fee algorithm %d", p.BaseFeeAlgorithm)
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+const (
+	codeErrUnknownFeeToken = uint32(iota) + 2 // NOTE: code 1 is reserved for internal errors
+	codeErrInvalidFeeTokenRate
+)
+
+var (
+	// ErrUnknownFeeToken returns an error if a denom is not a fee token of the params.
+	ErrUnknownFeeToken = errorsmod.Register(ModuleName, codeErrUnknownFeeToken, "unknown fee token")
+
+	// ErrInvalidFeeTokenRate returns an error if the rate of a fee token can't be determined.
+	ErrInvalidFeeTokenRate = errorsmod.Register(ModuleName, codeErrInvalidFeeTokenRate, "invalid fee token rate")
+)
@@ -17,7 +17,13 @@ package types
 
 // feemarket module events
 const (
-	EventTypeFeeMarket = "fee_market"
+	EventTypeFeeMarket       = "fee_market"
+	EventTypeFeeDistribution = "fee_distribution"
 
-	AttributeKeyBaseFee = "base_fee"
+	AttributeKeyBaseFee       = "base_fee"
+	AttributeKeyBlobBaseFee   = "blob_base_fee"
+	AttributeKeyBurned        = "burned"
+	AttributeKeyPriorityFees  = "priority_fees"
+	AttributeKeyCommunityPool = "community_pool"
+	AttributeKeyProposer      = "proposer"
 )
@@ -0,0 +1,70 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"context"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BlockFeesRetention is the number of blocks the fees are kept in the store for.
+const BlockFeesRetention = 1024
+
+// BankKeeper defines the bank methods needed to distribute the fees.
+type BankKeeper interface {
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+}
+
+// DistributionKeeper defines the distribution methods needed to fund the community pool.
+type DistributionKeeper interface {
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
+// GetBaseFeeBurnFraction returns the fraction of the base fee burned, zero if unset.
+func (p Params) GetBaseFeeBurnFraction() sdkmath.LegacyDec {
+	if p.BaseFeeBurnFraction.IsNil() {
+		return sdkmath.LegacyZeroDec()
+	}
+	return p.BaseFeeBurnFraction
+}
+
+func validateBaseFeeBurnFraction(v sdkmath.LegacyDec) error {
+	// unset on the chains upgraded from params without the field
+	if v.IsNil() {
+		return nil
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("base fee burn fraction cannot be negative: %s", v)
+	}
+	if v.GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("base fee burn fraction cannot be greater than 1: %s", v)
+	}
+	return nil
+}
+
+// SplitBaseFees returns the part of the base fees burned given the burn fraction,
+// rounding down, and the remainder.
+func (f BlockFees) SplitBaseFees(burnFraction sdkmath.LegacyDec) (burned, remainder sdk.Coins) {
+	for _, coin := range f.BaseFees {
+		burned = burned.Add(sdk.NewCoin(coin.Denom, burnFraction.MulInt(coin.Amount).TruncateInt()))
+	}
+	return burned, f.BaseFees.Sub(burned...)
+}
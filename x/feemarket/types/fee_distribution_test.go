@@ -0,0 +1,22 @@
+package types
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitBaseFees(t *testing.T) {
+	fees := BlockFees{BaseFees: sdk.NewCoins(sdk.NewInt64Coin("aphoton", 999), sdk.NewInt64Coin("ibc/usdc", 3))}
+
+	burned, remainder := fees.SplitBaseFees(sdkmath.LegacyNewDecWithPrec(5, 1))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("aphoton", 499), sdk.NewInt64Coin("ibc/usdc", 1)), burned)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("aphoton", 500), sdk.NewInt64Coin("ibc/usdc", 2)), remainder)
+	require.Equal(t, fees.BaseFees, burned.Add(remainder...))
+
+	burned, remainder = fees.SplitBaseFees(sdkmath.LegacyZeroDec())
+	require.True(t, burned.IsZero())
+	require.Equal(t, fees.BaseFees, remainder)
+}
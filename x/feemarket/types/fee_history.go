@@ -0,0 +1,77 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"fmt"
+	"sort"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+const (
+	// DefaultFeeHistorySize is 100 blocks, the default fee history cap of the JSON-RPC
+	DefaultFeeHistorySize = uint32(100)
+	// MaxFeeHistorySize bounds the number of blocks whose fee history is kept.
+	MaxFeeHistorySize = 10000
+)
+
+// SortTxRewards returns the rewards of the transactions of a block sorted by increasing
+// tip per gas, merging the transactions paying the same tip, as recorded by the fee
+// history.
+func SortTxRewards(txRewards []TxReward) []TxReward {
+	sorted := append([]TxReward(nil), txRewards...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Reward.LT(sorted[j].Reward)
+	})
+
+	var merged []TxReward
+	for _, reward := range sorted {
+		if n := len(merged); n > 0 && merged[n-1].Reward.Equal(reward.Reward) {
+			merged[n-1].GasUsed += reward.GasUsed
+			continue
+		}
+		merged = append(merged, reward)
+	}
+	return merged
+}
+
+// RewardAt returns the tip per gas at the given percentile of the gas used by the block,
+// following the reward computation of eth_feeHistory: the reward at a percentile is the
+// tip of the transaction reaching that percentile of the block gas used, the
+// transactions being sorted by increasing tip. It's zero if the block has no ethereum
+// transaction.
+func (e FeeHistoryEntry) RewardAt(percentile float64) sdkmath.Int {
+	if len(e.TxRewards) == 0 {
+		return sdkmath.ZeroInt()
+	}
+
+	thresholdGasUsed := uint64(float64(e.GasUsed) * percentile / 100)
+	txIndex := 0
+	sumGasUsed := e.TxRewards[0].GasUsed
+	for sumGasUsed < thresholdGasUsed && txIndex < len(e.TxRewards)-1 {
+		txIndex++
+		sumGasUsed += e.TxRewards[txIndex].GasUsed
+	}
+	return e.TxRewards[txIndex].Reward
+}
+
+func validateFeeHistorySize(size uint32) error {
+	if size > MaxFeeHistorySize {
+		return fmt.Errorf("fee history size cannot be greater than %d: %d", MaxFeeHistorySize, size)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSortTxRewards(t *testing.T) {
+	require.Empty(t, SortTxRewards(nil))
+
+	sorted := SortTxRewards([]TxReward{
+		{GasUsed: 450, Reward: sdkmath.NewInt(30)},
+		{GasUsed: 100, Reward: sdkmath.NewInt(10)},
+		{GasUsed: 200, Reward: sdkmath.NewInt(20)},
+		{GasUsed: 250, Reward: sdkmath.NewInt(20)},
+	})
+	require.Equal(t, []TxReward{
+		{GasUsed: 100, Reward: sdkmath.NewInt(10)},
+		{GasUsed: 450, Reward: sdkmath.NewInt(20)},
+		{GasUsed: 450, Reward: sdkmath.NewInt(30)},
+	}, sorted)
+}
+
+func TestRewardAt(t *testing.T) {
+	entry := FeeHistoryEntry{GasUsed: 1000}
+	require.True(t, entry.RewardAt(50).IsZero())
+
+	// the cheapest transaction uses 10% of the block, the others 45%
+	entry.TxRewards = SortTxRewards([]TxReward{
+		{GasUsed: 450, Reward: sdkmath.NewInt(30)},
+		{GasUsed: 100, Reward: sdkmath.NewInt(10)},
+		{GasUsed: 450, Reward: sdkmath.NewInt(20)},
+	})
+	testCases := []struct {
+		percentile float64
+		expReward  int64
+	}{
+		{0, 10},
+		{10, 10},
+		{10.1, 20},
+		{37, 20},
+		{55, 20},
+		{55.5, 30},
+		{99.9, 30},
+		{100, 30},
+	}
+	for _, tc := range testCases {
+		require.Equal(t, sdkmath.NewInt(tc.expReward), entry.RewardAt(tc.percentile), tc.percentile)
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FeeTokenOracle provides the rates of the fee tokens whose price source is the oracle.
+type FeeTokenOracle interface {
+	// GetFeeTokenRate returns the amount of denom worth one unit of the evm denom.
+	GetFeeTokenRate(ctx sdk.Context, denom string) (sdkmath.LegacyDec, error)
+}
+
+// Validate performs a basic validation of the fee token.
+func (t FeeToken) Validate() error {
+	if err := sdk.ValidateDenom(t.Denom); err != nil {
+		return fmt.Errorf("invalid fee token denom: %w", err)
+	}
+	if _, ok := FeeTokenPriceSource_name[int32(t.PriceSource)]; !ok {
+		return fmt.Errorf("invalid price source %d for fee token %s", t.PriceSource, t.Denom)
+	}
+	if t.PriceSource == FEE_TOKEN_PRICE_SOURCE_GOVERNANCE && (t.Rate.IsNil() || !t.Rate.IsPositive()) {
+		return fmt.Errorf("rate of fee token %s must be positive: %s", t.Denom, t.Rate)
+	}
+	if !t.Rate.IsNil() && t.Rate.IsNegative() {
+		return fmt.Errorf("rate of fee token %s cannot be negative: %s", t.Denom, t.Rate)
+	}
+	if t.Markup.IsNil() || t.Markup.IsNegative() {
+		return fmt.Errorf("markup of fee token %s cannot be negative: %s", t.Denom, t.Markup)
+	}
+	return nil
+}
+
+// Price returns the amount of the token paid per unit of the evm denom, given its rate.
+func (t FeeToken) Price(rate sdkmath.LegacyDec) sdkmath.LegacyDec {
+	return rate.Mul(sdkmath.LegacyOneDec().Add(t.Markup))
+}
+
+func validateFeeTokens(tokens []FeeToken) error {
+	seen := make(map[string]bool, len(tokens))
+	for _, token := range tokens {
+		if err := token.Validate(); err != nil {
+			return err
+		}
+		if seen[token.Denom] {
+			return fmt.Errorf("duplicated fee token %s", token.Denom)
+		}
+		seen[token.Denom] = true
+	}
+	return nil
+}
+
+// NewFeePayment returns a FeePayment in the given denom at the given price.
+func NewFeePayment(denom string, price sdkmath.LegacyDec) FeePayment {
+	return FeePayment{Denom: denom, Price: price}
+}
+
+// ConvertFee converts an amount of the evm denom charged to the sender, rounding up.
+func (p FeePayment) ConvertFee(amount sdkmath.Int) sdk.Coin {
+	return sdk.NewCoin(p.Denom, p.Price.MulInt(amount).Ceil().TruncateInt())
+}
+
+// ConvertRefund converts an amount of the evm denom refunded to the sender, rounding
+// down.
+func (p FeePayment) ConvertRefund(amount sdkmath.Int) sdk.Coin {
+	return sdk.NewCoin(p.Denom, p.Price.MulInt(amount).TruncateInt())
+}
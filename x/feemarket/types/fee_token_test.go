@@ -0,0 +1,51 @@
+package types
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeeTokensValidate(t *testing.T) {
+	token := FeeToken{Denom: "ibc/usdc", Rate: sdkmath.LegacyNewDec(2), Markup: sdkmath.LegacyNewDecWithPrec(5, 2)}
+	oracle := FeeToken{Denom: "ibc/atom", PriceSource: FEE_TOKEN_PRICE_SOURCE_ORACLE, Markup: sdkmath.LegacyZeroDec()}
+
+	testCases := []struct {
+		name     string
+		tokens   []FeeToken
+		expError bool
+	}{
+		{"empty", nil, false},
+		{"valid", []FeeToken{token, oracle}, false},
+		{"invalid denom", []FeeToken{{Denom: "1", Rate: token.Rate, Markup: token.Markup}}, true},
+		{"duplicated", []FeeToken{token, token}, true},
+		{"zero rate", []FeeToken{{Denom: token.Denom, Rate: sdkmath.LegacyZeroDec(), Markup: token.Markup}}, true},
+		{"nil markup", []FeeToken{{Denom: token.Denom, Rate: token.Rate}}, true},
+		{"negative markup", []FeeToken{{Denom: token.Denom, Rate: token.Rate, Markup: sdkmath.LegacyNewDec(-1)}}, true},
+		{"unknown price source", []FeeToken{{Denom: token.Denom, PriceSource: 5, Rate: token.Rate, Markup: token.Markup}}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := DefaultParams()
+			params.FeeTokens = tc.tokens
+			err := params.Validate()
+			if tc.expError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFeePaymentConvert(t *testing.T) {
+	token := FeeToken{Denom: "ibc/usdc", Rate: sdkmath.LegacyNewDecWithPrec(3, 1), Markup: sdkmath.LegacyNewDecWithPrec(1, 1)}
+	payment := NewFeePayment(token.Denom, token.Price(token.Rate))
+	require.Equal(t, sdkmath.LegacyNewDecWithPrec(33, 2), payment.Price)
+
+	// 0.33 * 10 = 3.3, the fee is rounded up and the refund down
+	require.Equal(t, "4ibc/usdc", payment.ConvertFee(sdkmath.NewInt(10)).String())
+	require.Equal(t, "3ibc/usdc", payment.ConvertRefund(sdkmath.NewInt(10)).String())
+}
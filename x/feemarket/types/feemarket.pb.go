@@ -6,6 +6,8 @@ package types
 import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -24,6 +26,70 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// BaseFeeAlgorithm defines how the base fee of a block is computed.
+type BaseFeeAlgorithm int32
+
+const (
+	// BASE_FEE_ALGORITHM_EIP1559 adjusts the base fee by the deviation of the gas of the
+	// parent block from the target, bounded by the base fee change denominator.
+	BASE_FEE_ALGORITHM_EIP1559 BaseFeeAlgorithm = 0
+	// BASE_FEE_ALGORITHM_AIMD adjusts the base fee exponentially with a learning rate
+	// increased additively when the gas is far from the target and decreased
+	// multiplicatively when it's close to it.
+	BASE_FEE_ALGORITHM_AIMD BaseFeeAlgorithm = 1
+	// BASE_FEE_ALGORITHM_MOVING_WINDOW adjusts the base fee like EIP-1559 by the deviation
+	// of the average gas of the last window_size blocks from the target.
+	BASE_FEE_ALGORITHM_MOVING_WINDOW BaseFeeAlgorithm = 2
+)
+
+var BaseFeeAlgorithm_name = map[int32]string{
+	0: "BASE_FEE_ALGORITHM_EIP1559",
+	1: "BASE_FEE_ALGORITHM_AIMD",
+	2: "BASE_FEE_ALGORITHM_MOVING_WINDOW",
+}
+
+var BaseFeeAlgorithm_value = map[string]int32{
+	"BASE_FEE_ALGORITHM_EIP1559":       0,
+	"BASE_FEE_ALGORITHM_AIMD":          1,
+	"BASE_FEE_ALGORITHM_MOVING_WINDOW": 2,
+}
+
+func (x BaseFeeAlgorithm) String() string {
+	return proto.EnumName(BaseFeeAlgorithm_name, int32(x))
+}
+
+func (BaseFeeAlgorithm) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{0}
+}
+
+// FeeTokenPriceSource defines where the rate of a fee token is read from.
+type FeeTokenPriceSource int32
+
+const (
+	// FEE_TOKEN_PRICE_SOURCE_GOVERNANCE uses the rate set by governance in the params.
+	FEE_TOKEN_PRICE_SOURCE_GOVERNANCE FeeTokenPriceSource = 0
+	// FEE_TOKEN_PRICE_SOURCE_ORACLE reads the rate from the oracle of the keeper.
+	FEE_TOKEN_PRICE_SOURCE_ORACLE FeeTokenPriceSource = 1
+)
+
+var FeeTokenPriceSource_name = map[int32]string{
+	0: "FEE_TOKEN_PRICE_SOURCE_GOVERNANCE",
+	1: "FEE_TOKEN_PRICE_SOURCE_ORACLE",
+}
+
+var FeeTokenPriceSource_value = map[string]int32{
+	"FEE_TOKEN_PRICE_SOURCE_GOVERNANCE": 0,
+	"FEE_TOKEN_PRICE_SOURCE_ORACLE":     1,
+}
+
+func (x FeeTokenPriceSource) String() string {
+	return proto.EnumName(FeeTokenPriceSource_name, int32(x))
+}
+
+func (FeeTokenPriceSource) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{1}
+}
+
 // Params defines the EVM module parameters
 type Params struct {
 	// no_base_fee forces the EIP-1559 base fee to 0 (needed for 0 price calls)
@@ -43,6 +109,28 @@ type Params struct {
 	// min_gas_multiplier bounds the minimum gas used to be charged
 	// to senders based on gas limit
 	MinGasMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=min_gas_multiplier,json=minGasMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_gas_multiplier"`
+	// fee_tokens defines the cosmos denoms, besides the evm denom, that can pay for the
+	// gas of ethereum transactions.
+	FeeTokens []FeeToken `protobuf:"bytes,9,rep,name=fee_tokens,json=feeTokens,proto3" json:"fee_tokens"`
+	// fee_distribution_enabled splits the fees of ethereum transactions at the end of the
+	// block: the burn fraction of the base fee is burned, the tips go to the block proposer
+	// and the remainder goes to the community pool.
+	FeeDistributionEnabled bool `protobuf:"varint,10,opt,name=fee_distribution_enabled,json=feeDistributionEnabled,proto3" json:"fee_distribution_enabled,omitempty"`
+	// base_fee_burn_fraction is the fraction of the base fee burned when the fee
+	// distribution is enabled.
+	BaseFeeBurnFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,11,opt,name=base_fee_burn_fraction,json=baseFeeBurnFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_fee_burn_fraction"`
+	// base_fee_algorithm selects how the base fee of a block is computed from the gas of
+	// the previous blocks.
+	BaseFeeAlgorithm BaseFeeAlgorithm `protobuf:"varint,12,opt,name=base_fee_algorithm,json=baseFeeAlgorithm,proto3,enum=ethermint.feemarket.v1.BaseFeeAlgorithm" json:"base_fee_algorithm,omitempty"`
+	// learning_rate is the maximum change of the base fee per block of the AIMD algorithm,
+	// relative to the deviation of the gas from its target.
+	LearningRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,13,opt,name=learning_rate,json=learningRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"learning_rate"`
+	// window_size is the number of blocks whose gas is averaged by the moving window
+	// algorithm.
+	WindowSize uint32 `protobuf:"varint,14,opt,name=window_size,json=windowSize,proto3" json:"window_size,omitempty"`
+	// fee_history_size is the number of blocks whose fee history is kept in the store, for
+	// the eth_feeHistory queries of pruned nodes.
+	FeeHistorySize uint32 `protobuf:"varint,15,opt,name=fee_history_size,json=feeHistorySize,proto3" json:"fee_history_size,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -106,8 +194,437 @@ func (m *Params) GetEnableHeight() int64 {
 	return 0
 }
 
+func (m *Params) GetFeeTokens() []FeeToken {
+	if m != nil {
+		return m.FeeTokens
+	}
+	return nil
+}
+
+func (m *Params) GetFeeDistributionEnabled() bool {
+	if m != nil {
+		return m.FeeDistributionEnabled
+	}
+	return false
+}
+
+func (m *Params) GetBaseFeeAlgorithm() BaseFeeAlgorithm {
+	if m != nil {
+		return m.BaseFeeAlgorithm
+	}
+	return BASE_FEE_ALGORITHM_EIP1559
+}
+
+func (m *Params) GetWindowSize() uint32 {
+	if m != nil {
+		return m.WindowSize
+	}
+	return 0
+}
+
+func (m *Params) GetFeeHistorySize() uint32 {
+	if m != nil {
+		return m.FeeHistorySize
+	}
+	return 0
+}
+
+// BaseFeeHistory is the state the base fee algorithms keep across blocks.
+type BaseFeeHistory struct {
+	// learning_rate is the current learning rate of the AIMD algorithm
+	LearningRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=learning_rate,json=learningRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"learning_rate"`
+	// gas_used is the gas of the blocks of the moving window, oldest first
+	GasUsed []uint64 `protobuf:"varint,2,rep,packed,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+}
+
+func (m *BaseFeeHistory) Reset()         { *m = BaseFeeHistory{} }
+func (m *BaseFeeHistory) String() string { return proto.CompactTextString(m) }
+func (*BaseFeeHistory) ProtoMessage()    {}
+func (*BaseFeeHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{1}
+}
+func (m *BaseFeeHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BaseFeeHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BaseFeeHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BaseFeeHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BaseFeeHistory.Merge(m, src)
+}
+func (m *BaseFeeHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *BaseFeeHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_BaseFeeHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BaseFeeHistory proto.InternalMessageInfo
+
+func (m *BaseFeeHistory) GetGasUsed() []uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return nil
+}
+
+// FeeToken defines a cosmos denom that can pay for the gas of ethereum transactions.
+type FeeToken struct {
+	// denom of the token
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// price_source defines where the rate of the token is read from
+	PriceSource FeeTokenPriceSource `protobuf:"varint,2,opt,name=price_source,json=priceSource,proto3,enum=ethermint.feemarket.v1.FeeTokenPriceSource" json:"price_source,omitempty"`
+	// rate is the amount of the token worth one unit of the evm denom, used when the
+	// price source is governance.
+	Rate cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+	// markup is the fraction added on top of the rate, to cover the conversion risk.
+	Markup cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=markup,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"markup"`
+}
+
+func (m *FeeToken) Reset()         { *m = FeeToken{} }
+func (m *FeeToken) String() string { return proto.CompactTextString(m) }
+func (*FeeToken) ProtoMessage()    {}
+func (*FeeToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{2}
+}
+func (m *FeeToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeToken.Merge(m, src)
+}
+func (m *FeeToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeToken proto.InternalMessageInfo
+
+func (m *FeeToken) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *FeeToken) GetPriceSource() FeeTokenPriceSource {
+	if m != nil {
+		return m.PriceSource
+	}
+	return FEE_TOKEN_PRICE_SOURCE_GOVERNANCE
+}
+
+// FeePayment records the denom the gas of an ethereum transaction is paid with, and
+// its price in units of the evm denom including the markup.
+type FeePayment struct {
+	// denom the gas is paid with
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// price is the amount of denom paid per unit of the evm denom
+	Price cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=price,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price"`
+}
+
+func (m *FeePayment) Reset()         { *m = FeePayment{} }
+func (m *FeePayment) String() string { return proto.CompactTextString(m) }
+func (*FeePayment) ProtoMessage()    {}
+func (*FeePayment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{3}
+}
+func (m *FeePayment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeePayment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeePayment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeePayment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeePayment.Merge(m, src)
+}
+func (m *FeePayment) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeePayment) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeePayment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeePayment proto.InternalMessageInfo
+
+func (m *FeePayment) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// BlockFees records the fees paid by the ethereum transactions of a block and how they
+// were distributed.
+type BlockFees struct {
+	// height of the block
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// base_fees is the part of the fees paid at the base fee
+	BaseFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=base_fees,json=baseFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"base_fees"`
+	// priority_fees is the part of the fees paid above the base fee
+	PriorityFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=priority_fees,json=priorityFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"priority_fees"`
+	// burned is the part of the base fees that was burned
+	Burned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=burned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned"`
+	// community_pool is the part of the base fees sent to the community pool
+	CommunityPool github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=community_pool,json=communityPool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"community_pool"`
+	// proposer is the bech32 address of the account that received the priority fees
+	Proposer string `protobuf:"bytes,6,opt,name=proposer,proto3" json:"proposer,omitempty"`
+}
+
+func (m *BlockFees) Reset()         { *m = BlockFees{} }
+func (m *BlockFees) String() string { return proto.CompactTextString(m) }
+func (*BlockFees) ProtoMessage()    {}
+func (*BlockFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{4}
+}
+func (m *BlockFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockFees.Merge(m, src)
+}
+func (m *BlockFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockFees proto.InternalMessageInfo
+
+func (m *BlockFees) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockFees) GetBaseFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BaseFees
+	}
+	return nil
+}
+
+func (m *BlockFees) GetPriorityFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PriorityFees
+	}
+	return nil
+}
+
+func (m *BlockFees) GetBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Burned
+	}
+	return nil
+}
+
+func (m *BlockFees) GetCommunityPool() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CommunityPool
+	}
+	return nil
+}
+
+func (m *BlockFees) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+// FeeHistoryEntry records the fee market of a block, for the eth_feeHistory queries.
+type FeeHistoryEntry struct {
+	// height of the block
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// base_fee of the block
+	BaseFee cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=base_fee,json=baseFee,proto3,customtype=cosmossdk.io/math.Int" json:"base_fee"`
+	// gas_wanted by the block, as used by the base fee calculation
+	GasWanted uint64 `protobuf:"varint,3,opt,name=gas_wanted,json=gasWanted,proto3" json:"gas_wanted,omitempty"`
+	// gas_used by the block
+	GasUsed uint64 `protobuf:"varint,4,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// gas_limit of the block
+	GasLimit uint64 `protobuf:"varint,5,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// next_base_fee is the base fee of the following block, as computed by the base fee
+	// algorithm at the end of the block
+	NextBaseFee cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=next_base_fee,json=nextBaseFee,proto3,customtype=cosmossdk.io/math.Int" json:"next_base_fee"`
+	// tx_rewards are the gas used and the tips per gas of the ethereum transactions of the
+	// block by increasing tip, the transactions paying the same tip being merged, from which
+	// the reward at any percentile of the gas used is computed
+	TxRewards []TxReward `protobuf:"bytes,8,rep,name=tx_rewards,json=txRewards,proto3" json:"tx_rewards"`
+}
+
+func (m *FeeHistoryEntry) Reset()         { *m = FeeHistoryEntry{} }
+func (m *FeeHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*FeeHistoryEntry) ProtoMessage()    {}
+func (*FeeHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{5}
+}
+func (m *FeeHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeHistoryEntry.Merge(m, src)
+}
+func (m *FeeHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeHistoryEntry proto.InternalMessageInfo
+
+func (m *FeeHistoryEntry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *FeeHistoryEntry) GetGasWanted() uint64 {
+	if m != nil {
+		return m.GasWanted
+	}
+	return 0
+}
+
+func (m *FeeHistoryEntry) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *FeeHistoryEntry) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *FeeHistoryEntry) GetTxRewards() []TxReward {
+	if m != nil {
+		return m.TxRewards
+	}
+	return nil
+}
+
+// TxReward records the gas used and the tip per gas of an ethereum transaction.
+type TxReward struct {
+	// gas_used by the transaction
+	GasUsed uint64 `protobuf:"varint,1,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// reward is the tip per gas paid above the base fee
+	Reward cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=reward,proto3,customtype=cosmossdk.io/math.Int" json:"reward"`
+}
+
+func (m *TxReward) Reset()         { *m = TxReward{} }
+func (m *TxReward) String() string { return proto.CompactTextString(m) }
+func (*TxReward) ProtoMessage()    {}
+func (*TxReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{6}
+}
+func (m *TxReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxReward.Merge(m, src)
+}
+func (m *TxReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxReward proto.InternalMessageInfo
+
+func (m *TxReward) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("ethermint.feemarket.v1.BaseFeeAlgorithm", BaseFeeAlgorithm_name, BaseFeeAlgorithm_value)
+	proto.RegisterEnum("ethermint.feemarket.v1.FeeTokenPriceSource", FeeTokenPriceSource_name, FeeTokenPriceSource_value)
 	proto.RegisterType((*Params)(nil), "ethermint.feemarket.v1.Params")
+	proto.RegisterType((*BaseFeeHistory)(nil), "ethermint.feemarket.v1.BaseFeeHistory")
+	proto.RegisterType((*FeeToken)(nil), "ethermint.feemarket.v1.FeeToken")
+	proto.RegisterType((*FeePayment)(nil), "ethermint.feemarket.v1.FeePayment")
+	proto.RegisterType((*BlockFees)(nil), "ethermint.feemarket.v1.BlockFees")
+	proto.RegisterType((*FeeHistoryEntry)(nil), "ethermint.feemarket.v1.FeeHistoryEntry")
+	proto.RegisterType((*TxReward)(nil), "ethermint.feemarket.v1.TxReward")
 }
 
 func init() {
@@ -115,33 +632,79 @@ func init() {
 }
 
 var fileDescriptor_4feb8b20cf98e6e1 = []byte{
-	// 401 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0x6a, 0xdb, 0x40,
-	0x14, 0x45, 0x35, 0x75, 0xe2, 0x28, 0x93, 0x1a, 0xcc, 0x90, 0x14, 0xd1, 0x50, 0x45, 0x34, 0x50,
-	0xb4, 0x28, 0x12, 0x21, 0x9b, 0x6e, 0xba, 0x71, 0x43, 0xdc, 0x96, 0x16, 0x5c, 0x2d, 0xbb, 0x19,
-	0x46, 0xf2, 0xb3, 0x34, 0x58, 0x33, 0x23, 0x34, 0x63, 0x53, 0xff, 0x45, 0xff, 0xa4, 0xbf, 0xe1,
-	0xa5, 0x97, 0xa5, 0x0b, 0x53, 0xec, 0x1f, 0x29, 0x96, 0x6c, 0xc9, 0xd0, 0x4d, 0x76, 0xd2, 0xbb,
-	0xf7, 0x5c, 0xde, 0x63, 0x2e, 0x7e, 0x03, 0x26, 0x83, 0x52, 0x70, 0x69, 0xc2, 0x09, 0x80, 0x60,
-	0xe5, 0x14, 0x4c, 0x38, 0xbf, 0x6b, 0x7f, 0x82, 0xa2, 0x54, 0x46, 0x91, 0x17, 0x8d, 0x2f, 0x68,
-	0xa5, 0xf9, 0xdd, 0xcb, 0xcb, 0x54, 0xa5, 0xaa, 0xb2, 0x84, 0xbb, 0xaf, 0xda, 0xfd, 0xfa, 0x57,
-	0x07, 0x77, 0x47, 0xac, 0x64, 0x42, 0x13, 0x17, 0x5f, 0x48, 0x45, 0x63, 0xa6, 0x81, 0x4e, 0x00,
-	0x1c, 0xe4, 0x21, 0xdf, 0x8e, 0xce, 0xa5, 0x1a, 0x30, 0x0d, 0x8f, 0x00, 0xe4, 0x3d, 0xbe, 0x3e,
-	0x88, 0x34, 0xc9, 0x98, 0x4c, 0x81, 0x8e, 0x41, 0x2a, 0xc1, 0x25, 0x33, 0xaa, 0x74, 0x9e, 0x79,
-	0xc8, 0xef, 0x45, 0x4e, 0x5c, 0xbb, 0x3f, 0x54, 0x86, 0x87, 0x56, 0x27, 0xf7, 0xf8, 0x0a, 0x72,
-	0xa6, 0x0d, 0x4f, 0xb8, 0x59, 0x50, 0x31, 0xcb, 0x0d, 0x2f, 0x72, 0x0e, 0xa5, 0xd3, 0xa9, 0xc0,
-	0xcb, 0x56, 0xfc, 0xda, 0x68, 0xe4, 0x16, 0xf7, 0x40, 0xb2, 0x38, 0x07, 0x9a, 0x01, 0x4f, 0x33,
-	0xe3, 0x9c, 0x7a, 0xc8, 0xef, 0x44, 0xcf, 0xeb, 0xe1, 0xc7, 0x6a, 0x46, 0xde, 0x61, 0xbb, 0xd9,
-	0xba, 0xeb, 0x21, 0xff, 0x7c, 0xf0, 0x6a, 0xb9, 0xbe, 0xb1, 0xfe, 0xac, 0x6f, 0xae, 0x12, 0xa5,
-	0x85, 0xd2, 0x7a, 0x3c, 0x0d, 0xb8, 0x0a, 0x05, 0x33, 0x59, 0xf0, 0x49, 0x9a, 0xe8, 0x6c, 0xbf,
-	0x24, 0x19, 0xe2, 0x9e, 0xe0, 0x92, 0xa6, 0x4c, 0xd3, 0xa2, 0xe4, 0x09, 0x38, 0x67, 0x15, 0x7e,
-	0xbb, 0xc7, 0xaf, 0xff, 0xc7, 0xbf, 0x40, 0xca, 0x92, 0xc5, 0x03, 0x24, 0xd1, 0x85, 0xe0, 0x72,
-	0xc8, 0xf4, 0x68, 0xc7, 0x91, 0x6f, 0x98, 0x1c, 0x82, 0x8e, 0x2e, 0xb3, 0x9f, 0x9e, 0xd6, 0xaf,
-	0xd3, 0xda, 0xd3, 0x3f, 0x9f, 0xd8, 0x27, 0xfd, 0xd3, 0xa8, 0xcf, 0x25, 0x37, 0x9c, 0xe5, 0xcd,
-	0xbb, 0x0c, 0x1e, 0x97, 0x1b, 0x17, 0xad, 0x36, 0x2e, 0xfa, 0xbb, 0x71, 0xd1, 0xcf, 0xad, 0x6b,
-	0xad, 0xb6, 0xae, 0xf5, 0x7b, 0xeb, 0x5a, 0xdf, 0xdf, 0xa6, 0xdc, 0x64, 0xb3, 0x38, 0x48, 0x94,
-	0x08, 0x61, 0x2e, 0x94, 0x0e, 0xdb, 0xca, 0xfc, 0x38, 0x2a, 0x8d, 0x59, 0x14, 0xa0, 0xe3, 0x6e,
-	0x55, 0x80, 0xfb, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x8a, 0x77, 0x52, 0x39, 0x58, 0x02, 0x00,
-	0x00,
+	// 1151 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdf, 0x6e, 0x1b, 0xc5,
+	0x17, 0xf6, 0xc6, 0x8e, 0x63, 0x1f, 0xc7, 0xa9, 0x35, 0x6d, 0xf3, 0xdb, 0x26, 0xaa, 0xe3, 0xba,
+	0x3f, 0x90, 0x55, 0xc0, 0x26, 0xa9, 0x2a, 0x5a, 0x21, 0x2e, 0xec, 0x64, 0x9d, 0xb8, 0x24, 0x71,
+	0xd8, 0xa4, 0x2d, 0x42, 0xa0, 0xd5, 0x78, 0x7d, 0x62, 0x8f, 0xe2, 0x9d, 0xb1, 0x76, 0xc6, 0x49,
+	0x5c, 0x5e, 0x80, 0x4b, 0xde, 0x81, 0x3b, 0x9e, 0xa4, 0x97, 0x95, 0xb8, 0x41, 0x5c, 0x14, 0xd4,
+	0x4a, 0x3c, 0x01, 0x12, 0xb7, 0x68, 0x67, 0xd7, 0x7f, 0xda, 0x26, 0x60, 0x50, 0xaf, 0xec, 0x33,
+	0xe7, 0xfb, 0xce, 0x9f, 0x99, 0xf9, 0xe6, 0x2c, 0xbc, 0x8f, 0xaa, 0x8b, 0xbe, 0xc7, 0xb8, 0xaa,
+	0x1c, 0x23, 0x7a, 0xd4, 0x3f, 0x41, 0x55, 0x39, 0x5d, 0x9f, 0x18, 0xe5, 0xbe, 0x2f, 0x94, 0x20,
+	0xcb, 0x63, 0x5c, 0x79, 0xe2, 0x3a, 0x5d, 0x5f, 0xc9, 0xbb, 0x42, 0x7a, 0x42, 0x56, 0x5a, 0x54,
+	0x62, 0xe5, 0x74, 0xbd, 0x85, 0x8a, 0xae, 0x57, 0x5c, 0xc1, 0x78, 0xc8, 0x5b, 0xb9, 0xd6, 0x11,
+	0x1d, 0xa1, 0xff, 0x56, 0x82, 0x7f, 0xe1, 0x6a, 0xf1, 0xcf, 0x24, 0x24, 0x0f, 0xa8, 0x4f, 0x3d,
+	0x49, 0xf2, 0x90, 0xe1, 0xc2, 0x09, 0xe8, 0xce, 0x31, 0xa2, 0x69, 0x14, 0x8c, 0x52, 0xca, 0x4e,
+	0x73, 0x51, 0xa3, 0x12, 0xeb, 0x88, 0xe4, 0x33, 0x58, 0x1d, 0x39, 0x1d, 0xb7, 0x4b, 0x79, 0x07,
+	0x9d, 0x36, 0x72, 0xe1, 0x31, 0x4e, 0x95, 0xf0, 0xcd, 0xb9, 0x82, 0x51, 0xca, 0xda, 0x66, 0x2b,
+	0x44, 0x6f, 0x6a, 0xc0, 0xd6, 0xc4, 0x4f, 0xee, 0xc2, 0x75, 0xec, 0x51, 0xa9, 0x98, 0xcb, 0xd4,
+	0xd0, 0xf1, 0x06, 0x3d, 0xc5, 0xfa, 0x3d, 0x86, 0xbe, 0x19, 0xd7, 0xc4, 0x6b, 0x13, 0xe7, 0xde,
+	0xd8, 0x47, 0x6e, 0x43, 0x16, 0x39, 0x6d, 0xf5, 0xd0, 0xe9, 0x22, 0xeb, 0x74, 0x95, 0x39, 0x5f,
+	0x30, 0x4a, 0x71, 0x7b, 0x31, 0x5c, 0xdc, 0xd1, 0x6b, 0xe4, 0x3e, 0xa4, 0xc6, 0x55, 0x27, 0x0b,
+	0x46, 0x29, 0x5d, 0xbb, 0xf9, 0xec, 0xc5, 0x5a, 0xec, 0x97, 0x17, 0x6b, 0xd7, 0xc3, 0x3d, 0x91,
+	0xed, 0x93, 0x32, 0x13, 0x15, 0x8f, 0xaa, 0x6e, 0xb9, 0xc1, 0x95, 0xbd, 0x10, 0x15, 0x49, 0xb6,
+	0x21, 0xeb, 0x31, 0xee, 0x74, 0xa8, 0x74, 0xfa, 0x3e, 0x73, 0xd1, 0x5c, 0xd0, 0xf4, 0xdb, 0x11,
+	0x7d, 0xf5, 0x6d, 0xfa, 0x2e, 0x76, 0xa8, 0x3b, 0xdc, 0x42, 0xd7, 0xce, 0x78, 0x8c, 0x6f, 0x53,
+	0x79, 0x10, 0xf0, 0xc8, 0x17, 0x40, 0x46, 0x81, 0xa6, 0x3a, 0x4b, 0xcd, 0x1e, 0x2d, 0x17, 0x46,
+	0x9b, 0x6a, 0xdd, 0x02, 0x08, 0x76, 0x5a, 0x89, 0x13, 0xe4, 0xd2, 0x4c, 0x17, 0xe2, 0xa5, 0xcc,
+	0x46, 0xa1, 0x7c, 0xf1, 0xe1, 0x97, 0xeb, 0x88, 0x47, 0x01, 0xb0, 0x96, 0x08, 0x92, 0xd9, 0xe9,
+	0xe3, 0xc8, 0x96, 0xe4, 0x3e, 0x98, 0x41, 0x98, 0x36, 0x93, 0xca, 0x67, 0xad, 0x81, 0x62, 0x82,
+	0x3b, 0xe1, 0xee, 0xb5, 0x4d, 0xd0, 0x47, 0xbc, 0x7c, 0x8c, 0xb8, 0x35, 0xe5, 0xb6, 0x42, 0x2f,
+	0xf9, 0x12, 0x96, 0xc7, 0xe7, 0xdd, 0x1a, 0xf8, 0xdc, 0x39, 0xf6, 0xa9, 0x1b, 0x00, 0xcc, 0xcc,
+	0xec, 0x7d, 0x5d, 0x8d, 0xb6, 0xba, 0x36, 0xf0, 0x79, 0x3d, 0xe2, 0x93, 0xc7, 0x40, 0xc6, 0x91,
+	0x69, 0xaf, 0x23, 0x7c, 0xa6, 0xba, 0x9e, 0xb9, 0x58, 0x30, 0x4a, 0x4b, 0x1b, 0xa5, 0xcb, 0x5a,
+	0x8c, 0xae, 0x61, 0x75, 0x84, 0xb7, 0x73, 0xad, 0x37, 0x56, 0xc8, 0x0e, 0x64, 0x7b, 0x48, 0x7d,
+	0xce, 0x78, 0xc7, 0xf1, 0xa9, 0x42, 0x33, 0x3b, 0x7b, 0xa1, 0x8b, 0x23, 0xa6, 0x4d, 0x15, 0x92,
+	0x35, 0xc8, 0x9c, 0x31, 0xde, 0x16, 0x67, 0x8e, 0x64, 0x4f, 0xd1, 0x5c, 0xd2, 0x57, 0x14, 0xc2,
+	0xa5, 0x43, 0xf6, 0x14, 0x49, 0x09, 0x72, 0x41, 0xf5, 0x5d, 0x26, 0x95, 0xf0, 0x87, 0x21, 0xea,
+	0x8a, 0x46, 0x2d, 0x1d, 0x23, 0xee, 0x84, 0xcb, 0x01, 0xf2, 0x61, 0x22, 0x95, 0xc8, 0xcd, 0xdb,
+	0x39, 0xc6, 0x99, 0x62, 0xb4, 0x37, 0xd6, 0x57, 0x71, 0x00, 0x4b, 0x51, 0x4b, 0x11, 0xfa, 0xed,
+	0xf2, 0x8d, 0xff, 0x5a, 0xfe, 0x0d, 0x48, 0x05, 0x57, 0x71, 0x20, 0xb1, 0x6d, 0xce, 0x15, 0xe2,
+	0xa5, 0x84, 0xbd, 0xd0, 0xa1, 0xf2, 0x91, 0xc4, 0x76, 0xf1, 0x77, 0x03, 0x52, 0xa3, 0xdb, 0x42,
+	0xae, 0xc1, 0xbc, 0x96, 0x70, 0x98, 0xc9, 0x0e, 0x0d, 0xb2, 0x0f, 0x8b, 0x5a, 0x0d, 0x8e, 0x14,
+	0x03, 0xdf, 0x45, 0xad, 0xec, 0xa5, 0x8d, 0x0f, 0xfe, 0xe9, 0xee, 0x69, 0x25, 0x1c, 0x6a, 0x8a,
+	0x9d, 0xe9, 0x4f, 0x0c, 0xf2, 0x09, 0x24, 0x74, 0x3b, 0xf1, 0xd9, 0xdb, 0xd1, 0x04, 0xf2, 0x29,
+	0x24, 0x83, 0x34, 0x83, 0xbe, 0x99, 0x98, 0x9d, 0x1a, 0x51, 0x8a, 0xdf, 0x00, 0xd4, 0x11, 0x0f,
+	0xe8, 0xd0, 0x43, 0xae, 0x2e, 0xe9, 0xf4, 0x01, 0xcc, 0x87, 0xba, 0x9f, 0x9b, 0x3d, 0x7e, 0xc8,
+	0x28, 0xfe, 0x11, 0x87, 0x74, 0xad, 0x27, 0xdc, 0x93, 0x3a, 0xa2, 0x24, 0xcb, 0x90, 0x8c, 0x1e,
+	0x28, 0x43, 0x3f, 0x50, 0x91, 0x45, 0xba, 0x90, 0x1e, 0x1d, 0xb8, 0xd4, 0x27, 0x91, 0xd9, 0xb8,
+	0x51, 0x0e, 0xa3, 0x97, 0x03, 0x47, 0x39, 0x7a, 0xa8, 0xcb, 0x9b, 0x82, 0xf1, 0xda, 0xc7, 0x41,
+	0xfe, 0x1f, 0x7f, 0x5d, 0x2b, 0x75, 0x98, 0xea, 0x0e, 0x5a, 0x65, 0x57, 0x78, 0x95, 0xe8, 0x55,
+	0x0f, 0x7f, 0x3e, 0x92, 0xed, 0x93, 0x8a, 0x1a, 0xf6, 0x51, 0x6a, 0x82, 0xb4, 0x53, 0x91, 0x06,
+	0x24, 0xe9, 0x43, 0xb6, 0xef, 0xb3, 0x40, 0x08, 0xc3, 0x30, 0x5b, 0xfc, 0xdd, 0x67, 0x5b, 0x1c,
+	0x65, 0xd0, 0x19, 0x5d, 0x48, 0x06, 0xcf, 0x02, 0xb6, 0xcd, 0xc4, 0xbb, 0x4f, 0x15, 0x85, 0x26,
+	0x3e, 0x2c, 0xb9, 0xc2, 0xf3, 0x06, 0x3c, 0xe8, 0xab, 0x2f, 0x44, 0xcf, 0x9c, 0x7f, 0xf7, 0xc9,
+	0xb2, 0xe3, 0x14, 0x07, 0x42, 0xf4, 0xc8, 0x0a, 0xa4, 0xfa, 0xbe, 0xe8, 0x0b, 0x89, 0x7e, 0x38,
+	0x4f, 0xec, 0xb1, 0x5d, 0xfc, 0x69, 0x0e, 0xae, 0x4c, 0x24, 0x6b, 0x71, 0xe5, 0x0f, 0x2f, 0x3d,
+	0xfc, 0xe9, 0xb9, 0x34, 0xf7, 0xaf, 0xe6, 0xd2, 0x4d, 0x80, 0x40, 0xbf, 0x67, 0x94, 0x2b, 0x6c,
+	0x6b, 0xdd, 0x24, 0xec, 0x74, 0x87, 0xca, 0x27, 0x7a, 0xe1, 0x35, 0x79, 0x27, 0x0a, 0xc6, 0x94,
+	0xbc, 0xc9, 0x2a, 0x04, 0x38, 0xa7, 0xc7, 0x3c, 0x16, 0x0e, 0xcb, 0x84, 0x1d, 0x60, 0x77, 0x03,
+	0x9b, 0x54, 0x21, 0xcb, 0xf1, 0x5c, 0x4d, 0x66, 0xfc, 0xc2, 0x2c, 0x55, 0x65, 0x02, 0xce, 0xe8,
+	0x23, 0xc0, 0x02, 0x50, 0xe7, 0x8e, 0x8f, 0x67, 0xd4, 0x6f, 0x4b, 0x33, 0xf5, 0xf7, 0x53, 0xe9,
+	0xe8, 0xdc, 0xd6, 0xc0, 0xd1, 0x54, 0x52, 0x91, 0x2d, 0x1f, 0x26, 0x52, 0xc9, 0xdc, 0x82, 0xbd,
+	0x10, 0xc5, 0x29, 0x7e, 0x0d, 0xa9, 0x11, 0xf6, 0xb5, 0xe6, 0x8c, 0xd7, 0x9b, 0xbb, 0x07, 0xc9,
+	0x90, 0x31, 0xdb, 0x76, 0x46, 0xe0, 0x3b, 0xdf, 0x42, 0xee, 0xcd, 0xe1, 0x41, 0xf2, 0xb0, 0x52,
+	0xab, 0x1e, 0x5a, 0x4e, 0xdd, 0xb2, 0x9c, 0xea, 0xee, 0x76, 0xd3, 0x6e, 0x1c, 0xed, 0xec, 0x39,
+	0x56, 0xe3, 0x60, 0xfd, 0xde, 0xbd, 0x07, 0xb9, 0x18, 0x59, 0x85, 0xff, 0x5d, 0xe0, 0xaf, 0x36,
+	0xf6, 0xb6, 0x72, 0x06, 0xf9, 0x3f, 0x14, 0x2e, 0x70, 0xee, 0x35, 0x1f, 0x37, 0xf6, 0xb7, 0x9d,
+	0x27, 0x8d, 0xfd, 0xad, 0xe6, 0x93, 0xdc, 0xdc, 0x4a, 0xe2, 0xbb, 0x1f, 0xf2, 0xb1, 0x3b, 0x08,
+	0x57, 0x2f, 0x78, 0x20, 0xc9, 0x7b, 0x70, 0x2b, 0x60, 0x1f, 0x35, 0x3f, 0xb7, 0xf6, 0x9d, 0x03,
+	0xbb, 0xb1, 0x69, 0x39, 0x87, 0xcd, 0x47, 0xf6, 0xa6, 0xe5, 0x6c, 0x37, 0x1f, 0x5b, 0xf6, 0x7e,
+	0x75, 0x7f, 0xd3, 0xca, 0xc5, 0xc8, 0x2d, 0xb8, 0x79, 0x09, 0xac, 0x69, 0x57, 0x37, 0x77, 0xad,
+	0x9c, 0x11, 0xa6, 0xa9, 0xd5, 0x9f, 0xbd, 0xcc, 0x1b, 0xcf, 0x5f, 0xe6, 0x8d, 0xdf, 0x5e, 0xe6,
+	0x8d, 0xef, 0x5f, 0xe5, 0x63, 0xcf, 0x5f, 0xe5, 0x63, 0x3f, 0xbf, 0xca, 0xc7, 0xbe, 0xfa, 0x70,
+	0x4a, 0x06, 0x78, 0x1a, 0xa8, 0x60, 0xf2, 0xa1, 0x79, 0x3e, 0xf5, 0xa9, 0xa9, 0x05, 0xd1, 0x4a,
+	0xea, 0xcf, 0xc2, 0xbb, 0x7f, 0x0d, 0x00, 0x1f, 0xbc, 0x7d, 0x13, 0x8e, 0x0a, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -164,16 +727,75 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FeeHistorySize != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.FeeHistorySize))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.WindowSize != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.WindowSize))
+		i--
+		dAtA[i] = 0x70
+	}
 	{
-		size := m.MinGasMultiplier.Size()
+		size := m.LearningRate.Size()
 		i -= size
-		if _, err := m.MinGasMultiplier.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.LearningRate.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintFeemarket(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x42
+	dAtA[i] = 0x6a
+	if m.BaseFeeAlgorithm != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.BaseFeeAlgorithm))
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size := m.BaseFeeBurnFraction.Size()
+		i -= size
+		if _, err := m.BaseFeeBurnFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.FeeDistributionEnabled {
+		i--
+		if m.FeeDistributionEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.FeeTokens) > 0 {
+		for iNdEx := len(m.FeeTokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeemarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	{
+		size := m.MinGasMultiplier.Size()
+		i -= size
+		if _, err := m.MinGasMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
 	{
 		size := m.MinGasPrice.Size()
 		i -= size
@@ -216,57 +838,1659 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		} else {
 			dAtA[i] = 0
 		}
-		i--
-		dAtA[i] = 0x8
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BaseFeeHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BaseFeeHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BaseFeeHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GasUsed) > 0 {
+		dAtA2 := make([]byte, len(m.GasUsed)*10)
+		var j1 int
+		for _, num := range m.GasUsed {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintFeemarket(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.LearningRate.Size()
+		i -= size
+		if _, err := m.LearningRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Markup.Size()
+		i -= size
+		if _, err := m.Markup.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PriceSource != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.PriceSource))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFeemarket(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeePayment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeePayment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeePayment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFeemarket(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintFeemarket(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.CommunityPool) > 0 {
+		for iNdEx := len(m.CommunityPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommunityPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeemarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Burned) > 0 {
+		for iNdEx := len(m.Burned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Burned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeemarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PriorityFees) > 0 {
+		for iNdEx := len(m.PriorityFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriorityFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeemarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.BaseFees) > 0 {
+		for iNdEx := len(m.BaseFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BaseFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeemarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxRewards) > 0 {
+		for iNdEx := len(m.TxRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TxRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeemarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	{
+		size := m.NextBaseFee.Size()
+		i -= size
+		if _, err := m.NextBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.GasLimit != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GasWanted != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.GasWanted))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.BaseFee.Size()
+		i -= size
+		if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Reward.Size()
+		i -= size
+		if _, err := m.Reward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.GasUsed != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeemarket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeemarket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NoBaseFee {
+		n += 2
+	}
+	if m.BaseFeeChangeDenominator != 0 {
+		n += 1 + sovFeemarket(uint64(m.BaseFeeChangeDenominator))
+	}
+	if m.ElasticityMultiplier != 0 {
+		n += 1 + sovFeemarket(uint64(m.ElasticityMultiplier))
+	}
+	if m.EnableHeight != 0 {
+		n += 1 + sovFeemarket(uint64(m.EnableHeight))
+	}
+	l = m.BaseFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.MinGasPrice.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.MinGasMultiplier.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if len(m.FeeTokens) > 0 {
+		for _, e := range m.FeeTokens {
+			l = e.Size()
+			n += 1 + l + sovFeemarket(uint64(l))
+		}
+	}
+	if m.FeeDistributionEnabled {
+		n += 2
+	}
+	l = m.BaseFeeBurnFraction.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if m.BaseFeeAlgorithm != 0 {
+		n += 1 + sovFeemarket(uint64(m.BaseFeeAlgorithm))
+	}
+	l = m.LearningRate.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if m.WindowSize != 0 {
+		n += 1 + sovFeemarket(uint64(m.WindowSize))
+	}
+	if m.FeeHistorySize != 0 {
+		n += 1 + sovFeemarket(uint64(m.FeeHistorySize))
+	}
+	return n
+}
+
+func (m *BaseFeeHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LearningRate.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if len(m.GasUsed) > 0 {
+		l = 0
+		for _, e := range m.GasUsed {
+			l += sovFeemarket(uint64(e))
+		}
+		n += 1 + sovFeemarket(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *FeeToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFeemarket(uint64(l))
+	}
+	if m.PriceSource != 0 {
+		n += 1 + sovFeemarket(uint64(m.PriceSource))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.Markup.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	return n
+}
+
+func (m *FeePayment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFeemarket(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	return n
+}
+
+func (m *BlockFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovFeemarket(uint64(m.Height))
+	}
+	if len(m.BaseFees) > 0 {
+		for _, e := range m.BaseFees {
+			l = e.Size()
+			n += 1 + l + sovFeemarket(uint64(l))
+		}
+	}
+	if len(m.PriorityFees) > 0 {
+		for _, e := range m.PriorityFees {
+			l = e.Size()
+			n += 1 + l + sovFeemarket(uint64(l))
+		}
+	}
+	if len(m.Burned) > 0 {
+		for _, e := range m.Burned {
+			l = e.Size()
+			n += 1 + l + sovFeemarket(uint64(l))
+		}
+	}
+	if len(m.CommunityPool) > 0 {
+		for _, e := range m.CommunityPool {
+			l = e.Size()
+			n += 1 + l + sovFeemarket(uint64(l))
+		}
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovFeemarket(uint64(l))
+	}
+	return n
+}
+
+func (m *FeeHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovFeemarket(uint64(m.Height))
+	}
+	l = m.BaseFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if m.GasWanted != 0 {
+		n += 1 + sovFeemarket(uint64(m.GasWanted))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovFeemarket(uint64(m.GasUsed))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovFeemarket(uint64(m.GasLimit))
+	}
+	l = m.NextBaseFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if len(m.TxRewards) > 0 {
+		for _, e := range m.TxRewards {
+			l = e.Size()
+			n += 1 + l + sovFeemarket(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TxReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		n += 1 + sovFeemarket(uint64(m.GasUsed))
+	}
+	l = m.Reward.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	return n
+}
+
+func sovFeemarket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeemarket(x uint64) (n int) {
+	return sovFeemarket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoBaseFee", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NoBaseFee = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeChangeDenominator", wireType)
+			}
+			m.BaseFeeChangeDenominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeChangeDenominator |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElasticityMultiplier", wireType)
+			}
+			m.ElasticityMultiplier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ElasticityMultiplier |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableHeight", wireType)
+			}
+			m.EnableHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EnableHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGasMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeTokens = append(m.FeeTokens, FeeToken{})
+			if err := m.FeeTokens[len(m.FeeTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDistributionEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FeeDistributionEnabled = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeBurnFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFeeBurnFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeAlgorithm", wireType)
+			}
+			m.BaseFeeAlgorithm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeAlgorithm |= BaseFeeAlgorithm(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LearningRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LearningRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowSize", wireType)
+			}
+			m.WindowSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeHistorySize", wireType)
+			}
+			m.FeeHistorySize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeHistorySize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BaseFeeHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BaseFeeHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BaseFeeHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LearningRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LearningRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowFeemarket
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.GasUsed = append(m.GasUsed, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowFeemarket
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthFeemarket
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthFeemarket
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.GasUsed) == 0 {
+					m.GasUsed = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFeemarket
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.GasUsed = append(m.GasUsed, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceSource", wireType)
+			}
+			m.PriceSource = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceSource |= FeeTokenPriceSource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Markup", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Markup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeePayment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeePayment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeePayment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseFees = append(m.BaseFees, types.Coin{})
+			if err := m.BaseFees[len(m.BaseFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriorityFees = append(m.PriorityFees, types.Coin{})
+			if err := m.PriorityFees[len(m.PriorityFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burned = append(m.Burned, types.Coin{})
+			if err := m.Burned[len(m.Burned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityPool = append(m.CommunityPool, types.Coin{})
+			if err := m.CommunityPool[len(m.CommunityPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintFeemarket(dAtA []byte, offset int, v uint64) int {
-	offset -= sovFeemarket(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.NoBaseFee {
-		n += 2
-	}
-	if m.BaseFeeChangeDenominator != 0 {
-		n += 1 + sovFeemarket(uint64(m.BaseFeeChangeDenominator))
-	}
-	if m.ElasticityMultiplier != 0 {
-		n += 1 + sovFeemarket(uint64(m.ElasticityMultiplier))
-	}
-	if m.EnableHeight != 0 {
-		n += 1 + sovFeemarket(uint64(m.EnableHeight))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.BaseFee.Size()
-	n += 1 + l + sovFeemarket(uint64(l))
-	l = m.MinGasPrice.Size()
-	n += 1 + l + sovFeemarket(uint64(l))
-	l = m.MinGasMultiplier.Size()
-	n += 1 + l + sovFeemarket(uint64(l))
-	return n
-}
-
-func sovFeemarket(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozFeemarket(x uint64) (n int) {
-	return sovFeemarket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *FeeHistoryEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -289,17 +2513,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeeHistoryEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeeHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoBaseFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var v int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeemarket
@@ -309,17 +2533,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.NoBaseFee = bool(v != 0)
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeChangeDenominator", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
 			}
-			m.BaseFeeChangeDenominator = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeemarket
@@ -329,16 +2552,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BaseFeeChangeDenominator |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ElasticityMultiplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasWanted", wireType)
 			}
-			m.ElasticityMultiplier = 0
+			m.GasWanted = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeemarket
@@ -348,16 +2586,35 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ElasticityMultiplier |= uint32(b&0x7F) << shift
+				m.GasWanted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
 			}
-			m.EnableHeight = 0
+			m.GasLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeemarket
@@ -367,14 +2624,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EnableHeight |= int64(b&0x7F) << shift
+				m.GasLimit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextBaseFee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -402,15 +2659,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NextBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxRewards", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeemarket
@@ -420,29 +2677,98 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFeemarket
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFeemarket
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TxRewards = append(m.TxRewards, TxReward{})
+			if err := m.TxRewards[len(m.TxRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinGasMultiplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -470,7 +2796,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinGasMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Reward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
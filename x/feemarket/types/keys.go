@@ -35,18 +35,36 @@ const (
 const (
 	prefixBlockGasWanted    = iota + 1
 	deprecatedPrefixBaseFee // unused
+	prefixExcessBlobGas
+	prefixBlockFees
+	prefixBaseFeeHistory
+	prefixFeeHistory
+	prefixNextBaseFee
 )
 
 const (
 	prefixTransientBlockGasUsed = iota + 1
+	prefixTransientBlobGasUsed
+	prefixTransientFeePayment
+	prefixTransientBlockFees
+	prefixTransientTxRewards
 )
 
 // KVStore key prefixes
 var (
 	KeyPrefixBlockGasWanted = []byte{prefixBlockGasWanted}
+	KeyPrefixExcessBlobGas  = []byte{prefixExcessBlobGas}
+	KeyPrefixBlockFees      = []byte{prefixBlockFees}
+	KeyPrefixBaseFeeHistory = []byte{prefixBaseFeeHistory}
+	KeyPrefixFeeHistory     = []byte{prefixFeeHistory}
+	KeyPrefixNextBaseFee    = []byte{prefixNextBaseFee}
 )
 
 // Transient Store key prefixes
 var (
 	KeyPrefixTransientBlockGasWanted = []byte{prefixTransientBlockGasUsed}
+	KeyPrefixTransientBlobGasUsed    = []byte{prefixTransientBlobGasUsed}
+	KeyPrefixTransientFeePayment     = []byte{prefixTransientFeePayment}
+	KeyPrefixTransientBlockFees      = []byte{prefixTransientBlockFees}
+	KeyPrefixTransientTxRewards      = []byte{prefixTransientTxRewards}
 )
@@ -33,6 +33,8 @@ var (
 	DefaultEnableHeight = int64(0)
 	// DefaultNoBaseFee is false
 	DefaultNoBaseFee = false
+	// DefaultBaseFeeBurnFraction is 0 (i.e the base fee isn't burned)
+	DefaultBaseFeeBurnFraction = sdkmath.LegacyZeroDec()
 )
 
 // Parameter keys
@@ -96,6 +98,10 @@ func DefaultParams() Params {
 		EnableHeight:             DefaultEnableHeight,
 		MinGasPrice:              DefaultMinGasPrice,
 		MinGasMultiplier:         DefaultMinGasMultiplier,
+		BaseFeeBurnFraction:      DefaultBaseFeeBurnFraction,
+		LearningRate:             DefaultLearningRate,
+		WindowSize:               DefaultWindowSize,
+		FeeHistorySize:           DefaultFeeHistorySize,
 	}
 }
 
@@ -117,9 +123,35 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateFeeTokens(p.FeeTokens); err != nil {
+		return err
+	}
+
+	if err := validateBaseFeeBurnFraction(p.BaseFeeBurnFraction); err != nil {
+		return err
+	}
+
+	if err := validateBaseFeeAlgorithm(p); err != nil {
+		return err
+	}
+
+	if err := validateFeeHistorySize(p.FeeHistorySize); err != nil {
+		return err
+	}
+
 	return validateMinGasPrice(p.MinGasPrice)
 }
 
+// GetFeeToken returns the fee token with the given denom.
+func (p Params) GetFeeToken(denom string) (FeeToken, bool) {
+	for _, token := range p.FeeTokens {
+		if token.Denom == denom {
+			return token, true
+		}
+	}
+	return FeeToken{}, false
+}
+
 func validateBool(i interface{}) error {
 	_, ok := i.(bool)
 	if !ok {
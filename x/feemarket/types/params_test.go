@@ -57,6 +57,21 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 			NewParams(true, 7, 3, 2000000000, int64(544435345345435345), sdkmath.LegacyNewDecWithPrec(20, 4), sdkmath.LegacyNewDec(2)),
 			true,
 		},
+		{
+			"valid: base fee burn fraction unset",
+			NewParams(true, 7, 3, 2000000000, int64(544435345345435345), DefaultMinGasPrice, DefaultMinGasMultiplier),
+			false,
+		},
+		{
+			"invalid: base fee burn fraction bigger than 1",
+			Params{BaseFeeChangeDenominator: 7, BaseFee: sdkmath.NewInt(1), MinGasPrice: DefaultMinGasPrice, MinGasMultiplier: DefaultMinGasMultiplier, BaseFeeBurnFraction: sdkmath.LegacyNewDec(2)},
+			true,
+		},
+		{
+			"invalid: base fee burn fraction negative",
+			Params{BaseFeeChangeDenominator: 7, BaseFee: sdkmath.NewInt(1), MinGasPrice: DefaultMinGasPrice, MinGasMultiplier: DefaultMinGasMultiplier, BaseFeeBurnFraction: sdkmath.LegacyNewDec(-1)},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -117,3 +132,44 @@ func (suite *ParamsTestSuite) TestParamsValidateMinGasPrice() {
 		}
 	}
 }
+
+func (suite *ParamsTestSuite) TestParamsValidateBaseFeeAlgorithm() {
+	testCases := []struct {
+		name     string
+		malleate func(p *Params)
+		expError bool
+	}{
+		{"eip1559", func(p *Params) {}, false},
+		{"aimd", func(p *Params) { p.BaseFeeAlgorithm = BASE_FEE_ALGORITHM_AIMD }, false},
+		{"aimd - zero learning rate", func(p *Params) {
+			p.BaseFeeAlgorithm = BASE_FEE_ALGORITHM_AIMD
+			p.LearningRate = sdkmath.LegacyZeroDec()
+		}, true},
+		{"aimd - learning rate bigger than 1", func(p *Params) {
+			p.BaseFeeAlgorithm = BASE_FEE_ALGORITHM_AIMD
+			p.LearningRate = sdkmath.LegacyNewDec(2)
+		}, true},
+		{"moving window", func(p *Params) { p.BaseFeeAlgorithm = BASE_FEE_ALGORITHM_MOVING_WINDOW }, false},
+		{"moving window - empty window", func(p *Params) {
+			p.BaseFeeAlgorithm = BASE_FEE_ALGORITHM_MOVING_WINDOW
+			p.WindowSize = 0
+		}, true},
+		{"moving window - window too large", func(p *Params) {
+			p.BaseFeeAlgorithm = BASE_FEE_ALGORITHM_MOVING_WINDOW
+			p.WindowSize = MaxWindowSize + 1
+		}, true},
+		{"unknown algorithm", func(p *Params) { p.BaseFeeAlgorithm = 3 }, true},
+	}
+
+	for _, tc := range testCases {
+		params := DefaultParams()
+		tc.malleate(&params)
+		err := params.Validate()
+
+		if tc.expError {
+			suite.Require().Error(err, tc.name)
+		} else {
+			suite.Require().NoError(err, tc.name)
+		}
+	}
+}
@@ -190,6 +190,83 @@ func (m *QueryBaseFeeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryBaseFeeResponse proto.InternalMessageInfo
 
+// QueryNextBaseFeeRequest defines the request type for querying the base fee of
+// the next block.
+type QueryNextBaseFeeRequest struct {
+}
+
+func (m *QueryNextBaseFeeRequest) Reset()         { *m = QueryNextBaseFeeRequest{} }
+func (m *QueryNextBaseFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNextBaseFeeRequest) ProtoMessage()    {}
+func (*QueryNextBaseFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{4}
+}
+func (m *QueryNextBaseFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextBaseFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextBaseFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNextBaseFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextBaseFeeRequest.Merge(m, src)
+}
+func (m *QueryNextBaseFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextBaseFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextBaseFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNextBaseFeeRequest proto.InternalMessageInfo
+
+// QueryNextBaseFeeResponse returns the base fee of the next block.
+type QueryNextBaseFeeResponse struct {
+	// base_fee of the next block, nil if the base fee isn't enabled for it
+	BaseFee *cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=base_fee,json=baseFee,proto3,customtype=cosmossdk.io/math.Int" json:"base_fee,omitempty"`
+}
+
+func (m *QueryNextBaseFeeResponse) Reset()         { *m = QueryNextBaseFeeResponse{} }
+func (m *QueryNextBaseFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNextBaseFeeResponse) ProtoMessage()    {}
+func (*QueryNextBaseFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{5}
+}
+func (m *QueryNextBaseFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextBaseFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextBaseFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNextBaseFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextBaseFeeResponse.Merge(m, src)
+}
+func (m *QueryNextBaseFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextBaseFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextBaseFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNextBaseFeeResponse proto.InternalMessageInfo
+
 // QueryBlockGasRequest defines the request type for querying the EIP1559 base
 // fee.
 type QueryBlockGasRequest struct {
@@ -199,7 +276,7 @@ func (m *QueryBlockGasRequest) Reset()         { *m = QueryBlockGasRequest{} }
 func (m *QueryBlockGasRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBlockGasRequest) ProtoMessage()    {}
 func (*QueryBlockGasRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_71a07c1ffd85fde2, []int{4}
+	return fileDescriptor_71a07c1ffd85fde2, []int{6}
 }
 func (m *QueryBlockGasRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -238,7 +315,7 @@ func (m *QueryBlockGasResponse) Reset()         { *m = QueryBlockGasResponse{} }
 func (m *QueryBlockGasResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBlockGasResponse) ProtoMessage()    {}
 func (*QueryBlockGasResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_71a07c1ffd85fde2, []int{5}
+	return fileDescriptor_71a07c1ffd85fde2, []int{7}
 }
 func (m *QueryBlockGasResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -274,13 +351,387 @@ func (m *QueryBlockGasResponse) GetGas() int64 {
 	return 0
 }
 
+// QueryBlobBaseFeeRequest defines the request type for querying the EIP4844
+// blob base fee.
+type QueryBlobBaseFeeRequest struct {
+}
+
+func (m *QueryBlobBaseFeeRequest) Reset()         { *m = QueryBlobBaseFeeRequest{} }
+func (m *QueryBlobBaseFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlobBaseFeeRequest) ProtoMessage()    {}
+func (*QueryBlobBaseFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{8}
+}
+func (m *QueryBlobBaseFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlobBaseFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlobBaseFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlobBaseFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlobBaseFeeRequest.Merge(m, src)
+}
+func (m *QueryBlobBaseFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlobBaseFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlobBaseFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlobBaseFeeRequest proto.InternalMessageInfo
+
+// QueryBlobBaseFeeResponse returns the EIP4844 blob base fee.
+type QueryBlobBaseFeeResponse struct {
+	// blob_base_fee is the EIP4844 blob base fee
+	BlobBaseFee *cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=blob_base_fee,json=blobBaseFee,proto3,customtype=cosmossdk.io/math.Int" json:"blob_base_fee,omitempty"`
+	// excess_blob_gas is the excess blob gas accumulated up to the parent block
+	ExcessBlobGas uint64 `protobuf:"varint,2,opt,name=excess_blob_gas,json=excessBlobGas,proto3" json:"excess_blob_gas,omitempty"`
+}
+
+func (m *QueryBlobBaseFeeResponse) Reset()         { *m = QueryBlobBaseFeeResponse{} }
+func (m *QueryBlobBaseFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlobBaseFeeResponse) ProtoMessage()    {}
+func (*QueryBlobBaseFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{9}
+}
+func (m *QueryBlobBaseFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlobBaseFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlobBaseFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlobBaseFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlobBaseFeeResponse.Merge(m, src)
+}
+func (m *QueryBlobBaseFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlobBaseFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlobBaseFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlobBaseFeeResponse proto.InternalMessageInfo
+
+func (m *QueryBlobBaseFeeResponse) GetExcessBlobGas() uint64 {
+	if m != nil {
+		return m.ExcessBlobGas
+	}
+	return 0
+}
+
+// QueryFeeTokenPricesRequest defines the request type for querying the prices of
+// the fee tokens.
+type QueryFeeTokenPricesRequest struct {
+}
+
+func (m *QueryFeeTokenPricesRequest) Reset()         { *m = QueryFeeTokenPricesRequest{} }
+func (m *QueryFeeTokenPricesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeTokenPricesRequest) ProtoMessage()    {}
+func (*QueryFeeTokenPricesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{10}
+}
+func (m *QueryFeeTokenPricesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeTokenPricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeTokenPricesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeTokenPricesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeTokenPricesRequest.Merge(m, src)
+}
+func (m *QueryFeeTokenPricesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeTokenPricesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeTokenPricesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeTokenPricesRequest proto.InternalMessageInfo
+
+// QueryFeeTokenPricesResponse returns the prices of the fee tokens.
+type QueryFeeTokenPricesResponse struct {
+	// prices of the fee tokens whose rate is available, including their markup
+	Prices []FeePayment `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices"`
+}
+
+func (m *QueryFeeTokenPricesResponse) Reset()         { *m = QueryFeeTokenPricesResponse{} }
+func (m *QueryFeeTokenPricesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeTokenPricesResponse) ProtoMessage()    {}
+func (*QueryFeeTokenPricesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{11}
+}
+func (m *QueryFeeTokenPricesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeTokenPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeTokenPricesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeTokenPricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeTokenPricesResponse.Merge(m, src)
+}
+func (m *QueryFeeTokenPricesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeTokenPricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeTokenPricesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeTokenPricesResponse proto.InternalMessageInfo
+
+func (m *QueryFeeTokenPricesResponse) GetPrices() []FeePayment {
+	if m != nil {
+		return m.Prices
+	}
+	return nil
+}
+
+// QueryBlockFeesRequest defines the request type for querying the fees of a block.
+type QueryBlockFeesRequest struct {
+	// height of the block, the latest recorded block if zero
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryBlockFeesRequest) Reset()         { *m = QueryBlockFeesRequest{} }
+func (m *QueryBlockFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockFeesRequest) ProtoMessage()    {}
+func (*QueryBlockFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{12}
+}
+func (m *QueryBlockFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockFeesRequest.Merge(m, src)
+}
+func (m *QueryBlockFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockFeesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockFeesRequest proto.InternalMessageInfo
+
+func (m *QueryBlockFeesRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// QueryBlockFeesResponse returns the fees of a block.
+type QueryBlockFeesResponse struct {
+	// block_fees recorded at the requested height
+	BlockFees BlockFees `protobuf:"bytes,1,opt,name=block_fees,json=blockFees,proto3" json:"block_fees"`
+}
+
+func (m *QueryBlockFeesResponse) Reset()         { *m = QueryBlockFeesResponse{} }
+func (m *QueryBlockFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockFeesResponse) ProtoMessage()    {}
+func (*QueryBlockFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{13}
+}
+func (m *QueryBlockFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockFeesResponse.Merge(m, src)
+}
+func (m *QueryBlockFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockFeesResponse proto.InternalMessageInfo
+
+func (m *QueryBlockFeesResponse) GetBlockFees() BlockFees {
+	if m != nil {
+		return m.BlockFees
+	}
+	return BlockFees{}
+}
+
+// QueryFeeHistoryRequest defines the request type for querying the fee history of a
+// range of blocks.
+type QueryFeeHistoryRequest struct {
+	// from_height is the first block of the range
+	FromHeight int64 `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// to_height is the last block of the range, included
+	ToHeight int64 `protobuf:"varint,2,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+}
+
+func (m *QueryFeeHistoryRequest) Reset()         { *m = QueryFeeHistoryRequest{} }
+func (m *QueryFeeHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeHistoryRequest) ProtoMessage()    {}
+func (*QueryFeeHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{14}
+}
+func (m *QueryFeeHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeHistoryRequest.Merge(m, src)
+}
+func (m *QueryFeeHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryFeeHistoryRequest) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *QueryFeeHistoryRequest) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+// QueryFeeHistoryResponse returns the fee history of a range of blocks.
+type QueryFeeHistoryResponse struct {
+	// entries of the blocks of the range still kept in the store, by increasing height
+	Entries []FeeHistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *QueryFeeHistoryResponse) Reset()         { *m = QueryFeeHistoryResponse{} }
+func (m *QueryFeeHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeHistoryResponse) ProtoMessage()    {}
+func (*QueryFeeHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{15}
+}
+func (m *QueryFeeHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeHistoryResponse.Merge(m, src)
+}
+func (m *QueryFeeHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryFeeHistoryResponse) GetEntries() []FeeHistoryEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "ethermint.feemarket.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "ethermint.feemarket.v1.QueryParamsResponse")
 	proto.RegisterType((*QueryBaseFeeRequest)(nil), "ethermint.feemarket.v1.QueryBaseFeeRequest")
 	proto.RegisterType((*QueryBaseFeeResponse)(nil), "ethermint.feemarket.v1.QueryBaseFeeResponse")
+	proto.RegisterType((*QueryNextBaseFeeRequest)(nil), "ethermint.feemarket.v1.QueryNextBaseFeeRequest")
+	proto.RegisterType((*QueryNextBaseFeeResponse)(nil), "ethermint.feemarket.v1.QueryNextBaseFeeResponse")
 	proto.RegisterType((*QueryBlockGasRequest)(nil), "ethermint.feemarket.v1.QueryBlockGasRequest")
 	proto.RegisterType((*QueryBlockGasResponse)(nil), "ethermint.feemarket.v1.QueryBlockGasResponse")
+	proto.RegisterType((*QueryBlobBaseFeeRequest)(nil), "ethermint.feemarket.v1.QueryBlobBaseFeeRequest")
+	proto.RegisterType((*QueryBlobBaseFeeResponse)(nil), "ethermint.feemarket.v1.QueryBlobBaseFeeResponse")
+	proto.RegisterType((*QueryFeeTokenPricesRequest)(nil), "ethermint.feemarket.v1.QueryFeeTokenPricesRequest")
+	proto.RegisterType((*QueryFeeTokenPricesResponse)(nil), "ethermint.feemarket.v1.QueryFeeTokenPricesResponse")
+	proto.RegisterType((*QueryBlockFeesRequest)(nil), "ethermint.feemarket.v1.QueryBlockFeesRequest")
+	proto.RegisterType((*QueryBlockFeesResponse)(nil), "ethermint.feemarket.v1.QueryBlockFeesResponse")
+	proto.RegisterType((*QueryFeeHistoryRequest)(nil), "ethermint.feemarket.v1.QueryFeeHistoryRequest")
+	proto.RegisterType((*QueryFeeHistoryResponse)(nil), "ethermint.feemarket.v1.QueryFeeHistoryResponse")
 }
 
 func init() {
@@ -288,35 +739,59 @@ func init() {
 }
 
 var fileDescriptor_71a07c1ffd85fde2 = []byte{
-	// 437 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x3f, 0x8f, 0xd3, 0x30,
-	0x14, 0x8f, 0x29, 0xf4, 0x0e, 0xb3, 0x20, 0xd3, 0x9e, 0x20, 0x42, 0xbe, 0x12, 0xa4, 0xd3, 0x1d,
-	0x1c, 0xb6, 0xee, 0x60, 0x64, 0xca, 0x70, 0x08, 0x89, 0x01, 0xc2, 0xc6, 0x52, 0x39, 0xe5, 0x35,
-	0x8d, 0xda, 0xc4, 0x69, 0xec, 0x56, 0x74, 0x65, 0x63, 0x41, 0x08, 0x3e, 0x0d, 0xdf, 0xa0, 0x63,
-	0x25, 0x16, 0xc4, 0x50, 0xa1, 0x96, 0x0f, 0x82, 0x12, 0x27, 0xa5, 0x01, 0x02, 0xdd, 0xac, 0x97,
-	0xdf, 0xbf, 0xf7, 0x7b, 0x0a, 0x76, 0x40, 0x0f, 0x20, 0x8d, 0xc2, 0x58, 0xf3, 0x3e, 0x40, 0x24,
-	0xd2, 0x21, 0x68, 0x3e, 0x3d, 0xe3, 0xe3, 0x09, 0xa4, 0x33, 0x96, 0xa4, 0x52, 0x4b, 0x72, 0xb0,
-	0xc1, 0xb0, 0x0d, 0x86, 0x4d, 0xcf, 0xec, 0x56, 0x20, 0x03, 0x99, 0x43, 0x78, 0xf6, 0x32, 0x68,
-	0xfb, 0xa8, 0x46, 0xf1, 0x17, 0xd5, 0xe0, 0x6e, 0x07, 0x52, 0x06, 0x23, 0xe0, 0x22, 0x09, 0xb9,
-	0x88, 0x63, 0xa9, 0x85, 0x0e, 0x65, 0xac, 0xcc, 0x57, 0xa7, 0x85, 0xc9, 0x8b, 0x2c, 0xc2, 0x73,
-	0x91, 0x8a, 0x48, 0x79, 0x30, 0x9e, 0x80, 0xd2, 0xce, 0x4b, 0x7c, 0xa3, 0x32, 0x55, 0x89, 0x8c,
-	0x15, 0x90, 0xc7, 0xb8, 0x99, 0xe4, 0x93, 0x9b, 0xa8, 0x83, 0x8e, 0xaf, 0x9d, 0x53, 0xf6, 0xf7,
-	0xc4, 0xcc, 0xf0, 0xdc, 0xcb, 0xf3, 0xe5, 0xa1, 0xe5, 0x15, 0x1c, 0xa7, 0x5d, 0x88, 0xba, 0x42,
-	0xc1, 0x05, 0x40, 0xe9, 0xf5, 0x0c, 0xb7, 0xaa, 0xe3, 0xc2, 0xec, 0x11, 0xde, 0xf7, 0x85, 0x82,
-	0x6e, 0x1f, 0x20, 0xb7, 0xbb, 0xea, 0xde, 0xfa, 0xb6, 0x3c, 0x6c, 0xf7, 0xa4, 0x8a, 0xa4, 0x52,
-	0xaf, 0x87, 0x2c, 0x94, 0x3c, 0x12, 0x7a, 0xc0, 0x9e, 0xc6, 0xda, 0xdb, 0xf3, 0x0d, 0xdb, 0x39,
-	0x28, 0xd5, 0x46, 0xb2, 0x37, 0x7c, 0x22, 0x36, 0x1b, 0x9d, 0xe0, 0xf6, 0x6f, 0xf3, 0xc2, 0xe6,
-	0x3a, 0x6e, 0x04, 0xc2, 0x2c, 0xd4, 0xf0, 0xb2, 0xe7, 0xf9, 0xe7, 0x06, 0xbe, 0x92, 0x63, 0xc9,
-	0x3b, 0x84, 0x9b, 0x66, 0x15, 0x72, 0xaf, 0x6e, 0xd5, 0x3f, 0xdb, 0xb3, 0xef, 0xef, 0x84, 0x35,
-	0xfe, 0xce, 0xd1, 0xdb, 0x2f, 0x3f, 0x3e, 0x5d, 0xea, 0x10, 0xca, 0x6b, 0xee, 0x69, 0xda, 0x23,
-	0xef, 0x11, 0xde, 0x2b, 0x2a, 0x22, 0xff, 0x36, 0xa8, 0xf6, 0x6b, 0x9f, 0xee, 0x06, 0x2e, 0xe2,
-	0x1c, 0xe7, 0x71, 0x1c, 0xd2, 0xa9, 0x8b, 0x53, 0xde, 0x84, 0x7c, 0x44, 0x78, 0xbf, 0x6c, 0x93,
-	0xfc, 0xc7, 0xa4, 0x7a, 0x0c, 0xfb, 0xc1, 0x8e, 0xe8, 0x22, 0xd3, 0x49, 0x9e, 0xe9, 0x2e, 0xb9,
-	0x53, 0x9b, 0x29, 0x63, 0x74, 0x03, 0xa1, 0xdc, 0x8b, 0xf9, 0x8a, 0xa2, 0xc5, 0x8a, 0xa2, 0xef,
-	0x2b, 0x8a, 0x3e, 0xac, 0xa9, 0xb5, 0x58, 0x53, 0xeb, 0xeb, 0x9a, 0x5a, 0xaf, 0x4e, 0x83, 0x50,
-	0x0f, 0x26, 0x3e, 0xeb, 0xc9, 0x88, 0xc3, 0x34, 0x92, 0x6a, 0x4b, 0xec, 0xcd, 0x96, 0x9c, 0x9e,
-	0x25, 0xa0, 0xfc, 0x66, 0xfe, 0x77, 0x3c, 0xfc, 0x19, 0x00, 0x00, 0xff, 0xff, 0x15, 0x29, 0x2d,
-	0xfd, 0xb7, 0x03, 0x00, 0x00,
+	// 829 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0x4d, 0x6f, 0xe3, 0x44,
+	0x18, 0xc7, 0xe3, 0x76, 0x49, 0x9b, 0x27, 0x5a, 0x40, 0x43, 0x9b, 0xcd, 0x7a, 0x57, 0x6e, 0xd6,
+	0xab, 0xed, 0x66, 0xfb, 0x62, 0xb7, 0x29, 0x47, 0x90, 0x50, 0x24, 0xd2, 0x22, 0x21, 0x14, 0x02,
+	0xe2, 0xc0, 0x25, 0xd8, 0xe1, 0x89, 0x63, 0x25, 0xf6, 0xa4, 0x9e, 0x69, 0x95, 0x08, 0x71, 0x80,
+	0x1b, 0x17, 0x84, 0xe0, 0xc6, 0x85, 0x0f, 0xc0, 0x17, 0xe9, 0xb1, 0x12, 0x17, 0xc4, 0xa1, 0x42,
+	0x2d, 0x27, 0x3e, 0x05, 0xf2, 0x78, 0xec, 0xc6, 0x49, 0x9d, 0x1a, 0xed, 0xcd, 0x79, 0x5e, 0x7f,
+	0xf9, 0x7b, 0xe6, 0x9f, 0x80, 0x8e, 0x7c, 0x80, 0x81, 0xe7, 0xfa, 0xdc, 0xec, 0x23, 0x7a, 0x56,
+	0x30, 0x44, 0x6e, 0x9e, 0x1f, 0x9a, 0xa7, 0x67, 0x18, 0x4c, 0x8d, 0x71, 0x40, 0x39, 0x25, 0x95,
+	0xa4, 0xc6, 0x48, 0x6a, 0x8c, 0xf3, 0x43, 0x75, 0xc3, 0xa1, 0x0e, 0x15, 0x25, 0x66, 0xf8, 0x14,
+	0x55, 0xab, 0xdb, 0x19, 0x13, 0x6f, 0x5b, 0xa3, 0xba, 0xa7, 0x0e, 0xa5, 0xce, 0x08, 0x4d, 0x6b,
+	0xec, 0x9a, 0x96, 0xef, 0x53, 0x6e, 0x71, 0x97, 0xfa, 0x2c, 0xca, 0xea, 0x1b, 0x40, 0x3e, 0x0d,
+	0x11, 0xda, 0x56, 0x60, 0x79, 0xac, 0x83, 0xa7, 0x67, 0xc8, 0xb8, 0xfe, 0x19, 0xbc, 0x93, 0x8a,
+	0xb2, 0x31, 0xf5, 0x19, 0x92, 0xf7, 0xa0, 0x38, 0x16, 0x91, 0xaa, 0x52, 0x53, 0xea, 0xe5, 0x86,
+	0x66, 0xdc, 0x4d, 0x6c, 0x44, 0x7d, 0xcd, 0x07, 0x17, 0x57, 0x5b, 0x85, 0x8e, 0xec, 0xd1, 0x37,
+	0xe5, 0xd0, 0xa6, 0xc5, 0xb0, 0x85, 0x18, 0xef, 0xfa, 0x18, 0x36, 0xd2, 0x61, 0xb9, 0xec, 0x5d,
+	0x58, 0xb7, 0x2d, 0x86, 0xdd, 0x3e, 0xa2, 0x58, 0x57, 0x6a, 0x3e, 0xfe, 0xeb, 0x6a, 0x6b, 0xb3,
+	0x47, 0x99, 0x47, 0x19, 0xfb, 0x7a, 0x68, 0xb8, 0xd4, 0xf4, 0x2c, 0x3e, 0x30, 0x3e, 0xf2, 0x79,
+	0x67, 0xcd, 0x8e, 0xba, 0xf5, 0xc7, 0xf0, 0x48, 0x4c, 0xfb, 0x04, 0x27, 0x7c, 0x6e, 0x51, 0x1b,
+	0xaa, 0x8b, 0xa9, 0xd7, 0x5a, 0x56, 0x89, 0xd1, 0x47, 0xb4, 0x37, 0x3c, 0xb6, 0x12, 0xf9, 0x5e,
+	0xc1, 0xe6, 0x5c, 0x5c, 0xae, 0x79, 0x1b, 0x56, 0x1d, 0x2b, 0x52, 0x6f, 0xb5, 0x13, 0x3e, 0x26,
+	0xbc, 0xcd, 0x11, 0xb5, 0xe7, 0x78, 0xbf, 0x53, 0xa0, 0xba, 0x98, 0x93, 0x93, 0xde, 0x87, 0x87,
+	0xf6, 0x88, 0xda, 0xdd, 0xfc, 0xd4, 0x65, 0xfb, 0x76, 0x0c, 0xd9, 0x86, 0xb7, 0x70, 0xd2, 0x43,
+	0xc6, 0xba, 0x62, 0x4a, 0x08, 0xb5, 0x52, 0x53, 0xea, 0x0f, 0x3a, 0x0f, 0xa3, 0x70, 0xb8, 0xf2,
+	0xd8, 0x62, 0xfa, 0x53, 0x50, 0x05, 0x42, 0x0b, 0xf1, 0x73, 0x3a, 0x44, 0xbf, 0x1d, 0xb8, 0x3d,
+	0x4c, 0xbe, 0x67, 0x17, 0x9e, 0xdc, 0x99, 0x95, 0x8c, 0x1f, 0x40, 0x71, 0x2c, 0x22, 0x55, 0xa5,
+	0xb6, 0x5a, 0x2f, 0x37, 0xf4, 0xac, 0xe3, 0xd2, 0x42, 0x6c, 0x5b, 0x53, 0x0f, 0x7d, 0x9e, 0x1c,
+	0x19, 0xd1, 0xa7, 0x9b, 0xb3, 0x42, 0xb6, 0x30, 0xd9, 0x4c, 0x2a, 0x50, 0x1c, 0xa0, 0xeb, 0x0c,
+	0xb8, 0xd4, 0x52, 0x7e, 0xd2, 0xbf, 0x82, 0xca, 0x7c, 0x83, 0x84, 0x69, 0x01, 0xd8, 0x61, 0x30,
+	0x14, 0x2b, 0x3e, 0xbf, 0xcf, 0xb2, 0x80, 0x92, 0x76, 0xc9, 0x53, 0xb2, 0xe3, 0x80, 0xfe, 0x85,
+	0xdc, 0xd0, 0x42, 0x3c, 0x71, 0x19, 0xa7, 0xc1, 0x34, 0x66, 0xda, 0x82, 0x72, 0x3f, 0xa0, 0x5e,
+	0x37, 0x05, 0x06, 0x61, 0xe8, 0x44, 0x44, 0xc8, 0x13, 0x28, 0x71, 0x1a, 0xa7, 0x57, 0x44, 0x7a,
+	0x9d, 0xd3, 0x28, 0xa9, 0xdb, 0xf0, 0x68, 0x61, 0xae, 0x44, 0x3f, 0x86, 0x35, 0xf4, 0x79, 0xe0,
+	0x26, 0x42, 0xbe, 0x5c, 0x22, 0xa4, 0x6c, 0xfe, 0xd0, 0xe7, 0xc1, 0x54, 0xd2, 0xc7, 0xdd, 0x8d,
+	0x7f, 0x4b, 0xf0, 0x86, 0x58, 0x42, 0x7e, 0x50, 0xa0, 0x18, 0x5d, 0x52, 0xb2, 0x93, 0x35, 0x6c,
+	0xd1, 0x17, 0xd4, 0xdd, 0x5c, 0xb5, 0x11, 0xb6, 0xbe, 0xfd, 0xfd, 0x1f, 0xff, 0xfc, 0xb2, 0x52,
+	0x23, 0x9a, 0x99, 0xe1, 0x54, 0x91, 0x2f, 0x90, 0x1f, 0x15, 0x58, 0x8b, 0xcf, 0xe5, 0xf2, 0x05,
+	0xe9, 0x0b, 0xa2, 0xee, 0xe5, 0x2b, 0x96, 0x38, 0x75, 0x81, 0xa3, 0x93, 0x5a, 0x16, 0x4e, 0x7c,
+	0x95, 0xc8, 0x6f, 0x0a, 0x94, 0x67, 0x4c, 0x82, 0x98, 0x4b, 0xf7, 0x2c, 0x3a, 0x8d, 0x7a, 0x90,
+	0xbf, 0x41, 0xc2, 0xed, 0x0b, 0xb8, 0x97, 0xe4, 0x45, 0x16, 0x9c, 0x8f, 0x13, 0x9e, 0x5c, 0x76,
+	0xf2, 0xb3, 0x02, 0xeb, 0xb1, 0xb9, 0x90, 0x7b, 0x64, 0x48, 0x7b, 0x93, 0xba, 0x9f, 0xb3, 0x5a,
+	0x82, 0xbd, 0x12, 0x60, 0xcf, 0xc9, 0xb3, 0x4c, 0xd5, 0xc4, 0xa5, 0x72, 0x2c, 0x26, 0x64, 0x9b,
+	0xb1, 0xaa, 0x7b, 0x64, 0x5b, 0x34, 0x3c, 0xf5, 0x20, 0x7f, 0x43, 0x5e, 0xd9, 0x52, 0x1e, 0x49,
+	0x7e, 0x57, 0xe0, 0xcd, 0xb4, 0x57, 0x91, 0xc6, 0xd2, 0x9d, 0x77, 0xda, 0x9e, 0x7a, 0xf4, 0xbf,
+	0x7a, 0x24, 0xea, 0x81, 0x40, 0xdd, 0x21, 0x75, 0x33, 0xfb, 0x77, 0xbb, 0xcb, 0xc3, 0xc6, 0x6e,
+	0x64, 0x7e, 0xa1, 0x9e, 0xa5, 0xc4, 0x88, 0x48, 0x8e, 0xf7, 0x36, 0x63, 0x90, 0xaa, 0x91, 0xb7,
+	0x5c, 0xe2, 0x1d, 0x09, 0xbc, 0x7d, 0xb2, 0xbb, 0xfc, 0x3d, 0xf7, 0x11, 0x99, 0xf9, 0x4d, 0x64,
+	0x61, 0xdf, 0x92, 0x5f, 0x15, 0x80, 0x5b, 0xcb, 0x21, 0xc6, 0x7d, 0xba, 0xa4, 0x0d, 0x53, 0x35,
+	0x73, 0xd7, 0x4b, 0xc8, 0x5d, 0x01, 0xf9, 0x82, 0x3c, 0x5f, 0xa6, 0xe1, 0x20, 0x6a, 0x6a, 0xb6,
+	0x2e, 0xae, 0x35, 0xe5, 0xf2, 0x5a, 0x53, 0xfe, 0xbe, 0xd6, 0x94, 0x9f, 0x6e, 0xb4, 0xc2, 0xe5,
+	0x8d, 0x56, 0xf8, 0xf3, 0x46, 0x2b, 0x7c, 0xb9, 0xe7, 0xb8, 0x7c, 0x70, 0x66, 0x1b, 0x3d, 0xea,
+	0x99, 0x78, 0xee, 0x51, 0x36, 0x33, 0x6e, 0x32, 0x33, 0x90, 0x4f, 0xc7, 0xc8, 0xec, 0xa2, 0xf8,
+	0xa3, 0x74, 0xf4, 0xdf, 0x00, 0x12, 0x4c, 0x04, 0xf1, 0xc2, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -335,8 +810,19 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// BaseFee queries the base fee of the parent block of the current block.
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
+	// NextBaseFee queries the base fee of the block following the current block, as
+	// computed by the base fee algorithm at the end of the current block.
+	NextBaseFee(ctx context.Context, in *QueryNextBaseFeeRequest, opts ...grpc.CallOption) (*QueryNextBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(ctx context.Context, in *QueryBlockGasRequest, opts ...grpc.CallOption) (*QueryBlockGasResponse, error)
+	// BlobBaseFee queries the EIP4844 blob base fee of the current block.
+	BlobBaseFee(ctx context.Context, in *QueryBlobBaseFeeRequest, opts ...grpc.CallOption) (*QueryBlobBaseFeeResponse, error)
+	// FeeTokenPrices queries the current prices of the fee tokens.
+	FeeTokenPrices(ctx context.Context, in *QueryFeeTokenPricesRequest, opts ...grpc.CallOption) (*QueryFeeTokenPricesResponse, error)
+	// BlockFees queries the fees collected and distributed at a given block height.
+	BlockFees(ctx context.Context, in *QueryBlockFeesRequest, opts ...grpc.CallOption) (*QueryBlockFeesResponse, error)
+	// FeeHistory queries the fee history kept for a range of blocks.
+	FeeHistory(ctx context.Context, in *QueryFeeHistoryRequest, opts ...grpc.CallOption) (*QueryFeeHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -365,6 +851,15 @@ func (c *queryClient) BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) NextBaseFee(ctx context.Context, in *QueryNextBaseFeeRequest, opts ...grpc.CallOption) (*QueryNextBaseFeeResponse, error) {
+	out := new(QueryNextBaseFeeResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.feemarket.v1.Query/NextBaseFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) BlockGas(ctx context.Context, in *QueryBlockGasRequest, opts ...grpc.CallOption) (*QueryBlockGasResponse, error) {
 	out := new(QueryBlockGasResponse)
 	err := c.cc.Invoke(ctx, "/ethermint.feemarket.v1.Query/BlockGas", in, out, opts...)
@@ -374,14 +869,61 @@ func (c *queryClient) BlockGas(ctx context.Context, in *QueryBlockGasRequest, op
 	return out, nil
 }
 
-// QueryServer is the server API for Query service.
+func (c *queryClient) BlobBaseFee(ctx context.Context, in *QueryBlobBaseFeeRequest, opts ...grpc.CallOption) (*QueryBlobBaseFeeResponse, error) {
+	out := new(QueryBlobBaseFeeResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.feemarket.v1.Query/BlobBaseFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeTokenPrices(ctx context.Context, in *QueryFeeTokenPricesRequest, opts ...grpc.CallOption) (*QueryFeeTokenPricesResponse, error) {
+	out := new(QueryFeeTokenPricesResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.feemarket.v1.Query/FeeTokenPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BlockFees(ctx context.Context, in *QueryBlockFeesRequest, opts ...grpc.CallOption) (*QueryBlockFeesResponse, error) {
+	out := new(QueryBlockFeesResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.feemarket.v1.Query/BlockFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeHistory(ctx context.Context, in *QueryFeeHistoryRequest, opts ...grpc.CallOption) (*QueryFeeHistoryResponse, error) {
+	out := new(QueryFeeHistoryResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.feemarket.v1.Query/FeeHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of x/feemarket module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// BaseFee queries the base fee of the parent block of the current block.
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
+	// NextBaseFee queries the base fee of the block following the current block, as
+	// computed by the base fee algorithm at the end of the current block.
+	NextBaseFee(context.Context, *QueryNextBaseFeeRequest) (*QueryNextBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(context.Context, *QueryBlockGasRequest) (*QueryBlockGasResponse, error)
+	// BlobBaseFee queries the EIP4844 blob base fee of the current block.
+	BlobBaseFee(context.Context, *QueryBlobBaseFeeRequest) (*QueryBlobBaseFeeResponse, error)
+	// FeeTokenPrices queries the current prices of the fee tokens.
+	FeeTokenPrices(context.Context, *QueryFeeTokenPricesRequest) (*QueryFeeTokenPricesResponse, error)
+	// BlockFees queries the fees collected and distributed at a given block height.
+	BlockFees(context.Context, *QueryBlockFeesRequest) (*QueryBlockFeesResponse, error)
+	// FeeHistory queries the fee history kept for a range of blocks.
+	FeeHistory(context.Context, *QueryFeeHistoryRequest) (*QueryFeeHistoryResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -394,9 +936,24 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BaseFee not implemented")
 }
+func (*UnimplementedQueryServer) NextBaseFee(ctx context.Context, req *QueryNextBaseFeeRequest) (*QueryNextBaseFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextBaseFee not implemented")
+}
 func (*UnimplementedQueryServer) BlockGas(ctx context.Context, req *QueryBlockGasRequest) (*QueryBlockGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BlockGas not implemented")
 }
+func (*UnimplementedQueryServer) BlobBaseFee(ctx context.Context, req *QueryBlobBaseFeeRequest) (*QueryBlobBaseFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlobBaseFee not implemented")
+}
+func (*UnimplementedQueryServer) FeeTokenPrices(ctx context.Context, req *QueryFeeTokenPricesRequest) (*QueryFeeTokenPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeTokenPrices not implemented")
+}
+func (*UnimplementedQueryServer) BlockFees(ctx context.Context, req *QueryBlockFeesRequest) (*QueryBlockFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockFees not implemented")
+}
+func (*UnimplementedQueryServer) FeeHistory(ctx context.Context, req *QueryFeeHistoryRequest) (*QueryFeeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeHistory not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -438,6 +995,24 @@ func _Query_BaseFee_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_NextBaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNextBaseFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NextBaseFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.feemarket.v1.Query/NextBaseFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NextBaseFee(ctx, req.(*QueryNextBaseFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_BlockGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryBlockGasRequest)
 	if err := dec(in); err != nil {
@@ -456,6 +1031,78 @@ func _Query_BlockGas_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BlobBaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlobBaseFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlobBaseFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.feemarket.v1.Query/BlobBaseFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlobBaseFee(ctx, req.(*QueryBlobBaseFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeTokenPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeTokenPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeTokenPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.feemarket.v1.Query/FeeTokenPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeTokenPrices(ctx, req.(*QueryFeeTokenPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlockFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.feemarket.v1.Query/BlockFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockFees(ctx, req.(*QueryBlockFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.feemarket.v1.Query/FeeHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeHistory(ctx, req.(*QueryFeeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethermint.feemarket.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -468,10 +1115,30 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BaseFee",
 			Handler:    _Query_BaseFee_Handler,
 		},
+		{
+			MethodName: "NextBaseFee",
+			Handler:    _Query_NextBaseFee_Handler,
+		},
 		{
 			MethodName: "BlockGas",
 			Handler:    _Query_BlockGas_Handler,
 		},
+		{
+			MethodName: "BlobBaseFee",
+			Handler:    _Query_BlobBaseFee_Handler,
+		},
+		{
+			MethodName: "FeeTokenPrices",
+			Handler:    _Query_FeeTokenPrices_Handler,
+		},
+		{
+			MethodName: "BlockFees",
+			Handler:    _Query_BlockFees_Handler,
+		},
+		{
+			MethodName: "FeeHistory",
+			Handler:    _Query_FeeHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethermint/feemarket/v1/query.proto",
@@ -591,6 +1258,64 @@ func (m *QueryBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryNextBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextBaseFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BaseFee != nil {
+		{
+			size := m.BaseFee.Size()
+			i -= size
+			if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryBlockGasRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -642,87 +1367,1144 @@ func (m *QueryBlockGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryBlobBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryBlobBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlobBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlobBaseFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlobBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlobBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if m.ExcessBlobGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExcessBlobGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlobBaseFee != nil {
+		{
+			size := m.BlobBaseFee.Size()
+			i -= size
+			if _, err := m.BlobBaseFee.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFeeTokenPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeTokenPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeTokenPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBaseFeeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFeeTokenPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeTokenPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeTokenPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BlockFees.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBaseFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBaseFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseFee != nil {
+		l = m.BaseFee.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNextBaseFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryNextBaseFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseFee != nil {
+		l = m.BaseFee.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlockGasRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBlockGasResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Gas != 0 {
+		n += 1 + sovQuery(uint64(m.Gas))
+	}
+	return n
+}
+
+func (m *QueryBlobBaseFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBlobBaseFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlobBaseFee != nil {
+		l = m.BlobBaseFee.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ExcessBlobGas != 0 {
+		n += 1 + sovQuery(uint64(m.ExcessBlobGas))
+	}
+	return n
+}
+
+func (m *QueryFeeTokenPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeeTokenPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBlockFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryBlockFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BlockFees.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFeeHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ToHeight))
+	}
+	return n
+}
+
+func (m *QueryFeeHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.BaseFee = &v
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNextBaseFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextBaseFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNextBaseFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextBaseFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.BaseFee = &v
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockGasRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockGasRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockGasRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockGasResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockGasResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlobBaseFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlobBaseFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlobBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryBaseFeeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BaseFee != nil {
-		l = m.BaseFee.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryBlockGasRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBlobBaseFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlobBaseFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlobBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.BlobBaseFee = &v
+			if err := m.BlobBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcessBlobGas", wireType)
+			}
+			m.ExcessBlobGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExcessBlobGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryBlockGasResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Gas != 0 {
-		n += 1 + sovQuery(uint64(m.Gas))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeTokenPricesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -745,10 +2527,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeTokenPricesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeTokenPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -772,7 +2554,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeTokenPricesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -795,15 +2577,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeTokenPricesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeTokenPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -830,7 +2612,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Prices = append(m.Prices, FeePayment{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -855,7 +2638,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBlockFeesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -878,12 +2661,31 @@ func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBaseFeeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlockFeesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlockFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -905,7 +2707,7 @@ func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBlockFeesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -928,17 +2730,17 @@ func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBaseFeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlockFeesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlockFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockFees", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -948,25 +2750,22 @@ func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.BaseFee = &v
-			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BlockFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -991,7 +2790,7 @@ func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlockGasRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1014,12 +2813,50 @@ func (m *QueryBlockGasRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlockGasRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlockGasRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1041,7 +2878,7 @@ func (m *QueryBlockGasRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlockGasResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1064,17 +2901,17 @@ func (m *QueryBlockGasResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlockGasResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlockGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			m.Gas = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1084,11 +2921,26 @@ func (m *QueryBlockGasResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Gas |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, FeeHistoryEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])